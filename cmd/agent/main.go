@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"reflect"
 	"runtime"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
@@ -24,17 +27,23 @@ import (
 	"github.com/kubeadapt/kubeadapt-agent/internal/cloud"
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector"
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector/gpu"
+	"github.com/kubeadapt/kubeadapt-agent/internal/collector/kubelet"
 	collectormetrics "github.com/kubeadapt/kubeadapt-agent/internal/collector/metrics"
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector/resource"
 	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/discovery"
 	"github.com/kubeadapt/kubeadapt-agent/internal/enrichment"
 	"github.com/kubeadapt/kubeadapt-agent/internal/errors"
 	"github.com/kubeadapt/kubeadapt-agent/internal/health"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+	"github.com/kubeadapt/kubeadapt-agent/internal/rightsizing"
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
 	"github.com/kubeadapt/kubeadapt-agent/internal/snapshot"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
 	"github.com/kubeadapt/kubeadapt-agent/internal/transport"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // Version is set at build time via ldflags: -X main.Version=<value>
@@ -42,13 +51,23 @@ var Version = "dev"
 
 func main() {
 	// 1. Load and validate config.
-	cfg := config.Load()
+	cfg, err := config.LoadWithFile()
+	if err != nil {
+		slog.Error("invalid configuration", "error", err)
+		os.Exit(1)
+	}
 	cfg.AgentVersion = config.ResolveAgentVersion(Version)
 	if err := cfg.Validate(); err != nil {
 		slog.Error("invalid configuration", "error", err)
 		os.Exit(1)
 	}
 
+	// The level is a LevelVar so a config file reload can change it.
+	var logLevel slog.LevelVar
+	level, _ := cfg.SlogLevel() // validated above
+	logLevel.Set(level)
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: &logLevel})))
+
 	// 2. Create context with signal handling.
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -78,6 +97,7 @@ func main() {
 	restCfg := buildKubeConfig()
 	kubeClient := kubernetes.NewForConfigOrDie(restCfg)
 	dynamicClient := dynamic.NewForConfigOrDie(restCfg)
+	metadataClient := metadata.NewForConfigOrDie(restCfg)
 	metricsClient := metricsclientset.NewForConfigOrDie(restCfg)
 
 	// 5. Detect cluster capabilities.
@@ -91,6 +111,7 @@ func main() {
 		"vpa", caps.VPA,
 		"karpenter", caps.Karpenter,
 		"dcgm_exporter", caps.DCGMExporter,
+		"gpu_exporters", len(caps.GPUExporters),
 		"provider", caps.Provider,
 	)
 
@@ -113,82 +134,257 @@ func main() {
 	registry := collector.NewRegistry()
 	resync := cfg.InformerResyncPeriod
 
-	registry.Register(resource.NewNodeCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewPodCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewNamespaceCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewDeploymentCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewStatefulSetCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewDaemonSetCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewReplicaSetCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewJobCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewCronJobCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewHPACollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewPDBCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewServiceCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewIngressCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewPVCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewPVCCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewStorageClassCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewPriorityClassCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewLimitRangeCollector(kubeClient, st, metrics, resync))
-	registry.Register(resource.NewResourceQuotaCollector(kubeClient, st, metrics, resync))
+	// Namespaces outside the scope are filtered by the informers where the
+	// API allows it and by the snapshot builder otherwise.
+	nsScope, err := scope.NewNamespaces(cfg.NamespaceInclude, cfg.NamespaceExclude, cfg.NamespaceLabelSelector)
+	if err != nil {
+		slog.Error("invalid namespace scope", "error", err)
+		os.Exit(1)
+	}
+	// Labels and annotations are redacted as objects are converted, before
+	// they reach the store; names are hashed by the snapshot builder.
+	redaction, err := redact.NewPolicy(cfg.RedactionRules())
+	if err != nil {
+		slog.Error("invalid redaction policy", "error", err)
+		os.Exit(1)
+	}
+	convert.SetRedactionPolicy(redaction)
+
+	// The label selector sees the namespace labels before redaction.
+	inScope := func(namespace string) bool {
+		nsLabels, _ := st.NamespaceLabels.Get(namespace)
+		return nsScope.Allows(namespace, nsLabels)
+	}
+
+	// Typed collectors share one informer factory so every resource type is
+	// watched and cached once; ReplicaSets and Namespaces only need metadata.
+	// Namespaced workloads come from a second factory restricted to the
+	// namespace scope. Pods and PVCs stay unrestricted because they feed the
+	// cluster totals, and Events because node events live in "default".
+	factory := resource.NewInformerFactory(kubeClient, resync)
+	scopedFactory := resource.NewScopedInformerFactory(kubeClient, resync, nsScope)
+	metadataFactory := resource.NewMetadataInformerFactory(metadataClient, resync)
 
+	registry.Register(resource.NewNodeCollector(factory, st, metrics))
+	registry.Register(resource.NewPodCollector(factory, st, metrics))
+	registry.Register(resource.NewNamespaceCollector(metadataFactory, st, metrics))
+	registry.Register(resource.NewDeploymentCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewStatefulSetCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewDaemonSetCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewReplicaSetCollector(metadataFactory, st, metrics))
+	registry.Register(resource.NewJobCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewCronJobCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewHPACollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewPDBCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewServiceCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewIngressCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewPVCollector(factory, st, metrics))
+	registry.Register(resource.NewPVCCollector(factory, st, metrics))
+	registry.Register(resource.NewStorageClassCollector(factory, st, metrics))
+	registry.Register(resource.NewPriorityClassCollector(factory, st, metrics))
+	registry.Register(resource.NewLimitRangeCollector(scopedFactory, st, metrics))
+	registry.Register(resource.NewResourceQuotaCollector(scopedFactory, st, metrics))
+	if cfg.EventsEnabled {
+		// Without the grant the informer would never sync and startup would
+		// wait out the full sync timeout.
+		allowed, err := discovery.CanListWatch(ctx, kubeClient, "events.k8s.io", "events")
+		if err != nil || !allowed {
+			slog.Warn("events collection disabled: cannot list and watch events.k8s.io events, check RBAC", "error", err)
+			cfg.EventsEnabled = false
+		}
+	}
+	if cfg.EventsEnabled {
+		st.Events = store.NewEventRing(cfg.EventsBufferSize)
+		registry.Register(resource.NewEventCollector(factory, st, metrics))
+	}
+
+	// Pods and ReplicaSets owned by CRDs (Argo Rollouts, Spark, KServe, ...)
+	// are resolved to those owners; the collector discovers the kinds itself.
+	registry.Register(resource.NewCustomWorkloadCollector(dynamicClient, kubeClient.Discovery(), st, metrics, resync))
+
+	// VPA and NodePool collectors can be toggled at runtime by backend
+	// directives, but only when their CRDs exist in the cluster.
 	if caps.VPA {
-		registry.Register(resource.NewVPACollector(dynamicClient, st, metrics, resync))
+		registry.RegisterOptional("vpas", func() collector.Collector {
+			return resource.NewVPACollector(dynamicClient, st, metrics, resync)
+		}, st.VPAs.Clear, true)
 	}
 	if caps.Karpenter {
-		registry.Register(resource.NewNodePoolCollector(dynamicClient, st, metrics, resync))
+		registry.RegisterOptional("nodepools", func() collector.Collector {
+			return resource.NewNodePoolCollector(dynamicClient, st, metrics, resync)
+		}, st.NodePools.Clear, true)
+	}
+	// Polling collectors are kept for config reloads; nil when not registered.
+	var (
+		mc                *collectormetrics.MetricsCollector
+		summaryCollector  *kubelet.SummaryCollector
+		cadvisorCollector *kubelet.CAdvisorCollector
+		gpuCollector      *gpu.GPUMetricsCollector
+	)
+	if metricsAPI := newMetricsAPI(cfg, caps.MetricsServer, metricsClient); metricsAPI != nil {
+		mc = collectormetrics.NewMetricsCollector(metricsAPI, ms, metrics, cfg.MetricsInterval, cfg.MetricsSampleInterval)
+		if nsScope != nil {
+			mc.SetNamespaceFilter(inScope)
+		}
+		registry.Register(mc)
 	}
-	if caps.MetricsServer {
-		registry.Register(collectormetrics.NewMetricsCollectorFromClient(
-			metricsClient.MetricsV1beta1(), ms, metrics, cfg.MetricsInterval,
-		))
+	if cfg.KubeletStatsEnabled || cfg.CAdvisorMetricsEnabled {
+		// Node names are read from the node store on each poll, so nodes
+		// joining or leaving are picked up without a restart.
+		nodeNames := func() []string {
+			names := make([]string, 0, st.Nodes.Len())
+			st.Nodes.Range(func(name string, _ model.NodeInfo) bool {
+				names = append(names, name)
+				return true
+			})
+			return names
+		}
+		kubeletClient := kubelet.NewNodeProxyClient(kubeClient)
+		if cfg.KubeletStatsEnabled {
+			summaryCollector = kubelet.NewSummaryCollector(
+				kubeletClient, nodeNames, ms, metrics,
+				cfg.MetricsInterval, cfg.KubeletStatsConcurrency,
+			)
+			registry.Register(summaryCollector)
+		}
+		if cfg.CAdvisorMetricsEnabled {
+			cadvisorCollector = kubelet.NewCAdvisorCollector(
+				kubeletClient, nodeNames, ms, metrics,
+				cfg.MetricsInterval, cfg.KubeletStatsConcurrency,
+			)
+			registry.Register(cadvisorCollector)
+		}
 	}
 
 	// 6b. Conditional GPU collector.
 	var gpuProvider snapshot.GPUMetricsProvider
-	if (caps.DCGMExporter || len(cfg.DCGMExporterEndpoints) > 0) && cfg.GPUMetricsEnabled {
-		gpuClient := gpu.NewDCGMExporterClient(&http.Client{Timeout: 10 * time.Second})
+	if (len(caps.GPUExporters) > 0 || len(cfg.DCGMExporterEndpoints) > 0) && cfg.GPUMetricsEnabled {
+		gpuClient := gpu.NewExporterClient(&http.Client{Timeout: 10 * time.Second})
 
-		var endpointsFn func() []string
+		var endpointsFn func() []gpu.Endpoint
 		if len(cfg.DCGMExporterEndpoints) > 0 {
-			// Static endpoints from env override — no refresh needed.
+			// Static endpoints from env override — no refresh needed. Hosts
+			// without a port get the dcgm-exporter port.
 			staticEndpoints := cfg.DCGMExporterEndpoints
-			endpointsFn = func() []string {
-				urls := make([]string, 0, len(staticEndpoints))
-				for _, ip := range staticEndpoints {
-					urls = append(urls, fmt.Sprintf("http://%s:%d", ip, cfg.DCGMExporterPort))
+			endpointsFn = func() []gpu.Endpoint {
+				endpoints := make([]gpu.Endpoint, 0, len(staticEndpoints))
+				for _, host := range staticEndpoints {
+					if _, _, err := net.SplitHostPort(host); err != nil {
+						host = net.JoinHostPort(host, strconv.Itoa(cfg.DCGMExporterPort))
+					}
+					endpoints = append(endpoints, gpu.Endpoint{URL: "http://" + host})
 				}
-				return urls
+				return endpoints
 			}
 		} else {
-			// Dynamic discovery — re-detect dcgm-exporter pods on each poll.
-			endpointsFn = func() []string {
-				_, ips := discovery.DetectDCGMEndpoints(ctx, kubeClient)
-				urls := make([]string, 0, len(ips))
-				for _, ip := range ips {
-					urls = append(urls, fmt.Sprintf("http://%s:%d", ip, cfg.DCGMExporterPort))
+			// Dynamic discovery — re-detect exporter pods of every GPU vendor
+			// on each poll.
+			endpointsFn = func() []gpu.Endpoint {
+				exporters := discovery.DetectGPUExporters(ctx, kubeClient)
+				endpoints := make([]gpu.Endpoint, 0, len(exporters))
+				for _, e := range exporters {
+					port := e.Port
+					if e.Vendor == model.GPUVendorNVIDIA {
+						port = cfg.DCGMExporterPort
+					}
+					endpoints = append(endpoints, gpu.Endpoint{
+						URL:  "http://" + net.JoinHostPort(e.IP, strconv.Itoa(port)),
+						Node: e.Node,
+					})
 				}
-				return urls
+				return endpoints
 			}
 		}
 
-		gpuCollector := gpu.NewGPUMetricsCollector(gpuClient, endpointsFn, cfg.GPUMetricsInterval)
+		gpuCollector = gpu.NewGPUMetricsCollector(gpuClient, endpointsFn, cfg.GPUMetricsInterval)
 		registry.Register(gpuCollector)
 		gpuProvider = gpuCollector
 	}
 
 	// 7. Build enrichment pipeline and snapshot builder.
-	pipeline := enrichment.NewPipeline(metrics,
+	enrichers := []enrichment.Enricher{
 		enrichment.NewAggregationEnricher(),
 		enrichment.NewTargetsEnricher(),
 		enrichment.NewMountsEnricher(),
-	)
+	}
+	if cfg.RightsizingEnabled {
+		enrichers = append(enrichers, enrichment.NewRightsizingEnricher(rightsizing.NewHistory(cfg.RightsizingWindow)))
+	}
+	pipeline := enrichment.NewPipeline(metrics, enrichers...)
 	builder := snapshot.NewSnapshotBuilder(st, ms, &cfg, metrics, errCollector, pipeline, gpuProvider, cloudMeta.AccountID)
 
 	// 8. Create transport and agent.
+	tlsConfig, err := transport.NewTLSConfig(&cfg)
+	if err != nil {
+		slog.Error("failed to load backend TLS configuration", "error", err)
+		os.Exit(1)
+	}
 	transportClient := transport.NewClient(&cfg, metrics, errCollector)
+	transportClient.SetTLSConfig(tlsConfig)
+	if cfg.BufferDir != "" {
+		spool, err := transport.NewSpool(cfg.BufferDir, cfg.BufferMaxBytes, metrics, errCollector)
+		if err != nil {
+			slog.Error("failed to open snapshot buffer, continuing without disk buffering", "error", err)
+		} else {
+			transportClient.SetSpool(spool)
+		}
+	}
 	ag := agent.NewAgent(&cfg, registry, builder, transportClient, sm, errCollector, metrics)
+	if cfg.LeaderElection {
+		le, err := agent.NewLeaderElector(kubeClient, &cfg, metrics)
+		if err != nil {
+			slog.Error("failed to set up leader election", "error", err)
+			os.Exit(1)
+		}
+		ag.SetLeaderElector(le)
+		slog.Info("leader election enabled",
+			"lease", cfg.PodNamespace+"/"+cfg.LeaderElectionLeaseName,
+			"identity", cfg.PodName,
+		)
+	}
+
+	// 8b. Watch the config file and apply the fields that can change
+	// without a restart.
+	if cfg.ConfigFile != "" {
+		watcher := config.NewWatcher(cfg, func(prev, next config.Config) {
+			level, _ := next.SlogLevel() // validated by the watcher
+			logLevel.Set(level)
+
+			if next.SnapshotInterval != prev.SnapshotInterval {
+				ag.SetSnapshotInterval(next.SnapshotInterval)
+			}
+			if mc != nil && (next.MetricsInterval != prev.MetricsInterval || next.MetricsSampleInterval != prev.MetricsSampleInterval) {
+				mc.SetIntervals(next.MetricsInterval, next.MetricsSampleInterval)
+			}
+			if next.MetricsInterval != prev.MetricsInterval {
+				if summaryCollector != nil {
+					summaryCollector.SetInterval(next.MetricsInterval)
+				}
+				if cadvisorCollector != nil {
+					cadvisorCollector.SetInterval(next.MetricsInterval)
+				}
+			}
+			if gpuCollector != nil && next.GPUMetricsInterval != prev.GPUMetricsInterval {
+				gpuCollector.SetInterval(next.GPUMetricsInterval)
+			}
+
+			// Stored objects are converted again from the informer caches so
+			// a new label and annotation policy applies to the next snapshot.
+			if reflect.DeepEqual(next.RedactionRules(), prev.RedactionRules()) {
+				return
+			}
+			policy, err := redact.NewPolicy(next.RedactionRules())
+			if err != nil {
+				slog.Error("config reload: invalid redaction policy", "error", err)
+				return
+			}
+			convert.SetRedactionPolicy(policy)
+			builder.SetRedaction(policy)
+			registry.ReconvertAll()
+		})
+		go watcher.Run(ctx)
+		slog.Info("watching config file", "path", cfg.ConfigFile)
+	}
 
 	// 9. Start health server.
 	healthSrv := health.NewServer(cfg.HealthPort, metrics, ag, ag, st, cfg.DebugEndpoints)
@@ -241,3 +437,36 @@ func buildKubeConfig() *rest.Config {
 	slog.Info("using kubeconfig file", "path", kubeconfig)
 	return cfg
 }
+
+// newMetricsAPI returns the usage source selected by KUBEADAPT_METRICS_SOURCE,
+// or nil when it is not available. In auto mode metrics-server wins when the
+// cluster serves metrics.k8s.io.
+func newMetricsAPI(cfg config.Config, metricsServer bool, metricsClient metricsclientset.Interface) collectormetrics.MetricsAPI {
+	source := cfg.MetricsSource
+	if source == config.MetricsSourceAuto || source == "" {
+		switch {
+		case metricsServer:
+			source = config.MetricsSourceMetricsServer
+		case cfg.PrometheusURL != "":
+			source = config.MetricsSourcePrometheus
+		}
+	}
+
+	switch {
+	case source == config.MetricsSourceMetricsServer && metricsServer:
+		slog.Info("collecting usage metrics", "source", source)
+		return collectormetrics.NewMetricsServerAPI(metricsClient.MetricsV1beta1())
+	case source == config.MetricsSourcePrometheus:
+		slog.Info("collecting usage metrics", "source", source, "url", cfg.PrometheusURL)
+		return collectormetrics.NewPrometheusAPI(&http.Client{Timeout: 30 * time.Second}, cfg.PrometheusURL, collectormetrics.PrometheusQueries{
+			NodeCPU:         cfg.PrometheusNodeCPUQuery,
+			NodeMemory:      cfg.PrometheusNodeMemoryQuery,
+			ContainerCPU:    cfg.PrometheusContainerCPUQuery,
+			ContainerMemory: cfg.PrometheusContainerMemQuery,
+		})
+	default:
+		slog.Warn("no usage metrics source available, snapshots will carry no usage",
+			"metrics_source", cfg.MetricsSource, "metrics_server", metricsServer, "prometheus_url", cfg.PrometheusURL)
+		return nil
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+)
+
+const convertHeader = `// Code generated by protogen from pkg/model. DO NOT EDIT.
+
+package modelpb
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+`
+
+// writeConverters renders the model <-> message conversion functions.
+func writeConverters(msgs []*message) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(convertHeader)
+
+	b.WriteString(`
+// FromModel converts a pointer to a pkg/model type mirrored by the schema to
+// its message. It returns nil for other types.
+func FromModel(v any) proto.Message {
+	switch m := v.(type) {
+`)
+	for _, m := range msgs {
+		fmt.Fprintf(&b, "case *model.%s:\nreturn %s(m)\n", m.name, fromFunc(m.name))
+	}
+	b.WriteString("}\nreturn nil\n}\n")
+
+	b.WriteString(`
+// ToModel converts a message to a pointer to its pkg/model type. It returns
+// nil for messages not generated from pkg/model.
+func ToModel(msg proto.Message) any {
+	switch p := msg.(type) {
+`)
+	for _, m := range msgs {
+		fmt.Fprintf(&b, "case *%s:\nreturn %s(p)\n", m.name, toFunc(m.name))
+	}
+	b.WriteString("}\nreturn nil\n}\n")
+
+	for _, m := range msgs {
+		fmt.Fprintf(&b, "\nfunc %s(m *model.%s) *%s {\nif m == nil {\nreturn nil\n}\nreturn &%s{\n",
+			fromFunc(m.name), m.name, m.name, m.name)
+		for _, f := range m.fields {
+			fmt.Fprintf(&b, "%s: %s,\n", f.pbGoName, fromExpr(f, "m."+f.goName))
+		}
+		b.WriteString("}\n}\n")
+
+		fmt.Fprintf(&b, "\nfunc %s(p *%s) *model.%s {\nif p == nil {\nreturn nil\n}\nreturn &model.%s{\n",
+			toFunc(m.name), m.name, m.name, m.name)
+		for _, f := range m.fields {
+			fmt.Fprintf(&b, "%s: %s,\n", f.goName, toExpr(f, "p."+f.pbGoName))
+		}
+		b.WriteString("}\n}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+func fromFunc(name string) string { return lowerFirst(name) + "FromModel" }
+func toFunc(name string) string   { return lowerFirst(name) + "ToModel" }
+
+func lowerFirst(s string) string {
+	return string(s[0]+'a'-'A') + s[1:]
+}
+
+// fromExpr converts the model field expression v to the message field type.
+func fromExpr(f field, v string) string {
+	switch f.kind {
+	case kindScalar:
+		if f.goType.Kind() == reflect.Int {
+			return "int64(" + v + ")"
+		}
+	case kindOptional:
+		if f.goType.Elem().Kind() == reflect.Int {
+			return "int64Ptr(" + v + ")"
+		}
+	case kindMessage:
+		if f.goType.Kind() == reflect.Struct {
+			return fromFunc(f.protoType) + "(&" + v + ")"
+		}
+		return fromFunc(f.protoType) + "(" + v + ")"
+	case kindRepeatedMsg:
+		return "fromModelSlice(" + v + ", " + fromFunc(f.protoType) + ")"
+	case kindMapScalar:
+		if f.goType.Elem().Kind() == reflect.Int {
+			return "int64Map(" + v + ")"
+		}
+	case kindMapStringList:
+		return "stringListMap(" + v + ")"
+	case kindStruct:
+		return "newStruct(" + v + ")"
+	}
+	return v
+}
+
+// toExpr converts the message field expression v to the model field type.
+func toExpr(f field, v string) string {
+	switch f.kind {
+	case kindScalar:
+		if f.goType.Kind() == reflect.Int {
+			return "int(" + v + ")"
+		}
+	case kindOptional:
+		if f.goType.Elem().Kind() == reflect.Int {
+			return "intPtr(" + v + ")"
+		}
+	case kindMessage:
+		if f.goType.Kind() == reflect.Struct {
+			return "deref(" + toFunc(f.protoType) + "(" + v + "))"
+		}
+		return toFunc(f.protoType) + "(" + v + ")"
+	case kindRepeatedMsg:
+		return "toModelSlice(" + v + ", " + toFunc(f.protoType) + ")"
+	case kindMapScalar:
+		if f.goType.Elem().Kind() == reflect.Int {
+			return "intMap(" + v + ")"
+		}
+	case kindMapStringList:
+		return "stringSliceMap(" + v + ")"
+	case kindStruct:
+		return "structMap(" + v + ")"
+	}
+	return v
+}
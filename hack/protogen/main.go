@@ -0,0 +1,80 @@
+// Command protogen generates the protobuf wire schema of ClusterSnapshot
+// (proto/kubeadapt/agent/v2/snapshot.proto) and the pkg/modelpb converters
+// from the pkg/model types. Field numbers already in the schema are kept, so
+// regenerating after a model change stays wire compatible.
+//
+// Run from the repository root via `make proto`, which then runs protoc with
+// protoc-gen-go on the schema. With -check it only reports whether the
+// checked-in files are up to date.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+const (
+	protoPath   = "proto/kubeadapt/agent/v2/snapshot.proto"
+	convertPath = "pkg/modelpb/convert.go"
+)
+
+func main() {
+	check := flag.Bool("check", false, "fail if the generated files are out of date instead of writing them")
+	flag.Parse()
+
+	if err := run(*check); err != nil {
+		fmt.Fprintln(os.Stderr, "protogen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(check bool) error {
+	proto, convert, err := generate(protoPath)
+	if err != nil {
+		return err
+	}
+	for path, want := range map[string][]byte{protoPath: proto, convertPath: convert} {
+		if check {
+			got, err := os.ReadFile(path)
+			if err != nil || !bytes.Equal(got, want) {
+				return fmt.Errorf("%s is out of date; run make proto", path)
+			}
+			continue
+		}
+		if err := os.WriteFile(path, want, 0o644); err != nil { //nolint:gosec // generated source
+			return err
+		}
+	}
+	return nil
+}
+
+// generate returns the schema and converters, numbering fields after the
+// schema at protoFile if it exists.
+func generate(protoFile string) (proto, convert []byte, err error) {
+	prev := map[string]*message{}
+	f, err := os.Open(protoFile)
+	switch {
+	case err == nil:
+		prev, err = readProto(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, nil, err
+	}
+
+	msgs, err := buildSchema(prev)
+	if err != nil {
+		return nil, nil, err
+	}
+	convert, err = writeConverters(msgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return writeProto(msgs), convert, nil
+}
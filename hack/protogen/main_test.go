@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerated_UpToDate fails when pkg/model changed without `make proto`.
+func TestGenerated_UpToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	proto, convert, err := generate(filepath.Join(root, protoPath))
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	for path, want := range map[string][]byte{protoPath: proto, convertPath: convert} {
+		got, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run make proto", path)
+		}
+	}
+}
+
+func TestNumber_KeepsAndReservesNumbers(t *testing.T) {
+	prev, err := readProto(strings.NewReader(`
+message PodInfo {
+  string name = 5;
+  string gone = 2;
+  reserved 7;
+}
+`))
+	if err != nil {
+		t.Fatalf("readProto: %v", err)
+	}
+	msg := &message{name: "PodInfo", fields: []field{{name: "uid"}, {name: "name"}}}
+	number(msg, prev["PodInfo"])
+
+	if msg.fields[1].number != 5 {
+		t.Errorf("name = %d, want its previous number 5", msg.fields[1].number)
+	}
+	if msg.fields[0].number != 8 {
+		t.Errorf("uid = %d, want 8, after every used and reserved number", msg.fields[0].number)
+	}
+	if len(msg.reserved) != 2 || msg.reserved[0] != 2 || msg.reserved[1] != 7 {
+		t.Errorf("reserved = %v, want [2 7]", msg.reserved)
+	}
+}
+
+func TestGoCamelCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"snapshot_id":      "SnapshotId",
+		"cpu_p95":          "CpuP95",
+		"gpu_3g_profile":   "Gpu_3GProfile", // "_" before a digit is kept
+		"x509_cert":        "X509Cert",
+		"statefulsets":     "Statefulsets",
+		"memory_usage_p99": "MemoryUsageP99",
+	} {
+		if got := goCamelCase(in); got != want {
+			t.Errorf("goCamelCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
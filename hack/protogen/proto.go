@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const protoHeader = `// Code generated by protogen from pkg/model. DO NOT EDIT.
+//
+// The wire schema of ClusterSnapshot for X-Kubeadapt-Protocol v2. Field names
+// are the JSON names of pkg/model; field numbers are stable across
+// regeneration and the numbers of removed fields are reserved.
+
+syntax = "proto3";
+
+package kubeadapt.agent.v2;
+
+import "google/protobuf/struct.proto";
+
+option go_package = "github.com/kubeadapt/kubeadapt-agent/pkg/modelpb";
+`
+
+// writeProto renders the schema as a .proto file.
+func writeProto(msgs []*message) []byte {
+	var b bytes.Buffer
+	b.WriteString(protoHeader)
+	for _, m := range msgs {
+		fmt.Fprintf(&b, "\nmessage %s {\n", m.name)
+		for _, f := range m.fields {
+			fmt.Fprintf(&b, "  %s %s = %d;\n", protoFieldType(f), f.name, f.number)
+		}
+		if len(m.reserved) > 0 {
+			nums := make([]string, len(m.reserved))
+			for i, n := range m.reserved {
+				nums[i] = strconv.Itoa(n)
+			}
+			fmt.Fprintf(&b, "  reserved %s;\n", strings.Join(nums, ", "))
+		}
+		b.WriteString("}\n")
+	}
+	fmt.Fprintf(&b, "\n// %s wraps a list of strings as a map value.\nmessage %s {\n  repeated string values = 1;\n}\n",
+		stringListMessage, stringListMessage)
+	return b.Bytes()
+}
+
+func protoFieldType(f field) string {
+	switch f.kind {
+	case kindOptional:
+		return "optional " + f.protoType
+	case kindRepeatedScalar, kindRepeatedMsg:
+		return "repeated " + f.protoType
+	case kindMapScalar, kindMapStringList:
+		return "map<string, " + f.protoType + ">"
+	default:
+		return f.protoType
+	}
+}
+
+var (
+	messageLine  = regexp.MustCompile(`^message (\w+) \{$`)
+	fieldLine    = regexp.MustCompile(`^\s+(?:optional |repeated )?(?:map<[^>]+>|[\w.]+) (\w+) = (\d+);$`)
+	reservedLine = regexp.MustCompile(`^\s+reserved ([\d, ]+);$`)
+)
+
+// readProto recovers the field numbers and reserved numbers of a .proto
+// file written by writeProto.
+func readProto(r io.Reader) (map[string]*message, error) {
+	msgs := make(map[string]*message)
+	var cur *message
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if m := messageLine.FindStringSubmatch(line); m != nil {
+			cur = &message{name: m[1]}
+			msgs[cur.name] = cur
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if m := fieldLine.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[2])
+			cur.fields = append(cur.fields, field{name: m[1], number: n})
+			continue
+		}
+		if m := reservedLine.FindStringSubmatch(line); m != nil {
+			for s := range strings.SplitSeq(m[1], ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(s))
+				if err != nil {
+					return nil, fmt.Errorf("message %s: bad reserved number %q", cur.name, s)
+				}
+				cur.reserved = append(cur.reserved, n)
+			}
+		}
+	}
+	return msgs, sc.Err()
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// kind is how a model field maps onto the schema.
+type kind int
+
+const (
+	kindScalar         kind = iota // string, bool, int*, uint64, float64
+	kindOptional                   // pointer to a scalar
+	kindMessage                    // struct or pointer to struct
+	kindRepeatedScalar             // []scalar
+	kindRepeatedMsg                // []struct
+	kindMapScalar                  // map[string]scalar
+	kindMapStringList              // map[string][]string
+	kindStruct                     // map[string]any, as google.protobuf.Struct
+)
+
+// message is a schema message mirroring one model struct.
+type message struct {
+	name     string
+	goType   reflect.Type
+	fields   []field
+	reserved []int
+}
+
+// field is one message field mirroring a model struct field.
+type field struct {
+	name      string // proto field name, the model field's JSON name
+	goName    string // model struct field name
+	pbGoName  string // field name in the protoc-gen-go generated struct
+	number    int
+	kind      kind
+	protoType string       // scalar type, or message name for messages
+	goType    reflect.Type // model field type
+}
+
+// stringListMessage wraps []string as a map value.
+const stringListMessage = "StringList"
+
+// scalarTypes maps model scalar kinds to proto scalar types. int is widened
+// to int64.
+var scalarTypes = map[reflect.Kind]string{
+	reflect.String:  "string",
+	reflect.Bool:    "bool",
+	reflect.Int:     "int64",
+	reflect.Int32:   "int32",
+	reflect.Int64:   "int64",
+	reflect.Uint64:  "uint64",
+	reflect.Float64: "double",
+}
+
+// buildSchema walks the model types reachable from ClusterSnapshot, in field
+// order, and numbers their fields. Fields present in prev keep their number;
+// numbers of removed fields are reserved.
+func buildSchema(prev map[string]*message) ([]*message, error) {
+	var msgs []*message
+	seen := make(map[reflect.Type]bool)
+	queue := []reflect.Type{reflect.TypeFor[model.ClusterSnapshot]()}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+
+		msg := &message{name: t.Name(), goType: t}
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if !sf.IsExported() || tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "" {
+				return nil, fmt.Errorf("%s.%s: missing JSON name", t.Name(), sf.Name)
+			}
+			f, next, err := classify(sf.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", t.Name(), sf.Name, err)
+			}
+			f.name, f.goName, f.pbGoName, f.goType = name, sf.Name, goCamelCase(name), sf.Type
+			msg.fields = append(msg.fields, f)
+			if next != nil {
+				queue = append(queue, next)
+			}
+		}
+		number(msg, prev[msg.name])
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// classify maps a model field type to its schema kind. It returns the struct
+// type to generate a message for, if any.
+func classify(t reflect.Type) (field, reflect.Type, error) {
+	if pt, ok := scalarTypes[t.Kind()]; ok {
+		return field{kind: kindScalar, protoType: pt}, nil, nil
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return field{kind: kindMessage, protoType: t.Name()}, t, nil
+	case reflect.Pointer:
+		e := t.Elem()
+		if e.Kind() == reflect.Struct {
+			return field{kind: kindMessage, protoType: e.Name()}, e, nil
+		}
+		if pt, ok := scalarTypes[e.Kind()]; ok {
+			return field{kind: kindOptional, protoType: pt}, nil, nil
+		}
+	case reflect.Slice:
+		e := t.Elem()
+		if e.Kind() == reflect.Struct {
+			return field{kind: kindRepeatedMsg, protoType: e.Name()}, e, nil
+		}
+		// Only slices whose elements need no conversion are supported.
+		if e.Kind() == reflect.String {
+			return field{kind: kindRepeatedScalar, protoType: "string"}, nil, nil
+		}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			break
+		}
+		e := t.Elem()
+		if pt, ok := scalarTypes[e.Kind()]; ok {
+			return field{kind: kindMapScalar, protoType: pt}, nil, nil
+		}
+		if e.Kind() == reflect.Slice && e.Elem().Kind() == reflect.String {
+			return field{kind: kindMapStringList, protoType: stringListMessage}, nil, nil
+		}
+		if e.Kind() == reflect.Interface && e.NumMethod() == 0 {
+			return field{kind: kindStruct, protoType: "google.protobuf.Struct"}, nil, nil
+		}
+	}
+	return field{}, nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// number assigns field numbers, keeping those of prev and reserving the
+// numbers of fields that are gone.
+func number(msg, prev *message) {
+	next := 1
+	used := make(map[string]int)
+	if prev != nil {
+		for _, f := range prev.fields {
+			used[f.name] = f.number
+			next = max(next, f.number+1)
+		}
+		msg.reserved = append(msg.reserved, prev.reserved...)
+		for _, n := range prev.reserved {
+			next = max(next, n+1)
+		}
+	}
+	for i := range msg.fields {
+		f := &msg.fields[i]
+		if n, ok := used[f.name]; ok {
+			f.number = n
+			delete(used, f.name)
+			continue
+		}
+		f.number = next
+		next++
+	}
+	for _, n := range used {
+		msg.reserved = append(msg.reserved, n)
+	}
+	slices.Sort(msg.reserved)
+}
+
+// goCamelCase is the field name protoc-gen-go derives from a proto field
+// name (google.golang.org/protobuf/internal/strs.GoCamelCase).
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// Skip over '_' in "_{{lowercase}}".
+		case c >= '0' && c <= '9':
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isLower(c byte) bool { return c >= 'a' && c <= 'z' }
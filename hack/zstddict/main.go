@@ -0,0 +1,235 @@
+// Command zstddict trains the zstd dictionary bundled with the agent
+// (internal/transport/snapshot.zdict). Samples are snapshot resources of
+// every kind, filled from a vocabulary of common Kubernetes names and
+// encoded in both wire formats, so the dictionary holds the field names,
+// label keys and values that repeat across clusters.
+//
+// Run from the repository root via `make dict`. The dictionary ID is fixed;
+// bump it whenever the dictionary changes, as the backend looks dictionaries
+// up by ID.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/klauspost/compress/dict"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/modelpb"
+)
+
+const (
+	dictPath = "internal/transport/snapshot.zdict"
+
+	// dictID identifies the dictionary to the backend.
+	dictID = 0x4b410001
+
+	// samplesPerKind is the number of resources generated per snapshot list.
+	samplesPerKind = 64
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "zstddict:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	samples, err := buildSamples(rand.New(rand.NewPCG(1, 2))) //nolint:gosec // deterministic samples
+	if err != nil {
+		return err
+	}
+	d, err := dict.BuildZstdDict(samples, dict.Options{
+		MaxDictSize: 64 << 10,
+		HashBytes:   6,
+		ZstdDictID:  dictID,
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dictPath, d, 0o644) //nolint:gosec // checked-in asset
+}
+
+// buildSamples encodes samplesPerKind resources of every ClusterSnapshot
+// list as JSON and as protobuf.
+func buildSamples(rng *rand.Rand) ([][]byte, error) {
+	var samples [][]byte
+	t := reflect.TypeFor[model.ClusterSnapshot]()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if sf.Type.Kind() != reflect.Slice || sf.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		for range samplesPerKind {
+			v := reflect.New(sf.Type.Elem())
+			fill(v.Elem(), "", rng)
+			raw, err := json.Marshal(v.Interface())
+			if err != nil {
+				return nil, err
+			}
+			samples = append(samples, raw)
+			if msg := modelpb.FromModel(v.Interface()); msg != nil {
+				raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+				if err != nil {
+					return nil, err
+				}
+				samples = append(samples, raw)
+			}
+		}
+	}
+	return samples, nil
+}
+
+// fill sets v to a plausible value. name is the JSON name of the field v is
+// in; it picks the vocabulary strings are drawn from.
+func fill(v reflect.Value, name string, rng *rand.Rand) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(word(name, rng))
+	case reflect.Bool:
+		v.SetBool(rng.IntN(4) > 0)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		switch {
+		case strings.Contains(name, "timestamp") || strings.HasSuffix(name, "_at"):
+			v.SetInt(1_700_000_000_000 + rng.Int64N(100_000_000_000))
+		case strings.Contains(name, "bytes"):
+			v.SetInt(int64(rng.IntN(64)+1) << 26)
+		default:
+			v.SetInt(int64(rng.IntN(10)))
+		}
+	case reflect.Uint64:
+		v.SetUint(uint64(rng.IntN(1000)))
+	case reflect.Float64:
+		v.SetFloat(float64(rng.IntN(4000)) / 1000)
+	case reflect.Pointer:
+		if rng.IntN(2) == 0 {
+			v.Set(reflect.New(v.Type().Elem()))
+			fill(v.Elem(), name, rng)
+		}
+	case reflect.Slice:
+		n := rng.IntN(3) + 1
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := range n {
+			fill(v.Index(i), name, rng)
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() == reflect.Interface {
+			return
+		}
+		v.Set(reflect.MakeMap(v.Type()))
+		for range rng.IntN(4) + 1 {
+			key, val := label(rng)
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if elem.Kind() == reflect.String {
+				elem.SetString(val)
+			} else {
+				fill(elem, name, rng)
+			}
+			v.SetMapIndex(reflect.ValueOf(key), elem)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			fname, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+			fill(v.Field(i), fname, rng)
+		}
+	}
+}
+
+// vocabularies maps a substring of a field name to values common in
+// Kubernetes clusters. The first match wins.
+var vocabularies = []struct {
+	match  string
+	values []string
+}{
+	{"namespace", []string{"default", "kube-system", "monitoring", "ingress-nginx", "cert-manager", "production", "staging"}},
+	{"image", []string{"nginx:1.25", "registry.k8s.io/pause:3.9", "quay.io/prometheus/node-exporter:v1.7.0", "docker.io/library/redis:7", "ghcr.io/kubeadapt/agent:v2.0.0", "public.ecr.aws/eks-distro/kubernetes/kube-proxy:v1.29.0"}},
+	{"owner_kind", []string{"ReplicaSet", "StatefulSet", "DaemonSet", "Job", "Deployment", "CronJob", "Node"}},
+	{"kind", []string{"Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job", "CronJob", "Pod", "Node"}},
+	{"phase", []string{"Running", "Pending", "Succeeded", "Failed", "Bound", "Active"}},
+	{"qos", []string{"Burstable", "BestEffort", "Guaranteed"}},
+	{"type", []string{"Ready", "PodScheduled", "ContainersReady", "Initialized", "MemoryPressure", "DiskPressure", "ClusterIP", "LoadBalancer", "Resource", "Utilization"}},
+	{"status", []string{"True", "False", "Unknown"}},
+	{"reason", []string{"KubeletReady", "Scheduled", "Pulled", "Created", "Started", "BackOff", "OOMKilled", "Completed", "FailedScheduling"}},
+	{"state", []string{"running", "waiting", "terminated"}},
+	{"protocol", []string{"TCP", "UDP"}},
+	{"instance_type", []string{"m5.xlarge", "m6i.2xlarge", "c5.large", "n2-standard-4", "Standard_D4s_v5"}},
+	{"region", []string{"us-east-1", "eu-west-1", "us-central1", "westeurope"}},
+	{"zone", []string{"us-east-1a", "us-east-1b", "eu-west-1c", "us-central1-a"}},
+	{"capacity_type", []string{"on-demand", "spot"}},
+	{"storage_class", []string{"gp3", "gp2", "standard", "premium-rwo"}},
+	{"effect", []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}},
+	{"strategy", []string{"RollingUpdate", "Recreate", "OnDelete"}},
+	{"version", []string{"v1.29.1", "v1.30.4-eks-a737599", "v1.28.9-gke.1000000"}},
+	{"runtime", []string{"containerd://1.7.11", "containerd://1.6.28", "cri-o://1.29.1"}},
+	{"provider_id", []string{"aws:///us-east-1a/i-0123456789abcdef0", "gce://project/us-central1-a/gke-pool-1", "azure:///subscriptions/sub/resourceGroups/rg"}},
+	{"architecture", []string{"amd64", "arm64"}},
+	{"os", []string{"linux", "windows"}},
+	{"uid", nil},
+	{"", []string{"app", "web", "api", "worker", "frontend", "backend", "coredns", "kube-proxy", "aws-node", "ebs-csi-node", "metrics-server", "prometheus", "grafana", "redis", "postgres"}},
+}
+
+// word returns a value for a field named name.
+func word(name string, rng *rand.Rand) string {
+	for _, v := range vocabularies {
+		if !strings.Contains(name, v.match) {
+			continue
+		}
+		if v.values == nil {
+			// UIDs never repeat; a random one teaches the shape only.
+			return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x", rng.Uint32(), rng.Uint32()&0xffff,
+				rng.Uint32()&0xffff, rng.Uint32()&0xffff, rng.Uint64()&0xffffffffffff)
+		}
+		w := v.values[rng.IntN(len(v.values))]
+		if v.match == "" && rng.IntN(2) == 0 {
+			w += "-" + strconv.Itoa(rng.IntN(100))
+		}
+		return w
+	}
+	return ""
+}
+
+// labels are common label and annotation keys with typical values.
+var labels = [][2]string{
+	{"app", "web"},
+	{"app.kubernetes.io/name", "api"},
+	{"app.kubernetes.io/instance", "api-production"},
+	{"app.kubernetes.io/component", "server"},
+	{"app.kubernetes.io/part-of", "platform"},
+	{"app.kubernetes.io/managed-by", "Helm"},
+	{"app.kubernetes.io/version", "1.4.2"},
+	{"helm.sh/chart", "api-1.4.2"},
+	{"pod-template-hash", "7d9f8b6c5d"},
+	{"controller-revision-hash", "5c8d7f9b4"},
+	{"kubernetes.io/arch", "amd64"},
+	{"kubernetes.io/os", "linux"},
+	{"kubernetes.io/hostname", "ip-10-0-1-23.ec2.internal"},
+	{"node.kubernetes.io/instance-type", "m5.xlarge"},
+	{"topology.kubernetes.io/region", "us-east-1"},
+	{"topology.kubernetes.io/zone", "us-east-1a"},
+	{"eks.amazonaws.com/nodegroup", "default-pool"},
+	{"eks.amazonaws.com/capacityType", "ON_DEMAND"},
+	{"karpenter.sh/nodepool", "default"},
+	{"karpenter.sh/capacity-type", "spot"},
+	{"cloud.google.com/gke-nodepool", "default-pool"},
+	{"kubernetes.azure.com/agentpool", "nodepool1"},
+	{"deployment.kubernetes.io/revision", "3"},
+	{"kubectl.kubernetes.io/restartedAt", "2024-01-15T10:00:00Z"},
+}
+
+func label(rng *rand.Rand) (string, string) {
+	l := labels[rng.IntN(len(labels))]
+	return l[0], l[1]
+}
@@ -23,10 +23,12 @@ type Agent struct {
 	config         *config.Config
 	registry       *collector.Registry
 	builder        *snapshot.SnapshotBuilder
+	delta          *snapshot.DeltaTracker
 	transport      *transport.Client
 	stateMachine   *StateMachine
 	errorCollector *errors.ErrorCollector
 	metrics        *observability.Metrics
+	leader         *LeaderElector // nil when leader election is disabled
 
 	latestSnapshot atomic.Pointer[model.ClusterSnapshot]
 	ready          atomic.Bool
@@ -40,8 +42,34 @@ type Agent struct {
 	prevSnapshotsFailed uint64
 	lastBuildMs         int64
 	lastSendMs          int64
+
+	// Snapshot cadence driven by backend directives (main loop goroutine only).
+	// interval is the steady-state period; nextDelay, when non-zero, overrides
+	// it for the next tick only.
+	interval  time.Duration
+	nextDelay time.Duration
+
+	// collectorFlags holds the last collect_* value the backend sent per
+	// collector (main loop goroutine only).
+	collectorFlags map[string]bool
+
+	// intervalCh carries SetSnapshotInterval changes to the main loop.
+	intervalCh chan time.Duration
 }
 
+// Bounds applied to backend-requested snapshot intervals so a bad directive
+// cannot hammer the backend or silence the agent.
+const (
+	minDirectiveInterval = 10 * time.Second
+	maxDirectiveInterval = time.Hour
+)
+
+// Names of the collectors the backend may toggle via directives.
+const (
+	vpaCollectorName      = "vpas"
+	nodePoolCollectorName = "nodepools"
+)
+
 // NewAgent creates an Agent with all required dependencies.
 func NewAgent(
 	cfg *config.Config,
@@ -56,14 +84,36 @@ func NewAgent(
 		config:         cfg,
 		registry:       registry,
 		builder:        builder,
+		delta:          snapshot.NewDeltaTracker(cfg.DeltaKeyframeInterval),
 		transport:      transport,
 		stateMachine:   stateMachine,
 		errorCollector: errCollector,
 		metrics:        metrics,
 		startedAt:      time.Now(),
+		interval:       cfg.SnapshotInterval,
+		collectorFlags: make(map[string]bool),
+		intervalCh:     make(chan time.Duration, 1),
 	}
 }
 
+// SetSnapshotInterval changes the steady-state snapshot period, e.g. after a
+// config file reload. Safe to call from any goroutine; a later backend
+// directive still takes precedence.
+func (a *Agent) SetSnapshotInterval(d time.Duration) {
+	select {
+	case <-a.intervalCh: // replace a change the loop has not picked up yet
+	default:
+	}
+	a.intervalCh <- d
+}
+
+// SetLeaderElector enables leader election: the agent only sends snapshots
+// while it holds the lease and idles in StateStandby otherwise. Must be
+// called before Run.
+func (a *Agent) SetLeaderElector(le *LeaderElector) {
+	a.leader = le
+}
+
 // IsReady reports whether the agent has completed initial sync and is
 // actively collecting data. Implements health.ReadinessChecker.
 func (a *Agent) IsReady() bool {
@@ -103,10 +153,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	defer a.registry.StopAll()
 
 	// 2. Wait for initial sync (with configurable timeout).
-	syncTimeout := a.config.InformerSyncTimeout
-	if syncTimeout == 0 {
-		syncTimeout = 5 * time.Minute
-	}
+	syncTimeout := a.syncTimeout()
 	slog.Info("waiting for informer sync", "timeout", syncTimeout)
 
 	syncCtx, syncCancel := context.WithTimeout(ctx, syncTimeout)
@@ -133,24 +180,49 @@ func (a *Agent) Run(ctx context.Context) error {
 	}
 
 	// 2b. Log post-sync store diagnostics so operators can verify counts.
-	a.logStoreCounts(ctx)
-
-	// 3. Transition to Running.
-	a.stateMachine.TransitionTo(StateRunning, "informers synced")
+	a.logStoreCounts()
+
+	// 3. Transition to Running, or to Standby until this replica wins the
+	// lease. Campaigning starts only after sync so a newly elected leader
+	// always has warm informers; standbys are ready too.
+	var leaderCh <-chan struct{}
+	if a.leader != nil {
+		a.stateMachine.TransitionTo(StateStandby, "waiting for leadership")
+		leaderCh = a.leader.Changed()
+		go a.leader.Run(ctx)
+	} else {
+		a.stateMachine.TransitionTo(StateRunning, "informers synced")
+	}
 	a.ready.Store(true)
-	slog.Info("agent is ready", "state", StateRunning)
+	slog.Info("agent is ready", "state", a.stateMachine.State())
 
 	// 4. Main loop.
-	ticker := time.NewTicker(a.config.SnapshotInterval)
+	period := a.interval
+	ticker := time.NewTicker(period)
 	defer ticker.Stop()
 
 	// Do first snapshot immediately.
-	a.doSnapshot(ctx)
+	if a.stateMachine.State() == StateRunning {
+		a.doSnapshot(ctx)
+	}
+	period = a.rearmTicker(ticker, period)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-leaderCh:
+			if a.applyLeadership() {
+				// Send right away instead of waiting for the next tick so
+				// failover leaves no gap.
+				a.doSnapshot(ctx)
+				period = a.rearmTicker(ticker, period)
+			}
+			continue
+		case d := <-a.intervalCh:
+			a.setInterval(d)
+			period = a.rearmTicker(ticker, period)
+			continue
 		case <-ticker.C:
 		}
 
@@ -166,6 +238,8 @@ func (a *Agent) Run(ctx context.Context) error {
 				slog.Debug("in backoff, skipping snapshot",
 					"remaining", a.stateMachine.BackoffRemaining())
 			}
+		case StateStandby:
+			slog.Debug("standby, skipping snapshot", "leader", a.leader.CurrentLeader())
 		case StateStopped, StateExiting:
 			slog.Info("agent exiting", "state", state,
 				"reason", a.stateMachine.StateReason())
@@ -177,54 +251,174 @@ func (a *Agent) Run(ctx context.Context) error {
 				"reason", a.stateMachine.StateReason())
 			return nil
 		}
+
+		period = a.rearmTicker(ticker, period)
+	}
+}
+
+// applyLeadership moves between StateStandby and StateRunning after a
+// leadership change, and reports whether this replica was just elected.
+// Either way the next snapshot is a full keyframe: the backend's last
+// acknowledged snapshot may have come from the other replica, which also
+// reported the events seen in the meantime.
+func (a *Agent) applyLeadership() bool {
+	leader := a.leader.IsLeader()
+	switch state := a.stateMachine.State(); {
+	case leader && state == StateStandby:
+		a.stateMachine.TransitionTo(StateRunning, "acquired leadership")
+		a.delta.Reset()
+		a.builder.ResetEvents()
+		return true
+	case !leader && (state == StateRunning || state == StateBackoff):
+		a.stateMachine.TransitionTo(StateStandby, "lost leadership")
+		a.delta.Reset()
 	}
+	return false
 }
 
-func (a *Agent) logStoreCounts(ctx context.Context) {
-	snap := a.builder.Build(ctx)
-	slog.Info("post-sync store counts",
-		"nodes", len(snap.Nodes),
-		"pods", len(snap.Pods),
-		"namespaces", len(snap.Namespaces),
-		"deployments", len(snap.Deployments),
-		"statefulsets", len(snap.StatefulSets),
-		"daemonsets", len(snap.DaemonSets),
-		"jobs", len(snap.Jobs),
-		"cronjobs", len(snap.CronJobs),
-		"hpas", len(snap.HPAs),
-		"services", len(snap.Services),
-		"ingresses", len(snap.Ingresses),
-		"pvs", len(snap.PVs),
-		"pvcs", len(snap.PVCs),
-	)
+// setInterval changes the steady-state snapshot period and passes it to the
+// builder, whose events window and staleness threshold follow it.
+func (a *Agent) setInterval(d time.Duration) {
+	a.interval = d
+	a.builder.SetSnapshotInterval(d)
+}
+
+// rearmTicker resets the ticker when the period requested by the latest
+// directives differs from the current one, and returns the new period.
+func (a *Agent) rearmTicker(ticker *time.Ticker, current time.Duration) time.Duration {
+	next := a.interval
+	if a.nextDelay > next {
+		next = a.nextDelay
+	}
+	a.nextDelay = 0
+	if next == current {
+		return current
+	}
+	ticker.Reset(next)
+	slog.Info("snapshot interval changed", "from", current, "to", next)
+	return next
+}
+
+// applyDirectives honors the Directives block of a successful response:
+// it forces a keyframe on a reported delta gap, adjusts the snapshot
+// cadence, and toggles optional collectors.
+func (a *Agent) applyDirectives(d model.Directives) {
+	if d.FullSnapshotRequired {
+		slog.Info("backend reported a delta gap, next snapshot will be a full keyframe")
+		a.delta.Reset()
+	}
+	if d.NextSnapshotInSeconds > 0 {
+		a.setInterval(clampDuration(
+			time.Duration(d.NextSnapshotInSeconds)*time.Second,
+			minDirectiveInterval, maxDirectiveInterval,
+		))
+	}
+	if d.RetryAfterSeconds != nil && *d.RetryAfterSeconds > 0 {
+		a.nextDelay = clampDuration(
+			time.Duration(*d.RetryAfterSeconds)*time.Second,
+			0, maxDirectiveInterval,
+		)
+	}
+
+	a.setCollectorEnabled(vpaCollectorName, d.CollectVPAs)
+	a.setCollectorEnabled(nodePoolCollectorName, d.CollectKarpenter)
+}
+
+// setCollectorEnabled applies a single collector toggle when the flag changes.
+// Backends that predate the toggles always send false, so the first value
+// received is only recorded, and a nil flag means the backend expressed no
+// preference. Collectors that were never registered as optional (e.g. the
+// CRD is not installed) are left alone.
+func (a *Agent) setCollectorEnabled(name string, enabled *bool) {
+	if enabled == nil {
+		return
+	}
+	prev, seen := a.collectorFlags[name]
+	a.collectorFlags[name] = *enabled
+	if !seen || prev == *enabled {
+		return
+	}
+	// The collector's cache syncs in the background, so a collector that
+	// cannot sync (e.g. missing RBAC) does not stall the snapshot loop; it
+	// is reported under stale_resources until it does.
+	if _, err := a.registry.SetEnabled(name, *enabled, a.syncTimeout()); err != nil {
+		if stderrors.Is(err, collector.ErrUnknownCollector) {
+			slog.Debug("directive ignored, collector unavailable", "collector", name)
+			return
+		}
+		slog.Warn("failed to apply collector directive", "collector", name, "enabled", *enabled, "error", err)
+	}
+}
+
+// syncTimeout returns the informer sync timeout, defaulting to 5 minutes.
+func (a *Agent) syncTimeout() time.Duration {
+	if a.config.InformerSyncTimeout == 0 {
+		return 5 * time.Minute
+	}
+	return a.config.InformerSyncTimeout
+}
+
+func clampDuration(d, lo, hi time.Duration) time.Duration {
+	if d < lo {
+		return lo
+	}
+	if d > hi {
+		return hi
+	}
+	return d
+}
+
+// logStoreCounts logs how many objects each store holds after the informer
+// sync. It reads the stores rather than building a snapshot, since a build
+// feeds the GPU idle tracker and the rightsizing history.
+func (a *Agent) logStoreCounts() {
+	counts := a.builder.StoreCounts()
+	var attrs []any
+	for _, name := range []string{
+		"nodes", "pods", "namespaces", "deployments", "statefulsets", "daemonsets",
+		"jobs", "cronjobs", "hpas", "services", "ingresses", "pvs", "pvcs",
+	} {
+		attrs = append(attrs, name, counts[name])
+	}
+	slog.Info("post-sync store counts", attrs...)
 }
 
 func (a *Agent) doSnapshot(ctx context.Context) {
 	// 1. Build snapshot and measure duration.
 	buildStart := time.Now()
-	snap := a.builder.Build(ctx)
+	snap, watermarks := a.builder.BuildWithWatermarks(ctx)
 	a.lastBuildMs = time.Since(buildStart).Milliseconds()
 
 	// 2. Populate health before sending (counters reflect completed operations only).
 	a.populateHealth(snap)
 	a.latestSnapshot.Store(snap)
 
-	// 3. Send and measure duration.
+	// 3. Reduce to a delta against the last acknowledged snapshot when
+	// delta mode is enabled; the full snapshot stays the latest for /debug.
+	wire := a.delta.Prepare(snap, watermarks)
+	if wire.SnapshotType != "" {
+		a.metrics.SnapshotTypeTotal.WithLabelValues(wire.SnapshotType).Inc()
+	}
+
+	// 4. Send and measure duration.
 	sendStart := time.Now()
-	resp, err := a.transport.Send(ctx, snap)
+	resp, err := a.transport.Send(ctx, wire)
 	a.lastSendMs = time.Since(sendStart).Milliseconds()
 
-	// 4. Update counters after send completes.
+	// 5. Update counters after send completes.
 	a.snapshotsTotal++
 	if err != nil {
 		a.snapshotsFailed++
+		a.delta.Reset()
 		slog.Error("snapshot send failed", "error", err)
 		return
 	}
 	a.snapshotsSent++
+	a.delta.Ack()
 
+	// Leadership may have been lost while the send was in flight.
 	state := a.stateMachine.State()
-	if state == StateStopped || state == StateExiting {
+	if state == StateStopped || state == StateExiting || state == StateStandby {
 		return
 	}
 
@@ -233,9 +427,11 @@ func (a *Agent) doSnapshot(ctx context.Context) {
 	if resp != nil {
 		slog.Info("snapshot sent successfully",
 			"snapshot_id", snap.SnapshotID,
+			"snapshot_type", wire.SnapshotType,
 			"quota_plan", resp.Quota.PlanType,
 			"within_quota", resp.Quota.IsWithinQuota,
 		)
+		a.applyDirectives(resp.Directives)
 	}
 }
 
@@ -258,6 +454,17 @@ func (a *Agent) populateHealth(snap *model.ClusterSnapshot) {
 	h.State = string(a.stateMachine.State())
 	h.StateReason = a.stateMachine.StateReason()
 
+	// Leader election.
+	if a.leader != nil {
+		h.LeaderElectionEnabled = true
+		h.IsLeader = a.leader.IsLeader()
+		h.LeaderIdentity = a.leader.CurrentLeader()
+		h.LeadershipTransitions = a.leader.Transitions()
+	}
+
+	// Redaction policy applied to the snapshot.
+	h.Redaction = a.builder.Redaction().Info()
+
 	// Build duration (current snapshot). Send duration from previous snapshot.
 	h.LastBuildDurationMs = a.lastBuildMs
 	h.LastSendDurationMs = a.lastSendMs
@@ -271,6 +478,7 @@ func (a *Agent) populateHealth(snap *model.ClusterSnapshot) {
 	if stats.CompressedBytes > 0 {
 		h.CompressionFactor = float64(stats.OriginalBytes) / float64(stats.CompressedBytes)
 	}
+	h.BufferedSnapshots, h.BufferedBytes = a.transport.SpoolStats()
 
 	// Entity counts from snapshot.
 	s := &snap.Summary
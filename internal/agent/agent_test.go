@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -363,3 +364,149 @@ func TestAgent_Run_EmptyRegistry_NoCollectors(t *testing.T) {
 	assert.ErrorIs(t, err, context.DeadlineExceeded)
 	assert.True(t, ag.IsReady())
 }
+
+func TestAgent_ApplyDirectives_Interval(t *testing.T) {
+	ag, _ := newTestAgent(t, "http://unused")
+	base := ag.interval
+
+	// Zero means "no change".
+	ag.applyDirectives(model.Directives{})
+	assert.Equal(t, base, ag.interval)
+
+	ag.applyDirectives(model.Directives{NextSnapshotInSeconds: 120})
+	assert.Equal(t, 120*time.Second, ag.interval)
+
+	// Out-of-range values are clamped.
+	ag.applyDirectives(model.Directives{NextSnapshotInSeconds: 1})
+	assert.Equal(t, minDirectiveInterval, ag.interval)
+	ag.applyDirectives(model.Directives{NextSnapshotInSeconds: 86400})
+	assert.Equal(t, maxDirectiveInterval, ag.interval)
+}
+
+func TestAgent_Run_SetSnapshotInterval(t *testing.T) {
+	var reqCount atomic.Int32
+	srv := newTestBackend(t, &reqCount, http.StatusOK)
+	defer srv.Close()
+
+	ag := newTestAgentWithCustomTransport(t, srv.URL)
+	ag.interval = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = ag.Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	require.Eventually(t, func() bool { return reqCount.Load() >= 1 }, 2*time.Second, 10*time.Millisecond)
+	// Without the change, the next snapshot would be an hour away.
+	ag.SetSnapshotInterval(50 * time.Millisecond)
+	require.Eventually(t, func() bool { return reqCount.Load() >= 3 }, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestAgent_RearmTicker_RetryAfterIsOneShot(t *testing.T) {
+	ag, _ := newTestAgent(t, "http://unused")
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	retryAfter := 300
+	ag.applyDirectives(model.Directives{
+		NextSnapshotInSeconds: 60,
+		RetryAfterSeconds:     &retryAfter,
+	})
+
+	period := ag.rearmTicker(ticker, ag.config.SnapshotInterval)
+	assert.Equal(t, 300*time.Second, period, "retry_after should delay the next tick")
+
+	period = ag.rearmTicker(ticker, period)
+	assert.Equal(t, 60*time.Second, period, "cadence should return to next_snapshot_in_seconds")
+}
+
+func TestAgent_ApplyDirectives_TogglesOptionalCollectors(t *testing.T) {
+	ag, reg := newTestAgent(t, "http://unused")
+	reg.RegisterOptional(vpaCollectorName, func() collector.Collector {
+		return &stubCollector{name: vpaCollectorName}
+	}, nil, true)
+
+	hasVPA := func() bool {
+		for _, c := range reg.Collectors() {
+			if c.Name() == vpaCollectorName {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, hasVPA())
+
+	off, on := false, true
+
+	// nil flag leaves the collector alone; Karpenter is not registered and is ignored.
+	ag.applyDirectives(model.Directives{CollectKarpenter: &on})
+	ag.applyDirectives(model.Directives{CollectKarpenter: &off})
+	assert.True(t, hasVPA())
+
+	// The first value is the baseline.
+	ag.applyDirectives(model.Directives{CollectVPAs: &on})
+	assert.True(t, hasVPA())
+
+	ag.applyDirectives(model.Directives{CollectVPAs: &off})
+	assert.False(t, hasVPA(), "collect_vpas=false should stop the VPA collector")
+
+	ag.applyDirectives(model.Directives{CollectVPAs: &on})
+	assert.True(t, hasVPA(), "collect_vpas=true should restart the VPA collector")
+}
+
+// TestAgent_ApplyDirectives_LegacyFalseKeepsCollectors verifies the false
+// flags that backends without collector toggles always send do not stop
+// the collectors.
+func TestAgent_ApplyDirectives_LegacyFalseKeepsCollectors(t *testing.T) {
+	ag, reg := newTestAgent(t, "http://unused")
+	reg.RegisterOptional(vpaCollectorName, func() collector.Collector {
+		return &stubCollector{name: vpaCollectorName}
+	}, nil, true)
+
+	off := false
+	for range 3 {
+		ag.applyDirectives(model.Directives{CollectVPAs: &off, CollectKarpenter: &off})
+	}
+
+	var names []string
+	for _, c := range reg.Collectors() {
+		names = append(names, c.Name())
+	}
+	assert.Contains(t, names, vpaCollectorName, "legacy collect_vpas=false should keep the VPA collector running")
+}
+
+func TestAgent_ApplyDirectives_FullSnapshotRequiredForcesKeyframe(t *testing.T) {
+	ag, _ := newTestAgent(t, "http://unused")
+	ag.delta = snapshot.NewDeltaTracker(10)
+
+	ag.delta.Prepare(&model.ClusterSnapshot{SnapshotID: "s1"}, nil)
+	ag.delta.Ack()
+	out := ag.delta.Prepare(&model.ClusterSnapshot{SnapshotID: "s2"}, nil)
+	require.Equal(t, model.SnapshotTypeDelta, out.SnapshotType)
+	ag.delta.Ack()
+
+	ag.applyDirectives(model.Directives{FullSnapshotRequired: true})
+
+	out = ag.delta.Prepare(&model.ClusterSnapshot{SnapshotID: "s3"}, nil)
+	assert.Equal(t, model.SnapshotTypeFull, out.SnapshotType)
+}
+
+// TestAgent_LogStoreCounts_DoesNotBuild verifies the post-sync log reads the
+// stores instead of building a snapshot, which would feed the GPU idle
+// tracker and the rightsizing history before the first send.
+func TestAgent_LogStoreCounts_DoesNotBuild(t *testing.T) {
+	ag, _ := newTestAgent(t, "http://unused")
+
+	ag.logStoreCounts()
+
+	pb := &dto.Metric{}
+	require.NoError(t, ag.metrics.SnapshotBuildDuration.Write(pb))
+	assert.Zero(t, pb.GetHistogram().GetSampleCount(), "logStoreCounts should not build a snapshot")
+}
@@ -0,0 +1,153 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+)
+
+// LeaderElector decides which agent replica sends snapshots, using a Lease in
+// the agent's namespace. Every replica runs its collectors; only the lease
+// holder builds and sends. A replica that loses the lease rejoins the
+// election as a candidate, and the lease is released on shutdown so a
+// standby takes over within one RetryPeriod.
+type LeaderElector struct {
+	elector  *leaderelection.LeaderElector
+	identity string
+	metrics  *observability.Metrics
+
+	transitions atomic.Uint64
+	changed     chan struct{}
+
+	mu            sync.RWMutex
+	isLeader      bool
+	currentLeader string
+}
+
+// NewLeaderElector creates a LeaderElector for the Lease named
+// cfg.LeaderElectionLeaseName in cfg.PodNamespace, identified by cfg.PodName.
+func NewLeaderElector(client kubernetes.Interface, cfg *config.Config, metrics *observability.Metrics) (*LeaderElector, error) {
+	le := &LeaderElector{
+		identity: cfg.PodName,
+		metrics:  metrics,
+		changed:  make(chan struct{}, 1),
+	}
+
+	lock, err := resourcelock.New(
+		resourcelock.LeasesResourceLock,
+		cfg.PodNamespace,
+		cfg.LeaderElectionLeaseName,
+		client.CoreV1(),
+		client.CoordinationV1(),
+		resourcelock.ResourceLockConfig{Identity: cfg.PodName},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("leader election lock: %w", err)
+	}
+
+	le.elector, err = leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   cfg.LeaderElectionLeaseDuration,
+		RenewDeadline:   cfg.LeaderElectionRenewDeadline,
+		RetryPeriod:     cfg.LeaderElectionRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            cfg.LeaderElectionLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) { le.setLeader(ctx, true) },
+			OnStoppedLeading: func() { le.setLeader(context.Background(), false) },
+			OnNewLeader:      le.observeLeader,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("leader election config: %w", err)
+	}
+	return le, nil
+}
+
+// Run campaigns for the lease until ctx is canceled. It blocks.
+func (le *LeaderElector) Run(ctx context.Context) {
+	for ctx.Err() == nil {
+		le.elector.Run(ctx)
+	}
+}
+
+// IsLeader reports whether this replica currently holds the lease.
+func (le *LeaderElector) IsLeader() bool {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.isLeader
+}
+
+// Identity returns this replica's holder identity.
+func (le *LeaderElector) Identity() string {
+	return le.identity
+}
+
+// CurrentLeader returns the identity of the last observed lease holder.
+func (le *LeaderElector) CurrentLeader() string {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	return le.currentLeader
+}
+
+// Transitions returns how many times this replica gained or lost leadership.
+func (le *LeaderElector) Transitions() uint64 {
+	return le.transitions.Load()
+}
+
+// Changed is signaled (coalesced) whenever IsLeader flips.
+func (le *LeaderElector) Changed() <-chan struct{} {
+	return le.changed
+}
+
+// setLeader records a leadership change. client-go starts OnStartedLeading
+// in a goroutine, so it can run after the lease was already lost; its ctx is
+// canceled by then and the stale start is dropped.
+func (le *LeaderElector) setLeader(ctx context.Context, leader bool) {
+	le.mu.Lock()
+	// OnStoppedLeading also fires when Run returns without ever leading.
+	if le.isLeader == leader || ctx.Err() != nil {
+		le.mu.Unlock()
+		return
+	}
+	le.isLeader = leader
+	le.mu.Unlock()
+
+	le.transitions.Add(1)
+	if le.metrics != nil {
+		le.metrics.LeaderTransitionsTotal.Inc()
+		if leader {
+			le.metrics.IsLeader.Set(1)
+		} else {
+			le.metrics.IsLeader.Set(0)
+		}
+	}
+	if leader {
+		slog.Info("acquired leadership", "lease_holder", le.identity)
+	} else {
+		slog.Warn("lost leadership, entering standby", "lease_holder", le.identity)
+	}
+
+	select {
+	case le.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (le *LeaderElector) observeLeader(identity string) {
+	le.mu.Lock()
+	le.currentLeader = identity
+	le.mu.Unlock()
+	if identity != le.identity {
+		slog.Info("observed new leader", "leader", identity)
+	}
+}
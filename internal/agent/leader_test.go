@@ -0,0 +1,139 @@
+package agent
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+)
+
+func newTestLeaderElector(t *testing.T, client kubernetes.Interface, identity string) *LeaderElector {
+	t.Helper()
+	cfg := &config.Config{
+		PodName:                     identity,
+		PodNamespace:                "kubeadapt",
+		LeaderElectionLeaseName:     "kubeadapt-agent",
+		LeaderElectionLeaseDuration: time.Second,
+		LeaderElectionRenewDeadline: 500 * time.Millisecond,
+		LeaderElectionRetryPeriod:   100 * time.Millisecond,
+	}
+	le, err := NewLeaderElector(client, cfg, observability.NewMetrics())
+	require.NoError(t, err)
+	return le
+}
+
+func TestLeaderElector_SingleLeaderAndFailover(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	a := newTestLeaderElector(t, client, "agent-a")
+	b := newTestLeaderElector(t, client, "agent-b")
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	defer cancelA()
+	doneA := make(chan struct{})
+	go func() {
+		defer close(doneA)
+		a.Run(ctxA)
+	}()
+	require.Eventually(t, a.IsLeader, 5*time.Second, 20*time.Millisecond)
+
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	go b.Run(ctxB)
+
+	// b observes a as the leader and stays standby.
+	require.Eventually(t, func() bool { return b.CurrentLeader() == "agent-a" }, 5*time.Second, 20*time.Millisecond)
+	assert.False(t, b.IsLeader())
+
+	// a shuts down and releases the lease; b takes over.
+	cancelA()
+	<-doneA
+	assert.False(t, a.IsLeader())
+	require.Eventually(t, b.IsLeader, 5*time.Second, 20*time.Millisecond)
+	// client-go reports the new leader from a goroutine of its own.
+	require.Eventually(t, func() bool { return b.CurrentLeader() == "agent-b" }, 5*time.Second, 20*time.Millisecond)
+
+	assert.Equal(t, uint64(2), a.Transitions(), "a gained and lost leadership")
+	assert.Equal(t, uint64(1), b.Transitions())
+	select {
+	case <-b.Changed():
+	default:
+		t.Fatal("expected a change notification on b")
+	}
+}
+
+func TestAgent_Run_StandbyUntilElected(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	var reqA, reqB atomic.Int32
+	srvA := newTestBackend(t, &reqA, http.StatusOK)
+	defer srvA.Close()
+	srvB := newTestBackend(t, &reqB, http.StatusOK)
+	defer srvB.Close()
+
+	agA, _ := newTestAgent(t, srvA.URL)
+	leA := newTestLeaderElector(t, client, "agent-a")
+	agA.SetLeaderElector(leA)
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	defer cancelA()
+	doneA := make(chan struct{})
+	go func() {
+		defer close(doneA)
+		_ = agA.Run(ctxA)
+	}()
+	require.Eventually(t, func() bool { return reqA.Load() > 0 }, 5*time.Second, 20*time.Millisecond)
+
+	agB, _ := newTestAgent(t, srvB.URL)
+	leB := newTestLeaderElector(t, client, "agent-b")
+	agB.SetLeaderElector(leB)
+
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	go func() { _ = agB.Run(ctxB) }()
+
+	// The standby is ready (warm informers) but sends nothing.
+	require.Eventually(t, agB.IsReady, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, StateStandby, agB.stateMachine.State())
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, int32(0), reqB.Load(), "standby must not send snapshots")
+
+	// Leader goes away; the standby takes over and starts sending.
+	cancelA()
+	<-doneA
+	require.Eventually(t, func() bool { return reqB.Load() > 0 }, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, StateRunning, agB.stateMachine.State())
+
+	snap := agB.latestSnapshot.Load()
+	require.NotNil(t, snap)
+	assert.True(t, snap.Health.LeaderElectionEnabled)
+	assert.True(t, snap.Health.IsLeader)
+	assert.Equal(t, "agent-b", snap.Health.LeaderIdentity)
+	assert.Equal(t, uint64(1), snap.Health.LeadershipTransitions)
+}
+
+func TestAgent_ApplyLeadership(t *testing.T) {
+	ag, _ := newTestAgent(t, "http://unused")
+	ag.leader = &LeaderElector{changed: make(chan struct{}, 1)}
+	ag.stateMachine.TransitionTo(StateStandby, "waiting for leadership")
+
+	ag.leader.isLeader = true
+	assert.True(t, ag.applyLeadership(), "standby replica that acquires the lease is elected")
+	assert.Equal(t, StateRunning, ag.stateMachine.State())
+
+	assert.False(t, ag.applyLeadership(), "no change while still leading")
+
+	ag.leader.isLeader = false
+	ag.stateMachine.TransitionTo(StateBackoff, "rate limited")
+	assert.False(t, ag.applyLeadership())
+	assert.Equal(t, StateStandby, ag.stateMachine.State())
+	assert.Equal(t, "lost leadership", ag.stateMachine.StateReason())
+}
@@ -17,6 +17,7 @@ const (
 	StateStarting AgentState = "starting"
 	StateRunning  AgentState = "running"
 	StateBackoff  AgentState = "backoff"
+	StateStandby  AgentState = "standby" // another replica holds the leader lease
 	StateStopped  AgentState = "stopped"
 	StateExiting  AgentState = "exiting"
 )
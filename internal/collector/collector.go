@@ -24,6 +24,16 @@ type HealthChecker interface {
 	IsHealthy() (healthy bool, reason string)
 }
 
+// Reconverter is an optional interface for informer-based collectors whose
+// converters apply the redaction policy. Reconvert converts the cached
+// objects again so that a new policy reaches the store without waiting for
+// the informer resync.
+type Reconverter interface {
+	// Reconvert replaces each stored object with a new conversion of its
+	// cached copy. Objects deleted in the meantime are not added back.
+	Reconvert()
+}
+
 // APICallCounter is an optional interface that collectors can implement to
 // report cumulative API call statistics. Only polling collectors (MetricsCollector,
 // GPUMetricsCollector) implement this — informer-based collectors do not make
@@ -0,0 +1,43 @@
+package gpu
+
+import (
+	"strings"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// amdMetrics are the AMD device metrics exporter's metrics. VRAM is reported
+// in MiB and power in watts.
+var amdMetrics = map[string]vendorMetric{
+	"gpu_gfx_activity":         {fieldUtilization, 1},
+	"gpu_umc_activity":         {fieldMemUtil, 1},
+	"gpu_used_vram":            {fieldMemUsed, mibToBytes},
+	"gpu_free_vram":            {fieldMemFree, mibToBytes},
+	"gpu_total_vram":           {fieldMemTotal, mibToBytes},
+	"gpu_edge_temperature":     {fieldTemperature, 1},
+	"gpu_junction_temperature": {fieldTemperature, 1},
+	"gpu_power_usage":          {fieldPower, 1},
+}
+
+// amdParser reads the AMD device metrics exporter (ROCm). Deployments that
+// prefix metric names with "amd_" (amd_gpu_*) are understood too.
+var amdParser = vendorParser{
+	vendor: model.GPUVendorAMD,
+	metric: func(name string) (vendorMetric, bool) {
+		m, ok := amdMetrics[strings.TrimPrefix(name, "amd_")]
+		return m, ok
+	},
+	labels: func(l map[string]string) deviceLabels {
+		return deviceLabels{
+			gpu:           l["gpu_id"],
+			uuid:          firstLabel(l, "gpu_uuid", "serial_number"),
+			modelName:     firstLabel(l, "card_model", "card_series"),
+			driverVersion: l["driver_version"],
+			hostname:      l["hostname"],
+			podName:       l["pod"],
+			namespace:     l["namespace"],
+			containerName: l["container"],
+			gpuInstanceID: l["gpu_partition_id"],
+		}
+	},
+}
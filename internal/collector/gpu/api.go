@@ -6,43 +6,59 @@ import (
 	"net/http"
 )
 
+// Endpoint is a GPU metrics exporter to scrape.
+type Endpoint struct {
+	// URL is the exporter's base URL (e.g., "http://10.0.0.5:9400").
+	URL string
+	// Node is the node the exporter runs on, if known. Devices the exporter
+	// does not label with a hostname are attributed to it.
+	Node string
+}
+
 // GPUMetricsAPI abstracts GPU metrics collection for testability.
 type GPUMetricsAPI interface {
-	ScrapeGPUMetrics(ctx context.Context, endpoints []string) ([]GPUDeviceMetrics, error)
+	ScrapeGPUMetrics(ctx context.Context, endpoints []Endpoint) ([]GPUDeviceMetrics, error)
 }
 
-// dcgmExporterClient implements GPUMetricsAPI by scraping dcgm-exporter endpoints.
-type dcgmExporterClient struct {
+// exporterClient implements GPUMetricsAPI by scraping GPU exporter endpoints.
+type exporterClient struct {
 	client *http.Client
 }
 
-// NewDCGMExporterClient creates a GPUMetricsAPI that scrapes dcgm-exporter HTTP endpoints.
-func NewDCGMExporterClient(client *http.Client) GPUMetricsAPI {
-	return &dcgmExporterClient{client: client}
+// NewExporterClient creates a GPUMetricsAPI that scrapes the HTTP endpoints
+// of dcgm-exporter and the AMD, Intel and Habana exporters. The vendor is
+// recognized from the metric names.
+func NewExporterClient(client *http.Client) GPUMetricsAPI {
+	return &exporterClient{client: client}
 }
 
-func (c *dcgmExporterClient) ScrapeGPUMetrics(ctx context.Context, endpoints []string) ([]GPUDeviceMetrics, error) {
+func (c *exporterClient) ScrapeGPUMetrics(ctx context.Context, endpoints []Endpoint) ([]GPUDeviceMetrics, error) {
 	var allMetrics []GPUDeviceMetrics
 
 	for _, endpoint := range endpoints {
-		body, err := scrapeEndpoint(ctx, c.client, endpoint)
+		body, err := scrapeEndpoint(ctx, c.client, endpoint.URL)
 		if err != nil {
-			slog.Warn("failed to scrape dcgm-exporter",
-				"endpoint", endpoint,
+			slog.Warn("failed to scrape GPU exporter",
+				"endpoint", endpoint.URL,
 				"error", err,
 			)
 			continue
 		}
 
-		metrics, err := ParseDCGMMetrics(body)
+		metrics, err := ParseGPUMetrics(body)
 		if err != nil {
-			slog.Warn("failed to parse dcgm-exporter metrics",
-				"endpoint", endpoint,
+			slog.Warn("failed to parse GPU exporter metrics",
+				"endpoint", endpoint.URL,
 				"error", err,
 			)
 			continue
 		}
 
+		for i := range metrics {
+			if metrics[i].Hostname == "" {
+				metrics[i].Hostname = endpoint.Node
+			}
+		}
 		allMetrics = append(allMetrics, metrics...)
 	}
 
@@ -8,12 +8,13 @@ import (
 	"time"
 )
 
-// GPUMetricsCollector polls dcgm-exporter endpoints on a timer and collects
+// GPUMetricsCollector polls GPU exporter endpoints on a timer and collects
 // GPU device metrics. It implements the collector.Collector interface.
 type GPUMetricsCollector struct {
 	api         GPUMetricsAPI
-	endpointsFn func() []string
+	endpointsFn func() []Endpoint
 	interval    time.Duration
+	intervalCh  chan time.Duration
 	stopCh      chan struct{}
 	done        chan struct{}
 
@@ -32,13 +33,14 @@ type GPUMetricsCollector struct {
 	lastUpTargets atomic.Int64
 }
 
-// NewGPUMetricsCollector creates a GPUMetricsCollector that polls dcgm-exporter endpoints.
+// NewGPUMetricsCollector creates a GPUMetricsCollector that polls GPU exporter endpoints.
 // endpointsFn is called on each poll to get the current list of endpoints to scrape.
-func NewGPUMetricsCollector(api GPUMetricsAPI, endpointsFn func() []string, interval time.Duration) *GPUMetricsCollector {
+func NewGPUMetricsCollector(api GPUMetricsAPI, endpointsFn func() []Endpoint, interval time.Duration) *GPUMetricsCollector {
 	return &GPUMetricsCollector{
 		api:         api,
 		endpointsFn: endpointsFn,
 		interval:    interval,
+		intervalCh:  make(chan time.Duration, 1),
 		stopCh:      make(chan struct{}),
 		done:        make(chan struct{}),
 		synced:      make(chan struct{}),
@@ -70,6 +72,16 @@ func (c *GPUMetricsCollector) Stop() {
 	<-c.done
 }
 
+// SetInterval changes the polling interval, e.g. after a config file
+// reload. It takes effect after the current tick.
+func (c *GPUMetricsCollector) SetInterval(interval time.Duration) {
+	select {
+	case <-c.intervalCh: // replace a change the loop has not picked up yet
+	default:
+	}
+	c.intervalCh <- interval
+}
+
 // GetGPUMetrics returns a copy of the latest collected GPU metrics.
 func (c *GPUMetricsCollector) GetGPUMetrics() []GPUDeviceMetrics {
 	c.mu.RLock()
@@ -93,6 +105,8 @@ func (c *GPUMetricsCollector) run(ctx context.Context) {
 		select {
 		case <-ticker.C:
 			c.poll(ctx)
+		case c.interval = <-c.intervalCh:
+			ticker.Reset(c.interval)
 		case <-c.stopCh:
 			return
 		case <-ctx.Done():
@@ -106,7 +120,7 @@ func (c *GPUMetricsCollector) poll(ctx context.Context) {
 	if len(endpoints) == 0 {
 		c.lastTargets.Store(0)
 		c.lastUpTargets.Store(0)
-		slog.Debug("gpu collector: no dcgm-exporter endpoints configured")
+		slog.Debug("gpu collector: no GPU exporter endpoints configured")
 		return
 	}
 
@@ -151,12 +165,12 @@ func (c *GPUMetricsCollector) IsHealthy() (bool, string) {
 }
 
 // APICallStats returns cumulative API call counters.
-// Each poll() makes one HTTP scrape call per dcgm-exporter endpoint.
+// Each poll() makes one HTTP scrape call per exporter endpoint.
 func (c *GPUMetricsCollector) APICallStats() (total, failed int64) {
 	return c.scrapeTotal.Load(), c.scrapeFailed.Load()
 }
 
-// DCGMTargetStats returns the number of dcgm-exporter targets and how many
+// DCGMTargetStats returns the number of GPU exporter targets and how many
 // responded successfully in the most recent poll.
 func (c *GPUMetricsCollector) DCGMTargetStats() (targets, upTargets int) {
 	return int(c.lastTargets.Load()), int(c.lastUpTargets.Load())
@@ -23,12 +23,21 @@ type mockGPUMetricsAPI struct {
 	err     error
 }
 
-func (m *mockGPUMetricsAPI) ScrapeGPUMetrics(_ context.Context, _ []string) ([]GPUDeviceMetrics, error) {
+func (m *mockGPUMetricsAPI) ScrapeGPUMetrics(_ context.Context, _ []Endpoint) ([]GPUDeviceMetrics, error) {
 	return m.metrics, m.err
 }
 
+// urls returns endpoints for the given exporter URLs.
+func urls(us ...string) []Endpoint {
+	endpoints := make([]Endpoint, len(us))
+	for i, u := range us {
+		endpoints[i] = Endpoint{URL: u}
+	}
+	return endpoints
+}
+
 func TestGPUMetricsCollector_Name(t *testing.T) {
-	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []string { return nil }, time.Minute)
+	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []Endpoint { return nil }, time.Minute)
 	assert.Equal(t, "gpu", c.Name())
 }
 
@@ -44,8 +53,8 @@ func TestGPUMetricsCollector_Lifecycle(t *testing.T) {
 		},
 	}
 
-	c := NewGPUMetricsCollector(mock, func() []string {
-		return []string{"http://localhost:9400"}
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
+		return urls("http://localhost:9400")
 	}, 50*time.Millisecond)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -71,7 +80,7 @@ func TestGPUMetricsCollector_Lifecycle(t *testing.T) {
 }
 
 func TestGPUMetricsCollector_StopsCleanly(t *testing.T) {
-	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []string { return nil }, 50*time.Millisecond)
+	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []Endpoint { return nil }, 50*time.Millisecond)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -101,10 +110,10 @@ func TestGPUMetricsCollector_UnreachableEndpoint(t *testing.T) {
 	server.Close()
 
 	client := &http.Client{Timeout: 1 * time.Second}
-	api := NewDCGMExporterClient(client)
+	api := NewExporterClient(client)
 
-	c := NewGPUMetricsCollector(api, func() []string {
-		return []string{closedURL}
+	c := NewGPUMetricsCollector(api, func() []Endpoint {
+		return urls(closedURL)
 	}, 50*time.Millisecond)
 
 	ctx, cancel := context.WithTimeout(context.Background(), testWaitTimeout)
@@ -134,10 +143,10 @@ func TestGPUMetricsCollector_SuccessfulScrapeWithMockHTTP(t *testing.T) {
 	defer server.Close()
 
 	client := server.Client()
-	api := NewDCGMExporterClient(client)
+	api := NewExporterClient(client)
 
-	c := NewGPUMetricsCollector(api, func() []string {
-		return []string{server.URL}
+	c := NewGPUMetricsCollector(api, func() []Endpoint {
+		return urls(server.URL)
 	}, 50*time.Millisecond)
 
 	ctx, cancel := context.WithTimeout(context.Background(), testWaitTimeout)
@@ -176,7 +185,7 @@ func TestGPUMetricsCollector_SuccessfulScrapeWithMockHTTP(t *testing.T) {
 
 func TestGPUMetricsCollector_NoEndpoints(t *testing.T) {
 	mock := &mockGPUMetricsAPI{}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return nil // no endpoints
 	}, 50*time.Millisecond)
 
@@ -202,8 +211,8 @@ func TestGPUMetricsCollector_GetGPUMetrics_ReturnsCopy(t *testing.T) {
 		},
 	}
 
-	c := NewGPUMetricsCollector(mock, func() []string {
-		return []string{"http://localhost:9400"}
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
+		return urls("http://localhost:9400")
 	}, time.Hour) // long interval so only first poll runs
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -234,8 +243,8 @@ func TestGPUMetricsCollector_APICallStats_CountsScrapes(t *testing.T) {
 		},
 	}
 
-	endpoints := []string{"http://10.0.0.1:9400", "http://10.0.0.2:9400"}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	endpoints := urls("http://10.0.0.1:9400", "http://10.0.0.2:9400")
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return endpoints
 	}, 50*time.Millisecond)
 
@@ -265,8 +274,8 @@ func TestGPUMetricsCollector_APICallStats_CountsFailures(t *testing.T) {
 		err: fmt.Errorf("scrape failed"),
 	}
 
-	endpoints := []string{"http://10.0.0.1:9400", "http://10.0.0.2:9400"}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	endpoints := urls("http://10.0.0.1:9400", "http://10.0.0.2:9400")
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return endpoints
 	}, 50*time.Millisecond)
 
@@ -292,7 +301,7 @@ func TestGPUMetricsCollector_APICallStats_CountsFailures(t *testing.T) {
 
 func TestGPUMetricsCollector_APICallStats_NoEndpoints(t *testing.T) {
 	mock := &mockGPUMetricsAPI{}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return nil
 	}, 50*time.Millisecond)
 
@@ -321,8 +330,8 @@ func TestGPUMetricsCollector_DCGMTargetStats_Success(t *testing.T) {
 		},
 	}
 
-	endpoints := []string{"http://10.0.0.1:9400", "http://10.0.0.2:9400", "http://10.0.0.3:9400"}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	endpoints := urls("http://10.0.0.1:9400", "http://10.0.0.2:9400", "http://10.0.0.3:9400")
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return endpoints
 	}, time.Hour)
 
@@ -346,8 +355,8 @@ func TestGPUMetricsCollector_DCGMTargetStats_Failure(t *testing.T) {
 		err: fmt.Errorf("scrape failed"),
 	}
 
-	endpoints := []string{"http://10.0.0.1:9400", "http://10.0.0.2:9400"}
-	c := NewGPUMetricsCollector(mock, func() []string {
+	endpoints := urls("http://10.0.0.1:9400", "http://10.0.0.2:9400")
+	c := NewGPUMetricsCollector(mock, func() []Endpoint {
 		return endpoints
 	}, time.Hour)
 
@@ -367,7 +376,7 @@ func TestGPUMetricsCollector_DCGMTargetStats_Failure(t *testing.T) {
 }
 
 func TestGPUMetricsCollector_DCGMTargetStats_NoEndpoints(t *testing.T) {
-	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []string {
+	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []Endpoint {
 		return nil
 	}, time.Hour)
 
@@ -385,3 +394,22 @@ func TestGPUMetricsCollector_DCGMTargetStats_NoEndpoints(t *testing.T) {
 	assert.Equal(t, 0, targets)
 	assert.Equal(t, 0, upTargets)
 }
+
+func TestGPUMetricsCollector_SetInterval(t *testing.T) {
+	c := NewGPUMetricsCollector(&mockGPUMetricsAPI{}, func() []Endpoint {
+		return urls("http://10.0.0.1:9400")
+	}, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+	require.NoError(t, c.WaitForSync(ctx))
+
+	// Only the initial poll has run; the shorter interval takes over at once.
+	c.SetInterval(20 * time.Millisecond)
+	require.Eventually(t, func() bool {
+		total, _ := c.APICallStats()
+		return total >= 3
+	}, testWaitTimeout, testPollInterval)
+}
@@ -1,9 +1,12 @@
-// Package gpu implements a collector for NVIDIA GPU metrics from dcgm-exporter.
+// Package gpu implements a collector for GPU and accelerator metrics scraped
+// from vendor exporters: NVIDIA dcgm-exporter, the AMD device metrics
+// exporter, Intel XPU Manager and the Habana Labs metric exporter.
 //
-// It scrapes Prometheus-format metrics endpoints exposed by dcgm-exporter pods
-// and parses DCGM GPU metrics including utilization, memory, temperature, and power.
+// Each scrape is parsed by vendor, recognized from the metric names, into
+// GPUDeviceMetrics. Devices an exporter does not label with a hostname are
+// attributed to the node the exporter runs on.
 //
-// The collector handles both old-style (pod_name, pod_namespace, container_name)
+// The DCGM parser handles both old-style (pod_name, pod_namespace, container_name)
 // and new-style (pod, namespace, container) dcgm-exporter label schemas, normalizing
 // them into a consistent set of fields. DCGM sentinel values (~1.8e19) are detected
 // and rejected to prevent data corruption.
@@ -0,0 +1,32 @@
+package gpu
+
+import "github.com/kubeadapt/kubeadapt-agent/pkg/model"
+
+// milliwattsToWatts converts the Habana exporter's power readings.
+const milliwattsToWatts = 0.001
+
+// habanaParser reads the Habana Labs metric exporter, for Gaudi
+// accelerators.
+var habanaParser = vendorParser{
+	vendor: model.GPUVendorHabana,
+	metric: metricTable(map[string]vendorMetric{
+		"habanalabs_utilization":        {fieldUtilization, 1},
+		"habanalabs_memory_used_bytes":  {fieldMemUsed, 1},
+		"habanalabs_memory_free_bytes":  {fieldMemFree, 1},
+		"habanalabs_memory_total_bytes": {fieldMemTotal, 1},
+		"habanalabs_temperature_onchip": {fieldTemperature, 1},
+		"habanalabs_power_mW":           {fieldPower, milliwattsToWatts},
+	}),
+	labels: func(l map[string]string) deviceLabels {
+		return deviceLabels{
+			gpu:           firstLabel(l, "device", "bus_id"),
+			uuid:          firstLabel(l, "UUID", "Serial"),
+			modelName:     l["ModelName"],
+			driverVersion: l["Driver"],
+			hostname:      l["hostname"],
+			podName:       l["pod"],
+			namespace:     l["namespace"],
+			containerName: l["container"],
+		}
+	},
+}
@@ -0,0 +1,98 @@
+package gpu
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// throttleReasons names the bits of the DCGM clock throttle reasons bitmask
+// (nvmlClocksEventReasons), indexed by bit position.
+var throttleReasons = []string{
+	"gpu_idle",
+	"applications_clocks_setting",
+	"sw_power_cap",
+	"hw_slowdown",
+	"sync_boost",
+	"sw_thermal_slowdown",
+	"hw_thermal_slowdown",
+	"hw_power_brake_slowdown",
+	"display_clock_setting",
+}
+
+// Throttle reason bits that point at a hardware problem rather than a
+// workload or configuration choice.
+const (
+	throttleHWSlowdown        uint64 = 1 << 3
+	throttleHWThermalSlowdown uint64 = 1 << 6
+	throttleHWPowerBrake      uint64 = 1 << 7
+)
+
+// unhealthyXIDs are XID errors after which a GPU needs a reset or
+// replacement: double-bit ECC, GSP and PMU failures, NVLink errors, row
+// remapping failures, uncontained ECC errors and falling off the bus.
+// Application faults (XID 13, 31, 43, 45) do not affect device health.
+var unhealthyXIDs = map[int]bool{
+	48: true, 61: true, 62: true, 64: true, 74: true, 79: true,
+	95: true, 119: true, 120: true, 140: true,
+}
+
+// degradedXIDs are XID errors a GPU recovers from but that warn of failing
+// memory: row remapping events, high single-bit ECC rates and contained ECC
+// errors.
+var degradedXIDs = map[int]bool{63: true, 92: true, 94: true}
+
+// ThrottleReasonNames returns the names of the bits set in a DCGM clock
+// throttle reasons bitmask. Unknown bits are named by their value.
+func ThrottleReasonNames(mask uint64) []string {
+	var names []string
+	for mask != 0 {
+		bit := bits.TrailingZeros64(mask)
+		mask &^= 1 << bit
+		if bit < len(throttleReasons) {
+			names = append(names, throttleReasons[bit])
+		} else {
+			names = append(names, fmt.Sprintf("0x%x", uint64(1)<<bit))
+		}
+	}
+	return names
+}
+
+// DeviceHealth derives a device's health from its XID, ECC and throttle
+// signals, returning one of the model.GPUHealth values and the reasons for
+// anything but healthy. It returns "" for devices without any of these
+// signals, such as those of exporters other than dcgm-exporter.
+func DeviceHealth(d *GPUDeviceMetrics) (string, []string) {
+	if d.XIDError == nil && d.ECCDoubleBitErrors == nil && d.ThrottleReasons == nil {
+		return "", nil
+	}
+
+	var unhealthy, degraded []string
+	if d.XIDError != nil && *d.XIDError != 0 {
+		reason := fmt.Sprintf("xid_%d", *d.XIDError)
+		switch {
+		case unhealthyXIDs[*d.XIDError]:
+			unhealthy = append(unhealthy, reason)
+		case degradedXIDs[*d.XIDError]:
+			degraded = append(degraded, reason)
+		}
+	}
+	if d.ECCDoubleBitErrors != nil && *d.ECCDoubleBitErrors > 0 {
+		unhealthy = append(unhealthy, "ecc_double_bit_errors")
+	}
+	if d.ThrottleReasons != nil {
+		if hw := *d.ThrottleReasons & (throttleHWSlowdown | throttleHWThermalSlowdown | throttleHWPowerBrake); hw != 0 {
+			degraded = append(degraded, ThrottleReasonNames(hw)...)
+		}
+	}
+
+	switch {
+	case len(unhealthy) > 0:
+		return model.GPUHealthUnhealthy, append(unhealthy, degraded...)
+	case len(degraded) > 0:
+		return model.GPUHealthDegraded, degraded
+	default:
+		return model.GPUHealthHealthy, nil
+	}
+}
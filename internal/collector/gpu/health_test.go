@@ -0,0 +1,80 @@
+package gpu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+func TestThrottleReasonNames(t *testing.T) {
+	assert.Nil(t, ThrottleReasonNames(0))
+	assert.Equal(t, []string{"gpu_idle"}, ThrottleReasonNames(1))
+	assert.Equal(t, []string{"sw_power_cap", "hw_thermal_slowdown"}, ThrottleReasonNames(0x44))
+	assert.Equal(t, []string{"0x1000"}, ThrottleReasonNames(0x1000))
+}
+
+func TestDeviceHealth(t *testing.T) {
+	xid := func(v int) *int { return &v }
+	count := func(v int64) *int64 { return &v }
+	mask := func(v uint64) *uint64 { return &v }
+
+	tests := []struct {
+		name    string
+		device  GPUDeviceMetrics
+		health  string
+		reasons []string
+	}{
+		{
+			name:   "no health signals",
+			device: GPUDeviceMetrics{Vendor: model.GPUVendorAMD},
+		},
+		{
+			name:   "healthy",
+			device: GPUDeviceMetrics{XIDError: xid(0), ECCDoubleBitErrors: count(0), ThrottleReasons: mask(0x1)},
+			health: model.GPUHealthHealthy,
+		},
+		{
+			name:   "application fault is not a device problem",
+			device: GPUDeviceMetrics{XIDError: xid(13)},
+			health: model.GPUHealthHealthy,
+		},
+		{
+			name:   "power cap is not a device problem",
+			device: GPUDeviceMetrics{ThrottleReasons: mask(0x4)},
+			health: model.GPUHealthHealthy,
+		},
+		{
+			name:    "fallen off the bus",
+			device:  GPUDeviceMetrics{XIDError: xid(79)},
+			health:  model.GPUHealthUnhealthy,
+			reasons: []string{"xid_79"},
+		},
+		{
+			name:    "double-bit ECC errors",
+			device:  GPUDeviceMetrics{ECCDoubleBitErrors: count(2), ThrottleReasons: mask(0x40)},
+			health:  model.GPUHealthUnhealthy,
+			reasons: []string{"ecc_double_bit_errors", "hw_thermal_slowdown"},
+		},
+		{
+			name:    "thermal slowdown",
+			device:  GPUDeviceMetrics{XIDError: xid(0), ThrottleReasons: mask(0x48)},
+			health:  model.GPUHealthDegraded,
+			reasons: []string{"hw_slowdown", "hw_thermal_slowdown"},
+		},
+		{
+			name:    "row remapping event",
+			device:  GPUDeviceMetrics{XIDError: xid(63)},
+			health:  model.GPUHealthDegraded,
+			reasons: []string{"xid_63"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, reasons := DeviceHealth(&tt.device)
+			assert.Equal(t, tt.health, health)
+			assert.Equal(t, tt.reasons, reasons)
+		})
+	}
+}
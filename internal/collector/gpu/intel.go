@@ -0,0 +1,32 @@
+package gpu
+
+import "github.com/kubeadapt/kubeadapt-agent/pkg/model"
+
+// intelParser reads the Intel XPU Manager exporter, for Data Center GPU Max
+// and Flex devices. Temperatures come per sensor location; the hottest is
+// kept.
+var intelParser = vendorParser{
+	vendor: model.GPUVendorIntel,
+	metric: metricTable(map[string]vendorMetric{
+		"xpum_gpu_utilization":     {fieldUtilization, 1},
+		"xpum_memory_bandwidth":    {fieldMemUtil, 1},
+		"xpum_memory_used_bytes":   {fieldMemUsed, 1},
+		"xpum_memory_free_bytes":   {fieldMemFree, 1},
+		"xpum_memory_total_bytes":  {fieldMemTotal, 1},
+		"xpum_temperature_celsius": {fieldTemperature, 1},
+		"xpum_power_watts":         {fieldPower, 1},
+	}),
+	labels: func(l map[string]string) deviceLabels {
+		return deviceLabels{
+			gpu:           l["deviceId"],
+			uuid:          firstLabel(l, "uuid", "pci_bdf"),
+			device:        l["dev_file"],
+			modelName:     l["dev_name"],
+			hostname:      l["hostname"],
+			podName:       l["pod"],
+			namespace:     l["namespace"],
+			containerName: l["container"],
+			gpuInstanceID: l["tile_id"],
+		}
+	},
+}
@@ -1,10 +1,8 @@
 package gpu
 
 import (
-	"bufio"
-	"bytes"
-	"strconv"
-	"strings"
+	"github.com/kubeadapt/kubeadapt-agent/internal/promtext"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 const (
@@ -27,9 +25,22 @@ const (
 	metricDevGPUTemp         = "DCGM_FI_DEV_GPU_TEMP"
 	metricDevPowerUsage      = "DCGM_FI_DEV_POWER_USAGE"
 	metricDevMIGMode         = "DCGM_FI_DEV_MIG_MODE"
+	metricDevSMClock         = "DCGM_FI_DEV_SM_CLOCK"
+	metricDevXIDErrors       = "DCGM_FI_DEV_XID_ERRORS"
+	metricDevECCSBEVolTotal  = "DCGM_FI_DEV_ECC_SBE_VOL_TOTAL"
+	metricDevECCDBEVolTotal  = "DCGM_FI_DEV_ECC_DBE_VOL_TOTAL"
+	metricDevThrottleReasons = "DCGM_FI_DEV_CLOCK_THROTTLE_REASONS"
+	// metricDevClocksEventReasons replaces DCGM_FI_DEV_CLOCK_THROTTLE_REASONS
+	// from DCGM 3.3 on; the bitmask is the same.
+	metricDevClocksEventReasons = "DCGM_FI_DEV_CLOCKS_EVENT_REASONS"
+	metricDevNVLinkBandwidth    = "DCGM_FI_DEV_NVLINK_BANDWIDTH_TOTAL"
+	metricProfNVLinkTxBytes     = "DCGM_FI_PROF_NVLINK_TX_BYTES"
+	metricProfNVLinkRxBytes     = "DCGM_FI_PROF_NVLINK_RX_BYTES"
 )
 
-type dcgmLabels struct {
+// deviceLabels are the labels identifying a device and the container using
+// it, normalized from each exporter's own label names.
+type deviceLabels struct {
 	gpu           string
 	uuid          string
 	device        string
@@ -43,298 +54,213 @@ type dcgmLabels struct {
 	gpuProfile    string
 }
 
-// parsedSample represents a single parsed Prometheus metric sample.
-type parsedSample struct {
-	name   string
-	labels dcgmLabels
-	value  float64
+// dcgmLabels normalizes dcgm-exporter labels. New-style labels (pod,
+// namespace, container) take priority over old-style ones (pod_name,
+// pod_namespace, container_name).
+func dcgmLabels(l map[string]string) deviceLabels {
+	return deviceLabels{
+		gpu:           l["gpu"],
+		uuid:          firstLabel(l, "UUID", "uuid"),
+		device:        l["device"],
+		modelName:     l["modelName"],
+		driverVersion: l["DCGM_FI_DRIVER_VERSION"],
+		hostname:      l["Hostname"],
+		podName:       firstLabel(l, "pod", "pod_name"),
+		namespace:     firstLabel(l, "namespace", "pod_namespace"),
+		containerName: firstLabel(l, "container", "container_name"),
+		gpuInstanceID: l["GPU_I_ID"],
+		gpuProfile:    l["GPU_I_PROFILE"],
+	}
 }
 
 // ParseDCGMMetrics parses Prometheus exposition text from dcgm-exporter and returns
 // per-GPU device metrics. It handles both old-style (pod_name, pod_namespace, container_name)
 // and new-style (pod, namespace, container) label schemas.
 func ParseDCGMMetrics(data []byte) ([]GPUDeviceMetrics, error) {
-	samples := parsePrometheusText(data)
+	return parseDCGMSamples(promtext.Parse(data, nil)), nil
+}
 
+func parseDCGMSamples(samples []promtext.Sample) []GPUDeviceMetrics {
 	gpus := make(map[string]*GPUDeviceMetrics)
 	hasProf := make(map[string]bool)
 
 	for _, s := range samples {
-		if s.labels.uuid == "" && s.labels.gpu == "" {
+		labels := dcgmLabels(s.Labels)
+		if labels.uuid == "" && labels.gpu == "" {
 			continue
 		}
 
-		key := s.labels.uuid
+		key := labels.uuid
 		if key == "" {
-			key = s.labels.gpu
+			key = labels.gpu
+		}
+		// MIG instances of a GPU share its UUID.
+		if labels.gpuInstanceID != "" {
+			key += "/" + labels.gpuInstanceID
 		}
 
-		gpu := getOrCreateGPU(gpus, key, s.labels)
+		gpu := getOrCreateGPU(gpus, key, model.GPUVendorNVIDIA, labels)
 
-		switch s.name {
+		switch s.Name {
 		case metricProfGrEngineActive:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			pct := s.value * 100
+			pct := s.Value * 100
 			gpu.GPUUtilization = &pct
 			hasProf[key] = true
 
 		case metricDevGPUUtil:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
 			if !hasProf[key] {
-				v := s.value
+				v := s.Value
 				gpu.GPUUtilization = &v
 			}
 
 		case metricProfTensorActive:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			pct := s.value * 100
+			pct := s.Value * 100
 			gpu.TensorActivePercent = &pct
 
 		case metricDevMemCopyUtil:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			v := s.value
+			v := s.Value
 			gpu.MemCopyUtilPercent = &v
 
 		case metricDevFBUsed:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			b := int64(s.value * mibToBytes)
+			b := int64(s.Value * mibToBytes)
 			gpu.MemoryUsedBytes = &b
 
 		case metricDevFBFree:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			b := int64(s.value * mibToBytes)
+			b := int64(s.Value * mibToBytes)
 			gpu.MemoryFreeBytes = &b
 
 		case metricDevFBTotal:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			b := int64(s.value * mibToBytes)
+			b := int64(s.Value * mibToBytes)
 			gpu.MemoryTotalBytes = &b
 
 		case metricDevGPUTemp:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			v := s.value
+			v := s.Value
 			gpu.Temperature = &v
 
 		case metricDevPowerUsage:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			v := s.value
+			v := s.Value
 			gpu.PowerUsage = &v
 
 		case metricDevMIGMode:
-			if isSentinel(s.value) {
+			if isSentinel(s.Value) {
 				continue
 			}
-			enabled := s.value == 1
+			enabled := s.Value == 1
 			gpu.MIGEnabled = &enabled
-		}
-	}
-
-	result := make([]GPUDeviceMetrics, 0, len(gpus))
-	for _, gpu := range gpus {
-		if gpu.MemoryTotalBytes == nil && gpu.MemoryUsedBytes != nil && gpu.MemoryFreeBytes != nil {
-			total := *gpu.MemoryUsedBytes + *gpu.MemoryFreeBytes
-			gpu.MemoryTotalBytes = &total
-		}
-		result = append(result, *gpu)
-	}
-	return result, nil
-}
-
-// parsePrometheusText parses Prometheus exposition text format line-by-line,
-// extracting metric samples with their labels and values.
-func parsePrometheusText(data []byte) []parsedSample {
-	var samples []parsedSample
-	scanner := bufio.NewScanner(bytes.NewReader(data))
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || line[0] == '#' {
-			continue
-		}
-
-		s, ok := parseSampleLine(line)
-		if !ok {
-			continue
-		}
-		samples = append(samples, s)
-	}
-
-	return samples
-}
-
-// parseSampleLine parses a single Prometheus metric line:
-//
-//	metric_name{label1="val1",label2="val2"} value [timestamp]
-func parseSampleLine(line string) (parsedSample, bool) {
-	var s parsedSample
-
-	braceStart := strings.IndexByte(line, '{')
-	if braceStart < 0 {
-		// No labels: "name value"
-		parts := strings.Fields(line)
-		if len(parts) < 2 {
-			return s, false
-		}
-		s.name = parts[0]
-		v, err := strconv.ParseFloat(parts[1], 64)
-		if err != nil {
-			return s, false
-		}
-		s.value = v
-		return s, true
-	}
-
-	s.name = line[:braceStart]
-
-	braceEnd := strings.LastIndexByte(line, '}')
-	if braceEnd <= braceStart {
-		return s, false
-	}
+		case metricDevSMClock:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := s.Value
+			gpu.SMClockMHz = &v
 
-	s.labels = parseLabels(line[braceStart+1 : braceEnd])
+		case metricDevXIDErrors:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := int(s.Value)
+			gpu.XIDError = &v
 
-	valueStr := strings.TrimSpace(line[braceEnd+1:])
-	parts := strings.Fields(valueStr)
-	if len(parts) == 0 {
-		return s, false
-	}
-	v, err := strconv.ParseFloat(parts[0], 64)
-	if err != nil {
-		return s, false
-	}
-	s.value = v
+		case metricDevECCSBEVolTotal:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := int64(s.Value)
+			gpu.ECCSingleBitErrors = &v
 
-	return s, true
-}
+		case metricDevECCDBEVolTotal:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := int64(s.Value)
+			gpu.ECCDoubleBitErrors = &v
 
-// parseLabels parses the label portion of a Prometheus metric line:
-//
-//	label1="val1",label2="val2"
-//
-// It handles escaped characters within quoted label values.
-func parseLabels(s string) dcgmLabels {
-	var l dcgmLabels
-	for len(s) > 0 {
-		// Find key=
-		eq := strings.IndexByte(s, '=')
-		if eq < 0 {
-			break
-		}
-		key := s[:eq]
-		s = s[eq+1:]
+		case metricDevThrottleReasons, metricDevClocksEventReasons:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := uint64(s.Value)
+			gpu.ThrottleReasons = &v
 
-		// Expect opening quote
-		if len(s) == 0 || s[0] != '"' {
-			break
-		}
-		s = s[1:]
-
-		// Read value until unescaped closing quote
-		var val strings.Builder
-		i := 0
-		for i < len(s) {
-			if s[i] == '\\' && i+1 < len(s) {
-				switch s[i+1] {
-				case '"':
-					val.WriteByte('"')
-				case '\\':
-					val.WriteByte('\\')
-				case 'n':
-					val.WriteByte('\n')
-				default:
-					val.WriteByte('\\')
-					val.WriteByte(s[i+1])
-				}
-				i += 2
+		case metricDevNVLinkBandwidth:
+			if isSentinel(s.Value) {
 				continue
 			}
-			if s[i] == '"' {
-				break
+			v := int64(s.Value)
+			gpu.NVLinkBandwidthTotal = &v
+
+		case metricProfNVLinkTxBytes:
+			if isSentinel(s.Value) {
+				continue
 			}
-			val.WriteByte(s[i])
-			i++
-		}
+			v := s.Value
+			gpu.NVLinkTxBytesPerSec = &v
 
-		value := val.String()
-		if i < len(s) {
-			s = s[i+1:] // skip closing quote
-		} else {
-			s = ""
+		case metricProfNVLinkRxBytes:
+			if isSentinel(s.Value) {
+				continue
+			}
+			v := s.Value
+			gpu.NVLinkRxBytesPerSec = &v
 		}
+	}
 
-		// Skip comma separator
-		if len(s) > 0 && s[0] == ',' {
-			s = s[1:]
+	result := make([]GPUDeviceMetrics, 0, len(gpus))
+	for _, gpu := range gpus {
+		if gpu.MemoryTotalBytes == nil && gpu.MemoryUsedBytes != nil && gpu.MemoryFreeBytes != nil {
+			total := *gpu.MemoryUsedBytes + *gpu.MemoryFreeBytes
+			gpu.MemoryTotalBytes = &total
 		}
+		result = append(result, *gpu)
+	}
+	return result
+}
 
-		// Map label to struct field, normalizing old/new schemas.
-		// New-style labels always overwrite; old-style only set if empty.
-		switch key {
-		case "gpu":
-			l.gpu = value
-		case "UUID", "uuid":
-			l.uuid = value
-		case "device":
-			l.device = value
-		case "modelName":
-			l.modelName = value
-		case "DCGM_FI_DRIVER_VERSION":
-			l.driverVersion = value
-		case "Hostname":
-			l.hostname = value
-		// New-style labels (take priority)
-		case "pod":
-			l.podName = value
-		case "namespace":
-			l.namespace = value
-		case "container":
-			l.containerName = value
-		// Old-style labels (fallback)
-		case "pod_name":
-			if l.podName == "" {
-				l.podName = value
-			}
-		case "pod_namespace":
-			if l.namespace == "" {
-				l.namespace = value
-			}
-		case "container_name":
-			if l.containerName == "" {
-				l.containerName = value
-			}
-		case "GPU_I_ID":
-			l.gpuInstanceID = value
-		case "GPU_I_PROFILE":
-			l.gpuProfile = value
+// firstLabel returns the first non-empty value among the given label names.
+func firstLabel(l map[string]string, names ...string) string {
+	for _, name := range names {
+		if v := l[name]; v != "" {
+			return v
 		}
 	}
-	return l
+	return ""
 }
 
 // getOrCreateGPU returns the GPUDeviceMetrics for the given key, creating it if needed.
-func getOrCreateGPU(gpus map[string]*GPUDeviceMetrics, key string, labels dcgmLabels) *GPUDeviceMetrics {
+func getOrCreateGPU(gpus map[string]*GPUDeviceMetrics, key, vendor string, labels deviceLabels) *GPUDeviceMetrics {
 	if g, ok := gpus[key]; ok {
 		return g
 	}
 	g := &GPUDeviceMetrics{
+		Vendor:        vendor,
 		GPU:           labels.gpu,
 		UUID:          labels.uuid,
 		Device:        labels.device,
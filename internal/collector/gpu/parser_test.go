@@ -301,55 +301,73 @@ DCGM_FI_DEV_GPU_UTIL{gpu="1",UUID="GPU-B",device="nvidia1",modelName="T4",Hostna
 	assert.InDelta(t, 60.0, *gpuB.GPUUtilization, 0.001)
 }
 
-func TestParseSampleLine(t *testing.T) {
-	tests := []struct {
-		name      string
-		line      string
-		wantOK    bool
-		wantName  string
-		wantValue float64
-	}{
-		{
-			name:      "metric with labels",
-			line:      `DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-123"} 42`,
-			wantOK:    true,
-			wantName:  "DCGM_FI_DEV_GPU_UTIL",
-			wantValue: 42,
-		},
-		{
-			name:      "metric without labels",
-			line:      `some_metric 3.14`,
-			wantOK:    true,
-			wantName:  "some_metric",
-			wantValue: 3.14,
-		},
-		{
-			name:      "metric with timestamp",
-			line:      `DCGM_FI_DEV_GPU_UTIL{gpu="0"} 42 1234567890`,
-			wantOK:    true,
-			wantName:  "DCGM_FI_DEV_GPU_UTIL",
-			wantValue: 42,
-		},
-		{
-			name:   "empty line",
-			line:   "",
-			wantOK: false,
-		},
-		{
-			name:   "malformed",
-			line:   "no_value_here",
-			wantOK: false,
-		},
+const dcgmOutputHealth = `# HELP DCGM_FI_DEV_XID_ERRORS Value of the last XID error encountered.
+# TYPE DCGM_FI_DEV_XID_ERRORS gauge
+DCGM_FI_DEV_XID_ERRORS{gpu="0",UUID="GPU-h0",Hostname="h100-node",err_code="79",err_msg="GPU has fallen off the bus"} 79
+DCGM_FI_DEV_XID_ERRORS{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 0
+DCGM_FI_DEV_ECC_SBE_VOL_TOTAL{gpu="0",UUID="GPU-h0",Hostname="h100-node"} 12
+DCGM_FI_DEV_ECC_DBE_VOL_TOTAL{gpu="0",UUID="GPU-h0",Hostname="h100-node"} 1
+DCGM_FI_DEV_CLOCK_THROTTLE_REASONS{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 64
+DCGM_FI_DEV_SM_CLOCK{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 1410
+DCGM_FI_DEV_NVLINK_BANDWIDTH_TOTAL{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 123456789
+DCGM_FI_PROF_NVLINK_TX_BYTES{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 2.5e+10
+DCGM_FI_PROF_NVLINK_RX_BYTES{gpu="1",UUID="GPU-h1",Hostname="h100-node"} 1.5e+10
+`
+
+func TestParseDCGMMetrics_HealthSignals(t *testing.T) {
+	metrics, err := ParseDCGMMetrics([]byte(dcgmOutputHealth))
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+
+	byUUID := make(map[string]GPUDeviceMetrics)
+	for _, m := range metrics {
+		byUUID[m.UUID] = m
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			s, ok := parseSampleLine(tt.line)
-			assert.Equal(t, tt.wantOK, ok)
-			if ok {
-				assert.Equal(t, tt.wantName, s.name)
-				assert.InDelta(t, tt.wantValue, s.value, 0.001)
-			}
-		})
+	g0 := byUUID["GPU-h0"]
+	require.NotNil(t, g0.XIDError)
+	assert.Equal(t, 79, *g0.XIDError)
+	require.NotNil(t, g0.ECCSingleBitErrors)
+	assert.Equal(t, int64(12), *g0.ECCSingleBitErrors)
+	require.NotNil(t, g0.ECCDoubleBitErrors)
+	assert.Equal(t, int64(1), *g0.ECCDoubleBitErrors)
+
+	g1 := byUUID["GPU-h1"]
+	require.NotNil(t, g1.XIDError)
+	assert.Equal(t, 0, *g1.XIDError)
+	require.NotNil(t, g1.ThrottleReasons)
+	assert.Equal(t, uint64(64), *g1.ThrottleReasons)
+	require.NotNil(t, g1.SMClockMHz)
+	assert.InDelta(t, 1410.0, *g1.SMClockMHz, 0.001)
+	require.NotNil(t, g1.NVLinkBandwidthTotal)
+	assert.Equal(t, int64(123456789), *g1.NVLinkBandwidthTotal)
+	require.NotNil(t, g1.NVLinkTxBytesPerSec)
+	assert.InDelta(t, 2.5e10, *g1.NVLinkTxBytesPerSec, 1)
+	require.NotNil(t, g1.NVLinkRxBytesPerSec)
+	assert.InDelta(t, 1.5e10, *g1.NVLinkRxBytesPerSec, 1)
+}
+
+func TestParseDCGMMetrics_ClocksEventReasons(t *testing.T) {
+	metrics, err := ParseDCGMMetrics([]byte(`DCGM_FI_DEV_CLOCKS_EVENT_REASONS{gpu="0",UUID="GPU-x"} 4` + "\n"))
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+	require.NotNil(t, metrics[0].ThrottleReasons)
+	assert.Equal(t, uint64(4), *metrics[0].ThrottleReasons)
+}
+
+func TestParseDCGMMetrics_MIGInstancesAreSeparateDevices(t *testing.T) {
+	metrics, err := ParseDCGMMetrics([]byte(`DCGM_FI_PROF_GR_ENGINE_ACTIVE{gpu="0",UUID="GPU-mig",GPU_I_ID="1",GPU_I_PROFILE="1g.10gb",pod="a",namespace="ml",container="c"} 0.1
+DCGM_FI_PROF_GR_ENGINE_ACTIVE{gpu="0",UUID="GPU-mig",GPU_I_ID="2",GPU_I_PROFILE="3g.40gb",pod="b",namespace="ml",container="c"} 0.6
+`))
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+
+	byInstance := make(map[string]GPUDeviceMetrics)
+	for _, m := range metrics {
+		byInstance[m.GPUInstanceID] = m
 	}
+	assert.Equal(t, "a", byInstance["1"].PodName)
+	assert.Equal(t, "3g.40gb", byInstance["2"].GPUProfile)
+	require.NotNil(t, byInstance["2"].GPUUtilization)
+	assert.InDelta(t, 60.0, *byInstance["2"].GPUUtilization, 0.001)
 }
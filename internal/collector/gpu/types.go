@@ -1,7 +1,9 @@
 package gpu
 
-// GPUDeviceMetrics represents metrics for a single GPU device scraped from dcgm-exporter.
+// GPUDeviceMetrics represents metrics for a single GPU device scraped from a
+// vendor's metrics exporter.
 type GPUDeviceMetrics struct {
+	Vendor        string `json:"vendor"`
 	GPU           string `json:"gpu"`
 	UUID          string `json:"uuid"`
 	Device        string `json:"device,omitempty"`
@@ -27,6 +29,18 @@ type GPUDeviceMetrics struct {
 
 	Temperature *float64 `json:"temperature,omitempty"`
 	PowerUsage  *float64 `json:"power_usage,omitempty"`
+	SMClockMHz  *float64 `json:"sm_clock_mhz,omitempty"`
+
+	// XIDError is the last XID error the driver reported, 0 when none.
+	XIDError           *int   `json:"xid_error,omitempty"`
+	ECCSingleBitErrors *int64 `json:"ecc_sbe_volatile_total,omitempty"`
+	ECCDoubleBitErrors *int64 `json:"ecc_dbe_volatile_total,omitempty"`
+	// ThrottleReasons is the DCGM clock throttle reasons bitmask.
+	ThrottleReasons *uint64 `json:"throttle_reasons,omitempty"`
+
+	NVLinkBandwidthTotal *int64   `json:"nvlink_bandwidth_total,omitempty"`
+	NVLinkTxBytesPerSec  *float64 `json:"nvlink_tx_bytes_per_sec,omitempty"`
+	NVLinkRxBytesPerSec  *float64 `json:"nvlink_rx_bytes_per_sec,omitempty"`
 
 	Timestamp int64 `json:"timestamp"`
 }
@@ -0,0 +1,148 @@
+package gpu
+
+import (
+	"math"
+	"strings"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/promtext"
+)
+
+// deviceField is a GPUDeviceMetrics field a vendor metric maps to.
+type deviceField int
+
+const (
+	fieldUtilization deviceField = iota
+	fieldMemUtil
+	fieldMemUsed
+	fieldMemFree
+	fieldMemTotal
+	fieldTemperature
+	fieldPower
+)
+
+// vendorMetric maps an exporter metric to a device field. The sample value
+// is multiplied by scale.
+type vendorMetric struct {
+	field deviceField
+	scale float64
+}
+
+// vendorParser describes the metrics of a non-DCGM GPU exporter.
+type vendorParser struct {
+	vendor string
+	// metric maps a sample name to its field, or returns false for metrics
+	// the parser ignores.
+	metric func(name string) (vendorMetric, bool)
+	labels func(l map[string]string) deviceLabels
+}
+
+// vendorParsers lists the exporters ParseGPUMetrics understands besides
+// dcgm-exporter, in the order their metric names are tried.
+var vendorParsers = []vendorParser{amdParser, intelParser, habanaParser}
+
+// ParseGPUMetrics parses Prometheus exposition text from any supported GPU
+// exporter: dcgm-exporter, the AMD device metrics exporter, Intel XPU Manager
+// or the Habana metric exporter. Samples are routed to a vendor by metric
+// name, so one scrape may contain several exporters' metrics.
+func ParseGPUMetrics(data []byte) ([]GPUDeviceMetrics, error) {
+	var (
+		dcgm     []promtext.Sample
+		byVendor = make(map[int][]promtext.Sample)
+	)
+	for _, s := range promtext.Parse(data, nil) {
+		if strings.HasPrefix(s.Name, "DCGM_") {
+			dcgm = append(dcgm, s)
+			continue
+		}
+		for i, p := range vendorParsers {
+			if _, ok := p.metric(s.Name); ok {
+				byVendor[i] = append(byVendor[i], s)
+				break
+			}
+		}
+	}
+
+	var result []GPUDeviceMetrics
+	if len(dcgm) > 0 {
+		result = append(result, parseDCGMSamples(dcgm)...)
+	}
+	for i, p := range vendorParsers {
+		if samples := byVendor[i]; len(samples) > 0 {
+			result = append(result, p.parse(samples)...)
+		}
+	}
+	return result, nil
+}
+
+// parse builds per-device metrics from samples of the vendor's exporter.
+// Devices are keyed by UUID, or by index without one. The highest of
+// several temperature sensors is kept.
+func (p vendorParser) parse(samples []promtext.Sample) []GPUDeviceMetrics {
+	gpus := make(map[string]*GPUDeviceMetrics)
+	var order []string
+
+	for _, s := range samples {
+		m, ok := p.metric(s.Name)
+		if !ok || math.IsNaN(s.Value) || math.IsInf(s.Value, 0) {
+			continue
+		}
+		labels := p.labels(s.Labels)
+		key := labels.uuid
+		if key == "" {
+			key = labels.gpu
+		}
+		if key == "" {
+			continue
+		}
+		if _, seen := gpus[key]; !seen {
+			order = append(order, key)
+		}
+		g := getOrCreateGPU(gpus, key, p.vendor, labels)
+
+		v := s.Value * m.scale
+		switch m.field {
+		case fieldUtilization:
+			g.GPUUtilization = &v
+		case fieldMemUtil:
+			g.MemCopyUtilPercent = &v
+		case fieldMemUsed:
+			b := int64(v)
+			g.MemoryUsedBytes = &b
+		case fieldMemFree:
+			b := int64(v)
+			g.MemoryFreeBytes = &b
+		case fieldMemTotal:
+			b := int64(v)
+			g.MemoryTotalBytes = &b
+		case fieldTemperature:
+			if g.Temperature == nil || v > *g.Temperature {
+				g.Temperature = &v
+			}
+		case fieldPower:
+			g.PowerUsage = &v
+		}
+	}
+
+	result := make([]GPUDeviceMetrics, 0, len(gpus))
+	for _, key := range order {
+		g := gpus[key]
+		switch {
+		case g.MemoryTotalBytes == nil && g.MemoryUsedBytes != nil && g.MemoryFreeBytes != nil:
+			total := *g.MemoryUsedBytes + *g.MemoryFreeBytes
+			g.MemoryTotalBytes = &total
+		case g.MemoryUsedBytes == nil && g.MemoryTotalBytes != nil && g.MemoryFreeBytes != nil:
+			used := *g.MemoryTotalBytes - *g.MemoryFreeBytes
+			g.MemoryUsedBytes = &used
+		}
+		result = append(result, *g)
+	}
+	return result
+}
+
+// metricTable returns a vendorParser.metric func looking names up in t.
+func metricTable(t map[string]vendorMetric) func(string) (vendorMetric, bool) {
+	return func(name string) (vendorMetric, bool) {
+		m, ok := t[name]
+		return m, ok
+	}
+}
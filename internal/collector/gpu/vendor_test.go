@@ -0,0 +1,147 @@
+package gpu
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const amdOutput = `# HELP gpu_gfx_activity Graphics engine activity (in %).
+# TYPE gpu_gfx_activity gauge
+gpu_gfx_activity{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",card_model="AMD Instinct MI300X",driver_version="6.8.5",hostname="mi300-node-1",pod="llm-0",namespace="ml",container="server"} 87
+gpu_gfx_activity{gpu_id="1",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c2",card_model="AMD Instinct MI300X",driver_version="6.8.5",hostname="mi300-node-1",pod="",namespace="",container=""} 0
+gpu_umc_activity{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 41
+gpu_used_vram{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 98304
+gpu_total_vram{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 196608
+gpu_edge_temperature{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 52
+gpu_junction_temperature{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 71
+gpu_power_usage{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 612.5
+gpu_clock{gpu_id="0",gpu_uuid="5fff74a1-0000-1000-80f1-d5a8e2e8b3c1",hostname="mi300-node-1"} 2100
+`
+
+func TestParseGPUMetrics_AMD(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte(amdOutput))
+	require.NoError(t, err)
+	require.Len(t, gpus, 2)
+
+	g := gpus[0]
+	assert.Equal(t, "amd", g.Vendor)
+	assert.Equal(t, "0", g.GPU)
+	assert.Equal(t, "5fff74a1-0000-1000-80f1-d5a8e2e8b3c1", g.UUID)
+	assert.Equal(t, "AMD Instinct MI300X", g.ModelName)
+	assert.Equal(t, "6.8.5", g.DriverVersion)
+	assert.Equal(t, "mi300-node-1", g.Hostname)
+	assert.Equal(t, "llm-0", g.PodName)
+	assert.Equal(t, "ml", g.Namespace)
+	assert.Equal(t, "server", g.ContainerName)
+
+	require.NotNil(t, g.GPUUtilization)
+	assert.InDelta(t, 87.0, *g.GPUUtilization, 0.001)
+	require.NotNil(t, g.MemCopyUtilPercent)
+	assert.InDelta(t, 41.0, *g.MemCopyUtilPercent, 0.001)
+	require.NotNil(t, g.MemoryUsedBytes)
+	assert.Equal(t, int64(96<<30), *g.MemoryUsedBytes)
+	require.NotNil(t, g.MemoryTotalBytes)
+	assert.Equal(t, int64(192<<30), *g.MemoryTotalBytes)
+	require.NotNil(t, g.Temperature)
+	assert.InDelta(t, 71.0, *g.Temperature, 0.001, "hottest sensor wins")
+	require.NotNil(t, g.PowerUsage)
+	assert.InDelta(t, 612.5, *g.PowerUsage, 0.001)
+
+	assert.Equal(t, "1", gpus[1].GPU)
+	assert.Empty(t, gpus[1].PodName)
+}
+
+func TestParseGPUMetrics_AMDPrefixed(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte(`amd_gpu_gfx_activity{gpu_id="3",serial_number="692251001124"} 12
+amd_gpu_used_vram{gpu_id="3",serial_number="692251001124"} 1024
+amd_gpu_free_vram{gpu_id="3",serial_number="692251001124"} 3072
+`))
+	require.NoError(t, err)
+	require.Len(t, gpus, 1)
+	assert.Equal(t, "amd", gpus[0].Vendor)
+	assert.Equal(t, "692251001124", gpus[0].UUID)
+	require.NotNil(t, gpus[0].MemoryTotalBytes)
+	assert.Equal(t, int64(4<<30), *gpus[0].MemoryTotalBytes, "total derived from used + free")
+}
+
+func TestParseGPUMetrics_Intel(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte(`# HELP xpum_gpu_utilization GPU utilization (%)
+xpum_gpu_utilization{deviceId="0",dev_file="card1",pci_bdf="0000:29:00.0",dev_name="Intel(R) Data Center GPU Max 1550"} 64.5
+xpum_memory_used_bytes{deviceId="0",dev_file="card1",pci_bdf="0000:29:00.0"} 8589934592
+xpum_temperature_celsius{deviceId="0",dev_file="card1",pci_bdf="0000:29:00.0",location="gpu"} 58
+xpum_temperature_celsius{deviceId="0",dev_file="card1",pci_bdf="0000:29:00.0",location="memory"} 49
+xpum_power_watts{deviceId="0",dev_file="card1",pci_bdf="0000:29:00.0"} 402
+`))
+	require.NoError(t, err)
+	require.Len(t, gpus, 1)
+
+	g := gpus[0]
+	assert.Equal(t, "intel", g.Vendor)
+	assert.Equal(t, "0", g.GPU)
+	assert.Equal(t, "0000:29:00.0", g.UUID)
+	assert.Equal(t, "card1", g.Device)
+	assert.Equal(t, "Intel(R) Data Center GPU Max 1550", g.ModelName)
+	require.NotNil(t, g.GPUUtilization)
+	assert.InDelta(t, 64.5, *g.GPUUtilization, 0.001)
+	require.NotNil(t, g.MemoryUsedBytes)
+	assert.Equal(t, int64(8<<30), *g.MemoryUsedBytes)
+	require.NotNil(t, g.Temperature)
+	assert.InDelta(t, 58.0, *g.Temperature, 0.001)
+	require.NotNil(t, g.PowerUsage)
+	assert.InDelta(t, 402.0, *g.PowerUsage, 0.001)
+}
+
+func TestParseGPUMetrics_Habana(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte(`habanalabs_utilization{UUID="01P0-HL2080A0-15-TNPS34-20-07-00",device="0",ModelName="HL-225"} 93
+habanalabs_memory_used_bytes{UUID="01P0-HL2080A0-15-TNPS34-20-07-00",device="0"} 68719476736
+habanalabs_memory_total_bytes{UUID="01P0-HL2080A0-15-TNPS34-20-07-00",device="0"} 103079215104
+habanalabs_temperature_onchip{UUID="01P0-HL2080A0-15-TNPS34-20-07-00",device="0"} 45
+habanalabs_power_mW{UUID="01P0-HL2080A0-15-TNPS34-20-07-00",device="0"} 420000
+`))
+	require.NoError(t, err)
+	require.Len(t, gpus, 1)
+
+	g := gpus[0]
+	assert.Equal(t, "habana", g.Vendor)
+	assert.Equal(t, "01P0-HL2080A0-15-TNPS34-20-07-00", g.UUID)
+	assert.Equal(t, "HL-225", g.ModelName)
+	require.NotNil(t, g.GPUUtilization)
+	assert.InDelta(t, 93.0, *g.GPUUtilization, 0.001)
+	require.NotNil(t, g.MemoryTotalBytes)
+	assert.Equal(t, int64(96<<30), *g.MemoryTotalBytes)
+	require.NotNil(t, g.PowerUsage)
+	assert.InDelta(t, 420.0, *g.PowerUsage, 0.001, "milliwatts converted to watts")
+}
+
+func TestParseGPUMetrics_DCGM(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte(dcgmOutputNewStyleMultiGPU))
+	require.NoError(t, err)
+	require.Len(t, gpus, 2)
+	for _, g := range gpus {
+		assert.Equal(t, "nvidia", g.Vendor)
+	}
+}
+
+func TestParseGPUMetrics_UnknownMetrics(t *testing.T) {
+	gpus, err := ParseGPUMetrics([]byte("process_cpu_seconds_total 12\ngo_goroutines 30\n"))
+	require.NoError(t, err)
+	assert.Empty(t, gpus)
+}
+
+func TestExporterClient_AttributesUnlabelledDevicesToNode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`xpum_gpu_utilization{deviceId="0",pci_bdf="0000:29:00.0"} 10` + "\n"))
+	}))
+	defer server.Close()
+
+	api := NewExporterClient(server.Client())
+	gpus, err := api.ScrapeGPUMetrics(context.Background(), []Endpoint{{URL: server.URL, Node: "xpu-node-1"}})
+	require.NoError(t, err)
+	require.Len(t, gpus, 1)
+	assert.Equal(t, "xpu-node-1", gpus[0].Hostname)
+}
@@ -0,0 +1,79 @@
+package kubelet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const proxyTimeout = 10 * time.Second
+
+// SummaryAPI abstracts kubelet Summary API access for testability.
+type SummaryAPI interface {
+	GetSummary(ctx context.Context, nodeName string) (*Summary, error)
+}
+
+// CAdvisorAPI abstracts kubelet cAdvisor metrics access for testability.
+type CAdvisorAPI interface {
+	GetContainerCounters(ctx context.Context, nodeName string) ([]ContainerCounters, error)
+}
+
+// NodeProxyClient fetches kubelet data through the API server's node proxy,
+// so the agent needs no direct network path to kubelets, only RBAC for
+// nodes/proxy. It implements SummaryAPI and CAdvisorAPI.
+type NodeProxyClient struct {
+	rest rest.Interface
+}
+
+// NewNodeProxyClient creates a NodeProxyClient.
+func NewNodeProxyClient(client kubernetes.Interface) *NodeProxyClient {
+	return &NodeProxyClient{rest: client.CoreV1().RESTClient()}
+}
+
+// GetSummary implements SummaryAPI.
+func (c *NodeProxyClient) GetSummary(ctx context.Context, nodeName string) (*Summary, error) {
+	ctx, cancel := context.WithTimeout(ctx, proxyTimeout)
+	defer cancel()
+
+	body, err := c.rest.Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("stats", "summary").
+		Param("only_cpu_and_memory", "false").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching stats summary for node %s: %w", nodeName, err)
+	}
+	return ParseSummary(body)
+}
+
+// GetContainerCounters implements CAdvisorAPI.
+func (c *NodeProxyClient) GetContainerCounters(ctx context.Context, nodeName string) ([]ContainerCounters, error) {
+	ctx, cancel := context.WithTimeout(ctx, proxyTimeout)
+	defer cancel()
+
+	body, err := c.rest.Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("metrics", "cadvisor").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching cadvisor metrics for node %s: %w", nodeName, err)
+	}
+	return ParseCAdvisorMetrics(body), nil
+}
+
+// ParseSummary decodes a kubelet stats/v1alpha1 Summary response.
+func ParseSummary(body []byte) (*Summary, error) {
+	var s Summary
+	if err := json.Unmarshal(body, &s); err != nil {
+		return nil, fmt.Errorf("decoding stats summary: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,76 @@
+package kubelet
+
+import "github.com/kubeadapt/kubeadapt-agent/internal/promtext"
+
+const (
+	metricCFSThrottledPeriods = "container_cpu_cfs_throttled_periods_total"
+	metricCFSPeriods          = "container_cpu_cfs_periods_total"
+	metricOOMEvents           = "container_oom_events_total"
+)
+
+// ContainerCounters holds the cumulative cAdvisor counters of one container
+// as scraped from /metrics/cadvisor.
+type ContainerCounters struct {
+	Namespace string
+	Pod       string
+	Container string
+
+	CFSThrottledPeriods float64
+	CFSPeriods          float64
+	OOMEvents           float64
+}
+
+// Key returns the "namespace/pod/container" key used in the MetricsStore.
+func (c ContainerCounters) Key() string {
+	return c.Namespace + "/" + c.Pod + "/" + c.Container
+}
+
+// ParseCAdvisorMetrics extracts CFS throttling and OOM counters per container
+// from kubelet cAdvisor Prometheus exposition text. Pod-level cgroups
+// (container="") and pause containers (container="POD") are skipped, as are
+// all other metric families.
+func ParseCAdvisorMetrics(data []byte) []ContainerCounters {
+	byKey := make(map[string]*ContainerCounters)
+	var order []string
+
+	for _, sample := range promtext.Parse(data, isCAdvisorMetric) {
+		labels := sample.Labels
+		container := labels["container"]
+		if container == "" || container == "POD" || labels["pod"] == "" {
+			continue
+		}
+		c := ContainerCounters{Namespace: labels["namespace"], Pod: labels["pod"], Container: container}
+		key := c.Key()
+		cc, exists := byKey[key]
+		if !exists {
+			cc = &c
+			byKey[key] = cc
+			order = append(order, key)
+		}
+
+		switch sample.Name {
+		case metricCFSThrottledPeriods:
+			cc.CFSThrottledPeriods = sample.Value
+		case metricCFSPeriods:
+			cc.CFSPeriods = sample.Value
+		case metricOOMEvents:
+			cc.OOMEvents = sample.Value
+		}
+	}
+
+	out := make([]ContainerCounters, 0, len(order))
+	for _, k := range order {
+		out = append(out, *byKey[k])
+	}
+	return out
+}
+
+// isCAdvisorMetric reports whether name is one of the metric families kept
+// from the cAdvisor endpoint.
+func isCAdvisorMetric(name string) bool {
+	switch name {
+	case metricCFSThrottledPeriods, metricCFSPeriods, metricOOMEvents:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,245 @@
+package kubelet
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// CAdvisorCollector scrapes the kubelet cAdvisor endpoint of every node on a
+// timer and writes per-interval CFS throttling and OOM signals to the
+// MetricsStore. Signals are the difference between two consecutive scrapes,
+// so a container first appears one interval after it is first seen.
+type CAdvisorCollector struct {
+	api          CAdvisorAPI
+	nodesFn      func() []string
+	metricsStore *store.MetricsStore
+	metrics      *observability.Metrics
+	interval     time.Duration
+	intervalCh   chan time.Duration
+	concurrency  int
+	stopCh       chan struct{}
+	done         chan struct{}
+
+	syncOnce sync.Once
+	synced   chan struct{}
+
+	// Counters from the previous scrape by container key, and the container
+	// keys each node reported last. Accessed only from the poll goroutine.
+	prev     map[string]ContainerCounters
+	nodeKeys map[string][]string
+
+	// API call counters — each poll() makes one call per node.
+	pollTotal  atomic.Int64
+	pollFailed atomic.Int64
+}
+
+// NewCAdvisorCollector creates a CAdvisorCollector. nodesFn is called on each
+// poll to get the current node names.
+func NewCAdvisorCollector(api CAdvisorAPI, nodesFn func() []string, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval time.Duration, concurrency int) *CAdvisorCollector {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &CAdvisorCollector{
+		api:          api,
+		nodesFn:      nodesFn,
+		metricsStore: metricsStore,
+		metrics:      metrics,
+		interval:     interval,
+		intervalCh:   make(chan time.Duration, 1),
+		concurrency:  concurrency,
+		stopCh:       make(chan struct{}),
+		done:         make(chan struct{}),
+		synced:       make(chan struct{}),
+		prev:         make(map[string]ContainerCounters),
+		nodeKeys:     make(map[string][]string),
+	}
+}
+
+// Name implements collector.Collector.
+func (c *CAdvisorCollector) Name() string { return "cadvisor" }
+
+// Start implements collector.Collector.
+func (c *CAdvisorCollector) Start(ctx context.Context) error {
+	go c.run(ctx)
+	return nil
+}
+
+// WaitForSync implements collector.Collector.
+func (c *CAdvisorCollector) WaitForSync(ctx context.Context) error {
+	select {
+	case <-c.synced:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop implements collector.Collector.
+func (c *CAdvisorCollector) Stop() {
+	close(c.stopCh)
+	<-c.done
+}
+
+// SetInterval changes the polling interval, e.g. after a config file
+// reload. It takes effect after the current tick.
+func (c *CAdvisorCollector) SetInterval(interval time.Duration) {
+	select {
+	case <-c.intervalCh: // replace a change the loop has not picked up yet
+	default:
+	}
+	c.intervalCh <- interval
+}
+
+func (c *CAdvisorCollector) run(ctx context.Context) {
+	defer close(c.done)
+
+	// Poll immediately on start to record the baseline counters.
+	c.poll(ctx)
+	c.syncOnce.Do(func() { close(c.synced) })
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.poll(ctx)
+		case c.interval = <-c.intervalCh:
+			ticker.Reset(c.interval)
+		case <-c.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *CAdvisorCollector) poll(ctx context.Context) {
+	start := time.Now()
+	nodes := c.nodesFn()
+
+	results := fetchNodes(ctx, nodes, c.concurrency, &c.pollTotal, &c.pollFailed, c.api.GetContainerCounters)
+
+	now := time.Now().UnixMilli()
+	var failed int
+	var firstErr error
+	for _, r := range results {
+		if r.err != nil {
+			// Keep the node's previous signals and baseline; the next
+			// successful scrape covers the whole gap.
+			failed++
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			slog.Debug("cadvisor: failed to scrape node", "node", r.node, "error", r.err)
+			continue
+		}
+		c.storeNode(r.node, r.value, now)
+	}
+	c.pruneRemovedNodes(nodes)
+
+	if failed > 0 {
+		slog.Warn("cadvisor: some node scrapes failed, keeping stale data",
+			"failed", failed, "nodes", len(nodes), "error", firstErr)
+	}
+	if c.metrics != nil {
+		c.metrics.CAdvisorScrapeDuration.Observe(time.Since(start).Seconds())
+	}
+}
+
+// storeNode turns one node's counters into interval signals and drops
+// containers the node no longer reports.
+func (c *CAdvisorCollector) storeNode(node string, counters []ContainerCounters, timestamp int64) {
+	keys := make([]string, 0, len(counters))
+	for _, cur := range counters {
+		key := cur.Key()
+		keys = append(keys, key)
+
+		prev, ok := c.prev[key]
+		c.prev[key] = cur
+		if !ok {
+			continue
+		}
+		c.metricsStore.ContainerSignals.Set(key, model.ContainerSignals{
+			Namespace:           cur.Namespace,
+			Pod:                 cur.Pod,
+			Container:           cur.Container,
+			CPUPeriods:          counterDelta(cur.CFSPeriods, prev.CFSPeriods),
+			CPUThrottledPeriods: counterDelta(cur.CFSThrottledPeriods, prev.CFSThrottledPeriods),
+			OOMEvents:           counterDelta(cur.OOMEvents, prev.OOMEvents),
+			Timestamp:           timestamp,
+		})
+	}
+
+	c.forget(c.nodeKeys[node], keys)
+	c.nodeKeys[node] = keys
+}
+
+// pruneRemovedNodes drops signals for nodes that are no longer in the cluster.
+func (c *CAdvisorCollector) pruneRemovedNodes(nodes []string) {
+	current := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		current[n] = struct{}{}
+	}
+	for node, keys := range c.nodeKeys {
+		if _, ok := current[node]; ok {
+			continue
+		}
+		c.forget(keys, nil)
+		delete(c.nodeKeys, node)
+	}
+}
+
+// forget removes the signals and baselines of keys in prev that are not in
+// next.
+func (c *CAdvisorCollector) forget(prev, next []string) {
+	keep := make(map[string]struct{}, len(next))
+	for _, k := range next {
+		keep[k] = struct{}{}
+	}
+	for _, k := range prev {
+		if _, ok := keep[k]; !ok {
+			c.metricsStore.ContainerSignals.Delete(k)
+			delete(c.prev, k)
+		}
+	}
+}
+
+// counterDelta returns the increase of a cumulative counter. A decrease means
+// the counter was reset (container restarted with a new cgroup), in which
+// case the current value is the increase since the reset.
+func counterDelta(cur, prev float64) int64 {
+	if cur < prev {
+		return int64(cur)
+	}
+	return int64(cur - prev)
+}
+
+// IsHealthy implements collector.HealthChecker.
+// Reports unhealthy if the polling goroutine exited unexpectedly.
+func (c *CAdvisorCollector) IsHealthy() (bool, string) {
+	select {
+	case <-c.done:
+		select {
+		case <-c.stopCh:
+			return true, ""
+		default:
+			return false, "cadvisor polling goroutine exited unexpectedly"
+		}
+	default:
+		return true, ""
+	}
+}
+
+// APICallStats returns cumulative API call counters.
+// Each poll() makes one cAdvisor scrape per node.
+func (c *CAdvisorCollector) APICallStats() (total, failed int64) {
+	return c.pollTotal.Load(), c.pollFailed.Load()
+}
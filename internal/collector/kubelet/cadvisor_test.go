@@ -0,0 +1,136 @@
+package kubelet
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+)
+
+func TestParseCAdvisorMetrics_Fixture(t *testing.T) {
+	data, err := os.ReadFile("testdata/cadvisor.txt")
+	require.NoError(t, err)
+
+	counters := ParseCAdvisorMetrics(data)
+	require.Len(t, counters, 3, "pod cgroups, pause containers and the root cgroup must be skipped")
+
+	byKey := make(map[string]ContainerCounters, len(counters))
+	for _, c := range counters {
+		byKey[c.Key()] = c
+	}
+
+	app := byKey["shop/web-0/app"]
+	assert.InDelta(t, 50000, app.CFSPeriods, 0.001)
+	assert.InDelta(t, 12500, app.CFSThrottledPeriods, 0.001)
+	assert.Zero(t, app.OOMEvents)
+
+	sidecar := byKey["shop/web-0/sidecar"]
+	assert.InDelta(t, 10000, sidecar.CFSPeriods, 0.001)
+	assert.Zero(t, sidecar.CFSThrottledPeriods)
+
+	// Escaped quotes in label values are unescaped.
+	worker, ok := byKey[`jobs/etl-"nightly"-x7k2/worker`]
+	require.True(t, ok)
+	assert.InDelta(t, 400, worker.CFSThrottledPeriods, 0.001)
+	assert.InDelta(t, 2, worker.OOMEvents, 0.001)
+}
+
+func TestParseCAdvisorMetrics_Empty(t *testing.T) {
+	assert.Empty(t, ParseCAdvisorMetrics(nil))
+	assert.Empty(t, ParseCAdvisorMetrics([]byte("# only comments\n")))
+}
+
+func TestCounterDelta(t *testing.T) {
+	assert.Equal(t, int64(50), counterDelta(150, 100))
+	assert.Equal(t, int64(0), counterDelta(100, 100))
+	assert.Equal(t, int64(30), counterDelta(30, 100), "a reset counter counts from zero")
+}
+
+// mockCAdvisorAPI returns per-node counters set by the test.
+type mockCAdvisorAPI struct {
+	mu       sync.Mutex
+	counters map[string][]ContainerCounters
+	failing  map[string]bool
+}
+
+func (m *mockCAdvisorAPI) GetContainerCounters(_ context.Context, nodeName string) ([]ContainerCounters, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failing[nodeName] {
+		return nil, errors.New("proxy error")
+	}
+	return m.counters[nodeName], nil
+}
+
+func (m *mockCAdvisorAPI) set(node string, counters ...ContainerCounters) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[node] = counters
+}
+
+func TestCAdvisorCollector_IntervalSignals(t *testing.T) {
+	api := &mockCAdvisorAPI{counters: map[string][]ContainerCounters{}, failing: map[string]bool{}}
+	nodes := []string{"node-1"}
+	ms := store.NewMetricsStore()
+	c := NewCAdvisorCollector(api, func() []string { return nodes }, ms, nil, 0, 2)
+	assert.Equal(t, "cadvisor", c.Name())
+
+	api.set("node-1", ContainerCounters{Namespace: "shop", Pod: "web-0", Container: "app", CFSPeriods: 1000, CFSThrottledPeriods: 100, OOMEvents: 1})
+	c.poll(context.Background())
+	assert.Equal(t, 0, ms.ContainerSignals.Len(), "first scrape only records the baseline")
+
+	api.set("node-1", ContainerCounters{Namespace: "shop", Pod: "web-0", Container: "app", CFSPeriods: 1600, CFSThrottledPeriods: 250, OOMEvents: 3})
+	c.poll(context.Background())
+
+	sig, ok := ms.ContainerSignals.Get("shop/web-0/app")
+	require.True(t, ok)
+	assert.Equal(t, int64(600), sig.CPUPeriods)
+	assert.Equal(t, int64(150), sig.CPUThrottledPeriods)
+	assert.Equal(t, int64(2), sig.OOMEvents)
+	assert.NotZero(t, sig.Timestamp)
+
+	// A failed scrape keeps the last signals.
+	api.mu.Lock()
+	api.failing["node-1"] = true
+	api.mu.Unlock()
+	c.poll(context.Background())
+	_, ok = ms.ContainerSignals.Get("shop/web-0/app")
+	assert.True(t, ok)
+
+	// The container is gone on the next successful scrape.
+	api.mu.Lock()
+	api.failing["node-1"] = false
+	api.mu.Unlock()
+	api.set("node-1")
+	c.poll(context.Background())
+	assert.Equal(t, 0, ms.ContainerSignals.Len())
+	assert.Empty(t, c.prev)
+
+	total, failed := c.APICallStats()
+	assert.Equal(t, int64(4), total)
+	assert.Equal(t, int64(1), failed)
+}
+
+func TestCAdvisorCollector_PrunesRemovedNodes(t *testing.T) {
+	api := &mockCAdvisorAPI{counters: map[string][]ContainerCounters{}}
+	nodes := []string{"node-1"}
+	ms := store.NewMetricsStore()
+	c := NewCAdvisorCollector(api, func() []string { return nodes }, ms, nil, 0, 1)
+
+	api.set("node-1", ContainerCounters{Namespace: "ns", Pod: "p", Container: "c", CFSPeriods: 10})
+	c.poll(context.Background())
+	c.poll(context.Background())
+	require.Equal(t, 1, ms.ContainerSignals.Len())
+
+	nodes = nil
+	c.poll(context.Background())
+	assert.Equal(t, 0, ms.ContainerSignals.Len())
+	assert.Empty(t, c.prev)
+	assert.Empty(t, c.nodeKeys)
+}
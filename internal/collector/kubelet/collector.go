@@ -0,0 +1,277 @@
+package kubelet
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+)
+
+// SummaryCollector polls the kubelet Summary API of every node on a timer
+// and writes node, pod, and PVC stats to the MetricsStore. At most
+// concurrency nodes are fetched at once.
+type SummaryCollector struct {
+	api          SummaryAPI
+	nodesFn      func() []string
+	metricsStore *store.MetricsStore
+	metrics      *observability.Metrics
+	interval     time.Duration
+	intervalCh   chan time.Duration
+	concurrency  int
+	stopCh       chan struct{}
+	done         chan struct{}
+
+	syncOnce sync.Once
+	synced   chan struct{}
+
+	// Store keys written per node on its last successful fetch, so stats for
+	// deleted pods and nodes can be pruned, and the number of nodes that
+	// reported each key. Accessed only from the poll goroutine.
+	podKeys    map[string][]string
+	volumeKeys map[string][]string
+	podRefs    map[string]int
+	volumeRefs map[string]int
+
+	// API call counters — each poll() makes one call per node.
+	pollTotal  atomic.Int64
+	pollFailed atomic.Int64
+}
+
+// NewSummaryCollector creates a SummaryCollector. nodesFn is called on each
+// poll to get the current node names.
+func NewSummaryCollector(api SummaryAPI, nodesFn func() []string, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval time.Duration, concurrency int) *SummaryCollector {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &SummaryCollector{
+		api:          api,
+		nodesFn:      nodesFn,
+		metricsStore: metricsStore,
+		metrics:      metrics,
+		interval:     interval,
+		intervalCh:   make(chan time.Duration, 1),
+		concurrency:  concurrency,
+		stopCh:       make(chan struct{}),
+		done:         make(chan struct{}),
+		synced:       make(chan struct{}),
+		podKeys:      make(map[string][]string),
+		volumeKeys:   make(map[string][]string),
+		podRefs:      make(map[string]int),
+		volumeRefs:   make(map[string]int),
+	}
+}
+
+// Name implements collector.Collector.
+func (c *SummaryCollector) Name() string { return "kubelet_stats" }
+
+// Start implements collector.Collector.
+func (c *SummaryCollector) Start(ctx context.Context) error {
+	go c.run(ctx)
+	return nil
+}
+
+// WaitForSync implements collector.Collector.
+func (c *SummaryCollector) WaitForSync(ctx context.Context) error {
+	select {
+	case <-c.synced:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop implements collector.Collector.
+func (c *SummaryCollector) Stop() {
+	close(c.stopCh)
+	<-c.done
+}
+
+// SetInterval changes the polling interval, e.g. after a config file
+// reload. It takes effect after the current tick.
+func (c *SummaryCollector) SetInterval(interval time.Duration) {
+	select {
+	case <-c.intervalCh: // replace a change the loop has not picked up yet
+	default:
+	}
+	c.intervalCh <- interval
+}
+
+func (c *SummaryCollector) run(ctx context.Context) {
+	defer close(c.done)
+
+	// Poll immediately on start.
+	c.poll(ctx)
+	c.syncOnce.Do(func() { close(c.synced) })
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.poll(ctx)
+		case c.interval = <-c.intervalCh:
+			ticker.Reset(c.interval)
+		case <-c.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *SummaryCollector) poll(ctx context.Context) {
+	start := time.Now()
+	nodes := c.nodesFn()
+
+	results := fetchNodes(ctx, nodes, c.concurrency, &c.pollTotal, &c.pollFailed, c.api.GetSummary)
+
+	now := time.Now().UnixMilli()
+	var failed int
+	var firstErr error
+	for _, r := range results {
+		if r.err != nil {
+			// Keep the node's previous stats; they are refreshed next poll.
+			failed++
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			slog.Debug("kubelet stats: failed to fetch summary", "node", r.node, "error", r.err)
+			continue
+		}
+		c.storeNode(r.node, r.value, now)
+	}
+	c.pruneRemovedNodes(nodes)
+
+	if failed > 0 {
+		slog.Warn("kubelet stats: some node summaries failed, keeping stale data",
+			"failed", failed, "nodes", len(nodes), "error", firstErr)
+	}
+	if c.metrics != nil {
+		c.metrics.KubeletSummaryDuration.Observe(time.Since(start).Seconds())
+	}
+}
+
+// storeNode writes one node's stats and removes pod/volume entries the node
+// reported last time but not this time.
+func (c *SummaryCollector) storeNode(node string, s *Summary, timestamp int64) {
+	nodeStats, pods, volumes := toModel(s, node, timestamp)
+	c.metricsStore.NodeStats.Set(node, nodeStats)
+
+	podKeys := make([]string, 0, len(pods))
+	for _, p := range pods {
+		key := p.Namespace + "/" + p.Name
+		c.metricsStore.PodStats.Set(key, p)
+		podKeys = append(podKeys, key)
+	}
+	volumeKeys := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		key := v.Namespace + "/" + v.PVCName
+		c.metricsStore.VolumeStats.Set(key, v)
+		volumeKeys = append(volumeKeys, key)
+	}
+
+	updateRefs(c.metricsStore.PodStats, c.podRefs, c.podKeys[node], podKeys)
+	updateRefs(c.metricsStore.VolumeStats, c.volumeRefs, c.volumeKeys[node], volumeKeys)
+	c.podKeys[node] = podKeys
+	c.volumeKeys[node] = volumeKeys
+}
+
+// pruneRemovedNodes drops stats for nodes that are no longer in the cluster.
+func (c *SummaryCollector) pruneRemovedNodes(nodes []string) {
+	current := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		current[n] = struct{}{}
+	}
+	for node := range c.podKeys {
+		if _, ok := current[node]; ok {
+			continue
+		}
+		c.metricsStore.NodeStats.Delete(node)
+		updateRefs(c.metricsStore.PodStats, c.podRefs, c.podKeys[node], nil)
+		updateRefs(c.metricsStore.VolumeStats, c.volumeRefs, c.volumeKeys[node], nil)
+		delete(c.podKeys, node)
+		delete(c.volumeKeys, node)
+	}
+}
+
+// nodeResult is the outcome of one per-node kubelet request.
+type nodeResult[T any] struct {
+	node  string
+	value T
+	err   error
+}
+
+// fetchNodes calls fetch for every node with at most concurrency calls in
+// flight, counting calls and failures. Results are in node order.
+func fetchNodes[T any](
+	ctx context.Context,
+	nodes []string,
+	concurrency int,
+	total, failed *atomic.Int64,
+	fetch func(ctx context.Context, node string) (T, error),
+) []nodeResult[T] {
+	results := make([]nodeResult[T], len(nodes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, node := range nodes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, node string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			total.Add(1)
+			value, err := fetch(ctx, node)
+			if err != nil {
+				failed.Add(1)
+			}
+			results[i] = nodeResult[T]{node: node, value: value, err: err}
+		}(i, node)
+	}
+	wg.Wait()
+	return results
+}
+
+// updateRefs moves a node's reference from the keys in prev to those in
+// next and deletes the keys no node references anymore. A PVC mounted on
+// several nodes (ReadWriteMany), or a pod reported by both its old and new
+// node while it moves, is kept until every node stops reporting it.
+func updateRefs[T any](s *store.TypedStore[T], refs map[string]int, prev, next []string) {
+	for _, k := range next {
+		refs[k]++
+	}
+	for _, k := range prev {
+		if refs[k]--; refs[k] <= 0 {
+			delete(refs, k)
+			s.Delete(k)
+		}
+	}
+}
+
+// IsHealthy implements collector.HealthChecker.
+// Reports unhealthy if the polling goroutine exited unexpectedly.
+func (c *SummaryCollector) IsHealthy() (bool, string) {
+	select {
+	case <-c.done:
+		select {
+		case <-c.stopCh:
+			return true, ""
+		default:
+			return false, "kubelet stats polling goroutine exited unexpectedly"
+		}
+	default:
+		return true, ""
+	}
+}
+
+// APICallStats returns cumulative API call counters.
+// Each poll() makes one Summary API call per node.
+func (c *SummaryCollector) APICallStats() (total, failed int64) {
+	return c.pollTotal.Load(), c.pollFailed.Load()
+}
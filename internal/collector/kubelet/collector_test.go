@@ -0,0 +1,199 @@
+package kubelet
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+)
+
+// mockSummaryAPI returns per-node summaries and tracks peak concurrency.
+type mockSummaryAPI struct {
+	mu        sync.Mutex
+	summaries map[string]*Summary
+	failing   map[string]bool
+	delay     time.Duration
+
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (m *mockSummaryAPI) GetSummary(_ context.Context, nodeName string) (*Summary, error) {
+	n := m.inFlight.Add(1)
+	defer m.inFlight.Add(-1)
+	for {
+		p := m.peak.Load()
+		if n <= p || m.peak.CompareAndSwap(p, n) {
+			break
+		}
+	}
+	time.Sleep(m.delay)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failing[nodeName] {
+		return nil, errors.New("proxy error")
+	}
+	s, ok := m.summaries[nodeName]
+	if !ok {
+		return &Summary{Node: NodeSummary{NodeName: nodeName}}, nil
+	}
+	return s, nil
+}
+
+func (m *mockSummaryAPI) set(node string, s *Summary) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.summaries[node] = s
+}
+
+func podSummary(namespace, name, pvc string) PodSummary {
+	p := PodSummary{PodRef: PodReference{Name: name, Namespace: namespace}}
+	if pvc != "" {
+		p.VolumeStats = []VolumeSummary{{Name: "data", PVCRef: &PVCReference{Name: pvc, Namespace: namespace}}}
+	}
+	return p
+}
+
+func newTestCollector(api SummaryAPI, nodes func() []string, concurrency int) (*SummaryCollector, *store.MetricsStore) {
+	ms := store.NewMetricsStore()
+	return NewSummaryCollector(api, nodes, ms, nil, time.Minute, concurrency), ms
+}
+
+func TestSummaryCollector_Name(t *testing.T) {
+	c, _ := newTestCollector(&mockSummaryAPI{}, func() []string { return nil }, 1)
+	assert.Equal(t, "kubelet_stats", c.Name())
+}
+
+func TestSummaryCollector_Lifecycle(t *testing.T) {
+	api := &mockSummaryAPI{summaries: map[string]*Summary{"node-1": loadFixture(t)}}
+	c, ms := newTestCollector(api, func() []string { return []string{"node-1"} }, 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.WaitForSync(ctx))
+
+	node, ok := ms.NodeStats.Get("node-1")
+	require.True(t, ok)
+	assert.Equal(t, int64(40000000000), node.FSUsedBytes)
+	assert.NotZero(t, node.Timestamp)
+
+	_, ok = ms.PodStats.Get("shop/web-0")
+	assert.True(t, ok)
+	_, ok = ms.VolumeStats.Get("shop/data-web-0")
+	assert.True(t, ok)
+
+	healthy, _ := c.IsHealthy()
+	assert.True(t, healthy)
+
+	c.Stop()
+	healthy, _ = c.IsHealthy()
+	assert.True(t, healthy, "a stopped collector is not unhealthy")
+}
+
+func TestSummaryCollector_BoundedConcurrency(t *testing.T) {
+	nodes := make([]string, 12)
+	for i := range nodes {
+		nodes[i] = "node-" + string(rune('a'+i))
+	}
+	api := &mockSummaryAPI{summaries: map[string]*Summary{}, delay: 10 * time.Millisecond}
+	c, ms := newTestCollector(api, func() []string { return nodes }, 3)
+
+	c.poll(context.Background())
+
+	assert.LessOrEqual(t, api.peak.Load(), int32(3))
+	assert.Greater(t, api.peak.Load(), int32(1), "requests should run in parallel")
+	assert.Equal(t, 12, ms.NodeStats.Len())
+
+	total, failed := c.APICallStats()
+	assert.Equal(t, int64(12), total)
+	assert.Equal(t, int64(0), failed)
+}
+
+func TestSummaryCollector_PrunesRemovedPodsAndNodes(t *testing.T) {
+	api := &mockSummaryAPI{summaries: map[string]*Summary{
+		"node-1": {Pods: []PodSummary{podSummary("ns", "a", "pvc-a"), podSummary("ns", "b", "")}},
+		"node-2": {Pods: []PodSummary{podSummary("ns", "c", "")}},
+	}}
+	nodes := []string{"node-1", "node-2"}
+	c, ms := newTestCollector(api, func() []string { return nodes }, 2)
+
+	c.poll(context.Background())
+	assert.Equal(t, 3, ms.PodStats.Len())
+	assert.Equal(t, 1, ms.VolumeStats.Len())
+
+	// Pod a is deleted; pod b moves to node-2; node-1 is still listed.
+	api.set("node-1", &Summary{})
+	api.set("node-2", &Summary{Pods: []PodSummary{podSummary("ns", "b", ""), podSummary("ns", "c", "")}})
+	c.poll(context.Background())
+
+	_, ok := ms.PodStats.Get("ns/a")
+	assert.False(t, ok, "deleted pod should be pruned")
+	_, ok = ms.PodStats.Get("ns/b")
+	assert.True(t, ok, "moved pod should be kept")
+	assert.Equal(t, 0, ms.VolumeStats.Len())
+
+	// node-2 leaves the cluster.
+	nodes = []string{"node-1"}
+	c.poll(context.Background())
+
+	_, ok = ms.NodeStats.Get("node-2")
+	assert.False(t, ok)
+	assert.Equal(t, 0, ms.PodStats.Len())
+}
+
+// TestSummaryCollector_SharedVolumeKeptWhileReported verifies an RWX PVC
+// mounted on two nodes is kept until neither node reports it.
+func TestSummaryCollector_SharedVolumeKeptWhileReported(t *testing.T) {
+	api := &mockSummaryAPI{summaries: map[string]*Summary{
+		"node-1": {Pods: []PodSummary{podSummary("ns", "a", "shared")}},
+		"node-2": {Pods: []PodSummary{podSummary("ns", "b", "shared")}},
+	}}
+	c, ms := newTestCollector(api, func() []string { return []string{"node-1", "node-2"} }, 2)
+
+	c.poll(context.Background())
+	require.Equal(t, 1, ms.VolumeStats.Len())
+
+	// Pod a is deleted: node-1 stops reporting the PVC, node-2 still does.
+	api.set("node-1", &Summary{})
+	c.poll(context.Background())
+	_, ok := ms.VolumeStats.Get("ns/shared")
+	assert.True(t, ok, "PVC still mounted on node-2 should be kept")
+
+	api.set("node-2", &Summary{})
+	c.poll(context.Background())
+	assert.Equal(t, 0, ms.VolumeStats.Len())
+}
+
+func TestSummaryCollector_FailedNodeKeepsStaleData(t *testing.T) {
+	api := &mockSummaryAPI{
+		summaries: map[string]*Summary{"node-1": {Pods: []PodSummary{podSummary("ns", "a", "")}}},
+		failing:   map[string]bool{},
+	}
+	c, ms := newTestCollector(api, func() []string { return []string{"node-1"} }, 1)
+
+	c.poll(context.Background())
+	require.Equal(t, 1, ms.PodStats.Len())
+
+	api.mu.Lock()
+	api.failing["node-1"] = true
+	api.mu.Unlock()
+	c.poll(context.Background())
+
+	assert.Equal(t, 1, ms.PodStats.Len(), "stats from the last successful fetch should remain")
+	_, ok := ms.NodeStats.Get("node-1")
+	assert.True(t, ok)
+
+	total, failed := c.APICallStats()
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, int64(1), failed)
+}
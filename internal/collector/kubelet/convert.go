@@ -0,0 +1,85 @@
+package kubelet
+
+import (
+	"math"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// toModel converts a Summary to model stats. nodeName is used when the
+// kubelet omits node.nodeName. Volumes without a PVC reference are skipped.
+func toModel(s *Summary, nodeName string, timestamp int64) (model.NodeStats, []model.PodStats, []model.VolumeStats) {
+	node := model.NodeStats{Name: s.Node.NodeName, Timestamp: timestamp}
+	if node.Name == "" {
+		node.Name = nodeName
+	}
+	if fs := s.Node.Fs; fs != nil {
+		node.FSUsedBytes = u64(fs.UsedBytes)
+		node.FSCapacityBytes = u64(fs.CapacityBytes)
+		node.FSAvailableBytes = u64(fs.AvailableBytes)
+	}
+	if rt := s.Node.Runtime; rt != nil && rt.ImageFs != nil {
+		node.ImageFSUsedBytes = u64(rt.ImageFs.UsedBytes)
+		node.ImageFSCapacityBytes = u64(rt.ImageFs.CapacityBytes)
+	}
+	if net := s.Node.Network; net != nil {
+		node.NetworkRxBytes = u64(net.RxBytes)
+		node.NetworkTxBytes = u64(net.TxBytes)
+	}
+
+	pods := make([]model.PodStats, 0, len(s.Pods))
+	var volumes []model.VolumeStats
+	for _, p := range s.Pods {
+		ps := model.PodStats{
+			Name:       p.PodRef.Name,
+			Namespace:  p.PodRef.Namespace,
+			Containers: make([]model.ContainerStats, 0, len(p.Containers)),
+			Timestamp:  timestamp,
+		}
+		if p.EphemeralStorage != nil {
+			ps.EphemeralStorageUsedBytes = u64(p.EphemeralStorage.UsedBytes)
+		}
+		if p.Network != nil {
+			ps.NetworkRxBytes = u64(p.Network.RxBytes)
+			ps.NetworkTxBytes = u64(p.Network.TxBytes)
+		}
+		for _, c := range p.Containers {
+			cs := model.ContainerStats{Name: c.Name}
+			if c.Rootfs != nil {
+				cs.RootfsUsedBytes = u64(c.Rootfs.UsedBytes)
+			}
+			if c.Logs != nil {
+				cs.LogsUsedBytes = u64(c.Logs.UsedBytes)
+			}
+			ps.Containers = append(ps.Containers, cs)
+		}
+		pods = append(pods, ps)
+
+		for _, v := range p.VolumeStats {
+			if v.PVCRef == nil {
+				continue
+			}
+			volumes = append(volumes, model.VolumeStats{
+				PVCName:        v.PVCRef.Name,
+				Namespace:      v.PVCRef.Namespace,
+				UsedBytes:      u64(v.UsedBytes),
+				CapacityBytes:  u64(v.CapacityBytes),
+				AvailableBytes: u64(v.AvailableBytes),
+				InodesUsed:     u64(v.InodesUsed),
+				Timestamp:      timestamp,
+			})
+		}
+	}
+	return node, pods, volumes
+}
+
+// u64 dereferences a kubelet counter, clamping to int64 and mapping nil to 0.
+func u64(v *uint64) int64 {
+	if v == nil {
+		return 0
+	}
+	if *v > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(*v)
+}
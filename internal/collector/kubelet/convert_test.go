@@ -0,0 +1,90 @@
+package kubelet
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadFixture(t *testing.T) *Summary {
+	t.Helper()
+	body, err := os.ReadFile("testdata/summary.json")
+	require.NoError(t, err)
+	s, err := ParseSummary(body)
+	require.NoError(t, err)
+	return s
+}
+
+func TestParseSummary_InvalidJSON(t *testing.T) {
+	_, err := ParseSummary([]byte("{not json"))
+	assert.Error(t, err)
+}
+
+func TestToModel_Node(t *testing.T) {
+	node, _, _ := toModel(loadFixture(t), "ignored", 1000)
+
+	assert.Equal(t, "node-1", node.Name)
+	assert.Equal(t, int64(40000000000), node.FSUsedBytes)
+	assert.Equal(t, int64(100000000000), node.FSCapacityBytes)
+	assert.Equal(t, int64(60000000000), node.FSAvailableBytes)
+	assert.Equal(t, int64(12000000000), node.ImageFSUsedBytes)
+	assert.Equal(t, int64(100000000000), node.ImageFSCapacityBytes)
+	assert.Equal(t, int64(1000000), node.NetworkRxBytes)
+	assert.Equal(t, int64(2000000), node.NetworkTxBytes)
+	assert.Equal(t, int64(1000), node.Timestamp)
+}
+
+func TestToModel_NodeNameFallback(t *testing.T) {
+	node, pods, volumes := toModel(&Summary{}, "node-2", 1000)
+
+	assert.Equal(t, "node-2", node.Name)
+	assert.Zero(t, node.FSUsedBytes)
+	assert.Empty(t, pods)
+	assert.Empty(t, volumes)
+}
+
+func TestToModel_Pods(t *testing.T) {
+	_, pods, _ := toModel(loadFixture(t), "node-1", 1000)
+	require.Len(t, pods, 2)
+
+	web := pods[0]
+	assert.Equal(t, "web-0", web.Name)
+	assert.Equal(t, "shop", web.Namespace)
+	assert.Equal(t, int64(4124672), web.EphemeralStorageUsedBytes)
+	assert.Equal(t, int64(5000), web.NetworkRxBytes)
+	assert.Equal(t, int64(7000), web.NetworkTxBytes)
+	require.Len(t, web.Containers, 2)
+	assert.Equal(t, "app", web.Containers[0].Name)
+	assert.Equal(t, int64(4096000), web.Containers[0].RootfsUsedBytes)
+	assert.Equal(t, int64(20480), web.Containers[0].LogsUsedBytes)
+	assert.Equal(t, int64(8192), web.Containers[1].RootfsUsedBytes)
+	assert.Zero(t, web.Containers[1].LogsUsedBytes, "missing logs stats should be zero")
+
+	// Pod without any optional stats.
+	dns := pods[1]
+	assert.Equal(t, "kube-system", dns.Namespace)
+	assert.Zero(t, dns.EphemeralStorageUsedBytes)
+	require.Len(t, dns.Containers, 1)
+	assert.Zero(t, dns.Containers[0].RootfsUsedBytes)
+}
+
+func TestToModel_VolumesOnlyPVCBacked(t *testing.T) {
+	_, _, volumes := toModel(loadFixture(t), "node-1", 1000)
+	require.Len(t, volumes, 1, "projected token volume has no pvcRef and must be skipped")
+
+	v := volumes[0]
+	assert.Equal(t, "data-web-0", v.PVCName)
+	assert.Equal(t, "shop", v.Namespace)
+	assert.Equal(t, int64(3000000000), v.UsedBytes)
+	assert.Equal(t, int64(10000000000), v.CapacityBytes)
+	assert.Equal(t, int64(7000000000), v.AvailableBytes)
+	assert.Equal(t, int64(1200), v.InodesUsed)
+}
+
+func TestU64_Clamps(t *testing.T) {
+	huge := uint64(1 << 63)
+	assert.Equal(t, int64(9223372036854775807), u64(&huge))
+	assert.Zero(t, u64(nil))
+}
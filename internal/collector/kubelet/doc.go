@@ -0,0 +1,14 @@
+// Package kubelet implements collectors for kubelet endpoints reached through
+// the API server node proxy (/api/v1/nodes/{node}/proxy/...).
+//
+// SummaryCollector reads the Summary API (stats/summary), which reports data
+// that metrics-server does not expose: node and image filesystem usage,
+// per-container writable layer and log usage, pod ephemeral-storage usage,
+// pod network counters, and usage of mounted PersistentVolumeClaims.
+//
+// CAdvisorCollector scrapes metrics/cadvisor for CFS throttling and OOM
+// counters and turns them into per-interval signals per container.
+//
+// Nodes are polled with bounded concurrency, and results are written to
+// store.MetricsStore.
+package kubelet
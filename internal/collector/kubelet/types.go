@@ -0,0 +1,72 @@
+package kubelet
+
+// Summary is the subset of the kubelet stats/v1alpha1 Summary response the
+// agent reads. Pointer fields are omitted by the kubelet when unknown.
+type Summary struct {
+	Node NodeSummary  `json:"node"`
+	Pods []PodSummary `json:"pods"`
+}
+
+// NodeSummary holds node-level stats.
+type NodeSummary struct {
+	NodeName string        `json:"nodeName"`
+	Fs       *FsStats      `json:"fs,omitempty"`
+	Runtime  *RuntimeStats `json:"runtime,omitempty"`
+	Network  *NetworkStats `json:"network,omitempty"`
+}
+
+// RuntimeStats holds container runtime stats.
+type RuntimeStats struct {
+	ImageFs *FsStats `json:"imageFs,omitempty"`
+}
+
+// PodSummary holds pod-level stats.
+type PodSummary struct {
+	PodRef           PodReference       `json:"podRef"`
+	Containers       []ContainerSummary `json:"containers"`
+	Network          *NetworkStats      `json:"network,omitempty"`
+	VolumeStats      []VolumeSummary    `json:"volume,omitempty"`
+	EphemeralStorage *FsStats           `json:"ephemeral-storage,omitempty"`
+}
+
+// PodReference identifies a pod.
+type PodReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	UID       string `json:"uid"`
+}
+
+// ContainerSummary holds container-level filesystem stats.
+type ContainerSummary struct {
+	Name   string   `json:"name"`
+	Rootfs *FsStats `json:"rootfs,omitempty"`
+	Logs   *FsStats `json:"logs,omitempty"`
+}
+
+// VolumeSummary holds stats for a pod volume. PVCRef is set only for
+// volumes backed by a PersistentVolumeClaim.
+type VolumeSummary struct {
+	FsStats
+	Name   string        `json:"name"`
+	PVCRef *PVCReference `json:"pvcRef,omitempty"`
+}
+
+// PVCReference identifies a PersistentVolumeClaim.
+type PVCReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// FsStats holds filesystem usage.
+type FsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	CapacityBytes  *uint64 `json:"capacityBytes,omitempty"`
+	UsedBytes      *uint64 `json:"usedBytes,omitempty"`
+	InodesUsed     *uint64 `json:"inodesUsed,omitempty"`
+}
+
+// NetworkStats holds cumulative counters for the default interface.
+type NetworkStats struct {
+	RxBytes *uint64 `json:"rxBytes,omitempty"`
+	TxBytes *uint64 `json:"txBytes,omitempty"`
+}
@@ -0,0 +1,243 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// PrometheusQueries are the instant PromQL queries a Prometheus MetricsAPI
+// runs. Node queries must return one series per node with a "node" label;
+// container queries one series per container with "namespace", "pod" and
+// "container" labels. CPU is in cores and memory in bytes.
+type PrometheusQueries struct {
+	NodeCPU         string
+	NodeMemory      string
+	ContainerCPU    string
+	ContainerMemory string
+}
+
+// prometheusClient implements MetricsAPI by running PromQL queries against
+// the Prometheus HTTP API.
+type prometheusClient struct {
+	client  *http.Client
+	baseURL string
+	queries PrometheusQueries
+}
+
+// NewPrometheusAPI creates a MetricsAPI that queries the Prometheus HTTP API
+// at baseURL (e.g. "http://prometheus.monitoring:9090"), for clusters
+// without metrics-server. Any server implementing /api/v1/query works,
+// such as Thanos Query or VictoriaMetrics.
+func NewPrometheusAPI(client *http.Client, baseURL string, queries PrometheusQueries) MetricsAPI {
+	return &prometheusClient{
+		client:  client,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		queries: queries,
+	}
+}
+
+func (c *prometheusClient) ListNodeMetrics(ctx context.Context) ([]metricsv1beta1.NodeMetrics, error) {
+	usage := make(map[string]corev1.ResourceList)
+	timestamps := make(map[string]time.Time)
+	for _, q := range []struct {
+		name, query string
+		resource    corev1.ResourceName
+	}{
+		{"node cpu", c.queries.NodeCPU, corev1.ResourceCPU},
+		{"node memory", c.queries.NodeMemory, corev1.ResourceMemory},
+	} {
+		samples, err := c.query(ctx, q.query)
+		if err != nil {
+			return nil, fmt.Errorf("prometheus: %s query: %w", q.name, err)
+		}
+		for _, s := range samples {
+			node := s.labels["node"]
+			if node == "" {
+				continue
+			}
+			if usage[node] == nil {
+				usage[node] = corev1.ResourceList{}
+			}
+			usage[node][q.resource] = quantity(q.resource, s.value)
+			timestamps[node] = later(timestamps[node], s.timestamp)
+		}
+	}
+
+	out := make([]metricsv1beta1.NodeMetrics, 0, len(usage))
+	for node, u := range usage {
+		out = append(out, metricsv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: node},
+			Timestamp:  metav1.NewTime(timestamps[node]),
+			Usage:      u,
+		})
+	}
+	return out, nil
+}
+
+func (c *prometheusClient) ListPodMetrics(ctx context.Context) ([]metricsv1beta1.PodMetrics, error) {
+	type podKey struct{ namespace, name string }
+	type pod struct {
+		containers map[string]corev1.ResourceList
+		order      []string
+		timestamp  time.Time
+	}
+	pods := make(map[podKey]*pod)
+	var order []podKey
+	for _, q := range []struct {
+		name, query string
+		resource    corev1.ResourceName
+	}{
+		{"container cpu", c.queries.ContainerCPU, corev1.ResourceCPU},
+		{"container memory", c.queries.ContainerMemory, corev1.ResourceMemory},
+	} {
+		samples, err := c.query(ctx, q.query)
+		if err != nil {
+			return nil, fmt.Errorf("prometheus: %s query: %w", q.name, err)
+		}
+		for _, s := range samples {
+			k := podKey{s.labels["namespace"], s.labels["pod"]}
+			container := s.labels["container"]
+			if k.namespace == "" || k.name == "" || container == "" {
+				continue
+			}
+			p, ok := pods[k]
+			if !ok {
+				p = &pod{containers: make(map[string]corev1.ResourceList)}
+				pods[k] = p
+				order = append(order, k)
+			}
+			if p.containers[container] == nil {
+				p.containers[container] = corev1.ResourceList{}
+				p.order = append(p.order, container)
+			}
+			p.containers[container][q.resource] = quantity(q.resource, s.value)
+			p.timestamp = later(p.timestamp, s.timestamp)
+		}
+	}
+
+	out := make([]metricsv1beta1.PodMetrics, 0, len(pods))
+	for _, k := range order {
+		p := pods[k]
+		pm := metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: k.name, Namespace: k.namespace},
+			Timestamp:  metav1.NewTime(p.timestamp),
+			Containers: make([]metricsv1beta1.ContainerMetrics, 0, len(p.order)),
+		}
+		for _, name := range p.order {
+			pm.Containers = append(pm.Containers, metricsv1beta1.ContainerMetrics{Name: name, Usage: p.containers[name]})
+		}
+		out = append(out, pm)
+	}
+	return out, nil
+}
+
+// promSample is one series of an instant vector.
+type promSample struct {
+	labels    map[string]string
+	value     float64
+	timestamp time.Time
+}
+
+// promResponse is the envelope of a Prometheus HTTP API response.
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  [2]any            `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// query runs an instant query and returns its samples. NaN and infinite
+// values are dropped.
+func (c *prometheusClient) query(ctx context.Context, query string) ([]promSample, error) {
+	u := c.baseURL + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req) //nolint:gosec // URL is from KUBEADAPT_PROMETHEUS_URL
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+	// Errors in the query itself come back as 400 or 422 with a JSON body.
+	var r promResponse
+	if err := json.Unmarshal(body, &r); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	if r.Status != "success" {
+		return nil, fmt.Errorf("%s: %s", r.ErrorType, r.Error)
+	}
+	if r.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("result type %q, want an instant vector", r.Data.ResultType)
+	}
+
+	samples := make([]promSample, 0, len(r.Data.Result))
+	for _, res := range r.Data.Result {
+		ts, ok := res.Value[0].(float64)
+		if !ok {
+			return nil, fmt.Errorf("invalid sample timestamp %v", res.Value[0])
+		}
+		s, ok := res.Value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid sample value %v", res.Value[1])
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample value %q: %w", s, err)
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		samples = append(samples, promSample{
+			labels:    res.Metric,
+			value:     v,
+			timestamp: time.UnixMilli(int64(math.Round(ts * 1000))),
+		})
+	}
+	return samples, nil
+}
+
+// quantity converts a sample to a quantity in the unit metrics-server uses:
+// nanocores for CPU, bytes for memory.
+func quantity(name corev1.ResourceName, v float64) resource.Quantity {
+	if name == corev1.ResourceCPU {
+		return *resource.NewScaledQuantity(int64(math.Round(v*1e9)), resource.Nano)
+	}
+	return *resource.NewQuantity(int64(math.Round(v)), resource.BinarySI)
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
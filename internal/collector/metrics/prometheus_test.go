@@ -0,0 +1,158 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+)
+
+var testQueries = PrometheusQueries{
+	NodeCPU:         "node_cpu",
+	NodeMemory:      "node_memory",
+	ContainerCPU:    "container_cpu",
+	ContainerMemory: "container_memory",
+}
+
+// fakePrometheus serves /api/v1/query, answering each query with the given
+// response body.
+func fakePrometheus(t *testing.T, responses map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" {
+			http.NotFound(w, r)
+			return
+		}
+		body, ok := responses[r.URL.Query().Get("query")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPrometheusAPI_ListNodeMetrics(t *testing.T) {
+	srv := fakePrometheus(t, map[string]string{
+		"node_cpu": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"node":"node-1"},"value":[1700000000.5,"1.25"]},
+			{"metric":{"node":"node-2"},"value":[1700000000.5,"NaN"]},
+			{"metric":{},"value":[1700000000.5,"3"]}]}}`,
+		"node_memory": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"node":"node-1"},"value":[1700000001,"2147483648"]},
+			{"metric":{"node":"node-2"},"value":[1700000001,"1073741824"]}]}}`,
+	})
+	api := NewPrometheusAPI(srv.Client(), srv.URL+"/", testQueries)
+
+	nodes, err := api.ListNodeMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	byName := make(map[string]int)
+	for i, n := range nodes {
+		byName[n.Name] = i
+	}
+	n1 := nodes[byName["node-1"]]
+	cpu := n1.Usage["cpu"]
+	mem := n1.Usage["memory"]
+	assert.InDelta(t, 1.25, cpu.AsApproximateFloat64(), 1e-9)
+	assert.Equal(t, int64(2<<30), mem.Value())
+	assert.Equal(t, int64(1700000001000), n1.Timestamp.UnixMilli())
+
+	// A NaN sample is dropped; the node keeps its memory.
+	n2 := nodes[byName["node-2"]]
+	_, hasCPU := n2.Usage["cpu"]
+	assert.False(t, hasCPU)
+	mem = n2.Usage["memory"]
+	assert.Equal(t, int64(1<<30), mem.Value())
+}
+
+func TestPrometheusAPI_ListPodMetrics(t *testing.T) {
+	srv := fakePrometheus(t, map[string]string{
+		"container_cpu": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"namespace":"default","pod":"web-1","container":"app"},"value":[1700000000,"0.1"]},
+			{"metric":{"namespace":"default","pod":"web-1","container":"sidecar"},"value":[1700000000,"0.05"]},
+			{"metric":{"namespace":"default","pod":"web-1"},"value":[1700000000,"0.15"]}]}}`,
+		"container_memory": `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"namespace":"default","pod":"web-1","container":"app"},"value":[1700000000,"268435456"]},
+			{"metric":{"namespace":"kube-system","pod":"dns","container":"coredns"},"value":[1700000000,"67108864"]}]}}`,
+	})
+	api := NewPrometheusAPI(srv.Client(), srv.URL, testQueries)
+
+	pods, err := api.ListPodMetrics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pods, 2)
+
+	web := pods[0]
+	assert.Equal(t, "default", web.Namespace)
+	assert.Equal(t, "web-1", web.Name)
+	assert.Equal(t, int64(1700000000000), web.Timestamp.UnixMilli())
+	require.Len(t, web.Containers, 2)
+	assert.Equal(t, "app", web.Containers[0].Name)
+	cpu := web.Containers[0].Usage["cpu"]
+	mem := web.Containers[0].Usage["memory"]
+	assert.InDelta(t, 0.1, cpu.AsApproximateFloat64(), 1e-9)
+	assert.Equal(t, int64(256<<20), mem.Value())
+	assert.Equal(t, "sidecar", web.Containers[1].Name)
+
+	assert.Equal(t, "dns", pods[1].Name)
+	require.Len(t, pods[1].Containers, 1)
+}
+
+func TestPrometheusAPI_Errors(t *testing.T) {
+	srv := fakePrometheus(t, map[string]string{
+		"node_cpu":         `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+		"container_cpu":    `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"container_memory": `not json`,
+	})
+
+	api := NewPrometheusAPI(srv.Client(), srv.URL, testQueries)
+	_, err := api.ListNodeMetrics(context.Background())
+	assert.ErrorContains(t, err, "node cpu query")
+	assert.ErrorContains(t, err, "instant vector")
+
+	_, err = api.ListPodMetrics(context.Background())
+	assert.ErrorContains(t, err, "container memory query")
+
+	// Query errors come back with a 400 and the server's message.
+	api = NewPrometheusAPI(srv.Client(), srv.URL, PrometheusQueries{NodeCPU: "bad{"})
+	_, err = api.ListNodeMetrics(context.Background())
+	assert.ErrorContains(t, err, "bad_data: parse error")
+}
+
+func TestPrometheusAPI_FeedsMetricsCollector(t *testing.T) {
+	vector := `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"node":"node-1","namespace":"default","pod":"web-1","container":"app"},"value":[1700000000,"0.5"]}]}}`
+	srv := fakePrometheus(t, map[string]string{
+		"node_cpu": vector, "node_memory": vector, "container_cpu": vector, "container_memory": vector,
+	})
+
+	ms := store.NewMetricsStore()
+	c := NewMetricsCollector(NewPrometheusAPI(srv.Client(), srv.URL, testQueries), ms, observability.NewMetrics(), time.Minute, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+	require.NoError(t, c.WaitForSync(ctx))
+
+	nm, ok := ms.NodeMetrics.Get("node-1")
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, nm.CPUUsageCores, 1e-9)
+
+	pm, ok := ms.PodMetrics.Get("default/web-1")
+	require.True(t, ok)
+	require.Len(t, pm.Containers, 1)
+	assert.InDelta(t, 0.5, pm.Containers[0].CPUUsageCores, 1e-9)
+	assert.Equal(t, int64(1), pm.Containers[0].MemoryUsageBytes)
+}
@@ -17,7 +17,8 @@ import (
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
-// MetricsAPI abstracts the metrics-server API for testability.
+// MetricsAPI abstracts the source of node and pod usage: metrics-server, or
+// Prometheus where metrics-server is not installed.
 type MetricsAPI interface {
 	ListNodeMetrics(ctx context.Context) ([]metricsv1beta1.NodeMetrics, error)
 	ListPodMetrics(ctx context.Context) ([]metricsv1beta1.PodMetrics, error)
@@ -28,6 +29,11 @@ type metricsAPIClient struct {
 	client metricsv1beta1client.MetricsV1beta1Interface
 }
 
+// NewMetricsServerAPI creates a MetricsAPI backed by the metrics.k8s.io API.
+func NewMetricsServerAPI(client metricsv1beta1client.MetricsV1beta1Interface) MetricsAPI {
+	return &metricsAPIClient{client: client}
+}
+
 func (c *metricsAPIClient) ListNodeMetrics(ctx context.Context) ([]metricsv1beta1.NodeMetrics, error) {
 	list, err := c.client.NodeMetricses().List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -44,15 +50,23 @@ func (c *metricsAPIClient) ListPodMetrics(ctx context.Context) ([]metricsv1beta1
 	return list.Items, nil
 }
 
-// MetricsCollector polls the metrics-server API on a timer and stores
-// node and pod resource usage data.
+// MetricsCollector polls a MetricsAPI on a timer and stores
+// node and pod resource usage data. It samples every sampleInterval and keeps
+// the container samples of the last interval in MetricsStore.ContainerUsage,
+// so snapshots can report peaks between metrics intervals.
 type MetricsCollector struct {
-	api          MetricsAPI
-	metricsStore *store.MetricsStore
-	metrics      *observability.Metrics
-	interval     time.Duration
-	stopCh       chan struct{}
-	done         chan struct{}
+	api            MetricsAPI
+	metricsStore   *store.MetricsStore
+	metrics        *observability.Metrics
+	interval       time.Duration
+	sampleInterval time.Duration
+	intervalCh     chan intervals
+	stopCh         chan struct{}
+	done           chan struct{}
+
+	// inScope, if set, drops pod metrics in namespaces outside the
+	// configured namespace scope.
+	inScope func(namespace string) bool
 
 	syncOnce sync.Once
 	synced   chan struct{}
@@ -62,22 +76,56 @@ type MetricsCollector struct {
 	pollFailed atomic.Int64
 }
 
-// NewMetricsCollector creates a MetricsCollector that polls using the given MetricsAPI.
-func NewMetricsCollector(api MetricsAPI, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval time.Duration) *MetricsCollector {
+// NewMetricsCollector creates a MetricsCollector that polls using the given
+// MetricsAPI. interval is the usage window; sampleInterval is how often the
+// API is polled, and is capped at interval (0 means once per interval).
+func NewMetricsCollector(api MetricsAPI, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval, sampleInterval time.Duration) *MetricsCollector {
 	return &MetricsCollector{
-		api:          api,
-		metricsStore: metricsStore,
-		metrics:      metrics,
-		interval:     interval,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		synced:       make(chan struct{}),
+		api:            api,
+		metricsStore:   metricsStore,
+		metrics:        metrics,
+		interval:       interval,
+		sampleInterval: capSampleInterval(interval, sampleInterval),
+		intervalCh:     make(chan intervals, 1),
+		stopCh:         make(chan struct{}),
+		done:           make(chan struct{}),
+		synced:         make(chan struct{}),
 	}
 }
 
 // NewMetricsCollectorFromClient creates a MetricsCollector using a real metrics-server client.
-func NewMetricsCollectorFromClient(client metricsv1beta1client.MetricsV1beta1Interface, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval time.Duration) *MetricsCollector {
-	return NewMetricsCollector(&metricsAPIClient{client: client}, metricsStore, metrics, interval)
+func NewMetricsCollectorFromClient(client metricsv1beta1client.MetricsV1beta1Interface, metricsStore *store.MetricsStore, metrics *observability.Metrics, interval, sampleInterval time.Duration) *MetricsCollector {
+	return NewMetricsCollector(NewMetricsServerAPI(client), metricsStore, metrics, interval, sampleInterval)
+}
+
+// intervals carries a SetIntervals change to the poll loop.
+type intervals struct {
+	window, sample time.Duration
+}
+
+// capSampleInterval returns sampleInterval, or interval if sampleInterval is
+// unset or longer.
+func capSampleInterval(interval, sampleInterval time.Duration) time.Duration {
+	if sampleInterval <= 0 || sampleInterval > interval {
+		return interval
+	}
+	return sampleInterval
+}
+
+// SetIntervals changes the usage window and sampling interval, e.g. after a
+// config file reload. It takes effect after the current sample.
+func (c *MetricsCollector) SetIntervals(interval, sampleInterval time.Duration) {
+	select {
+	case <-c.intervalCh: // replace a change the loop has not picked up yet
+	default:
+	}
+	c.intervalCh <- intervals{window: interval, sample: capSampleInterval(interval, sampleInterval)}
+}
+
+// SetNamespaceFilter makes the collector skip pod metrics in namespaces for
+// which inScope returns false. Must be called before Start.
+func (c *MetricsCollector) SetNamespaceFilter(inScope func(namespace string) bool) {
+	c.inScope = inScope
 }
 
 // Name implements collector.Collector.
@@ -112,13 +160,16 @@ func (c *MetricsCollector) run(ctx context.Context) {
 	c.poll(ctx)
 	c.syncOnce.Do(func() { close(c.synced) })
 
-	ticker := time.NewTicker(c.interval)
+	ticker := time.NewTicker(c.sampleInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
 			c.poll(ctx)
+		case iv := <-c.intervalCh:
+			c.interval, c.sampleInterval = iv.window, iv.sample
+			ticker.Reset(c.sampleInterval)
 		case <-c.stopCh:
 			return
 		case <-ctx.Done():
@@ -133,6 +184,10 @@ func (c *MetricsCollector) poll(ctx context.Context) {
 	c.pollNodeMetrics(ctx)
 	c.pollPodMetrics(ctx)
 
+	// Samples older than one interval no longer describe it; this also ages
+	// out containers that are gone.
+	c.metricsStore.ContainerUsage.Prune(time.Now().Add(-c.interval).UnixMilli())
+
 	elapsed := time.Since(start)
 	c.metrics.MetricsAPIDuration.Observe(elapsed.Seconds())
 	c.metrics.LastMetricsCollectMs.Store(elapsed.Milliseconds())
@@ -167,18 +222,24 @@ func (c *MetricsCollector) pollPodMetrics(ctx context.Context) {
 		return
 	}
 
+	now := time.Now().UnixMilli()
 	for _, pm := range podMetricsList {
+		if c.inScope != nil && !c.inScope(pm.Namespace) {
+			continue
+		}
+		key := pm.Namespace + "/" + pm.Name
 		containers := make([]model.ContainerMetrics, 0, len(pm.Containers))
 		for _, cm := range pm.Containers {
 			memQ := cm.Usage["memory"]
+			cpu := convert.ParseQuantity(cm.Usage["cpu"])
 			containers = append(containers, model.ContainerMetrics{
 				Name:             cm.Name,
-				CPUUsageCores:    convert.ParseQuantity(cm.Usage["cpu"]),
+				CPUUsageCores:    cpu,
 				MemoryUsageBytes: memQ.Value(),
 			})
+			c.metricsStore.ContainerUsage.Add(key+"/"+cm.Name, now, pm.Timestamp.UnixMilli(), cpu, memQ.Value())
 		}
 
-		key := pm.Namespace + "/" + pm.Name
 		c.metricsStore.PodMetrics.Set(key, model.PodMetrics{
 			Name:       pm.Name,
 			Namespace:  pm.Namespace,
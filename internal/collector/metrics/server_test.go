@@ -45,6 +45,7 @@ func TestMetricsCollector_Name(t *testing.T) {
 		store.NewMetricsStore(),
 		observability.NewMetrics(),
 		time.Minute,
+		0,
 	)
 	assert.Equal(t, "metrics", c.Name())
 }
@@ -73,7 +74,7 @@ func TestMetricsCollector_PollsAndStoresNodeMetrics(t *testing.T) {
 	}
 
 	ms := store.NewMetricsStore()
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -133,7 +134,7 @@ func TestMetricsCollector_PollsAndStoresPodMetrics(t *testing.T) {
 	}
 
 	ms := store.NewMetricsStore()
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -165,9 +166,46 @@ func TestMetricsCollector_PollsAndStoresPodMetrics(t *testing.T) {
 	assert.Equal(t, int64(64*1024*1024), pm.Containers[1].MemoryUsageBytes)
 }
 
+func TestMetricsCollector_NamespaceFilter(t *testing.T) {
+	ts := metav1.Now()
+	podMetrics := func(namespace string) metricsv1beta1.PodMetrics {
+		return metricsv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: namespace},
+			Timestamp:  ts,
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name: "app",
+				Usage: map[corev1.ResourceName]resource.Quantity{
+					"cpu":    resource.MustParse("100m"),
+					"memory": resource.MustParse("256Mi"),
+				},
+			}},
+		}
+	}
+	mock := &mockMetricsAPI{
+		podMetrics: []metricsv1beta1.PodMetrics{podMetrics("default"), podMetrics("regulated")},
+	}
+
+	ms := store.NewMetricsStore()
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), time.Minute, 0)
+	c.SetNamespaceFilter(func(namespace string) bool { return namespace != "regulated" })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+	require.NoError(t, c.WaitForSync(ctx))
+
+	_, ok := ms.PodMetrics.Get("default/pod-1")
+	assert.True(t, ok)
+	_, ok = ms.PodMetrics.Get("regulated/pod-1")
+	assert.False(t, ok, "pod metrics outside the namespace scope should be skipped")
+	assert.NotContains(t, ms.ContainerUsage.Stats(), "regulated/pod-1/app")
+}
+
 func TestMetricsCollector_StopsCleanly(t *testing.T) {
 	mock := &mockMetricsAPI{}
-	c := NewMetricsCollector(mock, store.NewMetricsStore(), observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, store.NewMetricsStore(), observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -208,7 +246,7 @@ func TestMetricsCollector_WaitForSyncWaitsForFirstPoll(t *testing.T) {
 	ms := store.NewMetricsStore()
 	// Use a long interval to ensure WaitForSync only returns after the first poll,
 	// not after waiting for a tick.
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 10*time.Second)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 10*time.Second, 0)
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
 	defer cancel()
@@ -232,7 +270,7 @@ func TestMetricsCollector_HandlesAPIErrors(t *testing.T) {
 	}
 
 	ms := store.NewMetricsStore()
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -264,7 +302,7 @@ func TestMetricsCollector_APICallStats_CountsSuccessfulPolls(t *testing.T) {
 	}
 
 	ms := store.NewMetricsStore()
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -294,7 +332,7 @@ func TestMetricsCollector_APICallStats_CountsFailures(t *testing.T) {
 	}
 
 	ms := store.NewMetricsStore()
-	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond)
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), 50*time.Millisecond, 0)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -316,3 +354,64 @@ func TestMetricsCollector_APICallStats_CountsFailures(t *testing.T) {
 	assert.True(t, total >= 2, "expected at least 2 API calls, got %d", total)
 	assert.Equal(t, total, failed, "all calls should be failures")
 }
+
+func TestMetricsCollector_SamplesContainerUsageWindow(t *testing.T) {
+	podAt := func(ts time.Time, cpu, mem string) []metricsv1beta1.PodMetrics {
+		return []metricsv1beta1.PodMetrics{{
+			ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+			Timestamp:  metav1.NewTime(ts),
+			Containers: []metricsv1beta1.ContainerMetrics{{
+				Name:  "app",
+				Usage: map[corev1.ResourceName]resource.Quantity{"cpu": resource.MustParse(cpu), "memory": resource.MustParse(mem)},
+			}},
+		}}
+	}
+
+	mock := &mockMetricsAPI{}
+	ms := store.NewMetricsStore()
+	c := NewMetricsCollector(mock, ms, observability.NewMetrics(), time.Minute, 15*time.Second)
+	assert.Equal(t, 15*time.Second, c.sampleInterval)
+
+	base := time.Now()
+	mock.podMetrics = podAt(base, "100m", "100Mi")
+	c.poll(context.Background())
+	mock.podMetrics = podAt(base.Add(15*time.Second), "300m", "900Mi") // spike between intervals
+	c.poll(context.Background())
+	c.poll(context.Background()) // metrics-server not refreshed: same timestamp, ignored
+	mock.podMetrics = podAt(base.Add(30*time.Second), "200m", "200Mi")
+	c.poll(context.Background())
+
+	stats, ok := ms.ContainerUsage.Stats()["default/p1/app"]
+	require.True(t, ok)
+	assert.Equal(t, 3, stats.Memory.Samples)
+	assert.Equal(t, int64(900*1024*1024), stats.Memory.MaxBytes, "the peak must survive until the snapshot")
+	assert.Equal(t, int64(100*1024*1024), stats.Memory.MinBytes)
+	assert.InDelta(t, 0.2, stats.CPU.AvgCores, 0.001)
+
+	// The point-in-time value is still the latest sample.
+	pm, ok := ms.PodMetrics.Get("default/p1")
+	require.True(t, ok)
+	assert.Equal(t, int64(200*1024*1024), pm.Containers[0].MemoryUsageBytes)
+}
+
+func TestMetricsCollector_SampleIntervalCappedAtInterval(t *testing.T) {
+	c := NewMetricsCollector(&mockMetricsAPI{}, store.NewMetricsStore(), observability.NewMetrics(), time.Minute, 5*time.Minute)
+	assert.Equal(t, time.Minute, c.sampleInterval)
+}
+
+func TestMetricsCollector_SetIntervals(t *testing.T) {
+	c := NewMetricsCollector(&mockMetricsAPI{}, store.NewMetricsStore(), observability.NewMetrics(), time.Hour, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, c.Start(ctx))
+	defer c.Stop()
+	require.NoError(t, c.WaitForSync(ctx))
+
+	// The sample interval is capped at the new window.
+	c.SetIntervals(20*time.Millisecond, time.Minute)
+	require.Eventually(t, func() bool {
+		total, _ := c.APICallStats()
+		return total >= 6 // 2 calls per poll * 3 polls
+	}, waitTimeout, pollInterval)
+}
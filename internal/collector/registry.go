@@ -2,9 +2,11 @@ package collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 )
 
 // Registry manages the lifecycle of all registered collectors.
@@ -12,10 +14,32 @@ import (
 // can be called from different goroutines.
 type Registry struct {
 	collectors []Collector
+	optional   map[string]optionalCollector
 	mu         sync.Mutex
 	started    bool
+	startCtx   context.Context
+	// syncing holds optional collectors enabled at runtime whose cache has
+	// not synced yet, with the func that cancels the wait.
+	syncing map[Collector]context.CancelFunc
 }
 
+// Factory builds a fresh collector instance. Collectors cannot be restarted
+// once stopped, so optional collectors are rebuilt each time they are enabled.
+type Factory func() Collector
+
+// optionalCollector describes a collector that can be switched on and off
+// at runtime (e.g. via backend directives).
+type optionalCollector struct {
+	factory Factory
+	// onDisable runs after the collector is stopped, typically to clear
+	// its store so stale objects are not reported.
+	onDisable func()
+}
+
+// ErrUnknownCollector is returned by SetEnabled for names that were not
+// registered with RegisterOptional.
+var ErrUnknownCollector = errors.New("collector not registered as optional")
+
 // NewRegistry creates a new, empty Registry.
 func NewRegistry() *Registry {
 	return &Registry{}
@@ -28,6 +52,132 @@ func (r *Registry) Register(c Collector) {
 	r.collectors = append(r.collectors, c)
 }
 
+// RegisterOptional records a collector that can be toggled at runtime with
+// SetEnabled. When enabled is true the collector is built and registered
+// immediately, exactly as if Register had been called.
+func (r *Registry) RegisterOptional(name string, factory Factory, onDisable func(), enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.optional == nil {
+		r.optional = make(map[string]optionalCollector)
+	}
+	r.optional[name] = optionalCollector{factory: factory, onDisable: onDisable}
+	if enabled {
+		r.collectors = append(r.collectors, factory())
+	}
+}
+
+// SetEnabled starts or stops an optional collector. Enabling builds a new
+// instance and, if the registry is already running, starts it without
+// waiting for its cache: the sync is awaited in the background, and
+// HealthReport lists the collector as stale until it completes. A warning
+// is logged if it takes longer than syncTimeout, e.g. when RBAC does not
+// grant the resource. Disabling stops and unregisters the collector.
+// Returns changed=false when the collector was already in the requested state.
+func (r *Registry) SetEnabled(name string, enabled bool, syncTimeout time.Duration) (changed bool, err error) {
+	r.mu.Lock()
+	opt, ok := r.optional[name]
+	if !ok {
+		r.mu.Unlock()
+		return false, fmt.Errorf("%w: %s", ErrUnknownCollector, name)
+	}
+	idx := -1
+	for i, c := range r.collectors {
+		if c.Name() == name {
+			idx = i
+			break
+		}
+	}
+
+	if !enabled {
+		if idx < 0 {
+			r.mu.Unlock()
+			return false, nil
+		}
+		c := r.collectors[idx]
+		r.collectors = append(r.collectors[:idx:idx], r.collectors[idx+1:]...)
+		if cancel, ok := r.syncing[c]; ok {
+			cancel()
+			delete(r.syncing, c)
+		}
+		started := r.started
+		r.mu.Unlock()
+
+		if started {
+			c.Stop()
+		}
+		if opt.onDisable != nil {
+			opt.onDisable()
+		}
+		slog.Info("optional collector disabled", "collector", name)
+		return true, nil
+	}
+
+	if idx >= 0 {
+		r.mu.Unlock()
+		return false, nil
+	}
+	c := opt.factory()
+	r.collectors = append(r.collectors, c)
+	started, startCtx := r.started, r.startCtx
+	r.mu.Unlock()
+
+	if !started {
+		return true, nil
+	}
+	if err := c.Start(startCtx); err != nil {
+		r.remove(c)
+		return false, fmt.Errorf("start collector %s: %w", name, err)
+	}
+	syncCtx, cancel := context.WithCancel(startCtx)
+	r.mu.Lock()
+	if r.syncing == nil {
+		r.syncing = make(map[Collector]context.CancelFunc)
+	}
+	r.syncing[c] = cancel
+	r.mu.Unlock()
+	go r.awaitSync(syncCtx, c, syncTimeout)
+
+	slog.Info("optional collector enabled", "collector", name)
+	return true, nil
+}
+
+// awaitSync waits for a collector enabled at runtime to sync and then stops
+// reporting it as stale. The wait ends early if the collector is disabled.
+func (r *Registry) awaitSync(ctx context.Context, c Collector, timeout time.Duration) {
+	slow := time.AfterFunc(timeout, func() {
+		slog.Warn("optional collector not synced yet, check RBAC for its resource",
+			"collector", c.Name(), "waited", timeout)
+	})
+	defer slow.Stop()
+
+	if err := c.WaitForSync(ctx); err != nil {
+		if ctx.Err() == nil {
+			slog.Warn("optional collector failed to sync", "collector", c.Name(), "error", err)
+		}
+		return
+	}
+	r.mu.Lock()
+	if cancel, ok := r.syncing[c]; ok {
+		cancel()
+		delete(r.syncing, c)
+	}
+	r.mu.Unlock()
+	slog.Info("optional collector synced", "collector", c.Name())
+}
+
+// remove unregisters a collector instance without stopping it.
+func (r *Registry) remove(target Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, c := range r.collectors {
+		if c == target {
+			r.collectors = append(r.collectors[:i:i], r.collectors[i+1:]...)
+			return
+		}
+	}
+}
+
 // PartialStartError is returned when some (but not all) collectors fail to start.
 // Callers can use errors.As to detect partial vs total failure.
 type PartialStartError struct {
@@ -47,6 +197,7 @@ func (r *Registry) StartAll(ctx context.Context) error {
 	collectors := make([]Collector, len(r.collectors))
 	copy(collectors, r.collectors)
 	r.started = true
+	r.startCtx = ctx
 	r.mu.Unlock()
 
 	if len(collectors) == 0 {
@@ -158,15 +309,25 @@ func (r *Registry) Collectors() []Collector {
 
 // HealthReport returns the number of healthy collectors, the total count,
 // and a list of collector names that are unhealthy (stale resources).
-// Collectors that do not implement HealthChecker are assumed healthy.
+// Optional collectors enabled at runtime are unhealthy until their cache
+// syncs. Collectors that do not implement HealthChecker are otherwise
+// assumed healthy.
 func (r *Registry) HealthReport() (healthy int, total int, stale []string) {
 	r.mu.Lock()
 	collectors := make([]Collector, len(r.collectors))
 	copy(collectors, r.collectors)
+	syncing := make(map[Collector]bool, len(r.syncing))
+	for c := range r.syncing {
+		syncing[c] = true
+	}
 	r.mu.Unlock()
 
 	total = len(collectors)
 	for _, c := range collectors {
+		if syncing[c] {
+			stale = append(stale, c.Name())
+			continue
+		}
 		if hc, ok := c.(HealthChecker); ok {
 			if h, _ := hc.IsHealthy(); h {
 				healthy++
@@ -181,6 +342,21 @@ func (r *Registry) HealthReport() (healthy int, total int, stale []string) {
 	return healthy, total, stale
 }
 
+// ReconvertAll calls Reconvert on every collector that implements
+// Reconverter, e.g. after the redaction policy was reloaded.
+func (r *Registry) ReconvertAll() {
+	r.mu.Lock()
+	collectors := make([]Collector, len(r.collectors))
+	copy(collectors, r.collectors)
+	r.mu.Unlock()
+
+	for _, c := range collectors {
+		if rc, ok := c.(Reconverter); ok {
+			rc.Reconvert()
+		}
+	}
+}
+
 // APICallReport returns the aggregate API call statistics across all collectors
 // that implement the APICallCounter interface.
 func (r *Registry) APICallReport() (total, failed int64) {
@@ -447,6 +447,26 @@ func TestRegistry_HealthReportEmpty(t *testing.T) {
 	}
 }
 
+// reconvertCollector implements Collector + Reconverter.
+type reconvertCollector struct {
+	mockCollector
+	reconverted int
+}
+
+func (c *reconvertCollector) Reconvert() { c.reconverted++ }
+
+func TestRegistry_ReconvertAll(t *testing.T) {
+	r := NewRegistry()
+	rc := &reconvertCollector{mockCollector: mockCollector{name: "pods"}}
+	r.Register(rc)
+	r.Register(&mockCollector{name: "metrics"})
+
+	r.ReconvertAll()
+	if rc.reconverted != 1 {
+		t.Errorf("Reconvert called %d times, want 1", rc.reconverted)
+	}
+}
+
 // apiCallCollector implements Collector + APICallCounter.
 type apiCallCollector struct {
 	name       string
@@ -552,3 +572,169 @@ func TestRegistry_DCGMTargetReportNone(t *testing.T) {
 		t.Errorf("expected upTargets=0, got %d", upTargets)
 	}
 }
+
+func TestRegistry_SetEnabledUnknown(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.SetEnabled("vpas", true, time.Second)
+	if !errors.Is(err, ErrUnknownCollector) {
+		t.Fatalf("expected ErrUnknownCollector, got %v", err)
+	}
+}
+
+func TestRegistry_SetEnabledTogglesAtRuntime(t *testing.T) {
+	r := NewRegistry()
+	r.Register(&mockCollector{name: "nodes"})
+
+	var built []*mockCollector
+	var cleared atomic.Int32
+	r.RegisterOptional("vpas", func() Collector {
+		c := &mockCollector{name: "vpas"}
+		built = append(built, c)
+		return c
+	}, func() { cleared.Add(1) }, false)
+
+	if got := len(r.Collectors()); got != 1 {
+		t.Fatalf("expected 1 collector before enabling, got %d", got)
+	}
+	if err := r.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll failed: %v", err)
+	}
+
+	// Enable: built, started, synced in the background.
+	changed, err := r.SetEnabled("vpas", true, time.Second)
+	if err != nil || !changed {
+		t.Fatalf("enable: changed=%v err=%v", changed, err)
+	}
+	if len(built) != 1 || !built[0].isStarted() {
+		t.Fatal("expected optional collector to be built and started")
+	}
+	waitFor(t, built[0].isSynced)
+	if got := len(r.Collectors()); got != 2 {
+		t.Fatalf("expected 2 collectors after enabling, got %d", got)
+	}
+
+	// Enabling again is a no-op.
+	changed, err = r.SetEnabled("vpas", true, time.Second)
+	if err != nil || changed {
+		t.Fatalf("re-enable: changed=%v err=%v", changed, err)
+	}
+
+	// Disable: stopped, unregistered, store cleared.
+	changed, err = r.SetEnabled("vpas", false, time.Second)
+	if err != nil || !changed {
+		t.Fatalf("disable: changed=%v err=%v", changed, err)
+	}
+	if !built[0].isStopped() {
+		t.Error("expected optional collector to be stopped")
+	}
+	if cleared.Load() != 1 {
+		t.Errorf("expected onDisable called once, got %d", cleared.Load())
+	}
+	if got := len(r.Collectors()); got != 1 {
+		t.Fatalf("expected 1 collector after disabling, got %d", got)
+	}
+
+	// Re-enable builds a fresh instance (stopped collectors cannot restart).
+	if _, err := r.SetEnabled("vpas", true, time.Second); err != nil {
+		t.Fatalf("re-enable after disable: %v", err)
+	}
+	if len(built) != 2 || !built[1].isStarted() {
+		t.Fatal("expected a fresh optional collector instance to be started")
+	}
+}
+
+func TestRegistry_RegisterOptionalEnabledStartsWithAll(t *testing.T) {
+	r := NewRegistry()
+
+	c := &mockCollector{name: "nodepools"}
+	r.RegisterOptional("nodepools", func() Collector { return c }, nil, true)
+
+	if err := r.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll failed: %v", err)
+	}
+	if !c.isStarted() {
+		t.Error("expected initially enabled optional collector to start with StartAll")
+	}
+}
+
+func TestRegistry_SetEnabledStartFailureUnregisters(t *testing.T) {
+	r := NewRegistry()
+	r.Register(&mockCollector{name: "nodes"})
+	r.RegisterOptional("vpas", func() Collector {
+		return &mockCollector{name: "vpas", startErr: errors.New("boom")}
+	}, nil, false)
+
+	if err := r.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll failed: %v", err)
+	}
+
+	if _, err := r.SetEnabled("vpas", true, time.Second); err == nil {
+		t.Fatal("expected start error")
+	}
+	if got := len(r.Collectors()); got != 1 {
+		t.Fatalf("expected failed collector to be unregistered, got %d collectors", got)
+	}
+}
+
+// waitFor polls cond until it holds or a second passes.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within 1s")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestRegistry_SetEnabledDoesNotWaitForSync verifies a collector that cannot
+// sync (e.g. missing RBAC) does not block SetEnabled and is reported stale.
+func TestRegistry_SetEnabledDoesNotWaitForSync(t *testing.T) {
+	r := NewRegistry()
+	r.Register(&mockCollector{name: "nodes"})
+	slow := &mockCollector{name: "vpas", syncDelay: time.Hour}
+	r.RegisterOptional("vpas", func() Collector { return slow }, nil, false)
+	if err := r.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll failed: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := r.SetEnabled("vpas", true, 10*time.Millisecond); err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("SetEnabled took %v, want it not to wait for sync", elapsed)
+	}
+	healthy, total, stale := r.HealthReport()
+	if healthy != 1 || total != 2 || len(stale) != 1 || stale[0] != "vpas" {
+		t.Errorf("HealthReport = %d/%d stale=%v, want vpas stale while syncing", healthy, total, stale)
+	}
+
+	if _, err := r.SetEnabled("vpas", false, time.Second); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+	if healthy, total, stale := r.HealthReport(); healthy != 1 || total != 1 || len(stale) != 0 {
+		t.Errorf("HealthReport after disable = %d/%d stale=%v", healthy, total, stale)
+	}
+}
+
+func TestRegistry_SetEnabledReportsHealthyOnceSynced(t *testing.T) {
+	r := NewRegistry()
+	c := &mockCollector{name: "vpas", syncDelay: 20 * time.Millisecond}
+	r.RegisterOptional("vpas", func() Collector { return c }, nil, false)
+	if err := r.StartAll(context.Background()); err != nil {
+		t.Fatalf("StartAll failed: %v", err)
+	}
+	if _, err := r.SetEnabled("vpas", true, time.Second); err != nil {
+		t.Fatalf("enable: %v", err)
+	}
+	waitFor(t, func() bool {
+		healthy, _, _ := r.HealthReport()
+		return healthy == 1
+	})
+	if !c.isSynced() {
+		t.Error("expected collector to be synced")
+	}
+}
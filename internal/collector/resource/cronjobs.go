@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // CronJobCollector watches Kubernetes CronJob objects via a SharedInformer
 // and writes model.CronJobInfo to the store on every add/update/delete event.
 type CronJobCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewCronJobCollector creates a new CronJobCollector.
-func NewCronJobCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *CronJobCollector {
+func NewCronJobCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *CronJobCollector {
 	return &CronJobCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +43,7 @@ func (c *CronJobCollector) Name() string { return "cronjobs" }
 
 // Start implements collector.Collector.
 func (c *CronJobCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Batch().V1().CronJobs().Informer()
+	c.informer = c.factory.Batch().V1().CronJobs().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -94,6 +90,14 @@ func (c *CronJobCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *CronJobCollector) Reconvert() {
+	reconvert(c.informer, c.store.CronJobs, func(cj *batchv1.CronJob) (string, model.CronJobInfo) {
+		info := convert.CronJobToModel(cj)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *CronJobCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -13,13 +13,13 @@ import (
 
 func TestCronJobCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewCronJobCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewCronJobCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "cronjobs", c.Name())
 }
 
 func TestCronJobCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewCronJobCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewCronJobCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
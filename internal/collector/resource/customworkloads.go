@@ -0,0 +1,318 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// customOwnerScanInterval is how often pod/ReplicaSet owner references are
+// scanned for CRD kinds that are not watched yet.
+const customOwnerScanInterval = 30 * time.Second
+
+// builtinOwnerGroups are API groups whose owner kinds already have dedicated
+// collectors (or, like core/v1 Node, are not workloads).
+var builtinOwnerGroups = map[string]bool{
+	"":      true,
+	"apps":  true,
+	"batch": true,
+}
+
+// CustomWorkloadCollector discovers CRD kinds that own pods — directly, via a
+// ReplicaSet (e.g. Argo Rollouts), or via another custom workload — and
+// watches each through a dynamic SharedInformer, writing
+// model.CustomWorkloadInfo to the store.
+//
+// Owner kinds are resolved to resources through API discovery. Kinds the
+// agent is not allowed to list are logged once and skipped.
+type CustomWorkloadCollector struct {
+	dynamicClient dynamic.Interface
+	discovery     discovery.DiscoveryInterface
+	store         *store.Store
+	metrics       *observability.Metrics
+	resyncPeriod  time.Duration
+	scanInterval  time.Duration
+
+	factory dynamicinformer.DynamicSharedInformerFactory
+
+	mu        sync.Mutex
+	watched   map[schema.GroupKind]schema.GroupVersionResource
+	skipped   map[schema.GroupKind]struct{}
+	informers []cache.SharedIndexInformer
+
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewCustomWorkloadCollector creates a new CustomWorkloadCollector.
+func NewCustomWorkloadCollector(
+	dynamicClient dynamic.Interface,
+	disc discovery.DiscoveryInterface,
+	s *store.Store,
+	m *observability.Metrics,
+	resyncPeriod time.Duration,
+) *CustomWorkloadCollector {
+	return &CustomWorkloadCollector{
+		dynamicClient: dynamicClient,
+		discovery:     disc,
+		store:         s,
+		metrics:       m,
+		resyncPeriod:  resyncPeriod,
+		scanInterval:  customOwnerScanInterval,
+		watched:       make(map[schema.GroupKind]schema.GroupVersionResource),
+		skipped:       make(map[schema.GroupKind]struct{}),
+		stopCh:        make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// Name implements collector.Collector.
+func (c *CustomWorkloadCollector) Name() string { return "custom_workloads" }
+
+// Start implements collector.Collector. Owner kinds are discovered in the
+// background because the pod and ReplicaSet stores fill concurrently.
+func (c *CustomWorkloadCollector) Start(ctx context.Context) error {
+	c.factory = dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicClient, c.resyncPeriod)
+
+	go func() {
+		defer close(c.done)
+		ticker := time.NewTicker(c.scanInterval)
+		defer ticker.Stop()
+		for {
+			c.scan(ctx)
+			select {
+			case <-c.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// WaitForSync implements collector.Collector. It waits for the informers of
+// the kinds discovered so far; kinds found later sync in the background.
+func (c *CustomWorkloadCollector) WaitForSync(ctx context.Context) error {
+	c.mu.Lock()
+	synced := make([]cache.InformerSynced, 0, len(c.informers))
+	for _, inf := range c.informers {
+		synced = append(synced, inf.HasSynced)
+	}
+	c.mu.Unlock()
+
+	if !cache.WaitForCacheSync(ctx.Done(), synced...) {
+		return fmt.Errorf("custom_workloads informer cache sync failed")
+	}
+	return nil
+}
+
+// Reconvert implements collector.Reconverter. Store keys are rebuilt from
+// the kind each cached object reports.
+func (c *CustomWorkloadCollector) Reconvert() {
+	c.mu.Lock()
+	informers := slices.Clone(c.informers)
+	c.mu.Unlock()
+
+	for _, inf := range informers {
+		reconvert(inf, c.store.CustomWorkloads, func(u *unstructured.Unstructured) (string, model.CustomWorkloadInfo) {
+			info := convert.CustomWorkloadToModel(u)
+			return customWorkloadKey(u.GroupVersionKind().GroupKind(), info.Namespace, info.Name), info
+		})
+	}
+}
+
+// Stop implements collector.Collector.
+func (c *CustomWorkloadCollector) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+	<-c.done
+	if c.factory != nil {
+		c.factory.Shutdown()
+	}
+}
+
+// IsHealthy implements collector.HealthChecker.
+func (c *CustomWorkloadCollector) IsHealthy() (bool, string) {
+	return informerHealthy(c.stopCh, c.done)
+}
+
+// WatchedKinds returns the CRD kinds currently being watched.
+func (c *CustomWorkloadCollector) WatchedKinds() []schema.GroupKind {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]schema.GroupKind, 0, len(c.watched))
+	for gk := range c.watched {
+		out = append(out, gk)
+	}
+	return out
+}
+
+// scan collects owner references from pods, ReplicaSets, and custom
+// workloads and starts an informer for every new custom kind.
+func (c *CustomWorkloadCollector) scan(ctx context.Context) {
+	owners := make(map[schema.GroupKind]string) // GroupKind → apiVersion
+	add := func(apiVersion, kind string) {
+		if apiVersion == "" || kind == "" {
+			return
+		}
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil || builtinOwnerGroups[gv.Group] {
+			return
+		}
+		owners[schema.GroupKind{Group: gv.Group, Kind: kind}] = apiVersion
+	}
+
+	c.store.Pods.Range(func(_ string, p model.PodInfo) bool {
+		add(p.OwnerAPIVersion, p.OwnerKind)
+		return true
+	})
+	c.store.ReplicaSets.Range(func(_ string, rs model.ReplicaSetInfo) bool {
+		add(rs.OwnerAPIVersion, rs.OwnerKind)
+		return true
+	})
+	c.store.CustomWorkloads.Range(func(_ string, cw model.CustomWorkloadInfo) bool {
+		add(cw.OwnerAPIVersion, cw.OwnerKind)
+		return true
+	})
+
+	for gk, apiVersion := range owners {
+		c.mu.Lock()
+		_, isWatched := c.watched[gk]
+		_, isSkipped := c.skipped[gk]
+		c.mu.Unlock()
+		if isWatched || isSkipped {
+			continue
+		}
+		c.watch(ctx, gk, apiVersion)
+	}
+}
+
+// watch resolves gk to a resource and starts an informer for it. Transient
+// discovery/list errors are retried on the next scan.
+func (c *CustomWorkloadCollector) watch(ctx context.Context, gk schema.GroupKind, apiVersion string) {
+	gvr, found, err := c.resolve(gk, apiVersion)
+	if err != nil {
+		slog.Debug("custom workload discovery failed, will retry", "kind", gk.String(), "error", err)
+		return
+	}
+	if !found {
+		slog.Info("custom workload owner kind not served by the API server, skipping", "kind", gk.String())
+		c.skip(gk)
+		return
+	}
+
+	// Probe list access once so a missing RBAC rule doesn't leave a
+	// reflector retrying (and logging) forever.
+	if _, err := c.dynamicClient.Resource(gvr).List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+			slog.Warn("cannot list custom workload kind, skipping", "kind", gk.String(), "resource", gvr.String(), "error", err)
+			c.skip(gk)
+		}
+		return
+	}
+
+	informer := c.factory.ForResource(gvr).Informer()
+	if _, err := informer.AddEventHandler(c.handler(gk)); err != nil {
+		slog.Warn("custom workload informer handler failed", "kind", gk.String(), "error", err)
+		return
+	}
+
+	c.mu.Lock()
+	c.watched[gk] = gvr
+	c.informers = append(c.informers, informer)
+	c.mu.Unlock()
+
+	c.factory.Start(c.stopCh)
+	slog.Info("watching custom workload kind", "kind", gk.String(), "resource", gvr.String())
+}
+
+func (c *CustomWorkloadCollector) skip(gk schema.GroupKind) {
+	c.mu.Lock()
+	c.skipped[gk] = struct{}{}
+	c.mu.Unlock()
+}
+
+// resolve maps an owner kind to its (non-subresource) API resource.
+func (c *CustomWorkloadCollector) resolve(gk schema.GroupKind, apiVersion string) (schema.GroupVersionResource, bool, error) {
+	list, err := c.discovery.ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return schema.GroupVersionResource{}, false, nil
+		}
+		return schema.GroupVersionResource{}, false, err
+	}
+	gv, err := schema.ParseGroupVersion(list.GroupVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+	for _, r := range list.APIResources {
+		if r.Kind == gk.Kind && !strings.Contains(r.Name, "/") {
+			return gv.WithResource(r.Name), true, nil
+		}
+	}
+	return schema.GroupVersionResource{}, false, nil
+}
+
+func (c *CustomWorkloadCollector) handler(gk schema.GroupKind) cache.ResourceEventHandlerFuncs {
+	upsert := func(obj interface{}, event string) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		info := convert.CustomWorkloadToModel(u)
+		c.store.CustomWorkloads.Set(customWorkloadKey(gk, info.Namespace, info.Name), info)
+		c.metrics.InformerEventsTotal.WithLabelValues("custom_workloads", event).Inc()
+		c.metrics.StoreItems.WithLabelValues("custom_workloads").Set(float64(c.store.CustomWorkloads.Len()))
+	}
+
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { upsert(obj, "add") },
+		UpdateFunc: func(_, newObj interface{}) {
+			upsert(newObj, "update")
+		},
+		DeleteFunc: func(obj interface{}) {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				u, ok = tombstone.Obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+			}
+			c.store.CustomWorkloads.Delete(customWorkloadKey(gk, u.GetNamespace(), u.GetName()))
+			c.metrics.InformerEventsTotal.WithLabelValues("custom_workloads", "delete").Inc()
+			c.metrics.StoreItems.WithLabelValues("custom_workloads").Set(float64(c.store.CustomWorkloads.Len()))
+		},
+	}
+}
+
+// customWorkloadKey includes the group and kind so that different CRDs with
+// the same namespace/name don't collide.
+func customWorkloadKey(gk schema.GroupKind, namespace, name string) string {
+	return gk.String() + "/" + nsNameKey(namespace, name)
+}
@@ -0,0 +1,118 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+var rolloutGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+
+func newCustomWorkloadTestEnv(t *testing.T) (*dynamicfake.FakeDynamicClient, *fakediscovery.FakeDiscovery, *store.Store, *observability.Metrics, context.Context) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{rolloutGVR: "RolloutList"})
+	disc := &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+		Resources: []*metav1.APIResourceList{{
+			GroupVersion: "argoproj.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{Name: "rollouts", Kind: "Rollout", Namespaced: true},
+				{Name: "rollouts/status", Kind: "Rollout", Namespaced: true},
+			},
+		}},
+	}}
+	return client, disc, store.NewStore(), observability.NewMetrics(), ctx
+}
+
+func TestCustomWorkloadCollector_Name(t *testing.T) {
+	client, disc, s, m, _ := newCustomWorkloadTestEnv(t)
+	c := NewCustomWorkloadCollector(client, disc, s, m, testResyncPeriod)
+	assert.Equal(t, "custom_workloads", c.Name())
+}
+
+func TestCustomWorkloadCollector_DiscoversOwnerKindFromReplicaSet(t *testing.T) {
+	client, disc, s, m, ctx := newCustomWorkloadTestEnv(t)
+
+	// Argo Rollouts: Pod → ReplicaSet → Rollout.
+	s.ReplicaSets.Set("shop/checkout-abc", model.ReplicaSetInfo{
+		Name: "checkout-abc", Namespace: "shop",
+		OwnerKind: "Rollout", OwnerName: "checkout", OwnerAPIVersion: "argoproj.io/v1alpha1",
+	})
+	// Built-in and unknown owners must be ignored.
+	s.Pods.Set("shop/web-1", model.PodInfo{
+		Name: "web-1", Namespace: "shop",
+		OwnerKind: "ReplicaSet", OwnerName: "web-abc", OwnerAPIVersion: "apps/v1",
+	})
+	s.Pods.Set("shop/x-1", model.PodInfo{
+		Name: "x-1", Namespace: "shop",
+		OwnerKind: "Widget", OwnerName: "x", OwnerAPIVersion: "example.com/v1",
+	})
+
+	rollout := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]interface{}{
+			"name":      "checkout",
+			"namespace": "shop",
+			"uid":       "rollout-uid-1",
+		},
+		"spec":   map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{"readyReplicas": int64(3)},
+	}}
+	_, err := client.Resource(rolloutGVR).Namespace("shop").Create(ctx, rollout, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	c := NewCustomWorkloadCollector(client, disc, s, m, testResyncPeriod)
+	c.scanInterval = pollInterval
+	require.NoError(t, c.Start(ctx))
+	t.Cleanup(c.Stop)
+
+	require.Eventually(t, func() bool {
+		return s.CustomWorkloads.Len() == 1
+	}, waitTimeout, pollInterval)
+
+	info, ok := s.CustomWorkloads.Get("Rollout.argoproj.io/shop/checkout")
+	require.True(t, ok)
+	assert.Equal(t, "rollout-uid-1", info.UID)
+	require.NotNil(t, info.Replicas)
+	assert.Equal(t, int32(3), *info.Replicas)
+
+	assert.Equal(t, []schema.GroupKind{{Group: "argoproj.io", Kind: "Rollout"}}, c.WatchedKinds())
+	require.NoError(t, c.WaitForSync(ctx))
+
+	// --- Delete ---
+	err = client.Resource(rolloutGVR).Namespace("shop").Delete(ctx, "checkout", metav1.DeleteOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return s.CustomWorkloads.Len() == 0
+	}, waitTimeout, pollInterval)
+}
+
+func TestCustomWorkloadCollector_StopWithoutDiscoveredKinds(t *testing.T) {
+	client, disc, s, m, ctx := newCustomWorkloadTestEnv(t)
+
+	c := NewCustomWorkloadCollector(client, disc, s, m, testResyncPeriod)
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.WaitForSync(ctx))
+
+	healthy, _ := c.IsHealthy()
+	assert.True(t, healthy)
+	c.Stop()
+}
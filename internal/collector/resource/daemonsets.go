@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // DaemonSetCollector watches Kubernetes DaemonSet objects via a SharedInformer
 // and writes model.DaemonSetInfo to the store on every add/update/delete event.
 type DaemonSetCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewDaemonSetCollector creates a new DaemonSetCollector.
-func NewDaemonSetCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *DaemonSetCollector {
+func NewDaemonSetCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *DaemonSetCollector {
 	return &DaemonSetCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +43,7 @@ func (c *DaemonSetCollector) Name() string { return "daemonsets" }
 
 // Start implements collector.Collector.
 func (c *DaemonSetCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Apps().V1().DaemonSets().Informer()
+	c.informer = c.factory.Apps().V1().DaemonSets().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -94,6 +90,14 @@ func (c *DaemonSetCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *DaemonSetCollector) Reconvert() {
+	reconvert(c.informer, c.store.DaemonSets, func(ds *appsv1.DaemonSet) (string, model.DaemonSetInfo) {
+		info := convert.DaemonSetToModel(ds)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *DaemonSetCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -12,13 +12,13 @@ import (
 
 func TestDaemonSetCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewDaemonSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewDaemonSetCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "daemonsets", c.Name())
 }
 
 func TestDaemonSetCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewDaemonSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewDaemonSetCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
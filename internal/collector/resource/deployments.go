@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // DeploymentCollector watches Kubernetes Deployment objects via a SharedInformer
 // and writes model.DeploymentInfo to the store on every add/update/delete event.
 type DeploymentCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewDeploymentCollector creates a new DeploymentCollector.
-func NewDeploymentCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *DeploymentCollector {
+func NewDeploymentCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *DeploymentCollector {
 	return &DeploymentCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -51,8 +48,7 @@ func nsNameKey(namespace, name string) string {
 
 // Start implements collector.Collector.
 func (c *DeploymentCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Apps().V1().Deployments().Informer()
+	c.informer = c.factory.Apps().V1().Deployments().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -99,6 +95,14 @@ func (c *DeploymentCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *DeploymentCollector) Reconvert() {
+	reconvert(c.informer, c.store.Deployments, func(dep *appsv1.Deployment) (string, model.DeploymentInfo) {
+		info := convert.DeploymentToModel(dep)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *DeploymentCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
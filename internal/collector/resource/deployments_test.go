@@ -9,17 +9,20 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
 )
 
 func TestDeploymentCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewDeploymentCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewDeploymentCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "deployments", c.Name())
 }
 
 func TestDeploymentCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewDeploymentCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewDeploymentCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -60,3 +63,37 @@ func TestDeploymentCollector_AddUpdateDelete(t *testing.T) {
 		return env.store.Deployments.Len() == 0
 	}, waitTimeout, pollInterval)
 }
+
+func TestDeploymentCollector_Reconvert(t *testing.T) {
+	env := newTestEnv(t)
+	c := NewDeploymentCollector(env.factory, env.store, env.metrics)
+	startCollector(t, env, c)
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web", Namespace: "default",
+			Labels: map[string]string{"app": "web", "owner": "jane"},
+		},
+	}
+	_, err := env.client.AppsV1().Deployments("default").Create(env.ctx, dep, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return env.store.Deployments.Len() == 1
+	}, waitTimeout, pollInterval)
+
+	policy, err := redact.NewPolicy(redact.Rules{LabelDeny: []string{"owner"}})
+	require.NoError(t, err)
+	convert.SetRedactionPolicy(policy)
+	t.Cleanup(func() { convert.SetRedactionPolicy(nil) })
+
+	// A new policy reaches stored objects without an informer event.
+	c.Reconvert()
+	info, ok := env.store.Deployments.Get("default/web")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"app": "web"}, info.Labels)
+
+	// A deleted object is not added back.
+	env.store.Deployments.Delete("default/web")
+	c.Reconvert()
+	assert.Equal(t, 0, env.store.Deployments.Len())
+}
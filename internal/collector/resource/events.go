@@ -0,0 +1,128 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+)
+
+// EventCollector watches events.k8s.io/v1 Events (which also serves events
+// written through core/v1) via a SharedInformer and records every new
+// occurrence in the store's EventRing. Events that already exist when the
+// informer first lists are not recorded: how many of their occurrences are
+// recent is unknown, so only their later updates count. Deleting an Event
+// does not remove its occurrences; they age out of the ring instead.
+type EventCollector struct {
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewEventCollector creates a new EventCollector.
+func NewEventCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *EventCollector {
+	return &EventCollector{
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Name implements collector.Collector.
+func (c *EventCollector) Name() string { return "events" }
+
+// Start implements collector.Collector.
+func (c *EventCollector) Start(_ context.Context) error {
+	c.informer = c.factory.Events().V1().Events().Informer()
+
+	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			ev, ok := obj.(*eventsv1.Event)
+			if !ok {
+				return
+			}
+			c.metrics.InformerEventsTotal.WithLabelValues("events", "add").Inc()
+			if isInInitialList {
+				return
+			}
+			// A new Event: every occurrence it counts happened after the
+			// initial list.
+			c.store.Events.Add(convert.EventToModel(ev))
+			c.metrics.StoreItems.WithLabelValues("events").Set(float64(c.store.Events.Len()))
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEv, ok := oldObj.(*eventsv1.Event)
+			if !ok {
+				return
+			}
+			newEv, ok := newObj.(*eventsv1.Event)
+			if !ok {
+				return
+			}
+			prev := convert.EventToModel(oldEv)
+			info := convert.EventToModel(newEv)
+			info.Count = newOccurrences(prev.Count, info.Count, prev.LastTimestamp, info.LastTimestamp)
+			if info.Count == 0 {
+				return // resync or metadata-only update
+			}
+			info.FirstTimestamp = max(info.FirstTimestamp, prev.LastTimestamp)
+			c.store.Events.Add(info)
+			c.metrics.InformerEventsTotal.WithLabelValues("events", "update").Inc()
+			c.metrics.StoreItems.WithLabelValues("events").Set(float64(c.store.Events.Len()))
+		},
+		DeleteFunc: func(_ interface{}) {
+			c.metrics.InformerEventsTotal.WithLabelValues("events", "delete").Inc()
+		},
+	}); err != nil {
+		return fmt.Errorf("%s: add event handler: %w", c.Name(), err)
+	}
+
+	runInformerWithRecovery(c.informer, c.Name(), c.stopCh, c.done)
+	return nil
+}
+
+// newOccurrences returns how many times an event recurred between two
+// observations: the count increase, or one if only the last-seen time moved.
+func newOccurrences(prevCount, count int32, prevLast, last int64) int32 {
+	if count > prevCount {
+		return count - prevCount
+	}
+	if last > prevLast {
+		return 1
+	}
+	return 0
+}
+
+// WaitForSync implements collector.Collector.
+func (c *EventCollector) WaitForSync(ctx context.Context) error {
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		return fmt.Errorf("events informer cache sync failed")
+	}
+	return nil
+}
+
+// Stop implements collector.Collector.
+func (c *EventCollector) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+	<-c.done
+}
+
+// IsHealthy implements collector.HealthChecker.
+func (c *EventCollector) IsHealthy() (bool, string) {
+	return informerHealthy(c.stopCh, c.done)
+}
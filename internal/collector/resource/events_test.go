@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventCollector_Name(t *testing.T) {
+	env := newTestEnv(t)
+	c := NewEventCollector(env.factory, env.store, env.metrics)
+	assert.Equal(t, "events", c.Name())
+}
+
+func TestEventCollector_RecordsOccurrences(t *testing.T) {
+	env := newTestEnv(t)
+	c := NewEventCollector(env.factory, env.store, env.metrics)
+	startCollector(t, env, c)
+
+	// --- Add ---
+	first := time.Now().Add(-time.Minute).Truncate(time.Second)
+	ev := &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1.17a", Namespace: "default"},
+		EventTime:  metav1.NewMicroTime(first),
+		Series:     &eventsv1.EventSeries{Count: 2, LastObservedTime: metav1.NewMicroTime(first)},
+		Reason:     "FailedScheduling",
+		Type:       corev1.EventTypeWarning,
+		Note:       "0/3 nodes are available",
+		Regarding:  corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "web-1"},
+	}
+	_, err := env.client.EventsV1().Events("default").Create(env.ctx, ev, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return env.store.Events.Len() == 1
+	}, waitTimeout, pollInterval)
+
+	got, _ := env.store.Events.Summarize(time.Time{})
+	require.Len(t, got, 1)
+	assert.Equal(t, "FailedScheduling", got[0].Reason)
+	assert.Equal(t, "web-1", got[0].InvolvedName)
+	assert.Equal(t, int32(2), got[0].Count)
+
+	// --- Update: the series count grows by 3 ---
+	ev.Series = &eventsv1.EventSeries{Count: 5, LastObservedTime: metav1.NewMicroTime(first.Add(30 * time.Second))}
+	_, err = env.client.EventsV1().Events("default").Update(env.ctx, ev, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return env.store.Events.Len() == 2
+	}, waitTimeout, pollInterval)
+
+	got, _ = env.store.Events.Summarize(time.Time{})
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(5), got[0].Count)
+	assert.Equal(t, first.Add(30*time.Second).UnixMilli(), got[0].LastTimestamp)
+
+	// --- Delete: recorded occurrences are kept ---
+	err = env.client.EventsV1().Events("default").Delete(env.ctx, "web-1.17a", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	assert.Never(t, func() bool {
+		return env.store.Events.Len() != 2
+	}, 200*time.Millisecond, pollInterval)
+}
+
+func TestEventCollector_SkipsInitialList(t *testing.T) {
+	env := newTestEnv(t)
+
+	// An Event listed at startup carries its lifetime count, most of which
+	// is older than any snapshot window.
+	last := time.Now().Add(-time.Minute).Truncate(time.Second)
+	ev := &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1.17b", Namespace: "default"},
+		EventTime:  metav1.NewMicroTime(last.Add(-time.Hour)),
+		Series:     &eventsv1.EventSeries{Count: 40, LastObservedTime: metav1.NewMicroTime(last)},
+		Reason:     "BackOff",
+		Type:       corev1.EventTypeWarning,
+		Regarding:  corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "web-1"},
+	}
+	_, err := env.client.EventsV1().Events("default").Create(env.ctx, ev, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	c := NewEventCollector(env.factory, env.store, env.metrics)
+	startCollector(t, env, c)
+	assert.Equal(t, 0, env.store.Events.Len())
+
+	// Only the occurrences after the initial list are recorded.
+	ev.Series = &eventsv1.EventSeries{Count: 42, LastObservedTime: metav1.NewMicroTime(last.Add(30 * time.Second))}
+	_, err = env.client.EventsV1().Events("default").Update(env.ctx, ev, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return env.store.Events.Len() == 1
+	}, waitTimeout, pollInterval)
+	got, _ := env.store.Events.Summarize(time.Time{})
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(2), got[0].Count)
+}
+
+func TestNewOccurrences(t *testing.T) {
+	assert.Equal(t, int32(3), newOccurrences(2, 5, 1000, 2000))
+	assert.Equal(t, int32(1), newOccurrences(5, 5, 1000, 2000), "last-seen time moved without a count change")
+	assert.Equal(t, int32(1), newOccurrences(5, 1, 1000, 2000), "count reset")
+	assert.Equal(t, int32(0), newOccurrences(5, 5, 2000, 2000), "resync")
+}
@@ -0,0 +1,119 @@
+package resource
+
+import (
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
+)
+
+// lastAppliedAnnotation holds a full copy of the object as last applied by
+// kubectl; it is often the largest field on the object and never read.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// NewInformerFactory creates the SharedInformerFactory shared by every typed
+// collector, so each resource type is listed and watched once. Objects are
+// passed through stripUnusedFields before they enter the informer cache.
+//
+// Collectors run their own informers (see runInformerWithRecovery); callers
+// must not call Start on the returned factory.
+func NewInformerFactory(client kubernetes.Interface, resyncPeriod time.Duration) informers.SharedInformerFactory {
+	return informers.NewSharedInformerFactoryWithOptions(client, resyncPeriod,
+		informers.WithTransform(stripUnusedFields),
+	)
+}
+
+// NewScopedInformerFactory is NewInformerFactory restricted to the given
+// namespaces server-side: to the namespace itself when exactly one is
+// included, and with a field selector dropping excluded namespaces otherwise.
+// Include lists of several namespaces and label selectors cannot be expressed
+// in a list call and are applied by the snapshot builder instead.
+//
+// Only namespaced collectors may use the returned factory; cluster-scoped
+// resources reject the metadata.namespace field selector.
+func NewScopedInformerFactory(client kubernetes.Interface, resyncPeriod time.Duration, namespaces *scope.Namespaces) informers.SharedInformerFactory {
+	opts := []informers.SharedInformerOption{informers.WithTransform(stripUnusedFields)}
+	if ns := namespaces.SingleNamespace(); ns != "" {
+		opts = append(opts, informers.WithNamespace(ns))
+	} else if sel := namespaces.FieldSelector(); sel != "" {
+		opts = append(opts, informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+			o.FieldSelector = sel
+		}))
+	}
+	return informers.NewSharedInformerFactoryWithOptions(client, resyncPeriod, opts...)
+}
+
+// NewMetadataInformerFactory creates the factory for collectors that only
+// read object metadata (ReplicaSets, Namespaces). The API server sends
+// PartialObjectMetadata, so specs and statuses are never decoded or cached.
+func NewMetadataInformerFactory(client metadata.Interface, resyncPeriod time.Duration) metadatainformer.SharedInformerFactory {
+	return metadatainformer.NewSharedInformerFactoryWithOptions(client, resyncPeriod,
+		metadatainformer.WithTransform(stripUnusedFields),
+	)
+}
+
+// stripUnusedFields is a cache.TransformFunc that drops the parts of an
+// object the converters in internal/convert never read: managedFields, the
+// last-applied annotation, and everything in container specs other than
+// name, image, resources and ports. It mutates obj in place, which is safe
+// because the informer owns the freshly decoded object, and is idempotent
+// since a relist may feed an already transformed object back in.
+func stripUnusedFields(obj interface{}) (interface{}, error) {
+	if m, ok := obj.(metav1.Object); ok {
+		m.SetManagedFields(nil)
+		if ann := m.GetAnnotations(); ann != nil {
+			delete(ann, lastAppliedAnnotation)
+		}
+	}
+
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		o.Spec.Containers = trimContainers(o.Spec.Containers)
+		o.Spec.InitContainers = trimContainers(o.Spec.InitContainers)
+		o.Spec.EphemeralContainers = nil
+	case *corev1.Node:
+		// Every image on the node with its size; can be hundreds of entries.
+		o.Status.Images = nil
+	case *appsv1.Deployment:
+		trimPodTemplate(&o.Spec.Template)
+	case *appsv1.StatefulSet:
+		trimPodTemplate(&o.Spec.Template)
+	case *appsv1.DaemonSet:
+		trimPodTemplate(&o.Spec.Template)
+	case *batchv1.Job:
+		trimPodTemplate(&o.Spec.Template)
+	case *batchv1.CronJob:
+		trimPodTemplate(&o.Spec.JobTemplate.Spec.Template)
+	}
+	return obj, nil
+}
+
+// trimContainers keeps only the container fields read by the converters.
+// Env, command, args, probes, mounts and security context are dropped.
+func trimContainers(containers []corev1.Container) []corev1.Container {
+	for i, c := range containers {
+		containers[i] = corev1.Container{
+			Name:          c.Name,
+			Image:         c.Image,
+			Resources:     c.Resources,
+			Ports:         c.Ports,
+			RestartPolicy: c.RestartPolicy,
+		}
+	}
+	return containers
+}
+
+// trimPodTemplate reduces a workload's pod template to its labels and
+// trimmed containers; only the containers' resources are converted.
+func trimPodTemplate(t *corev1.PodTemplateSpec) {
+	t.Annotations = nil
+	t.Spec = corev1.PodSpec{Containers: trimContainers(t.Spec.Containers)}
+}
@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"runtime"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// benchmarkPodCache fills an informer-style cache.Store with benchPodCount
+// pods, optionally passed through transform, and reports the heap retained
+// per cached pod.
+func benchmarkPodCache(b *testing.B, transform cache.TransformFunc) {
+	b.ReportAllocs()
+
+	const benchPodCount = 1000
+
+	// Pre-build the pods so only the cached copies are measured.
+	pods := make([]*corev1.Pod, benchPodCount)
+	for i := range pods {
+		pods[i] = bloatedPod(i)
+	}
+
+	var retained int64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for _, p := range pods {
+			var obj interface{} = p.DeepCopy()
+			if transform != nil {
+				obj, _ = transform(obj)
+			}
+			_ = store.Add(obj)
+		}
+
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		retained = int64(after.HeapAlloc) - int64(before.HeapAlloc)
+		runtime.KeepAlive(store)
+	}
+
+	b.ReportMetric(float64(retained)/benchPodCount, "heap-bytes/pod")
+}
+
+// BenchmarkPodCache_Raw measures the memory held by an informer cache of
+// pods as decoded from the API server.
+func BenchmarkPodCache_Raw(b *testing.B) {
+	benchmarkPodCache(b, nil)
+}
+
+// BenchmarkPodCache_Stripped measures the same cache with stripUnusedFields
+// applied, as done by NewInformerFactory.
+func BenchmarkPodCache_Stripped(b *testing.B) {
+	benchmarkPodCache(b, stripUnusedFields)
+}
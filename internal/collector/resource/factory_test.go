@@ -0,0 +1,224 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
+)
+
+// bloatedPod returns a pod carrying the fields a real API server sends but
+// the agent never reads: managedFields, last-applied config, env, probes and
+// volume mounts.
+func bloatedPod(i int) *corev1.Pod {
+	container := func(name string) corev1.Container {
+		env := make([]corev1.EnvVar, 20)
+		for j := range env {
+			env[j] = corev1.EnvVar{Name: fmt.Sprintf("SETTING_%d", j), Value: fmt.Sprintf("value-%d-%d", i, j)}
+		}
+		return corev1.Container{
+			Name:    name,
+			Image:   "registry.example.com/team/" + name + ":v1.2.3",
+			Command: []string{"/bin/" + name},
+			Args:    []string{"--config=/etc/" + name + "/config.yaml", "--log-level=info"},
+			Env:     env,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+			},
+			Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP}},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "config", MountPath: "/etc/" + name},
+				{Name: "kube-api-access", MountPath: "/var/run/secrets/kubernetes.io/serviceaccount", ReadOnly: true},
+			},
+			LivenessProbe: &corev1.Probe{
+				ProbeHandler:  corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz"}},
+				PeriodSeconds: 10,
+			},
+			ReadinessProbe: &corev1.Probe{
+				ProbeHandler:  corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Path: "/ready"}},
+				PeriodSeconds: 5,
+			},
+		}
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("web-%d", i),
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web", "pod-template-hash": "abc123"},
+			Annotations: map[string]string{
+				lastAppliedAnnotation:  `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web"},"spec":{"containers":[{"name":"app"}]}}`,
+				"prometheus.io/scrape": "true",
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, FieldsType: "FieldsV1",
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{".":{},"f:app":{}}},"f:spec":{"f:containers":{}}}`)}},
+				{Manager: "kubelet", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", FieldsType: "FieldsV1",
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{},"f:containerStatuses":{},"f:phase":{}}}`)}},
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:       "node-1",
+			Containers:     []corev1.Container{container("app"), container("sidecar")},
+			InitContainers: []corev1.Container{container("init")},
+			Volumes: []corev1.Volume{
+				{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "web-config"},
+				}}},
+				{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase:    corev1.PodRunning,
+			QOSClass: corev1.PodQOSBurstable,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", Ready: true, RestartCount: 2},
+				{Name: "sidecar", Ready: true},
+			},
+		},
+	}
+}
+
+func TestStripUnusedFields_Pod(t *testing.T) {
+	pod := bloatedPod(0)
+	want := convert.PodToModel(pod.DeepCopy())
+
+	obj, err := stripUnusedFields(pod)
+	require.NoError(t, err)
+	stripped, ok := obj.(*corev1.Pod)
+	require.True(t, ok)
+
+	assert.Nil(t, stripped.ManagedFields)
+	assert.NotContains(t, stripped.Annotations, lastAppliedAnnotation)
+	assert.Equal(t, "true", stripped.Annotations["prometheus.io/scrape"])
+	for _, c := range append(stripped.Spec.Containers, stripped.Spec.InitContainers...) {
+		assert.Nil(t, c.Env, c.Name)
+		assert.Nil(t, c.Command, c.Name)
+		assert.Nil(t, c.VolumeMounts, c.Name)
+		assert.Nil(t, c.LivenessProbe, c.Name)
+	}
+
+	// Everything the converter reads survives the transform.
+	assert.Equal(t, want, convert.PodToModel(stripped))
+
+	// A relist can feed an already transformed object back in.
+	again, err := stripUnusedFields(stripped.DeepCopy())
+	require.NoError(t, err)
+	assert.Equal(t, stripped, again)
+}
+
+func TestStripUnusedFields_Deployment(t *testing.T) {
+	pod := bloatedPod(0)
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", ManagedFields: pod.ManagedFields},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec:       pod.Spec,
+			},
+		},
+	}
+	want := convert.DeploymentToModel(dep.DeepCopy())
+
+	obj, err := stripUnusedFields(dep)
+	require.NoError(t, err)
+	stripped, ok := obj.(*appsv1.Deployment)
+	require.True(t, ok)
+
+	assert.Nil(t, stripped.ManagedFields)
+	assert.Nil(t, stripped.Spec.Template.Spec.Volumes)
+	assert.Nil(t, stripped.Spec.Template.Spec.InitContainers)
+	assert.Equal(t, map[string]string{"app": "web"}, stripped.Spec.Template.Labels)
+	assert.Equal(t, want, convert.DeploymentToModel(stripped))
+}
+
+func TestStripUnusedFields_Node(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Images: []corev1.ContainerImage{{Names: []string{"nginx:1.21"}, SizeBytes: 1 << 20}},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("4"),
+			},
+		},
+	}
+
+	obj, err := stripUnusedFields(node)
+	require.NoError(t, err)
+	stripped, ok := obj.(*corev1.Node)
+	require.True(t, ok)
+
+	assert.Nil(t, stripped.Status.Images)
+	assert.Equal(t, resource.MustParse("4"), stripped.Status.Capacity[corev1.ResourceCPU])
+}
+
+func TestStripUnusedFields_Tombstone(t *testing.T) {
+	// Non-object values pass through unchanged.
+	obj, err := stripUnusedFields("not-an-object")
+	require.NoError(t, err)
+	assert.Equal(t, "not-an-object", obj)
+}
+
+func TestInformerFactory_SharedAcrossCollectors(t *testing.T) {
+	env := newTestEnv(t)
+	pods := NewPodCollector(env.factory, env.store, env.metrics)
+	startCollector(t, env, pods)
+
+	_, err := env.client.CoreV1().Pods("default").Create(env.ctx, bloatedPod(0), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return env.store.Pods.Len() == 1
+	}, waitTimeout, pollInterval)
+
+	// The collector's informer is the factory's, and it caches the stripped pod.
+	informer := env.factory.Core().V1().Pods().Informer()
+	assert.Same(t, pods.informer, informer)
+	cached, ok, err := informer.GetStore().GetByKey("default/web-0")
+	require.NoError(t, err)
+	require.True(t, ok)
+	pod, ok := cached.(*corev1.Pod)
+	require.True(t, ok)
+	assert.Nil(t, pod.ManagedFields)
+	assert.Nil(t, pod.Spec.Containers[0].Env)
+}
+
+func TestScopedInformerFactory_SingleNamespace(t *testing.T) {
+	env := newTestEnv(t)
+	ns, err := scope.NewNamespaces([]string{"team-a"}, nil, "")
+	require.NoError(t, err)
+	factory := NewScopedInformerFactory(env.client, testResyncPeriod, ns)
+
+	for _, namespace := range []string{"team-a", "team-b"} {
+		_, err := env.client.AppsV1().Deployments(namespace).Create(env.ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: namespace},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	startCollector(t, env, NewDeploymentCollector(factory, env.store, env.metrics))
+
+	require.Eventually(t, func() bool {
+		return env.store.Deployments.Len() == 1
+	}, waitTimeout, pollInterval)
+	_, ok := env.store.Deployments.Get("team-a/web")
+	assert.True(t, ok)
+	_, ok = env.store.Deployments.Get("team-b/web")
+	assert.False(t, ok)
+}
@@ -10,7 +10,11 @@ import (
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/metadata/metadatainformer"
 )
 
 const (
@@ -21,23 +25,35 @@ const (
 
 // testEnv bundles the dependencies shared by every collector test.
 type testEnv struct {
-	client  *fake.Clientset
-	store   *store.Store
-	metrics *observability.Metrics
-	ctx     context.Context
-	cancel  context.CancelFunc
+	client      *fake.Clientset
+	factory     informers.SharedInformerFactory
+	metaClient  *metadatafake.FakeMetadataClient
+	metaFactory metadatainformer.SharedInformerFactory
+	store       *store.Store
+	metrics     *observability.Metrics
+	ctx         context.Context
+	cancel      context.CancelFunc
 }
 
 func newTestEnv(t *testing.T) *testEnv {
 	t.Helper()
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
+	client := fake.NewSimpleClientset()
+
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	metaClient := metadatafake.NewSimpleMetadataClient(scheme)
+
 	return &testEnv{
-		client:  fake.NewSimpleClientset(),
-		store:   store.NewStore(),
-		metrics: observability.NewMetrics(),
-		ctx:     ctx,
-		cancel:  cancel,
+		client:      client,
+		factory:     NewInformerFactory(client, testResyncPeriod),
+		metaClient:  metaClient,
+		metaFactory: NewMetadataInformerFactory(metaClient, testResyncPeriod),
+		store:       store.NewStore(),
+		metrics:     observability.NewMetrics(),
+		ctx:         ctx,
+		cancel:      cancel,
 	}
 }
 
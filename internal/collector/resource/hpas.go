@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // HPACollector watches Kubernetes HorizontalPodAutoscaler (v2) objects via a SharedInformer
 // and writes model.HPAInfo to the store on every add/update/delete event.
 type HPACollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewHPACollector creates a new HPACollector.
-func NewHPACollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *HPACollector {
+func NewHPACollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *HPACollector {
 	return &HPACollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +43,7 @@ func (c *HPACollector) Name() string { return "hpas" }
 
 // Start implements collector.Collector.
 func (c *HPACollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer()
+	c.informer = c.factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -94,6 +90,14 @@ func (c *HPACollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *HPACollector) Reconvert() {
+	reconvert(c.informer, c.store.HPAs, func(hpa *autoscalingv2.HorizontalPodAutoscaler) (string, model.HPAInfo) {
+		info := convert.HPAToModel(hpa)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *HPACollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -13,13 +13,13 @@ import (
 
 func TestHPACollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewHPACollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewHPACollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "hpas", c.Name())
 }
 
 func TestHPACollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewHPACollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewHPACollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // IngressCollector watches Kubernetes Ingress objects via a SharedInformer
 // and writes model.IngressInfo to the store on every add/update/delete event.
 type IngressCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewIngressCollector creates a new IngressCollector.
-func NewIngressCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *IngressCollector {
+func NewIngressCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *IngressCollector {
 	return &IngressCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +43,7 @@ func (c *IngressCollector) Name() string { return "ingresses" }
 
 // Start implements collector.Collector.
 func (c *IngressCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Networking().V1().Ingresses().Informer()
+	c.informer = c.factory.Networking().V1().Ingresses().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -94,6 +90,14 @@ func (c *IngressCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *IngressCollector) Reconvert() {
+	reconvert(c.informer, c.store.Ingresses, func(ing *networkingv1.Ingress) (string, model.IngressInfo) {
+		info := convert.IngressToModel(ing)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *IngressCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
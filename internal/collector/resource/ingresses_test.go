@@ -13,13 +13,13 @@ import (
 
 func TestIngressCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewIngressCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewIngressCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "ingresses", c.Name())
 }
 
 func TestIngressCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewIngressCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewIngressCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
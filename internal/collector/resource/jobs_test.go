@@ -14,13 +14,13 @@ import (
 
 func TestJobCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewJobCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewJobCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "jobs", c.Name())
 }
 
 func TestJobCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewJobCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewJobCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -13,13 +13,13 @@ import (
 
 func TestLimitRangeCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewLimitRangeCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewLimitRangeCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "limitranges", c.Name())
 }
 
 func TestLimitRangeCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewLimitRangeCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewLimitRangeCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
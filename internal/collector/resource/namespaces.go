@@ -4,40 +4,40 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
-// NamespaceCollector watches Kubernetes Namespace objects via a SharedInformer
-// and writes model.NamespaceInfo to the store on every add/update/delete event.
+// NamespaceCollector watches Kubernetes Namespace metadata via a metadata-only
+// SharedInformer and writes model.NamespaceInfo to the store on every
+// add/update/delete event. The unredacted labels are kept alongside for the
+// namespace label selector.
 type NamespaceCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  metadatainformer.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewNamespaceCollector creates a new NamespaceCollector.
-func NewNamespaceCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *NamespaceCollector {
+func NewNamespaceCollector(factory metadatainformer.SharedInformerFactory, s *store.Store, m *observability.Metrics) *NamespaceCollector {
 	return &NamespaceCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,43 +46,45 @@ func (c *NamespaceCollector) Name() string { return "namespaces" }
 
 // Start implements collector.Collector.
 func (c *NamespaceCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Core().V1().Namespaces().Informer()
+	c.informer = c.factory.ForResource(corev1.SchemeGroupVersion.WithResource("namespaces")).Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
-			ns, ok := obj.(*corev1.Namespace)
+			ns, ok := obj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				return
 			}
 			info := convert.NamespaceToModel(ns)
+			c.store.NamespaceLabels.Set(ns.Name, ns.Labels)
 			c.store.Namespaces.Set(info.Name, info)
 			c.metrics.InformerEventsTotal.WithLabelValues("namespaces", "add").Inc()
 			c.metrics.StoreItems.WithLabelValues("namespaces").Set(float64(c.store.Namespaces.Len()))
 		},
 		UpdateFunc: func(_, newObj interface{}) {
-			ns, ok := newObj.(*corev1.Namespace)
+			ns, ok := newObj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				return
 			}
 			info := convert.NamespaceToModel(ns)
+			c.store.NamespaceLabels.Set(ns.Name, ns.Labels)
 			c.store.Namespaces.Set(info.Name, info)
 			c.metrics.InformerEventsTotal.WithLabelValues("namespaces", "update").Inc()
 			c.metrics.StoreItems.WithLabelValues("namespaces").Set(float64(c.store.Namespaces.Len()))
 		},
 		DeleteFunc: func(obj interface{}) {
-			ns, ok := obj.(*corev1.Namespace)
+			ns, ok := obj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
 				if !ok {
 					return
 				}
-				ns, ok = tombstone.Obj.(*corev1.Namespace)
+				ns, ok = tombstone.Obj.(*metav1.PartialObjectMetadata)
 				if !ok {
 					return
 				}
 			}
 			c.store.Namespaces.Delete(ns.Name)
+			c.store.NamespaceLabels.Delete(ns.Name)
 			c.metrics.InformerEventsTotal.WithLabelValues("namespaces", "delete").Inc()
 			c.metrics.StoreItems.WithLabelValues("namespaces").Set(float64(c.store.Namespaces.Len()))
 		},
@@ -94,6 +96,14 @@ func (c *NamespaceCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *NamespaceCollector) Reconvert() {
+	reconvert(c.informer, c.store.Namespaces, func(ns *metav1.PartialObjectMetadata) (string, model.NamespaceInfo) {
+		info := convert.NamespaceToModel(ns)
+		return info.Name, info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *NamespaceCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
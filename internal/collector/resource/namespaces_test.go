@@ -8,28 +8,31 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metadatafake "k8s.io/client-go/metadata/fake"
 )
 
 func TestNamespaceCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewNamespaceCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewNamespaceCollector(env.metaFactory, env.store, env.metrics)
 	assert.Equal(t, "namespaces", c.Name())
 }
 
 func TestNamespaceCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewNamespaceCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewNamespaceCollector(env.metaFactory, env.store, env.metrics)
 	startCollector(t, env, c)
 
+	namespaces := env.metaClient.Resource(corev1.SchemeGroupVersion.WithResource("namespaces")).(metadatafake.MetadataClient)
+
 	// --- Add ---
-	ns := &corev1.Namespace{
+	ns := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   "test-ns",
 			Labels: map[string]string{"env": "test"},
 		},
-		Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
 	}
-	_, err := env.client.CoreV1().Namespaces().Create(env.ctx, ns, metav1.CreateOptions{})
+	_, err := namespaces.CreateFake(ns, metav1.CreateOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
@@ -40,10 +43,13 @@ func TestNamespaceCollector_AddUpdateDelete(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, "test-ns", info.Name)
 	assert.Equal(t, "Active", info.Phase)
+	nsLabels, ok := env.store.NamespaceLabels.Get("test-ns")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"env": "test"}, nsLabels)
 
 	// --- Update ---
 	ns.Labels["env"] = "staging"
-	_, err = env.client.CoreV1().Namespaces().Update(env.ctx, ns, metav1.UpdateOptions{})
+	_, err = namespaces.UpdateFake(ns, metav1.UpdateOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
@@ -51,11 +57,23 @@ func TestNamespaceCollector_AddUpdateDelete(t *testing.T) {
 		return info.Labels["env"] == "staging"
 	}, waitTimeout, pollInterval)
 
+	// --- Update: deletion started ---
+	now := metav1.Now()
+	ns.DeletionTimestamp = &now
+	_, err = namespaces.UpdateFake(ns, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		info, _ := env.store.Namespaces.Get("test-ns")
+		return info.Phase == "Terminating"
+	}, waitTimeout, pollInterval)
+
 	// --- Delete ---
-	err = env.client.CoreV1().Namespaces().Delete(env.ctx, "test-ns", metav1.DeleteOptions{})
+	err = env.metaClient.Resource(corev1.SchemeGroupVersion.WithResource("namespaces")).Delete(env.ctx, "test-ns", metav1.DeleteOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
 		return env.store.Namespaces.Len() == 0
 	}, waitTimeout, pollInterval)
+	assert.Equal(t, 0, env.store.NamespaceLabels.Len())
 }
@@ -13,13 +13,13 @@ import (
 
 func TestNodeCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewNodeCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewNodeCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "nodes", c.Name())
 }
 
 func TestNodeCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewNodeCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewNodeCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -68,7 +68,7 @@ func TestNodeCollector_AddUpdateDelete(t *testing.T) {
 
 func TestNodeCollector_MultipleNodes(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewNodeCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewNodeCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	for _, name := range []string{"node-1", "node-2", "node-3"} {
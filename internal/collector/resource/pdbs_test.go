@@ -13,13 +13,13 @@ import (
 
 func TestPDBCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPDBCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPDBCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "pdbs", c.Name())
 }
 
 func TestPDBCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPDBCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPDBCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
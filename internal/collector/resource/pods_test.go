@@ -12,13 +12,13 @@ import (
 
 func TestPodCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPodCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPodCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "pods", c.Name())
 }
 
 func TestPodCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPodCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPodCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -60,7 +60,7 @@ func TestPodCollector_AddUpdateDelete(t *testing.T) {
 
 func TestPodCollector_MultipleNamespaces(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPodCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPodCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	pods := []struct{ ns, name string }{
@@ -4,11 +4,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
@@ -19,25 +17,23 @@ import (
 // PriorityClassCollector watches Kubernetes PriorityClass objects via a SharedInformer
 // and writes model.PriorityClassInfo to the store on every add/update/delete event.
 type PriorityClassCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewPriorityClassCollector creates a new PriorityClassCollector.
-func NewPriorityClassCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *PriorityClassCollector {
+func NewPriorityClassCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *PriorityClassCollector {
 	return &PriorityClassCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +42,7 @@ func (c *PriorityClassCollector) Name() string { return "priorityclasses" }
 
 // Start implements collector.Collector.
 func (c *PriorityClassCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Scheduling().V1().PriorityClasses().Informer()
+	c.informer = c.factory.Scheduling().V1().PriorityClasses().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -12,13 +12,13 @@ import (
 
 func TestPriorityClassCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPriorityClassCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPriorityClassCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "priorityclasses", c.Name())
 }
 
 func TestPriorityClassCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPriorityClassCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPriorityClassCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -14,13 +14,13 @@ import (
 
 func TestPVCCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPVCCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPVCCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "pvcs", c.Name())
 }
 
 func TestPVCCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPVCCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPVCCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
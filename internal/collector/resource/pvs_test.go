@@ -13,13 +13,13 @@ import (
 
 func TestPVCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPVCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPVCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "pvs", c.Name())
 }
 
 func TestPVCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewPVCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewPVCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
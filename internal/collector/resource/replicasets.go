@@ -4,41 +4,40 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
-// ReplicaSetCollector watches Kubernetes ReplicaSet objects via a SharedInformer
-// and writes model.ReplicaSetInfo to the store on every add/update/delete event.
-// ReplicaSets are primarily used for ownership resolution of pods to deployments.
+// ReplicaSetCollector watches Kubernetes ReplicaSet metadata via a metadata-only
+// SharedInformer and writes model.ReplicaSetInfo to the store on every
+// add/update/delete event. ReplicaSets are only used for ownership resolution
+// of pods to deployments, so their specs are never fetched.
 type ReplicaSetCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  metadatainformer.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewReplicaSetCollector creates a new ReplicaSetCollector.
-func NewReplicaSetCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *ReplicaSetCollector {
+func NewReplicaSetCollector(factory metadatainformer.SharedInformerFactory, s *store.Store, m *observability.Metrics) *ReplicaSetCollector {
 	return &ReplicaSetCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -47,12 +46,11 @@ func (c *ReplicaSetCollector) Name() string { return "replicasets" }
 
 // Start implements collector.Collector.
 func (c *ReplicaSetCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Apps().V1().ReplicaSets().Informer()
+	c.informer = c.factory.ForResource(appsv1.SchemeGroupVersion.WithResource("replicasets")).Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
-			rs, ok := obj.(*appsv1.ReplicaSet)
+			rs, ok := obj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				return
 			}
@@ -62,7 +60,7 @@ func (c *ReplicaSetCollector) Start(_ context.Context) error {
 			c.metrics.StoreItems.WithLabelValues("replicasets").Set(float64(c.store.ReplicaSets.Len()))
 		},
 		UpdateFunc: func(_, newObj interface{}) {
-			rs, ok := newObj.(*appsv1.ReplicaSet)
+			rs, ok := newObj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				return
 			}
@@ -72,13 +70,13 @@ func (c *ReplicaSetCollector) Start(_ context.Context) error {
 			c.metrics.StoreItems.WithLabelValues("replicasets").Set(float64(c.store.ReplicaSets.Len()))
 		},
 		DeleteFunc: func(obj interface{}) {
-			rs, ok := obj.(*appsv1.ReplicaSet)
+			rs, ok := obj.(*metav1.PartialObjectMetadata)
 			if !ok {
 				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
 				if !ok {
 					return
 				}
-				rs, ok = tombstone.Obj.(*appsv1.ReplicaSet)
+				rs, ok = tombstone.Obj.(*metav1.PartialObjectMetadata)
 				if !ok {
 					return
 				}
@@ -95,6 +93,14 @@ func (c *ReplicaSetCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *ReplicaSetCollector) Reconvert() {
+	reconvert(c.informer, c.store.ReplicaSets, func(rs *metav1.PartialObjectMetadata) (string, model.ReplicaSetInfo) {
+		info := convert.ReplicaSetToModel(rs)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *ReplicaSetCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -9,25 +9,29 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/utils/ptr"
+	metadatafake "k8s.io/client-go/metadata/fake"
 )
 
 func TestReplicaSetCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewReplicaSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewReplicaSetCollector(env.metaFactory, env.store, env.metrics)
 	assert.Equal(t, "replicasets", c.Name())
 }
 
 func TestReplicaSetCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewReplicaSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewReplicaSetCollector(env.metaFactory, env.store, env.metrics)
 	startCollector(t, env, c)
 
+	replicaSets := env.metaClient.Resource(appsv1.SchemeGroupVersion.WithResource("replicasets")).Namespace("default")
+
 	// --- Add ---
-	rs := &appsv1.ReplicaSet{
+	rs := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "web-abc123",
 			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
 			OwnerReferences: []metav1.OwnerReference{
 				{
 					Kind: "Deployment",
@@ -36,15 +40,8 @@ func TestReplicaSetCollector_AddUpdateDelete(t *testing.T) {
 				},
 			},
 		},
-		Spec: appsv1.ReplicaSetSpec{
-			Replicas: ptr.To(int32(3)),
-			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
-		},
-		Status: appsv1.ReplicaSetStatus{
-			ReadyReplicas: 2,
-		},
 	}
-	_, err := env.client.AppsV1().ReplicaSets("default").Create(env.ctx, rs, metav1.CreateOptions{})
+	_, err := replicaSets.(metadatafake.MetadataClient).CreateFake(rs, metav1.CreateOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
@@ -58,17 +55,17 @@ func TestReplicaSetCollector_AddUpdateDelete(t *testing.T) {
 	assert.Equal(t, "web", info.OwnerName)
 
 	// --- Update ---
-	rs.Status.ReadyReplicas = 3
-	_, err = env.client.AppsV1().ReplicaSets("default").Update(env.ctx, rs, metav1.UpdateOptions{})
+	rs.Labels["app"] = "web-v2"
+	_, err = replicaSets.(metadatafake.MetadataClient).UpdateFake(rs, metav1.UpdateOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
 		info, _ := env.store.ReplicaSets.Get("default/web-abc123")
-		return info.ReadyReplicas == 3
+		return info.Labels["app"] == "web-v2"
 	}, waitTimeout, pollInterval)
 
 	// --- Delete ---
-	err = env.client.AppsV1().ReplicaSets("default").Delete(env.ctx, "web-abc123", metav1.DeleteOptions{})
+	err = replicaSets.Delete(env.ctx, "web-abc123", metav1.DeleteOptions{})
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
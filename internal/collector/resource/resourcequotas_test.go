@@ -13,13 +13,13 @@ import (
 
 func TestResourceQuotaCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewResourceQuotaCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewResourceQuotaCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "resourcequotas", c.Name())
 }
 
 func TestResourceQuotaCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewResourceQuotaCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewResourceQuotaCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
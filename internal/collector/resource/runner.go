@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
 )
 
 const informerMaxRetries = 3
@@ -36,3 +38,25 @@ func runInformerWithRecovery(informer cache.SharedIndexInformer, name string, st
 		)
 	}()
 }
+
+// reconvert converts every object cached by informer again and replaces its
+// entry in s. Objects whose store entry is gone are skipped, so a delete
+// racing the loop is never undone, and objects the informer has replaced
+// since the list are left to the event handler.
+func reconvert[O, T any](informer cache.SharedIndexInformer, s *store.TypedStore[T], conv func(O) (string, T)) {
+	if informer == nil {
+		return
+	}
+	cached := informer.GetStore()
+	for _, obj := range cached.List() {
+		o, ok := obj.(O)
+		if !ok {
+			continue
+		}
+		if cur, exists, err := cached.Get(obj); err != nil || !exists || cur != obj {
+			continue
+		}
+		key, info := conv(o)
+		s.Replace(key, info)
+	}
+}
@@ -12,13 +12,13 @@ import (
 
 func TestServiceCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewServiceCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewServiceCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "services", c.Name())
 }
 
 func TestServiceCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewServiceCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewServiceCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
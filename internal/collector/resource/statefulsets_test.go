@@ -13,13 +13,13 @@ import (
 
 func TestStatefulSetCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewStatefulSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewStatefulSetCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "statefulsets", c.Name())
 }
 
 func TestStatefulSetCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewStatefulSetCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewStatefulSetCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
@@ -4,40 +4,37 @@ import (
 	"context"
 	"fmt"
 	"sync"
-	"time"
 
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // StorageClassCollector watches Kubernetes StorageClass objects via a SharedInformer
 // and writes model.StorageClassInfo to the store on every add/update/delete event.
 type StorageClassCollector struct {
-	client       kubernetes.Interface
-	store        *store.Store
-	metrics      *observability.Metrics
-	informer     cache.SharedIndexInformer
-	stopCh       chan struct{}
-	done         chan struct{}
-	stopOnce     sync.Once
-	resyncPeriod time.Duration
+	factory  informers.SharedInformerFactory
+	store    *store.Store
+	metrics  *observability.Metrics
+	informer cache.SharedIndexInformer
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewStorageClassCollector creates a new StorageClassCollector.
-func NewStorageClassCollector(client kubernetes.Interface, s *store.Store, m *observability.Metrics, resyncPeriod time.Duration) *StorageClassCollector {
+func NewStorageClassCollector(factory informers.SharedInformerFactory, s *store.Store, m *observability.Metrics) *StorageClassCollector {
 	return &StorageClassCollector{
-		client:       client,
-		store:        s,
-		metrics:      m,
-		stopCh:       make(chan struct{}),
-		done:         make(chan struct{}),
-		resyncPeriod: resyncPeriod,
+		factory: factory,
+		store:   s,
+		metrics: m,
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -46,8 +43,7 @@ func (c *StorageClassCollector) Name() string { return "storageclasses" }
 
 // Start implements collector.Collector.
 func (c *StorageClassCollector) Start(_ context.Context) error {
-	factory := informers.NewSharedInformerFactory(c.client, c.resyncPeriod)
-	c.informer = factory.Storage().V1().StorageClasses().Informer()
+	c.informer = c.factory.Storage().V1().StorageClasses().Informer()
 
 	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -94,6 +90,14 @@ func (c *StorageClassCollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *StorageClassCollector) Reconvert() {
+	reconvert(c.informer, c.store.StorageClasses, func(sc *storagev1.StorageClass) (string, model.StorageClassInfo) {
+		info := convert.StorageClassToModel(sc)
+		return info.Name, info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *StorageClassCollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -12,13 +12,13 @@ import (
 
 func TestStorageClassCollector_Name(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewStorageClassCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewStorageClassCollector(env.factory, env.store, env.metrics)
 	assert.Equal(t, "storageclasses", c.Name())
 }
 
 func TestStorageClassCollector_AddUpdateDelete(t *testing.T) {
 	env := newTestEnv(t)
-	c := NewStorageClassCollector(env.client, env.store, env.metrics, testResyncPeriod)
+	c := NewStorageClassCollector(env.factory, env.store, env.metrics)
 	startCollector(t, env, c)
 
 	// --- Add ---
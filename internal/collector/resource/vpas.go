@@ -15,6 +15,7 @@ import (
 	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 var vpaGVR = schema.GroupVersionResource{
@@ -101,6 +102,14 @@ func (c *VPACollector) Start(_ context.Context) error {
 	return nil
 }
 
+// Reconvert implements collector.Reconverter.
+func (c *VPACollector) Reconvert() {
+	reconvert(c.informer, c.store.VPAs, func(u *unstructured.Unstructured) (string, model.VPAInfo) {
+		info := convert.VPAToModel(u)
+		return nsNameKey(info.Namespace, info.Name), info
+	})
+}
+
 // WaitForSync implements collector.Collector.
 func (c *VPACollector) WaitForSync(ctx context.Context) error {
 	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
 )
 
 // Config holds all agent configuration values.
@@ -19,14 +23,61 @@ type Config struct {
 	MaxRetries           int
 	RequestTimeout       time.Duration
 	BufferMaxBytes       int64
+	BufferDir            string // KUBEADAPT_BUFFER_DIR, default: "" (disk buffering disabled)
 	HealthPort           int
 	AgentVersion         string
 	KubernetesVersion    string
 
+	// MetricsSampleInterval is how often metrics-server is sampled within
+	// MetricsInterval; snapshots report min/avg/max/p95 over the samples.
+	MetricsSampleInterval time.Duration // KUBEADAPT_METRICS_SAMPLE_INTERVAL, default: 15s (capped at MetricsInterval)
+
+	// MetricsSource selects where pod and node usage comes from: auto uses
+	// metrics-server when the cluster serves metrics.k8s.io and falls back
+	// to Prometheus when PrometheusURL is set. The queries must return the
+	// labels documented on metrics.PrometheusQueries.
+	MetricsSource               string // KUBEADAPT_METRICS_SOURCE, default: "auto" — auto, metrics-server or prometheus
+	PrometheusURL               string // KUBEADAPT_PROMETHEUS_URL, default: "" — base URL of the Prometheus HTTP API
+	PrometheusNodeCPUQuery      string // KUBEADAPT_PROMETHEUS_NODE_CPU_QUERY, default: DefaultPrometheusNodeCPUQuery
+	PrometheusNodeMemoryQuery   string // KUBEADAPT_PROMETHEUS_NODE_MEMORY_QUERY, default: DefaultPrometheusNodeMemoryQuery
+	PrometheusContainerCPUQuery string // KUBEADAPT_PROMETHEUS_CONTAINER_CPU_QUERY, default: DefaultPrometheusContainerCPUQuery
+	PrometheusContainerMemQuery string // KUBEADAPT_PROMETHEUS_CONTAINER_MEMORY_QUERY, default: DefaultPrometheusContainerMemQuery
+
 	// MaxCompressedBodyBytes mirrors the server's MAX_COMPRESSED_BODY_SIZE; oversize
-	// snapshots fail locally with ErrPayloadTooLarge. Both sides must agree.
+	// snapshots fail locally with ErrPayloadTooLarge unless ChunkedUploadEnabled
+	// is set. Both sides must agree.
 	MaxCompressedBodyBytes int64
 
+	// ChunkedUploadEnabled splits snapshots over MaxCompressedBodyBytes into
+	// parts that each fit, instead of failing with ErrPayloadTooLarge.
+	ChunkedUploadEnabled bool // KUBEADAPT_CHUNKED_UPLOAD_ENABLED, default: false
+
+	// StreamingEncodeEnabled compresses snapshots, and chunked parts, into
+	// temp files, in BufferDir when set, instead of memory, so no encoded
+	// copy of the snapshot is held. The built snapshot still is, in full.
+	StreamingEncodeEnabled bool // KUBEADAPT_STREAMING_ENCODE_ENABLED, default: false
+
+	// WireFormat is the snapshot encoding: JSON (protocol v1) or protobuf
+	// (protocol v2, pkg/modelpb). A backend that rejects v2 gets JSON.
+	WireFormat string // KUBEADAPT_WIRE_FORMAT, default: "json" — json or protobuf
+
+	// CompressionDictionary selects the zstd dictionary snapshots are
+	// compressed with: none, the one bundled with the agent, or the one the
+	// backend names in its directives (the bundled one until it names one).
+	CompressionDictionary string // KUBEADAPT_COMPRESSION_DICTIONARY, default: "off" — off, bundled or backend
+
+	// DeltaKeyframeInterval sends a full keyframe every N snapshots and deltas
+	// (added/updated/deleted entities only) in between.
+	DeltaKeyframeInterval int // KUBEADAPT_DELTA_KEYFRAME_INTERVAL, default: 0 (delta snapshots disabled)
+
+	// Leader election (multi-replica HA). The Lease lives in PodNamespace and
+	// the holder identity is PodName.
+	LeaderElection              bool          // KUBEADAPT_LEADER_ELECTION, default: false
+	LeaderElectionLeaseName     string        // KUBEADAPT_LEADER_ELECTION_LEASE_NAME, default: "kubeadapt-agent"
+	LeaderElectionLeaseDuration time.Duration // KUBEADAPT_LEADER_ELECTION_LEASE_DURATION, default: 15s
+	LeaderElectionRenewDeadline time.Duration // KUBEADAPT_LEADER_ELECTION_RENEW_DEADLINE, default: 10s
+	LeaderElectionRetryPeriod   time.Duration // KUBEADAPT_LEADER_ELECTION_RETRY_PERIOD, default: 2s
+
 	// Kubernetes pod metadata (injected via Helm downward API)
 	ChartVersion    string // KUBEADAPT_CHART_VERSION
 	HelmReleaseName string // HELM_RELEASE_NAME
@@ -34,55 +85,277 @@ type Config struct {
 	PodNamespace    string // POD_NAMESPACE
 	NodeName        string // NODE_NAME
 
+	// ConfigFile is the YAML file the config was loaded from, watched for
+	// changes to the fields that can be applied without a restart.
+	ConfigFile string // KUBEADAPT_CONFIG_FILE, default: "" (environment only)
+	LogLevel   string // KUBEADAPT_LOG_LEVEL, default: "info" — debug, info, warn or error
+
 	// Security
 	AllowInsecure  bool // KUBEADAPT_ALLOW_INSECURE, default: false — allows http:// BackendURL
 	DebugEndpoints bool // KUBEADAPT_DEBUG_ENDPOINTS, default: false — enables pprof/debug on health port
 
+	// TLS to the backend. The CA bundle is trusted in addition to the system
+	// roots, e.g. for a TLS-inspecting proxy. The client certificate is
+	// presented for mutual TLS and reloaded when its files change.
+	TLSCAFile     string // KUBEADAPT_TLS_CA_FILE, default: "" (system roots only) — PEM bundle
+	TLSCertFile   string // KUBEADAPT_TLS_CERT_FILE, default: "" (no client certificate) — PEM, requires TLSKeyFile
+	TLSKeyFile    string // KUBEADAPT_TLS_KEY_FILE, default: "" — PEM, requires TLSCertFile
+	TLSMinVersion string // KUBEADAPT_TLS_MIN_VERSION, default: "1.2" — 1.2 or 1.3
+	TLSServerName string // KUBEADAPT_TLS_SERVER_NAME, default: "" (BackendURL host) — SNI and verified name
+
 	// GPU monitoring
 	GPUMetricsEnabled     bool          // KUBEADAPT_GPU_METRICS_ENABLED, default: true
 	DCGMExporterPort      int           // KUBEADAPT_DCGM_PORT, default: 9400
 	DCGMExporterNamespace string        // KUBEADAPT_DCGM_NAMESPACE, default: "" (auto-detect)
 	DCGMExporterEndpoints []string      // KUBEADAPT_DCGM_ENDPOINTS, comma-separated IPs/hosts override (for local dev)
 	GPUMetricsInterval    time.Duration // KUBEADAPT_GPU_METRICS_INTERVAL, default: MetricsInterval
+
+	// Kubelet endpoints via the API server node proxy, polled every
+	// MetricsInterval: the Summary API (filesystem and network stats) and
+	// cAdvisor (CPU throttling and OOM counters). The concurrency limit
+	// applies to each collector separately. Opt-in: both need get on
+	// nodes/proxy, which also exposes the kubelet's log and exec endpoints.
+	KubeletStatsEnabled     bool // KUBEADAPT_KUBELET_STATS_ENABLED, default: false
+	CAdvisorMetricsEnabled  bool // KUBEADAPT_CADVISOR_METRICS_ENABLED, default: false
+	KubeletStatsConcurrency int  // KUBEADAPT_KUBELET_STATS_CONCURRENCY, default: 10 — max nodes fetched in parallel
+
+	// Kubernetes Events. Occurrences are kept in a ring of EventsBufferSize
+	// entries; each snapshot reports per-reason counts since the previous
+	// one, and the deduplicated events themselves when EventsRaw is set.
+	// Turned off at startup when RBAC does not allow watching events.
+	EventsEnabled    bool // KUBEADAPT_EVENTS_ENABLED, default: true
+	EventsBufferSize int  // KUBEADAPT_EVENTS_BUFFER_SIZE, default: 5000
+	EventsRaw        bool // KUBEADAPT_EVENTS_RAW, default: false
+
+	// Namespace scoping. Objects in namespaces outside the scope are never
+	// sent; a namespace is in scope when it is included (or the include list
+	// is empty), not excluded, and its labels match the selector.
+	NamespaceInclude       []string // KUBEADAPT_NAMESPACE_INCLUDE, comma-separated, default: empty (all namespaces)
+	NamespaceExclude       []string // KUBEADAPT_NAMESPACE_EXCLUDE, comma-separated, default: empty
+	NamespaceLabelSelector string   // KUBEADAPT_NAMESPACE_LABEL_SELECTOR, default: "" (any labels)
+
+	// Redaction applied before anything leaves the cluster. Key patterns are
+	// globs where * matches any characters; value patterns are regular
+	// expressions, one per line, whose matches are masked. Name hashing
+	// replaces the names of namespaced objects (namespaces, pods, workloads,
+	// autoscalers, PDBs, services, ingresses, PVCs) with an HMAC keyed by the salt.
+	RedactLabelAllow      []string // KUBEADAPT_REDACT_LABEL_ALLOW, comma-separated, default: empty (all keys)
+	RedactLabelDeny       []string // KUBEADAPT_REDACT_LABEL_DENY, comma-separated, default: empty
+	RedactAnnotationAllow []string // KUBEADAPT_REDACT_ANNOTATION_ALLOW, comma-separated, default: empty (all keys)
+	RedactAnnotationDeny  []string // KUBEADAPT_REDACT_ANNOTATION_DENY, comma-separated, default: empty
+	RedactValuePatterns   []string // KUBEADAPT_REDACT_VALUE_PATTERNS, newline-separated, default: empty
+	RedactHashNames       bool     // KUBEADAPT_REDACT_HASH_NAMES, default: false
+	RedactHashSalt        string   // KUBEADAPT_REDACT_HASH_SALT, default: "" — required with RedactHashNames
+
+	// Cost attribution. Nodes are priced from the bundled catalog or a JSON
+	// or CSV override (e.g. a mounted ConfigMap); nodes missing from it, such
+	// as on-prem nodes, are priced from per-resource rates, which replace the
+	// catalog's own rates when set.
+	PricingEnabled      bool    // KUBEADAPT_PRICING_ENABLED, default: false
+	PricingCatalogFile  string  // KUBEADAPT_PRICING_CATALOG_FILE, default: "" (bundled catalog)
+	PricingCPUCoreHour  float64 // KUBEADAPT_PRICING_CPU_CORE_HOUR, default: 0 (catalog rate)
+	PricingMemoryGBHour float64 // KUBEADAPT_PRICING_MEMORY_GB_HOUR, default: 0 (catalog rate) — per GiB
+	PricingGPUHour      float64 // KUBEADAPT_PRICING_GPU_HOUR, default: 0 (catalog rate)
+
+	// Rightsizing recommendations from hourly usage peaks kept in memory
+	// over RightsizingWindow. The history starts empty on every restart.
+	RightsizingEnabled bool          // KUBEADAPT_RIGHTSIZING_ENABLED, default: false
+	RightsizingWindow  time.Duration // KUBEADAPT_RIGHTSIZING_WINDOW, default: 168h (7 days)
+
+	// Idle GPU detection: containers and MIG slices whose GPU utilization
+	// (or tensor activity, when its threshold is set) stayed below the
+	// threshold for all of GPUIdleWindow, and nodes with GPUs left
+	// unallocated that long. Thresholds are percentages.
+	GPUIdleEnabled              bool          // KUBEADAPT_GPU_IDLE_ENABLED, default: false
+	GPUIdleWindow               time.Duration // KUBEADAPT_GPU_IDLE_WINDOW, default: 6h
+	GPUIdleUtilizationThreshold float64       // KUBEADAPT_GPU_IDLE_UTILIZATION_THRESHOLD, default: 10
+	GPUIdleTensorThreshold      float64       // KUBEADAPT_GPU_IDLE_TENSOR_THRESHOLD, default: 0 (off)
 }
 
+// Metrics sources accepted in KUBEADAPT_METRICS_SOURCE.
+const (
+	MetricsSourceAuto          = "auto"
+	MetricsSourceMetricsServer = "metrics-server"
+	MetricsSourcePrometheus    = "prometheus"
+)
+
+// Snapshot wire formats accepted in KUBEADAPT_WIRE_FORMAT.
+const (
+	WireFormatJSON     = "json"
+	WireFormatProtobuf = "protobuf"
+)
+
+// Minimum TLS versions accepted in KUBEADAPT_TLS_MIN_VERSION.
+const (
+	TLSVersion12 = "1.2"
+	TLSVersion13 = "1.3"
+)
+
+// zstd dictionary sources accepted in KUBEADAPT_COMPRESSION_DICTIONARY.
+const (
+	CompressionDictionaryOff     = "off"
+	CompressionDictionaryBundled = "bundled"
+	CompressionDictionaryBackend = "backend"
+)
+
+// Default PromQL for the Prometheus metrics source: cAdvisor series as
+// scraped by kube-prometheus and the prometheus-community Helm chart, which
+// add the "node" label. Memory is the working set, as in metrics-server.
+const (
+	DefaultPrometheusNodeCPUQuery      = `sum by (node) (rate(container_cpu_usage_seconds_total{id="/"}[5m]))`
+	DefaultPrometheusNodeMemoryQuery   = `sum by (node) (container_memory_working_set_bytes{id="/"})`
+	DefaultPrometheusContainerCPUQuery = `sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD"}[5m]))`
+	DefaultPrometheusContainerMemQuery = `sum by (namespace, pod, container) (container_memory_working_set_bytes{container!="",container!="POD"})`
+)
+
 // Load reads configuration from environment variables and returns a Config
 // with defaults applied for any unset values.
 func Load() Config {
+	return load(fileConfig{})
+}
+
+// LoadWithFile is Load with the YAML file named by KUBEADAPT_CONFIG_FILE, if
+// set, as the base: values from the file replace the defaults, and
+// environment variables override both.
+func LoadWithFile() (Config, error) {
+	path := os.Getenv("KUBEADAPT_CONFIG_FILE")
+	if path == "" {
+		return Load(), nil
+	}
+	f, err := readFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg := load(f)
+	cfg.ConfigFile = path
+	return cfg, nil
+}
+
+// load reads the environment, falling back to the values in f and then to
+// the defaults.
+func load(f fileConfig) Config {
 	cfg := Config{
-		APIKey:               envOrFallback("KUBEADAPT_API_KEY", "KUBEADAPT_AGENT_TOKEN"),
-		BackendURL:           envOrFallbackOrDefault("KUBEADAPT_BACKEND_URL", "KUBEADAPT_BACKEND_API_ENDPOINT", "https://agent.kubeadapt.io"),
-		SnapshotInterval:     parseDuration("KUBEADAPT_SNAPSHOT_INTERVAL", 60*time.Second),
-		MetricsInterval:      parseDuration("KUBEADAPT_METRICS_INTERVAL", 60*time.Second),
-		InformerResyncPeriod: parseDuration("KUBEADAPT_INFORMER_RESYNC", 300*time.Second),
-		InformerSyncTimeout:  parseDuration("KUBEADAPT_INFORMER_SYNC_TIMEOUT", 5*time.Minute),
-		CompressionLevel:     parseInt("KUBEADAPT_COMPRESSION_LEVEL", 3),
-		MaxRetries:           parseInt("KUBEADAPT_MAX_RETRIES", 5),
-		RequestTimeout:       parseDuration("KUBEADAPT_REQUEST_TIMEOUT", 30*time.Second),
-		BufferMaxBytes:       parseInt64("KUBEADAPT_BUFFER_MAX_BYTES", 52428800),
-		HealthPort:           parseInt("KUBEADAPT_HEALTH_PORT", 8080),
+		APIKey:               envOrFallbackOrDefault("KUBEADAPT_API_KEY", "KUBEADAPT_AGENT_TOKEN", str(f.APIKey, "")),
+		BackendURL:           envOrFallbackOrDefault("KUBEADAPT_BACKEND_URL", "KUBEADAPT_BACKEND_API_ENDPOINT", str(f.BackendURL, "https://agent.kubeadapt.io")),
+		SnapshotInterval:     parseDuration("KUBEADAPT_SNAPSHOT_INTERVAL", dur(f.SnapshotInterval, 60*time.Second)),
+		MetricsInterval:      parseDuration("KUBEADAPT_METRICS_INTERVAL", dur(f.MetricsInterval, 60*time.Second)),
+		InformerResyncPeriod: parseDuration("KUBEADAPT_INFORMER_RESYNC", dur(f.InformerResyncPeriod, 300*time.Second)),
+		InformerSyncTimeout:  parseDuration("KUBEADAPT_INFORMER_SYNC_TIMEOUT", dur(f.InformerSyncTimeout, 5*time.Minute)),
+		CompressionLevel:     parseInt("KUBEADAPT_COMPRESSION_LEVEL", integer(f.CompressionLevel, 3)),
+		MaxRetries:           parseInt("KUBEADAPT_MAX_RETRIES", integer(f.MaxRetries, 5)),
+		RequestTimeout:       parseDuration("KUBEADAPT_REQUEST_TIMEOUT", dur(f.RequestTimeout, 30*time.Second)),
+		BufferMaxBytes:       parseInt64("KUBEADAPT_BUFFER_MAX_BYTES", int64v(f.BufferMaxBytes, 52428800)),
+		BufferDir:            envOrDefault("KUBEADAPT_BUFFER_DIR", str(f.BufferDir, "")),
+		HealthPort:           parseInt("KUBEADAPT_HEALTH_PORT", integer(f.HealthPort, 8080)),
 		// Must match server's MAX_COMPRESSED_BODY_SIZE or the smaller value wins.
-		MaxCompressedBodyBytes: parseInt64("KUBEADAPT_MAX_COMPRESSED_BODY_BYTES", 52428800),
+		MaxCompressedBodyBytes: parseInt64("KUBEADAPT_MAX_COMPRESSED_BODY_BYTES", int64v(f.MaxCompressedBodyBytes, 52428800)),
+		ChunkedUploadEnabled:   parseBool("KUBEADAPT_CHUNKED_UPLOAD_ENABLED", boolean(f.ChunkedUploadEnabled, false)),
+		StreamingEncodeEnabled: parseBool("KUBEADAPT_STREAMING_ENCODE_ENABLED", boolean(f.StreamingEncodeEnabled, false)),
+		WireFormat:             envOrDefault("KUBEADAPT_WIRE_FORMAT", str(f.WireFormat, WireFormatJSON)),
+		CompressionDictionary:  envOrDefault("KUBEADAPT_COMPRESSION_DICTIONARY", str(f.CompressionDictionary, CompressionDictionaryOff)),
+		DeltaKeyframeInterval:  parseInt("KUBEADAPT_DELTA_KEYFRAME_INTERVAL", integer(f.DeltaKeyframeInterval, 0)),
 	}
 
+	cfg.MetricsSampleInterval = parseDuration("KUBEADAPT_METRICS_SAMPLE_INTERVAL", dur(f.MetricsSampleInterval, min(15*time.Second, cfg.MetricsInterval)))
+	pr := f.Prometheus
+	cfg.MetricsSource = envOrDefault("KUBEADAPT_METRICS_SOURCE", str(f.MetricsSource, MetricsSourceAuto))
+	cfg.PrometheusURL = envOrDefault("KUBEADAPT_PROMETHEUS_URL", pr.URL)
+	cfg.PrometheusNodeCPUQuery = envOrDefault("KUBEADAPT_PROMETHEUS_NODE_CPU_QUERY", str(pr.NodeCPUQuery, DefaultPrometheusNodeCPUQuery))
+	cfg.PrometheusNodeMemoryQuery = envOrDefault("KUBEADAPT_PROMETHEUS_NODE_MEMORY_QUERY", str(pr.NodeMemoryQuery, DefaultPrometheusNodeMemoryQuery))
+	cfg.PrometheusContainerCPUQuery = envOrDefault("KUBEADAPT_PROMETHEUS_CONTAINER_CPU_QUERY", str(pr.ContainerCPUQuery, DefaultPrometheusContainerCPUQuery))
+	cfg.PrometheusContainerMemQuery = envOrDefault("KUBEADAPT_PROMETHEUS_CONTAINER_MEMORY_QUERY", str(pr.ContainerMemoryQuery, DefaultPrometheusContainerMemQuery))
+	cfg.LogLevel = envOrDefault("KUBEADAPT_LOG_LEVEL", str(f.LogLevel, "info"))
+
 	cfg.ChartVersion = os.Getenv("KUBEADAPT_CHART_VERSION")
 	cfg.HelmReleaseName = os.Getenv("HELM_RELEASE_NAME")
 	cfg.PodName = os.Getenv("POD_NAME")
 	cfg.PodNamespace = os.Getenv("POD_NAMESPACE")
 	cfg.NodeName = os.Getenv("NODE_NAME")
 
-	cfg.AllowInsecure = parseBool("KUBEADAPT_ALLOW_INSECURE", false)
-	cfg.DebugEndpoints = parseBool("KUBEADAPT_DEBUG_ENDPOINTS", false)
+	cfg.LeaderElection = parseBool("KUBEADAPT_LEADER_ELECTION", boolean(f.LeaderElection, false))
+	cfg.LeaderElectionLeaseName = envOrDefault("KUBEADAPT_LEADER_ELECTION_LEASE_NAME", str(f.LeaderElectionLeaseName, "kubeadapt-agent"))
+	cfg.LeaderElectionLeaseDuration = parseDuration("KUBEADAPT_LEADER_ELECTION_LEASE_DURATION", dur(f.LeaderElectionLeaseDuration, 15*time.Second))
+	cfg.LeaderElectionRenewDeadline = parseDuration("KUBEADAPT_LEADER_ELECTION_RENEW_DEADLINE", dur(f.LeaderElectionRenewDeadline, 10*time.Second))
+	cfg.LeaderElectionRetryPeriod = parseDuration("KUBEADAPT_LEADER_ELECTION_RETRY_PERIOD", dur(f.LeaderElectionRetryPeriod, 2*time.Second))
+
+	cfg.AllowInsecure = parseBool("KUBEADAPT_ALLOW_INSECURE", boolean(f.AllowInsecure, false))
+	cfg.DebugEndpoints = parseBool("KUBEADAPT_DEBUG_ENDPOINTS", boolean(f.DebugEndpoints, false))
+	cfg.TLSCAFile = envOrDefault("KUBEADAPT_TLS_CA_FILE", str(f.TLSCAFile, ""))
+	cfg.TLSCertFile = envOrDefault("KUBEADAPT_TLS_CERT_FILE", str(f.TLSCertFile, ""))
+	cfg.TLSKeyFile = envOrDefault("KUBEADAPT_TLS_KEY_FILE", str(f.TLSKeyFile, ""))
+	cfg.TLSMinVersion = envOrDefault("KUBEADAPT_TLS_MIN_VERSION", str(f.TLSMinVersion, TLSVersion12))
+	cfg.TLSServerName = envOrDefault("KUBEADAPT_TLS_SERVER_NAME", str(f.TLSServerName, ""))
+
+	cfg.GPUMetricsEnabled = parseBool("KUBEADAPT_GPU_METRICS_ENABLED", boolean(f.GPUMetricsEnabled, true))
+	cfg.DCGMExporterPort = parseInt("KUBEADAPT_DCGM_PORT", integer(f.DCGMExporterPort, 9400))
+	cfg.DCGMExporterNamespace = envOrDefault("KUBEADAPT_DCGM_NAMESPACE", str(f.DCGMExporterNamespace, ""))
+	cfg.DCGMExporterEndpoints = parseStringSlice("KUBEADAPT_DCGM_ENDPOINTS", f.DCGMExporterEndpoints)
+	cfg.GPUMetricsInterval = parseDuration("KUBEADAPT_GPU_METRICS_INTERVAL", dur(f.GPUMetricsInterval, cfg.MetricsInterval))
+
+	cfg.KubeletStatsEnabled = parseBool("KUBEADAPT_KUBELET_STATS_ENABLED", boolean(f.KubeletStatsEnabled, false))
+	cfg.CAdvisorMetricsEnabled = parseBool("KUBEADAPT_CADVISOR_METRICS_ENABLED", boolean(f.CAdvisorMetricsEnabled, false))
+	cfg.KubeletStatsConcurrency = parseInt("KUBEADAPT_KUBELET_STATS_CONCURRENCY", integer(f.KubeletStatsConcurrency, 10))
 
-	cfg.GPUMetricsEnabled = parseBool("KUBEADAPT_GPU_METRICS_ENABLED", true)
-	cfg.DCGMExporterPort = parseInt("KUBEADAPT_DCGM_PORT", 9400)
-	cfg.DCGMExporterNamespace = envOrDefault("KUBEADAPT_DCGM_NAMESPACE", "")
-	cfg.DCGMExporterEndpoints = parseStringSlice("KUBEADAPT_DCGM_ENDPOINTS")
-	cfg.GPUMetricsInterval = parseDuration("KUBEADAPT_GPU_METRICS_INTERVAL", cfg.MetricsInterval)
+	cfg.EventsEnabled = parseBool("KUBEADAPT_EVENTS_ENABLED", boolean(f.EventsEnabled, true))
+	cfg.EventsBufferSize = parseInt("KUBEADAPT_EVENTS_BUFFER_SIZE", integer(f.EventsBufferSize, 5000))
+	cfg.EventsRaw = parseBool("KUBEADAPT_EVENTS_RAW", boolean(f.EventsRaw, false))
+
+	ns := f.Namespaces
+	cfg.NamespaceInclude = parseStringSlice("KUBEADAPT_NAMESPACE_INCLUDE", ns.Include)
+	cfg.NamespaceExclude = parseStringSlice("KUBEADAPT_NAMESPACE_EXCLUDE", ns.Exclude)
+	cfg.NamespaceLabelSelector = envOrDefault("KUBEADAPT_NAMESPACE_LABEL_SELECTOR", ns.LabelSelector)
+
+	r := f.Redaction
+	cfg.RedactLabelAllow = parseStringSlice("KUBEADAPT_REDACT_LABEL_ALLOW", r.Labels.Allow)
+	cfg.RedactLabelDeny = parseStringSlice("KUBEADAPT_REDACT_LABEL_DENY", r.Labels.Deny)
+	cfg.RedactAnnotationAllow = parseStringSlice("KUBEADAPT_REDACT_ANNOTATION_ALLOW", r.Annotations.Allow)
+	cfg.RedactAnnotationDeny = parseStringSlice("KUBEADAPT_REDACT_ANNOTATION_DENY", r.Annotations.Deny)
+	cfg.RedactValuePatterns = parseLines("KUBEADAPT_REDACT_VALUE_PATTERNS", r.ValuePatterns)
+	cfg.RedactHashNames = parseBool("KUBEADAPT_REDACT_HASH_NAMES", boolean(r.HashNames, false))
+	cfg.RedactHashSalt = envOrDefault("KUBEADAPT_REDACT_HASH_SALT", r.HashSalt)
+
+	p := f.Pricing
+	cfg.PricingEnabled = parseBool("KUBEADAPT_PRICING_ENABLED", boolean(p.Enabled, false))
+	cfg.PricingCatalogFile = envOrDefault("KUBEADAPT_PRICING_CATALOG_FILE", p.CatalogFile)
+	cfg.PricingCPUCoreHour = parseFloat64("KUBEADAPT_PRICING_CPU_CORE_HOUR", float(p.CPUCoreHour, 0))
+	cfg.PricingMemoryGBHour = parseFloat64("KUBEADAPT_PRICING_MEMORY_GB_HOUR", float(p.MemoryGBHour, 0))
+	cfg.PricingGPUHour = parseFloat64("KUBEADAPT_PRICING_GPU_HOUR", float(p.GPUHour, 0))
+
+	rs := f.Rightsizing
+	cfg.RightsizingEnabled = parseBool("KUBEADAPT_RIGHTSIZING_ENABLED", boolean(rs.Enabled, false))
+	cfg.RightsizingWindow = parseDuration("KUBEADAPT_RIGHTSIZING_WINDOW", dur(rs.Window, 7*24*time.Hour))
+
+	gi := f.GPUIdle
+	cfg.GPUIdleEnabled = parseBool("KUBEADAPT_GPU_IDLE_ENABLED", boolean(gi.Enabled, false))
+	cfg.GPUIdleWindow = parseDuration("KUBEADAPT_GPU_IDLE_WINDOW", dur(gi.Window, 6*time.Hour))
+	cfg.GPUIdleUtilizationThreshold = parseFloat64("KUBEADAPT_GPU_IDLE_UTILIZATION_THRESHOLD", float(gi.UtilizationThreshold, 10))
+	cfg.GPUIdleTensorThreshold = parseFloat64("KUBEADAPT_GPU_IDLE_TENSOR_THRESHOLD", float(gi.TensorThreshold, 0))
 
 	return cfg
 }
 
+// RedactionRules returns the redaction settings as redact.Rules.
+func (c *Config) RedactionRules() redact.Rules {
+	return redact.Rules{
+		LabelAllow:      c.RedactLabelAllow,
+		LabelDeny:       c.RedactLabelDeny,
+		AnnotationAllow: c.RedactAnnotationAllow,
+		AnnotationDeny:  c.RedactAnnotationDeny,
+		ValuePatterns:   c.RedactValuePatterns,
+		HashNames:       c.RedactHashNames,
+		HashSalt:        c.RedactHashSalt,
+	}
+}
+
+// PricingRates returns the configured per-resource rates as pricing.Rates.
+func (c *Config) PricingRates() pricing.Rates {
+	return pricing.Rates{
+		CPUCoreHour:  c.PricingCPUCoreHour,
+		MemoryGBHour: c.PricingMemoryGBHour,
+		GPUHour:      c.PricingGPUHour,
+	}
+}
+
 func envOrDefault(key, defaultVal string) string {
 	if v := os.Getenv(key); v != "" {
 		return v
@@ -110,6 +383,16 @@ func envOrFallbackOrDefault(primary, fallback, defaultVal string) string {
 	return defaultVal
 }
 
+// SlogLevel returns LogLevel as a slog.Level. An empty LogLevel is info.
+func (c *Config) SlogLevel() (slog.Level, error) {
+	var level slog.Level
+	if c.LogLevel == "" {
+		return slog.LevelInfo, nil
+	}
+	err := level.UnmarshalText([]byte(c.LogLevel))
+	return level, err
+}
+
 // parseDuration tries time.ParseDuration first, then falls back to treating
 // the value as integer seconds.
 func parseDuration(key string, defaultVal time.Duration) time.Duration {
@@ -156,10 +439,10 @@ func parseInt(key string, defaultVal int) int {
 	return n
 }
 
-func parseStringSlice(key string) []string {
+func parseStringSlice(key string, defaultVal []string) []string {
 	v := os.Getenv(key)
 	if v == "" {
-		return nil
+		return defaultVal
 	}
 	var result []string
 	for _, s := range strings.Split(v, ",") {
@@ -171,6 +454,23 @@ func parseStringSlice(key string) []string {
 	return result
 }
 
+// parseLines splits a value on newlines rather than commas, for lists whose
+// entries may themselves contain commas (such as regular expressions).
+func parseLines(key string, defaultVal []string) []string {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal
+	}
+	var result []string
+	for _, s := range strings.Split(v, "\n") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
 func parseInt64(key string, defaultVal int64) int64 {
 	v := os.Getenv(key)
 	if v == "" {
@@ -183,6 +483,18 @@ func parseInt64(key string, defaultVal int64) int64 {
 	return n
 }
 
+func parseFloat64(key string, defaultVal float64) float64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultVal
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return defaultVal
+	}
+	return n
+}
+
 // ResolveAgentVersion determines the agent version using this precedence:
 //  1. Build-time ldflags value (main.Version)
 //  2. KUBEADAPT_AGENT_VERSION env var (Helm chart / runtime override)
@@ -2,8 +2,11 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
 )
 
 // helper to clear all KUBEADAPT_ env vars before each test
@@ -14,19 +17,71 @@ func clearEnv(t *testing.T) {
 		"KUBEADAPT_BACKEND_URL",
 		"KUBEADAPT_SNAPSHOT_INTERVAL",
 		"KUBEADAPT_METRICS_INTERVAL",
+		"KUBEADAPT_METRICS_SAMPLE_INTERVAL",
 		"KUBEADAPT_INFORMER_RESYNC",
 		"KUBEADAPT_INFORMER_SYNC_TIMEOUT",
 		"KUBEADAPT_COMPRESSION_LEVEL",
 		"KUBEADAPT_MAX_RETRIES",
 		"KUBEADAPT_REQUEST_TIMEOUT",
 		"KUBEADAPT_BUFFER_MAX_BYTES",
+		"KUBEADAPT_BUFFER_DIR",
+		"KUBEADAPT_CHUNKED_UPLOAD_ENABLED",
+		"KUBEADAPT_STREAMING_ENCODE_ENABLED",
+		"KUBEADAPT_WIRE_FORMAT",
+		"KUBEADAPT_COMPRESSION_DICTIONARY",
+		"KUBEADAPT_DELTA_KEYFRAME_INTERVAL",
+		"KUBEADAPT_LEADER_ELECTION",
+		"KUBEADAPT_LEADER_ELECTION_LEASE_NAME",
+		"KUBEADAPT_LEADER_ELECTION_LEASE_DURATION",
+		"KUBEADAPT_LEADER_ELECTION_RENEW_DEADLINE",
+		"KUBEADAPT_LEADER_ELECTION_RETRY_PERIOD",
 		"KUBEADAPT_HEALTH_PORT",
 		"KUBEADAPT_GPU_METRICS_ENABLED",
 		"KUBEADAPT_DCGM_PORT",
 		"KUBEADAPT_DCGM_NAMESPACE",
 		"KUBEADAPT_GPU_METRICS_INTERVAL",
+		"KUBEADAPT_KUBELET_STATS_ENABLED",
+		"KUBEADAPT_KUBELET_STATS_CONCURRENCY",
+		"KUBEADAPT_CADVISOR_METRICS_ENABLED",
+		"KUBEADAPT_EVENTS_ENABLED",
+		"KUBEADAPT_EVENTS_BUFFER_SIZE",
+		"KUBEADAPT_EVENTS_RAW",
+		"KUBEADAPT_NAMESPACE_INCLUDE",
+		"KUBEADAPT_NAMESPACE_EXCLUDE",
+		"KUBEADAPT_NAMESPACE_LABEL_SELECTOR",
+		"KUBEADAPT_REDACT_LABEL_ALLOW",
+		"KUBEADAPT_REDACT_LABEL_DENY",
+		"KUBEADAPT_REDACT_ANNOTATION_ALLOW",
+		"KUBEADAPT_REDACT_ANNOTATION_DENY",
+		"KUBEADAPT_REDACT_VALUE_PATTERNS",
+		"KUBEADAPT_REDACT_HASH_NAMES",
+		"KUBEADAPT_REDACT_HASH_SALT",
 		"KUBEADAPT_ALLOW_INSECURE",
 		"KUBEADAPT_DEBUG_ENDPOINTS",
+		"KUBEADAPT_TLS_CA_FILE",
+		"KUBEADAPT_TLS_CERT_FILE",
+		"KUBEADAPT_TLS_KEY_FILE",
+		"KUBEADAPT_TLS_MIN_VERSION",
+		"KUBEADAPT_TLS_SERVER_NAME",
+		"KUBEADAPT_CONFIG_FILE",
+		"KUBEADAPT_LOG_LEVEL",
+		"KUBEADAPT_PRICING_ENABLED",
+		"KUBEADAPT_PRICING_CATALOG_FILE",
+		"KUBEADAPT_PRICING_CPU_CORE_HOUR",
+		"KUBEADAPT_PRICING_MEMORY_GB_HOUR",
+		"KUBEADAPT_PRICING_GPU_HOUR",
+		"KUBEADAPT_RIGHTSIZING_ENABLED",
+		"KUBEADAPT_RIGHTSIZING_WINDOW",
+		"KUBEADAPT_GPU_IDLE_ENABLED",
+		"KUBEADAPT_GPU_IDLE_WINDOW",
+		"KUBEADAPT_GPU_IDLE_UTILIZATION_THRESHOLD",
+		"KUBEADAPT_GPU_IDLE_TENSOR_THRESHOLD",
+		"KUBEADAPT_METRICS_SOURCE",
+		"KUBEADAPT_PROMETHEUS_URL",
+		"KUBEADAPT_PROMETHEUS_NODE_CPU_QUERY",
+		"KUBEADAPT_PROMETHEUS_NODE_MEMORY_QUERY",
+		"KUBEADAPT_PROMETHEUS_CONTAINER_CPU_QUERY",
+		"KUBEADAPT_PROMETHEUS_CONTAINER_MEMORY_QUERY",
 	}
 	for _, v := range envVars {
 		os.Unsetenv(v)
@@ -282,6 +337,39 @@ func TestLoad_SecurityDefaults(t *testing.T) {
 	if cfg.DebugEndpoints {
 		t.Error("DebugEndpoints should default to false")
 	}
+	if cfg.TLSMinVersion != TLSVersion12 {
+		t.Errorf("TLSMinVersion = %q, want %q by default", cfg.TLSMinVersion, TLSVersion12)
+	}
+}
+
+func TestLoad_TLS(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+	t.Setenv("KUBEADAPT_TLS_CA_FILE", "/etc/kubeadapt/tls/ca.crt")
+	t.Setenv("KUBEADAPT_TLS_CERT_FILE", "/etc/kubeadapt/tls/tls.crt")
+	t.Setenv("KUBEADAPT_TLS_KEY_FILE", "/etc/kubeadapt/tls/tls.key")
+	t.Setenv("KUBEADAPT_TLS_MIN_VERSION", "1.3")
+	t.Setenv("KUBEADAPT_TLS_SERVER_NAME", "ingest.kubeadapt.io")
+
+	cfg := Load()
+	if cfg.TLSCAFile != "/etc/kubeadapt/tls/ca.crt" || cfg.TLSCertFile != "/etc/kubeadapt/tls/tls.crt" ||
+		cfg.TLSKeyFile != "/etc/kubeadapt/tls/tls.key" || cfg.TLSMinVersion != TLSVersion13 ||
+		cfg.TLSServerName != "ingest.kubeadapt.io" {
+		t.Errorf("TLS config not loaded: %+v", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cfg.TLSKeyFile = ""
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for a certificate without a key")
+	}
+	cfg.TLSKeyFile = "/etc/kubeadapt/tls/tls.key"
+	cfg.TLSMinVersion = "1.1"
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for TLS 1.1")
+	}
 }
 
 func TestLoad_GPUConfig(t *testing.T) {
@@ -347,3 +435,632 @@ func TestLoad_GPUMetricsEnabledParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_BufferDir(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.BufferDir != "" {
+		t.Errorf("BufferDir = %q, want empty (disabled) by default", cfg.BufferDir)
+	}
+
+	t.Setenv("KUBEADAPT_BUFFER_DIR", "/var/lib/kubeadapt/spool")
+	cfg = Load()
+	if cfg.BufferDir != "/var/lib/kubeadapt/spool" {
+		t.Errorf("BufferDir = %q, want %q", cfg.BufferDir, "/var/lib/kubeadapt/spool")
+	}
+}
+
+func TestValidate_BufferDirRequiresPositiveMax(t *testing.T) {
+	cfg := Config{
+		APIKey:           "test-key",
+		BackendURL:       "https://api.kubeadapt.io",
+		SnapshotInterval: 60 * time.Second,
+		MetricsInterval:  60 * time.Second,
+		CompressionLevel: 3,
+		MaxRetries:       5,
+		HealthPort:       8080,
+		BufferDir:        "/tmp/spool",
+		BufferMaxBytes:   0,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for BufferDir with BufferMaxBytes=0")
+	}
+
+	cfg.BufferMaxBytes = 1024
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error with positive BufferMaxBytes, got: %v", err)
+	}
+}
+
+func TestLoad_ChunkedUploadEnabled(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.ChunkedUploadEnabled {
+		t.Error("ChunkedUploadEnabled = true, want false by default")
+	}
+
+	t.Setenv("KUBEADAPT_CHUNKED_UPLOAD_ENABLED", "true")
+	cfg = Load()
+	if !cfg.ChunkedUploadEnabled {
+		t.Error("ChunkedUploadEnabled = false, want true")
+	}
+}
+
+func TestLoad_StreamingEncodeEnabled(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.StreamingEncodeEnabled {
+		t.Error("StreamingEncodeEnabled = true, want false by default")
+	}
+
+	t.Setenv("KUBEADAPT_STREAMING_ENCODE_ENABLED", "true")
+	cfg = Load()
+	if !cfg.StreamingEncodeEnabled {
+		t.Error("StreamingEncodeEnabled = false, want true")
+	}
+}
+
+func TestLoad_WireFormat(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.WireFormat != WireFormatJSON {
+		t.Errorf("WireFormat = %q, want %q by default", cfg.WireFormat, WireFormatJSON)
+	}
+
+	t.Setenv("KUBEADAPT_WIRE_FORMAT", "protobuf")
+	cfg = Load()
+	if cfg.WireFormat != WireFormatProtobuf {
+		t.Errorf("WireFormat = %q, want %q", cfg.WireFormat, WireFormatProtobuf)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cfg.WireFormat = "msgpack"
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for unknown wire format")
+	}
+}
+
+func TestLoad_CompressionDictionary(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.CompressionDictionary != CompressionDictionaryOff {
+		t.Errorf("CompressionDictionary = %q, want %q by default", cfg.CompressionDictionary, CompressionDictionaryOff)
+	}
+
+	t.Setenv("KUBEADAPT_COMPRESSION_DICTIONARY", "backend")
+	cfg = Load()
+	if cfg.CompressionDictionary != CompressionDictionaryBackend {
+		t.Errorf("CompressionDictionary = %q, want %q", cfg.CompressionDictionary, CompressionDictionaryBackend)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cfg.CompressionDictionary = "trained"
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for unknown dictionary source")
+	}
+}
+
+func TestLoad_DeltaKeyframeInterval(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.DeltaKeyframeInterval != 0 {
+		t.Errorf("DeltaKeyframeInterval = %d, want 0 (disabled) by default", cfg.DeltaKeyframeInterval)
+	}
+
+	t.Setenv("KUBEADAPT_DELTA_KEYFRAME_INTERVAL", "10")
+	cfg = Load()
+	if cfg.DeltaKeyframeInterval != 10 {
+		t.Errorf("DeltaKeyframeInterval = %d, want 10", cfg.DeltaKeyframeInterval)
+	}
+}
+
+func TestValidate_NegativeDeltaKeyframeInterval(t *testing.T) {
+	cfg := Config{
+		APIKey:                "test-key",
+		BackendURL:            "https://api.kubeadapt.io",
+		SnapshotInterval:      60 * time.Second,
+		MetricsInterval:       60 * time.Second,
+		CompressionLevel:      3,
+		MaxRetries:            5,
+		HealthPort:            8080,
+		DeltaKeyframeInterval: -1,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for negative DeltaKeyframeInterval")
+	}
+}
+
+func TestLoad_LeaderElection(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.LeaderElection {
+		t.Error("LeaderElection = true, want false by default")
+	}
+	if cfg.LeaderElectionLeaseName != "kubeadapt-agent" {
+		t.Errorf("LeaderElectionLeaseName = %q, want %q", cfg.LeaderElectionLeaseName, "kubeadapt-agent")
+	}
+	if cfg.LeaderElectionLeaseDuration != 15*time.Second ||
+		cfg.LeaderElectionRenewDeadline != 10*time.Second ||
+		cfg.LeaderElectionRetryPeriod != 2*time.Second {
+		t.Errorf("leader election durations = %v/%v/%v, want 15s/10s/2s",
+			cfg.LeaderElectionLeaseDuration, cfg.LeaderElectionRenewDeadline, cfg.LeaderElectionRetryPeriod)
+	}
+
+	t.Setenv("KUBEADAPT_LEADER_ELECTION", "true")
+	t.Setenv("KUBEADAPT_LEADER_ELECTION_LEASE_NAME", "agent-lock")
+	t.Setenv("KUBEADAPT_LEADER_ELECTION_LEASE_DURATION", "30s")
+	cfg = Load()
+	if !cfg.LeaderElection {
+		t.Error("LeaderElection = false, want true")
+	}
+	if cfg.LeaderElectionLeaseName != "agent-lock" {
+		t.Errorf("LeaderElectionLeaseName = %q, want %q", cfg.LeaderElectionLeaseName, "agent-lock")
+	}
+	if cfg.LeaderElectionLeaseDuration != 30*time.Second {
+		t.Errorf("LeaderElectionLeaseDuration = %v, want 30s", cfg.LeaderElectionLeaseDuration)
+	}
+}
+
+func TestValidate_LeaderElection(t *testing.T) {
+	cfg := Config{
+		APIKey:                      "test-key",
+		BackendURL:                  "https://api.kubeadapt.io",
+		SnapshotInterval:            60 * time.Second,
+		MetricsInterval:             60 * time.Second,
+		CompressionLevel:            3,
+		MaxRetries:                  5,
+		HealthPort:                  8080,
+		LeaderElection:              true,
+		LeaderElectionLeaseName:     "kubeadapt-agent",
+		LeaderElectionLeaseDuration: 15 * time.Second,
+		LeaderElectionRenewDeadline: 10 * time.Second,
+		LeaderElectionRetryPeriod:   2 * time.Second,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error when POD_NAMESPACE/POD_NAME are unset")
+	}
+
+	cfg.PodNamespace = "kubeadapt"
+	cfg.PodName = "kubeadapt-agent-0"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	cfg.LeaderElectionRenewDeadline = 20 * time.Second
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error when RenewDeadline >= LeaseDuration")
+	}
+}
+
+func TestLoad_KubeletStats(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.KubeletStatsEnabled {
+		t.Error("KubeletStatsEnabled = true, want false by default")
+	}
+	if cfg.KubeletStatsConcurrency != 10 {
+		t.Errorf("KubeletStatsConcurrency = %d, want 10", cfg.KubeletStatsConcurrency)
+	}
+	if cfg.CAdvisorMetricsEnabled {
+		t.Error("CAdvisorMetricsEnabled = true, want false by default")
+	}
+
+	t.Setenv("KUBEADAPT_KUBELET_STATS_ENABLED", "true")
+	t.Setenv("KUBEADAPT_CADVISOR_METRICS_ENABLED", "true")
+	t.Setenv("KUBEADAPT_KUBELET_STATS_CONCURRENCY", "4")
+	cfg = Load()
+	if !cfg.KubeletStatsEnabled {
+		t.Error("KubeletStatsEnabled = false, want true")
+	}
+	if !cfg.CAdvisorMetricsEnabled {
+		t.Error("CAdvisorMetricsEnabled = false, want true")
+	}
+	if cfg.KubeletStatsConcurrency != 4 {
+		t.Errorf("KubeletStatsConcurrency = %d, want 4", cfg.KubeletStatsConcurrency)
+	}
+}
+
+func TestValidate_KubeletStatsConcurrency(t *testing.T) {
+	cfg := Config{
+		APIKey:                  "test-key",
+		BackendURL:              "https://api.kubeadapt.io",
+		SnapshotInterval:        60 * time.Second,
+		MetricsInterval:         60 * time.Second,
+		CompressionLevel:        3,
+		MaxRetries:              5,
+		HealthPort:              8080,
+		KubeletStatsEnabled:     true,
+		KubeletStatsConcurrency: 0,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for KubeletStatsConcurrency 0")
+	}
+
+	cfg.KubeletStatsEnabled = false
+	cfg.CAdvisorMetricsEnabled = true
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for KubeletStatsConcurrency 0 with cAdvisor enabled")
+	}
+
+	cfg.CAdvisorMetricsEnabled = false
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("concurrency is not checked when disabled, got: %v", err)
+	}
+}
+
+func TestLoad_MetricsSampleInterval(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.MetricsSampleInterval != 15*time.Second {
+		t.Errorf("MetricsSampleInterval = %v, want 15s", cfg.MetricsSampleInterval)
+	}
+
+	// The default never exceeds MetricsInterval.
+	t.Setenv("KUBEADAPT_METRICS_INTERVAL", "10s")
+	cfg = Load()
+	if cfg.MetricsSampleInterval != 10*time.Second {
+		t.Errorf("MetricsSampleInterval = %v, want 10s", cfg.MetricsSampleInterval)
+	}
+
+	t.Setenv("KUBEADAPT_METRICS_SAMPLE_INTERVAL", "5s")
+	cfg = Load()
+	if cfg.MetricsSampleInterval != 5*time.Second {
+		t.Errorf("MetricsSampleInterval = %v, want 5s", cfg.MetricsSampleInterval)
+	}
+}
+
+func TestValidate_MetricsSampleInterval(t *testing.T) {
+	cfg := Config{
+		APIKey:                "test-key",
+		BackendURL:            "https://api.kubeadapt.io",
+		SnapshotInterval:      60 * time.Second,
+		MetricsInterval:       60 * time.Second,
+		MetricsSampleInterval: 90 * time.Second,
+		CompressionLevel:      3,
+		MaxRetries:            5,
+		HealthPort:            8080,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error when MetricsSampleInterval > MetricsInterval")
+	}
+
+	cfg.MetricsSampleInterval = 500 * time.Millisecond
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error when MetricsSampleInterval < 1s")
+	}
+
+	cfg.MetricsSampleInterval = 15 * time.Second
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestLoad_Events(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if !cfg.EventsEnabled {
+		t.Error("EventsEnabled = false, want true by default")
+	}
+	if cfg.EventsBufferSize != 5000 {
+		t.Errorf("EventsBufferSize = %d, want 5000", cfg.EventsBufferSize)
+	}
+	if cfg.EventsRaw {
+		t.Error("EventsRaw = true, want false by default")
+	}
+
+	t.Setenv("KUBEADAPT_EVENTS_ENABLED", "false")
+	t.Setenv("KUBEADAPT_EVENTS_BUFFER_SIZE", "200")
+	t.Setenv("KUBEADAPT_EVENTS_RAW", "true")
+	cfg = Load()
+	if cfg.EventsEnabled {
+		t.Error("EventsEnabled = true, want false")
+	}
+	if cfg.EventsBufferSize != 200 {
+		t.Errorf("EventsBufferSize = %d, want 200", cfg.EventsBufferSize)
+	}
+	if !cfg.EventsRaw {
+		t.Error("EventsRaw = false, want true")
+	}
+}
+
+func TestValidate_EventsBufferSize(t *testing.T) {
+	cfg := Config{
+		APIKey:           "test-key",
+		BackendURL:       "https://api.kubeadapt.io",
+		SnapshotInterval: 60 * time.Second,
+		MetricsInterval:  60 * time.Second,
+		CompressionLevel: 3,
+		MaxRetries:       5,
+		HealthPort:       8080,
+		EventsEnabled:    true,
+		EventsBufferSize: 0,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for EventsBufferSize 0")
+	}
+
+	cfg.EventsEnabled = false
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("buffer size is not checked when disabled, got: %v", err)
+	}
+}
+
+func TestLoad_NamespaceScope(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.NamespaceInclude != nil || cfg.NamespaceExclude != nil || cfg.NamespaceLabelSelector != "" {
+		t.Errorf("expected no namespace scope by default, got %v %v %q",
+			cfg.NamespaceInclude, cfg.NamespaceExclude, cfg.NamespaceLabelSelector)
+	}
+
+	t.Setenv("KUBEADAPT_NAMESPACE_INCLUDE", "team-a, team-b")
+	t.Setenv("KUBEADAPT_NAMESPACE_EXCLUDE", "regulated")
+	t.Setenv("KUBEADAPT_NAMESPACE_LABEL_SELECTOR", "kubeadapt.io/collect!=false")
+	cfg = Load()
+	if len(cfg.NamespaceInclude) != 2 || cfg.NamespaceInclude[1] != "team-b" {
+		t.Errorf("NamespaceInclude = %v, want [team-a team-b]", cfg.NamespaceInclude)
+	}
+	if len(cfg.NamespaceExclude) != 1 || cfg.NamespaceExclude[0] != "regulated" {
+		t.Errorf("NamespaceExclude = %v, want [regulated]", cfg.NamespaceExclude)
+	}
+	if cfg.NamespaceLabelSelector != "kubeadapt.io/collect!=false" {
+		t.Errorf("NamespaceLabelSelector = %q", cfg.NamespaceLabelSelector)
+	}
+}
+
+func TestValidate_NamespaceScope(t *testing.T) {
+	cfg := Config{
+		APIKey:                 "test-key",
+		BackendURL:             "https://api.kubeadapt.io",
+		SnapshotInterval:       60 * time.Second,
+		MetricsInterval:        60 * time.Second,
+		CompressionLevel:       3,
+		MaxRetries:             5,
+		HealthPort:             8080,
+		NamespaceLabelSelector: "team in (a",
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for an invalid label selector")
+	}
+
+	cfg.NamespaceLabelSelector = ""
+	cfg.NamespaceInclude = []string{"team-a"}
+	cfg.NamespaceExclude = []string{"team-a"}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for a namespace both included and excluded")
+	}
+
+	cfg.NamespaceExclude = []string{"regulated"}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestLoad_Redaction(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.RedactLabelDeny != nil || cfg.RedactValuePatterns != nil || cfg.RedactHashNames {
+		t.Errorf("expected no redaction by default, got %+v", cfg.RedactionRules())
+	}
+
+	t.Setenv("KUBEADAPT_REDACT_LABEL_ALLOW", "app.kubernetes.io/*, team")
+	t.Setenv("KUBEADAPT_REDACT_ANNOTATION_DENY", "*customer*")
+	// Value patterns are split on newlines so they may contain commas.
+	t.Setenv("KUBEADAPT_REDACT_VALUE_PATTERNS", "[A-Z]{2,5}-\\d+\n  [\\w.]+@[\\w.]+  \n")
+	t.Setenv("KUBEADAPT_REDACT_HASH_NAMES", "true")
+	t.Setenv("KUBEADAPT_REDACT_HASH_SALT", "0123456789abcdef")
+	cfg = Load()
+
+	if len(cfg.RedactLabelAllow) != 2 || cfg.RedactLabelAllow[1] != "team" {
+		t.Errorf("RedactLabelAllow = %v", cfg.RedactLabelAllow)
+	}
+	if len(cfg.RedactAnnotationDeny) != 1 {
+		t.Errorf("RedactAnnotationDeny = %v", cfg.RedactAnnotationDeny)
+	}
+	if len(cfg.RedactValuePatterns) != 2 || cfg.RedactValuePatterns[0] != `[A-Z]{2,5}-\d+` || cfg.RedactValuePatterns[1] != `[\w.]+@[\w.]+` {
+		t.Errorf("RedactValuePatterns = %q", cfg.RedactValuePatterns)
+	}
+	if !cfg.RedactHashNames || cfg.RedactHashSalt != "0123456789abcdef" {
+		t.Errorf("RedactHashNames = %v, RedactHashSalt = %q", cfg.RedactHashNames, cfg.RedactHashSalt)
+	}
+}
+
+func TestValidate_Redaction(t *testing.T) {
+	cfg := Config{
+		APIKey:              "test-key",
+		BackendURL:          "https://api.kubeadapt.io",
+		SnapshotInterval:    60 * time.Second,
+		MetricsInterval:     60 * time.Second,
+		CompressionLevel:    3,
+		MaxRetries:          5,
+		HealthPort:          8080,
+		RedactValuePatterns: []string{"(unclosed"},
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for an invalid value pattern")
+	}
+
+	cfg.RedactValuePatterns = nil
+	cfg.RedactHashNames = true
+	cfg.RedactHashSalt = "short"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for a short hash salt")
+	}
+
+	cfg.RedactHashSalt = "0123456789abcdef"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestLoad_Pricing(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.PricingEnabled || cfg.PricingCatalogFile != "" || cfg.PricingRates() != (pricing.Rates{}) {
+		t.Errorf("expected cost attribution off by default, got %+v", cfg.PricingRates())
+	}
+
+	t.Setenv("KUBEADAPT_PRICING_ENABLED", "true")
+	t.Setenv("KUBEADAPT_PRICING_CPU_CORE_HOUR", "0.025")
+	t.Setenv("KUBEADAPT_PRICING_MEMORY_GB_HOUR", "0.0035")
+	t.Setenv("KUBEADAPT_PRICING_GPU_HOUR", "not-a-number")
+	cfg = Load()
+
+	want := pricing.Rates{CPUCoreHour: 0.025, MemoryGBHour: 0.0035}
+	if !cfg.PricingEnabled || cfg.PricingRates() != want {
+		t.Errorf("PricingEnabled = %v, rates = %+v, want %+v", cfg.PricingEnabled, cfg.PricingRates(), want)
+	}
+}
+
+func TestValidate_Pricing(t *testing.T) {
+	cfg := Config{
+		APIKey:             "test-key",
+		BackendURL:         "https://api.kubeadapt.io",
+		SnapshotInterval:   60 * time.Second,
+		MetricsInterval:    60 * time.Second,
+		CompressionLevel:   3,
+		MaxRetries:         5,
+		HealthPort:         8080,
+		PricingCPUCoreHour: -0.01,
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for a negative rate")
+	}
+
+	cfg.PricingCPUCoreHour = 0
+	cfg.PricingEnabled = true
+	cfg.PricingCatalogFile = filepath.Join(t.TempDir(), "prices.json")
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for a missing catalog file")
+	}
+
+	cfg.PricingCatalogFile = ""
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error with the bundled catalog, got: %v", err)
+	}
+}
+
+func TestLoad_Rightsizing(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.RightsizingEnabled || cfg.RightsizingWindow != 7*24*time.Hour {
+		t.Errorf("RightsizingEnabled = %v, RightsizingWindow = %v", cfg.RightsizingEnabled, cfg.RightsizingWindow)
+	}
+
+	t.Setenv("KUBEADAPT_RIGHTSIZING_ENABLED", "true")
+	t.Setenv("KUBEADAPT_RIGHTSIZING_WINDOW", "48h")
+	cfg = Load()
+	if !cfg.RightsizingEnabled || cfg.RightsizingWindow != 48*time.Hour {
+		t.Errorf("RightsizingEnabled = %v, RightsizingWindow = %v", cfg.RightsizingEnabled, cfg.RightsizingWindow)
+	}
+
+	cfg.RightsizingWindow = 30 * time.Minute
+	if err := cfg.Validate(); err == nil {
+		t.Error("expected error for a window under an hour")
+	}
+}
+
+func TestLoad_MetricsSource(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.MetricsSource != MetricsSourceAuto || cfg.PrometheusURL != "" {
+		t.Errorf("MetricsSource = %q, PrometheusURL = %q", cfg.MetricsSource, cfg.PrometheusURL)
+	}
+	if cfg.PrometheusNodeCPUQuery != DefaultPrometheusNodeCPUQuery || cfg.PrometheusContainerMemQuery != DefaultPrometheusContainerMemQuery {
+		t.Errorf("expected default queries, got %q and %q", cfg.PrometheusNodeCPUQuery, cfg.PrometheusContainerMemQuery)
+	}
+
+	t.Setenv("KUBEADAPT_METRICS_SOURCE", "prometheus")
+	t.Setenv("KUBEADAPT_PROMETHEUS_URL", "http://prometheus.monitoring:9090")
+	t.Setenv("KUBEADAPT_PROMETHEUS_NODE_CPU_QUERY", "node_cpu")
+	cfg = Load()
+	if cfg.MetricsSource != MetricsSourcePrometheus || cfg.PrometheusURL != "http://prometheus.monitoring:9090" || cfg.PrometheusNodeCPUQuery != "node_cpu" {
+		t.Errorf("MetricsSource = %q, PrometheusURL = %q, PrometheusNodeCPUQuery = %q", cfg.MetricsSource, cfg.PrometheusURL, cfg.PrometheusNodeCPUQuery)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Config){
+		"unknown source":     func(c *Config) { c.MetricsSource = "datadog" },
+		"prometheus, no URL": func(c *Config) { c.PrometheusURL = "" },
+		"URL without scheme": func(c *Config) { c.PrometheusURL = "prometheus:9090" },
+		"empty query":        func(c *Config) { c.PrometheusContainerCPUQuery = "" },
+	} {
+		bad := cfg
+		mutate(&bad)
+		if err := bad.Validate(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLoad_GPUIdle(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg := Load()
+	if cfg.GPUIdleEnabled || cfg.GPUIdleWindow != 6*time.Hour || cfg.GPUIdleUtilizationThreshold != 10 || cfg.GPUIdleTensorThreshold != 0 {
+		t.Errorf("GPU idle defaults = %v, %v, %v, %v", cfg.GPUIdleEnabled, cfg.GPUIdleWindow, cfg.GPUIdleUtilizationThreshold, cfg.GPUIdleTensorThreshold)
+	}
+
+	t.Setenv("KUBEADAPT_GPU_IDLE_ENABLED", "true")
+	t.Setenv("KUBEADAPT_GPU_IDLE_WINDOW", "2h")
+	t.Setenv("KUBEADAPT_GPU_IDLE_UTILIZATION_THRESHOLD", "5")
+	t.Setenv("KUBEADAPT_GPU_IDLE_TENSOR_THRESHOLD", "2.5")
+	cfg = Load()
+	if !cfg.GPUIdleEnabled || cfg.GPUIdleWindow != 2*time.Hour || cfg.GPUIdleUtilizationThreshold != 5 || cfg.GPUIdleTensorThreshold != 2.5 {
+		t.Errorf("GPU idle = %v, %v, %v, %v", cfg.GPUIdleEnabled, cfg.GPUIdleWindow, cfg.GPUIdleUtilizationThreshold, cfg.GPUIdleTensorThreshold)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, mutate := range map[string]func(*Config){
+		"short window":         func(c *Config) { c.GPUIdleWindow = 5 * time.Minute },
+		"zero utilization":     func(c *Config) { c.GPUIdleUtilizationThreshold = 0 },
+		"tensor above 100":     func(c *Config) { c.GPUIdleTensorThreshold = 101 },
+		"negative tensor":      func(c *Config) { c.GPUIdleTensorThreshold = -1 },
+		"utilization over 100": func(c *Config) { c.GPUIdleUtilizationThreshold = 100.5 },
+	} {
+		c := cfg
+		mutate(&c)
+		if err := c.Validate(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// fileConfig is the schema of the YAML file named by KUBEADAPT_CONFIG_FILE.
+// Scalar fields are pointers so that an explicit zero value in the file can
+// be told apart from an unset field. Durations use Go syntax ("30s", "5m").
+type fileConfig struct {
+	APIKey                 *string          `json:"apiKey,omitempty"`
+	BackendURL             *string          `json:"backendURL,omitempty"`
+	SnapshotInterval       *metav1.Duration `json:"snapshotInterval,omitempty"`
+	MetricsInterval        *metav1.Duration `json:"metricsInterval,omitempty"`
+	MetricsSampleInterval  *metav1.Duration `json:"metricsSampleInterval,omitempty"`
+	MetricsSource          *string          `json:"metricsSource,omitempty"`
+	InformerResyncPeriod   *metav1.Duration `json:"informerResyncPeriod,omitempty"`
+	InformerSyncTimeout    *metav1.Duration `json:"informerSyncTimeout,omitempty"`
+	CompressionLevel       *int             `json:"compressionLevel,omitempty"`
+	MaxRetries             *int             `json:"maxRetries,omitempty"`
+	RequestTimeout         *metav1.Duration `json:"requestTimeout,omitempty"`
+	BufferMaxBytes         *int64           `json:"bufferMaxBytes,omitempty"`
+	BufferDir              *string          `json:"bufferDir,omitempty"`
+	HealthPort             *int             `json:"healthPort,omitempty"`
+	MaxCompressedBodyBytes *int64           `json:"maxCompressedBodyBytes,omitempty"`
+	ChunkedUploadEnabled   *bool            `json:"chunkedUploadEnabled,omitempty"`
+	StreamingEncodeEnabled *bool            `json:"streamingEncodeEnabled,omitempty"`
+	WireFormat             *string          `json:"wireFormat,omitempty"`
+	CompressionDictionary  *string          `json:"compressionDictionary,omitempty"`
+	DeltaKeyframeInterval  *int             `json:"deltaKeyframeInterval,omitempty"`
+	LogLevel               *string          `json:"logLevel,omitempty"`
+
+	LeaderElection              *bool            `json:"leaderElection,omitempty"`
+	LeaderElectionLeaseName     *string          `json:"leaderElectionLeaseName,omitempty"`
+	LeaderElectionLeaseDuration *metav1.Duration `json:"leaderElectionLeaseDuration,omitempty"`
+	LeaderElectionRenewDeadline *metav1.Duration `json:"leaderElectionRenewDeadline,omitempty"`
+	LeaderElectionRetryPeriod   *metav1.Duration `json:"leaderElectionRetryPeriod,omitempty"`
+
+	AllowInsecure  *bool   `json:"allowInsecure,omitempty"`
+	DebugEndpoints *bool   `json:"debugEndpoints,omitempty"`
+	TLSCAFile      *string `json:"tlsCAFile,omitempty"`
+	TLSCertFile    *string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile     *string `json:"tlsKeyFile,omitempty"`
+	TLSMinVersion  *string `json:"tlsMinVersion,omitempty"`
+	TLSServerName  *string `json:"tlsServerName,omitempty"`
+
+	GPUMetricsEnabled     *bool            `json:"gpuMetricsEnabled,omitempty"`
+	DCGMExporterPort      *int             `json:"dcgmExporterPort,omitempty"`
+	DCGMExporterNamespace *string          `json:"dcgmExporterNamespace,omitempty"`
+	DCGMExporterEndpoints []string         `json:"dcgmExporterEndpoints,omitempty"`
+	GPUMetricsInterval    *metav1.Duration `json:"gpuMetricsInterval,omitempty"`
+
+	KubeletStatsEnabled     *bool `json:"kubeletStatsEnabled,omitempty"`
+	CAdvisorMetricsEnabled  *bool `json:"cadvisorMetricsEnabled,omitempty"`
+	KubeletStatsConcurrency *int  `json:"kubeletStatsConcurrency,omitempty"`
+
+	EventsEnabled    *bool `json:"eventsEnabled,omitempty"`
+	EventsBufferSize *int  `json:"eventsBufferSize,omitempty"`
+	EventsRaw        *bool `json:"eventsRaw,omitempty"`
+
+	Prometheus  filePrometheus  `json:"prometheus,omitempty"`
+	Namespaces  fileNamespaces  `json:"namespaces,omitempty"`
+	Redaction   fileRedaction   `json:"redaction,omitempty"`
+	Pricing     filePricing     `json:"pricing,omitempty"`
+	Rightsizing fileRightsizing `json:"rightsizing,omitempty"`
+	GPUIdle     fileGPUIdle     `json:"gpuIdle,omitempty"`
+}
+
+type filePrometheus struct {
+	URL                  string  `json:"url,omitempty"`
+	NodeCPUQuery         *string `json:"nodeCPUQuery,omitempty"`
+	NodeMemoryQuery      *string `json:"nodeMemoryQuery,omitempty"`
+	ContainerCPUQuery    *string `json:"containerCPUQuery,omitempty"`
+	ContainerMemoryQuery *string `json:"containerMemoryQuery,omitempty"`
+}
+
+type fileNamespaces struct {
+	Include       []string `json:"include,omitempty"`
+	Exclude       []string `json:"exclude,omitempty"`
+	LabelSelector string   `json:"labelSelector,omitempty"`
+}
+
+type fileRedaction struct {
+	Labels        fileKeyRules `json:"labels,omitempty"`
+	Annotations   fileKeyRules `json:"annotations,omitempty"`
+	ValuePatterns []string     `json:"valuePatterns,omitempty"`
+	HashNames     *bool        `json:"hashNames,omitempty"`
+	HashSalt      string       `json:"hashSalt,omitempty"`
+}
+
+type filePricing struct {
+	Enabled      *bool    `json:"enabled,omitempty"`
+	CatalogFile  string   `json:"catalogFile,omitempty"`
+	CPUCoreHour  *float64 `json:"cpuCoreHour,omitempty"`
+	MemoryGBHour *float64 `json:"memoryGBHour,omitempty"`
+	GPUHour      *float64 `json:"gpuHour,omitempty"`
+}
+
+type fileRightsizing struct {
+	Enabled *bool            `json:"enabled,omitempty"`
+	Window  *metav1.Duration `json:"window,omitempty"`
+}
+
+type fileGPUIdle struct {
+	Enabled              *bool            `json:"enabled,omitempty"`
+	Window               *metav1.Duration `json:"window,omitempty"`
+	UtilizationThreshold *float64         `json:"utilizationThreshold,omitempty"`
+	TensorThreshold      *float64         `json:"tensorThreshold,omitempty"`
+}
+
+type fileKeyRules struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// readFile parses the config file at path. Unknown fields are rejected so
+// that a misspelt key fails loudly instead of being ignored.
+func readFile(path string) (fileConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fileConfig{}, fmt.Errorf("config: read %s: %w", path, err)
+	}
+	return parseFile(path, data)
+}
+
+func parseFile(path string, data []byte) (fileConfig, error) {
+	var f fileConfig
+	if err := yaml.UnmarshalStrict(data, &f); err != nil {
+		return f, fmt.Errorf("config: parse %s: %w", path, err)
+	}
+	return f, nil
+}
+
+func str(p *string, defaultVal string) string {
+	if p == nil {
+		return defaultVal
+	}
+	return *p
+}
+
+func dur(p *metav1.Duration, defaultVal time.Duration) time.Duration {
+	if p == nil {
+		return defaultVal
+	}
+	return p.Duration
+}
+
+func boolean(p *bool, defaultVal bool) bool {
+	if p == nil {
+		return defaultVal
+	}
+	return *p
+}
+
+func integer(p *int, defaultVal int) int {
+	if p == nil {
+		return defaultVal
+	}
+	return *p
+}
+
+func int64v(p *int64, defaultVal int64) int64 {
+	if p == nil {
+		return defaultVal
+	}
+	return *p
+}
+
+func float(p *float64, defaultVal float64) float64 {
+	if p == nil {
+		return defaultVal
+	}
+	return *p
+}
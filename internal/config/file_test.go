@@ -0,0 +1,142 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes content to a config file in a temp dir and points
+// KUBEADAPT_CONFIG_FILE at it.
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KUBEADAPT_CONFIG_FILE", path)
+	return path
+}
+
+func TestLoadWithFile_NoFile(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+
+	cfg, err := LoadWithFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ConfigFile != "" || cfg.SnapshotInterval != 60*time.Second || cfg.LogLevel != "info" {
+		t.Errorf("expected env-only defaults, got %+v", cfg)
+	}
+}
+
+func TestLoadWithFile_FileAndEnvOverride(t *testing.T) {
+	clearEnv(t)
+	path := writeConfigFile(t, `
+apiKey: file-key
+snapshotInterval: 2m
+metricsInterval: 30s
+compressionLevel: 0
+gpuMetricsEnabled: false
+logLevel: debug
+namespaces:
+  exclude: [regulated, audit]
+  labelSelector: team
+redaction:
+  labels:
+    deny: ["*email*"]
+  valuePatterns:
+    - '[A-Z]{2,5}-\d+'
+  hashNames: true
+  hashSalt: 0123456789abcdef
+`)
+	t.Setenv("KUBEADAPT_SNAPSHOT_INTERVAL", "90s")
+
+	cfg, err := LoadWithFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ConfigFile != path {
+		t.Errorf("ConfigFile = %q, want %q", cfg.ConfigFile, path)
+	}
+	if cfg.APIKey != "file-key" {
+		t.Errorf("APIKey = %q, want file-key", cfg.APIKey)
+	}
+	if cfg.SnapshotInterval != 90*time.Second {
+		t.Errorf("SnapshotInterval = %v, want the env value 90s", cfg.SnapshotInterval)
+	}
+	if cfg.MetricsInterval != 30*time.Second {
+		t.Errorf("MetricsInterval = %v, want 30s", cfg.MetricsInterval)
+	}
+	// Derived defaults follow the file's MetricsInterval.
+	if cfg.GPUMetricsInterval != 30*time.Second || cfg.MetricsSampleInterval != 15*time.Second {
+		t.Errorf("GPUMetricsInterval = %v, MetricsSampleInterval = %v", cfg.GPUMetricsInterval, cfg.MetricsSampleInterval)
+	}
+	// Explicit zero values in the file are kept.
+	if cfg.CompressionLevel != 0 || cfg.GPUMetricsEnabled {
+		t.Errorf("CompressionLevel = %d, GPUMetricsEnabled = %v", cfg.CompressionLevel, cfg.GPUMetricsEnabled)
+	}
+	if cfg.LogLevel != "debug" {
+		t.Errorf("LogLevel = %q", cfg.LogLevel)
+	}
+	if len(cfg.NamespaceExclude) != 2 || cfg.NamespaceLabelSelector != "team" {
+		t.Errorf("namespace scope = %v %q", cfg.NamespaceExclude, cfg.NamespaceLabelSelector)
+	}
+	if len(cfg.RedactLabelDeny) != 1 || len(cfg.RedactValuePatterns) != 1 || cfg.RedactValuePatterns[0] != `[A-Z]{2,5}-\d+` {
+		t.Errorf("redaction = %+v", cfg.RedactionRules())
+	}
+	if !cfg.RedactHashNames || cfg.RedactHashSalt != "0123456789abcdef" {
+		t.Errorf("RedactHashNames = %v, RedactHashSalt = %q", cfg.RedactHashNames, cfg.RedactHashSalt)
+	}
+
+	// Environment variables override structured options too.
+	t.Setenv("KUBEADAPT_NAMESPACE_EXCLUDE", "kube-system")
+	cfg, err = LoadWithFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.NamespaceExclude) != 1 || cfg.NamespaceExclude[0] != "kube-system" {
+		t.Errorf("NamespaceExclude = %v, want [kube-system]", cfg.NamespaceExclude)
+	}
+}
+
+func TestLoadWithFile_Errors(t *testing.T) {
+	clearEnv(t)
+	writeConfigFile(t, "snapshotIntervall: 2m\n")
+	if _, err := LoadWithFile(); err == nil || !strings.Contains(err.Error(), "snapshotIntervall") {
+		t.Errorf("expected an unknown field error, got %v", err)
+	}
+
+	writeConfigFile(t, "snapshotInterval: soon\n")
+	if _, err := LoadWithFile(); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+
+	t.Setenv("KUBEADAPT_CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+	if _, err := LoadWithFile(); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestValidate_LogLevel(t *testing.T) {
+	cfg := Config{
+		APIKey:           "test-key",
+		BackendURL:       "https://api.kubeadapt.io",
+		SnapshotInterval: 60 * time.Second,
+		MetricsInterval:  60 * time.Second,
+		CompressionLevel: 3,
+		MaxRetries:       5,
+		HealthPort:       8080,
+		LogLevel:         "verbose",
+	}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for an unknown log level")
+	}
+	cfg.LogLevel = "warn"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
@@ -2,10 +2,19 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
 )
 
+// minRedactHashSaltLen keeps the name hashing salt long enough that hashes
+// of guessable names cannot be brute-forced by trying salts.
+const minRedactHashSaltLen = 16
+
 // Validate checks that the Config contains valid values.
 // Returns an error describing the first invalid field found.
 func (c Config) Validate() error {
@@ -20,6 +29,15 @@ func (c Config) Validate() error {
 		return fmt.Errorf("config: KUBEADAPT_BACKEND_URL must use https:// (got %q); set KUBEADAPT_ALLOW_INSECURE=true to override", c.BackendURL)
 	}
 
+	switch c.TLSMinVersion {
+	case "", TLSVersion12, TLSVersion13:
+	default:
+		return fmt.Errorf("config: KUBEADAPT_TLS_MIN_VERSION must be 1.2 or 1.3, got %q", c.TLSMinVersion)
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("config: KUBEADAPT_TLS_CERT_FILE and KUBEADAPT_TLS_KEY_FILE must be set together")
+	}
+
 	if c.SnapshotInterval < 10*time.Second {
 		return fmt.Errorf("config: SnapshotInterval must be >= 10s, got %v", c.SnapshotInterval)
 	}
@@ -28,6 +46,27 @@ func (c Config) Validate() error {
 		return fmt.Errorf("config: MetricsInterval must be >= 10s, got %v", c.MetricsInterval)
 	}
 
+	if c.MetricsSampleInterval != 0 && (c.MetricsSampleInterval < time.Second || c.MetricsSampleInterval > c.MetricsInterval) {
+		return fmt.Errorf("config: MetricsSampleInterval must be between 1s and MetricsInterval (%v), got %v", c.MetricsInterval, c.MetricsSampleInterval)
+	}
+
+	switch c.MetricsSource {
+	case "", MetricsSourceAuto, MetricsSourceMetricsServer, MetricsSourcePrometheus:
+	default:
+		return fmt.Errorf("config: KUBEADAPT_METRICS_SOURCE must be auto, metrics-server or prometheus, got %q", c.MetricsSource)
+	}
+	if c.MetricsSource == MetricsSourcePrometheus && c.PrometheusURL == "" {
+		return fmt.Errorf("config: KUBEADAPT_PROMETHEUS_URL is required when KUBEADAPT_METRICS_SOURCE is prometheus")
+	}
+	if c.PrometheusURL != "" {
+		if u, err := url.Parse(c.PrometheusURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("config: KUBEADAPT_PROMETHEUS_URL must be an http:// or https:// URL, got %q", c.PrometheusURL)
+		}
+		if c.PrometheusNodeCPUQuery == "" || c.PrometheusNodeMemoryQuery == "" || c.PrometheusContainerCPUQuery == "" || c.PrometheusContainerMemQuery == "" {
+			return fmt.Errorf("config: KUBEADAPT_PROMETHEUS_*_QUERY must not be empty")
+		}
+	}
+
 	if c.CompressionLevel < 1 || c.CompressionLevel > 4 {
 		return fmt.Errorf("config: CompressionLevel must be 1-4, got %d", c.CompressionLevel)
 	}
@@ -36,9 +75,92 @@ func (c Config) Validate() error {
 		return fmt.Errorf("config: MaxRetries must be >= 0, got %d", c.MaxRetries)
 	}
 
+	if c.BufferDir != "" && c.BufferMaxBytes <= 0 {
+		return fmt.Errorf("config: BufferMaxBytes must be > 0 when KUBEADAPT_BUFFER_DIR is set, got %d", c.BufferMaxBytes)
+	}
+
+	switch c.WireFormat {
+	case "", WireFormatJSON, WireFormatProtobuf:
+	default:
+		return fmt.Errorf("config: KUBEADAPT_WIRE_FORMAT must be json or protobuf, got %q", c.WireFormat)
+	}
+
+	switch c.CompressionDictionary {
+	case "", CompressionDictionaryOff, CompressionDictionaryBundled, CompressionDictionaryBackend:
+	default:
+		return fmt.Errorf("config: KUBEADAPT_COMPRESSION_DICTIONARY must be off, bundled or backend, got %q", c.CompressionDictionary)
+	}
+
+	if c.DeltaKeyframeInterval < 0 {
+		return fmt.Errorf("config: DeltaKeyframeInterval must be >= 0, got %d", c.DeltaKeyframeInterval)
+	}
+
+	if c.LeaderElection {
+		if c.PodNamespace == "" || c.PodName == "" {
+			return fmt.Errorf("config: POD_NAMESPACE and POD_NAME are required when KUBEADAPT_LEADER_ELECTION is enabled")
+		}
+		if c.LeaderElectionLeaseName == "" {
+			return fmt.Errorf("config: KUBEADAPT_LEADER_ELECTION_LEASE_NAME is required when KUBEADAPT_LEADER_ELECTION is enabled")
+		}
+		if c.LeaderElectionRetryPeriod <= 0 ||
+			c.LeaderElectionRenewDeadline <= c.LeaderElectionRetryPeriod ||
+			c.LeaderElectionLeaseDuration <= c.LeaderElectionRenewDeadline {
+			return fmt.Errorf("config: leader election durations must satisfy LeaseDuration > RenewDeadline > RetryPeriod > 0, got %v, %v, %v",
+				c.LeaderElectionLeaseDuration, c.LeaderElectionRenewDeadline, c.LeaderElectionRetryPeriod)
+		}
+	}
+
+	if (c.KubeletStatsEnabled || c.CAdvisorMetricsEnabled) && c.KubeletStatsConcurrency < 1 {
+		return fmt.Errorf("config: KubeletStatsConcurrency must be >= 1, got %d", c.KubeletStatsConcurrency)
+	}
+
+	if c.EventsEnabled && c.EventsBufferSize < 1 {
+		return fmt.Errorf("config: EventsBufferSize must be >= 1, got %d", c.EventsBufferSize)
+	}
+
+	if _, err := scope.NewNamespaces(c.NamespaceInclude, c.NamespaceExclude, c.NamespaceLabelSelector); err != nil {
+		return fmt.Errorf("config: namespace scope: %w", err)
+	}
+
+	if c.RedactHashNames && len(c.RedactHashSalt) < minRedactHashSaltLen {
+		return fmt.Errorf("config: KUBEADAPT_REDACT_HASH_SALT must be at least %d bytes when KUBEADAPT_REDACT_HASH_NAMES is enabled", minRedactHashSaltLen)
+	}
+	if _, err := redact.NewPolicy(c.RedactionRules()); err != nil {
+		return fmt.Errorf("config: redaction policy: %w", err)
+	}
+
+	if c.PricingCPUCoreHour < 0 || c.PricingMemoryGBHour < 0 || c.PricingGPUHour < 0 {
+		return fmt.Errorf("config: KUBEADAPT_PRICING_* rates must be >= 0")
+	}
+	if c.PricingEnabled {
+		if _, err := pricing.Load(c.PricingCatalogFile); err != nil {
+			return fmt.Errorf("config: %w", err)
+		}
+	}
+
+	if c.RightsizingEnabled && c.RightsizingWindow < time.Hour {
+		return fmt.Errorf("config: KUBEADAPT_RIGHTSIZING_WINDOW must be >= 1h when KUBEADAPT_RIGHTSIZING_ENABLED is true, got %v", c.RightsizingWindow)
+	}
+
+	if c.GPUIdleEnabled {
+		if c.GPUIdleWindow < 10*time.Minute {
+			return fmt.Errorf("config: KUBEADAPT_GPU_IDLE_WINDOW must be >= 10m when KUBEADAPT_GPU_IDLE_ENABLED is true, got %v", c.GPUIdleWindow)
+		}
+		if c.GPUIdleUtilizationThreshold <= 0 || c.GPUIdleUtilizationThreshold > 100 {
+			return fmt.Errorf("config: KUBEADAPT_GPU_IDLE_UTILIZATION_THRESHOLD must be in (0, 100], got %v", c.GPUIdleUtilizationThreshold)
+		}
+		if c.GPUIdleTensorThreshold < 0 || c.GPUIdleTensorThreshold > 100 {
+			return fmt.Errorf("config: KUBEADAPT_GPU_IDLE_TENSOR_THRESHOLD must be in [0, 100], got %v", c.GPUIdleTensorThreshold)
+		}
+	}
+
 	if c.HealthPort < 1 || c.HealthPort > 65535 {
 		return fmt.Errorf("config: HealthPort must be 1-65535, got %d", c.HealthPort)
 	}
 
+	if _, err := c.SlogLevel(); err != nil {
+		return fmt.Errorf("config: KUBEADAPT_LOG_LEVEL must be debug, info, warn or error, got %q", c.LogLevel)
+	}
+
 	return nil
 }
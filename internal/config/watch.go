@@ -0,0 +1,120 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"reflect"
+	"time"
+)
+
+// watchInterval is how often Watcher checks the config file. Kubelet syncs
+// mounted ConfigMaps about once a minute, so polling is not the bottleneck.
+const watchInterval = 10 * time.Second
+
+// applyReloadable copies the fields that can change without a restart from
+// src to dst: intervals, the log level and the redaction policy.
+func applyReloadable(dst *Config, src Config) {
+	dst.SnapshotInterval = src.SnapshotInterval
+	dst.MetricsInterval = src.MetricsInterval
+	dst.MetricsSampleInterval = src.MetricsSampleInterval
+	dst.GPUMetricsInterval = src.GPUMetricsInterval
+	dst.LogLevel = src.LogLevel
+	dst.RedactLabelAllow = src.RedactLabelAllow
+	dst.RedactLabelDeny = src.RedactLabelDeny
+	dst.RedactAnnotationAllow = src.RedactAnnotationAllow
+	dst.RedactAnnotationDeny = src.RedactAnnotationDeny
+	dst.RedactValuePatterns = src.RedactValuePatterns
+	dst.RedactHashNames = src.RedactHashNames
+	dst.RedactHashSalt = src.RedactHashSalt
+}
+
+// Watcher polls the config file and passes changes to the reloadable fields
+// to an apply function. A changed file is loaded like at startup, with
+// environment variables still taking precedence, and must pass Validate;
+// otherwise it is ignored. Changes to other fields are logged and left for
+// the next restart.
+type Watcher struct {
+	current  Config
+	apply    func(prev, next Config)
+	interval time.Duration
+	lastData []byte
+}
+
+// NewWatcher creates a Watcher for current.ConfigFile. apply is called from
+// the Run goroutine with the previous and new effective config.
+func NewWatcher(current Config, apply func(prev, next Config)) *Watcher {
+	return &Watcher{
+		current:  current,
+		apply:    apply,
+		interval: watchInterval,
+	}
+}
+
+// Run checks the file every watch interval until ctx is canceled.
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// check reloads the file if its content changed since the last check.
+func (w *Watcher) check() {
+	path := w.current.ConfigFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		slog.Warn("config reload: cannot read config file", "path", path, "error", err)
+		return
+	}
+	if w.lastData != nil && bytes.Equal(data, w.lastData) {
+		return
+	}
+	w.lastData = data
+
+	f, err := parseFile(path, data)
+	if err != nil {
+		slog.Error("config reload rejected", "error", err)
+		return
+	}
+	next := load(f)
+	next.ConfigFile = path
+	// Set at startup rather than loaded.
+	next.AgentVersion = w.current.AgentVersion
+	next.KubernetesVersion = w.current.KubernetesVersion
+	if err := next.Validate(); err != nil {
+		slog.Error("config reload rejected", "path", path, "error", err)
+		return
+	}
+
+	applied := w.current
+	applyReloadable(&applied, next)
+	if fields := changedFields(applied, next); len(fields) > 0 {
+		slog.Warn("config reload: changes need a restart to take effect", "fields", fields)
+	}
+	if changed := changedFields(w.current, applied); len(changed) > 0 {
+		slog.Info("config reloaded", "path", path, "fields", changed)
+		prev := w.current
+		w.current = applied
+		w.apply(prev, applied)
+	}
+}
+
+// changedFields returns the names of the Config fields that differ.
+func changedFields(a, b Config) []string {
+	var fields []string
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for i := range va.NumField() {
+		if !reflect.DeepEqual(va.Field(i).Interface(), vb.Field(i).Interface()) {
+			fields = append(fields, va.Type().Field(i).Name)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWatcher_AppliesReloadableFields(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("KUBEADAPT_API_KEY", "test-key")
+	path := writeConfigFile(t, "snapshotInterval: 60s\nhealthPort: 8080\n")
+	current, err := LoadWithFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls []Config
+	w := NewWatcher(current, func(_, next Config) { calls = append(calls, next) })
+
+	// An unchanged file applies nothing.
+	w.check()
+	if len(calls) != 0 {
+		t.Fatalf("expected no reload, got %d", len(calls))
+	}
+
+	// Reloadable fields are applied; the health port needs a restart.
+	writeFile(t, path, "snapshotInterval: 30s\nhealthPort: 9090\nlogLevel: debug\n")
+	w.check()
+	if len(calls) != 1 {
+		t.Fatalf("expected one reload, got %d", len(calls))
+	}
+	if got := calls[0]; got.SnapshotInterval != 30*time.Second || got.LogLevel != "debug" || got.HealthPort != 8080 {
+		t.Errorf("applied SnapshotInterval = %v, LogLevel = %q, HealthPort = %d",
+			got.SnapshotInterval, got.LogLevel, got.HealthPort)
+	}
+
+	// A file that fails Validate is ignored.
+	writeFile(t, path, "snapshotInterval: 1s\n")
+	w.check()
+	if len(calls) != 1 {
+		t.Fatalf("expected the invalid file to be ignored, got %d reloads", len(calls))
+	}
+
+	// Environment variables still win over the file.
+	t.Setenv("KUBEADAPT_METRICS_INTERVAL", "45s")
+	writeFile(t, path, "snapshotInterval: 30s\nmetricsInterval: 20s\n")
+	w.check()
+	if len(calls) != 2 || calls[1].MetricsInterval != 45*time.Second {
+		t.Errorf("expected MetricsInterval from the environment, got %+v", calls)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
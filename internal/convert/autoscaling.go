@@ -29,7 +29,7 @@ func HPAToModel(hpa *autoscalingv2.HorizontalPodAutoscaler) model.HPAInfo {
 		CurrentReplicas: hpa.Status.CurrentReplicas,
 		DesiredReplicas: hpa.Status.DesiredReplicas,
 
-		Labels:            hpa.Labels,
+		Labels:            FilterLabels(hpa.Labels),
 		Annotations:       FilterAnnotations(hpa.Annotations),
 		CreationTimestamp: hpa.CreationTimestamp.UnixMilli(),
 	}
@@ -217,7 +217,7 @@ func VPAToModel(obj *unstructured.Unstructured) model.VPAInfo {
 	info := model.VPAInfo{
 		Name:              obj.GetName(),
 		Namespace:         obj.GetNamespace(),
-		Labels:            obj.GetLabels(),
+		Labels:            FilterLabels(obj.GetLabels()),
 		CreationTimestamp: obj.GetCreationTimestamp().UnixMilli(),
 	}
 
@@ -334,24 +334,15 @@ func PDBToModel(pdb *policyv1.PodDisruptionBudget) model.PDBInfo {
 		DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
 		ExpectedPods:       pdb.Status.ExpectedPods,
 
-		Labels:            pdb.Labels,
+		Labels:            FilterLabels(pdb.Labels),
 		Annotations:       FilterAnnotations(pdb.Annotations),
 		CreationTimestamp: pdb.CreationTimestamp.UnixMilli(),
 	}
 
 	// Selector
 	if pdb.Spec.Selector != nil {
-		info.MatchLabels = pdb.Spec.Selector.MatchLabels
-		if len(pdb.Spec.Selector.MatchExpressions) > 0 {
-			info.MatchExpressions = make([]model.LabelSelectorRequirement, len(pdb.Spec.Selector.MatchExpressions))
-			for i, expr := range pdb.Spec.Selector.MatchExpressions {
-				info.MatchExpressions[i] = model.LabelSelectorRequirement{
-					Key:      expr.Key,
-					Operator: string(expr.Operator),
-					Values:   expr.Values,
-				}
-			}
-		}
+		info.MatchLabels = FilterLabels(pdb.Spec.Selector.MatchLabels)
+		info.MatchExpressions = filterSelectorRequirements(pdb.Spec.Selector.MatchExpressions)
 	}
 
 	// MinAvailable / MaxUnavailable
@@ -406,7 +397,7 @@ func JobToModel(job *batchv1.Job) model.JobInfo {
 
 		ContainerSpecs: extractContainerSpecs(job.Spec.Template.Spec.Containers),
 
-		Labels:            job.Labels,
+		Labels:            FilterLabels(job.Labels),
 		Annotations:       FilterAnnotations(job.Annotations),
 		CreationTimestamp: job.CreationTimestamp.UnixMilli(),
 	}
@@ -471,7 +462,7 @@ func CronJobToModel(cj *batchv1.CronJob) model.CronJobInfo {
 
 		ContainerSpecs: extractContainerSpecs(cj.Spec.JobTemplate.Spec.Template.Spec.Containers),
 
-		Labels:            cj.Labels,
+		Labels:            FilterLabels(cj.Labels),
 		Annotations:       FilterAnnotations(cj.Annotations),
 		CreationTimestamp: cj.CreationTimestamp.UnixMilli(),
 	}
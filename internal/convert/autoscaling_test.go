@@ -12,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
 )
 
 // ---- HPA Tests ----
@@ -386,6 +388,36 @@ func TestPDBToModel_MatchLabels(t *testing.T) {
 	}
 }
 
+func TestPDBToModel_RedactsSelector(t *testing.T) {
+	setRedaction(t, selectorRedaction)
+
+	info := PDBToModel(&policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-pdb", Namespace: "production"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: redactedSelector,
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "owner", Operator: metav1.LabelSelectorOpIn, Values: []string{"jane"}},
+					{Key: "contact", Operator: metav1.LabelSelectorOpIn, Values: []string{"jane@example.com", "ops"}},
+					{Key: "tier", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+		},
+	})
+
+	assertLabels(t, "MatchLabels", info.MatchLabels, wantRedactedSelector)
+	if len(info.MatchExpressions) != 2 {
+		t.Fatalf("MatchExpressions = %+v, want the owner requirement dropped", info.MatchExpressions)
+	}
+	contact := info.MatchExpressions[0]
+	assertEqual(t, "MatchExpressions[0].Key", contact.Key, "contact")
+	assertEqual(t, "MatchExpressions[0].Operator", contact.Operator, "In")
+	if len(contact.Values) != 2 || contact.Values[0] != redact.Mask || contact.Values[1] != "ops" {
+		t.Errorf("MatchExpressions[0].Values = %v, want [%s ops]", contact.Values, redact.Mask)
+	}
+	assertEqual(t, "MatchExpressions[1].Key", info.MatchExpressions[1].Key, "tier")
+}
+
 // ---- Job Tests ----
 
 func TestJobToModel_WithCompletionTime(t *testing.T) {
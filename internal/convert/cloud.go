@@ -4,6 +4,21 @@ import (
 	"strings"
 )
 
+// ProviderName returns the cloud provider ("aws", "gcp" or "azure") named
+// by a node's spec.providerID, or "" for other providers and on-prem nodes.
+func ProviderName(providerID string) string {
+	switch {
+	case strings.HasPrefix(providerID, "aws://"):
+		return "aws"
+	case strings.HasPrefix(providerID, "gce://"):
+		return "gcp"
+	case strings.HasPrefix(providerID, "azure://"):
+		return "azure"
+	default:
+		return ""
+	}
+}
+
 // ParseProviderID extracts cloud instance metadata from a Kubernetes node's
 // spec.providerID field. Pure string parsing — no cloud API calls.
 //
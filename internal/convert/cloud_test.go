@@ -307,3 +307,18 @@ func TestExtractNodeGroup_EKS_Priority_Over_GKE_AKS(t *testing.T) {
 		t.Errorf("ExtractNodeGroup = %q, want %q (EKS should take priority)", got, "eks-group")
 	}
 }
+
+func TestProviderName(t *testing.T) {
+	tests := map[string]string{
+		"aws:///us-east-1a/i-1234567890abcdef0":                        "aws",
+		"gce://my-project/us-central1-a/gke-node-1":                    "gcp",
+		"azure:///subscriptions/s/resourceGroups/r/virtualMachines/vm": "azure",
+		"kind://docker/kind/kind-control-plane":                        "",
+		"":                                                             "",
+	}
+	for providerID, want := range tests {
+		if got := ProviderName(providerID); got != want {
+			t.Errorf("ProviderName(%q) = %q, want %q", providerID, got, want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package convert
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// CustomWorkloadToModel converts an unstructured CRD object that owns pods
+// (Argo Rollout, SparkApplication, InferenceService, ...) to
+// model.CustomWorkloadInfo. Replica counts are read from the conventional
+// spec.replicas and status.readyReplicas (or status.availableReplicas) fields
+// when the CRD follows them; the rest of status is passed through as-is.
+func CustomWorkloadToModel(obj *unstructured.Unstructured) model.CustomWorkloadInfo {
+	info := model.CustomWorkloadInfo{
+		APIVersion:        obj.GetAPIVersion(),
+		Kind:              obj.GetKind(),
+		Name:              obj.GetName(),
+		UID:               string(obj.GetUID()),
+		Namespace:         obj.GetNamespace(),
+		Labels:            FilterLabels(obj.GetLabels()),
+		Annotations:       FilterAnnotations(obj.GetAnnotations()),
+		CreationTimestamp: obj.GetCreationTimestamp().UnixMilli(),
+	}
+
+	// Owner — immediate ownerReferences[0] only
+	if owners := obj.GetOwnerReferences(); len(owners) > 0 {
+		owner := owners[0]
+		info.OwnerKind = owner.Kind
+		info.OwnerName = owner.Name
+		info.OwnerUID = string(owner.UID)
+		info.OwnerAPIVersion = owner.APIVersion
+	}
+
+	if spec, ok := nestedMap(obj.Object, "spec"); ok {
+		info.Replicas = int32Val(spec, "replicas")
+	}
+
+	if status, ok := nestedMap(obj.Object, "status"); ok {
+		info.ReadyReplicas = int32Val(status, "readyReplicas")
+		if info.ReadyReplicas == nil {
+			info.ReadyReplicas = int32Val(status, "availableReplicas")
+		}
+		// Shallow copy so callers can't mutate the informer cache's top level.
+		info.Status = make(map[string]interface{}, len(status))
+		for k, v := range status {
+			info.Status[k] = v
+		}
+	}
+
+	return info
+}
+
+// int32Val reads an integer field from unstructured content. JSON numbers
+// decode as int64 (or float64 for some clients); anything else yields nil.
+func int32Val(m map[string]interface{}, key string) *int32 {
+	var n int32
+	switch v := m[key].(type) {
+	case int64:
+		n = int32(v)
+	case int32:
+		n = v
+	case int:
+		n = int32(v)
+	case float64:
+		n = int32(v)
+	default:
+		return nil
+	}
+	return &n
+}
@@ -0,0 +1,94 @@
+package convert
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCustomWorkloadToModel_Rollout(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Rollout",
+			"metadata": map[string]interface{}{
+				"name":              "checkout",
+				"namespace":         "shop",
+				"uid":               "rollout-uid-1",
+				"creationTimestamp": "2025-06-01T08:00:00Z",
+				"labels":            map[string]interface{}{"app": "checkout"},
+				"annotations": map[string]interface{}{
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					"team": "payments",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(5),
+			},
+			"status": map[string]interface{}{
+				"readyReplicas": int64(4),
+				"phase":         "Progressing",
+			},
+		},
+	}
+
+	info := CustomWorkloadToModel(obj)
+
+	assertEqual(t, "APIVersion", info.APIVersion, "argoproj.io/v1alpha1")
+	assertEqual(t, "Kind", info.Kind, "Rollout")
+	assertEqual(t, "Name", info.Name, "checkout")
+	assertEqual(t, "Namespace", info.Namespace, "shop")
+	assertEqual(t, "UID", info.UID, "rollout-uid-1")
+	if info.Replicas == nil || *info.Replicas != 5 {
+		t.Errorf("Replicas = %v, want 5", info.Replicas)
+	}
+	if info.ReadyReplicas == nil || *info.ReadyReplicas != 4 {
+		t.Errorf("ReadyReplicas = %v, want 4", info.ReadyReplicas)
+	}
+	if info.Status["phase"] != "Progressing" {
+		t.Errorf("Status[phase] = %v, want Progressing", info.Status["phase"])
+	}
+	if _, ok := info.Annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		t.Error("last-applied-configuration annotation should be filtered")
+	}
+	if info.CreationTimestamp == 0 {
+		t.Error("CreationTimestamp should be set")
+	}
+}
+
+func TestCustomWorkloadToModel_OwnerAndAvailableReplicasFallback(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "sparkoperator.k8s.io/v1beta2",
+			"kind":       "SparkApplication",
+			"metadata": map[string]interface{}{
+				"name":      "nightly-20250601",
+				"namespace": "data",
+				"ownerReferences": []interface{}{
+					map[string]interface{}{
+						"apiVersion": "sparkoperator.k8s.io/v1beta2",
+						"kind":       "ScheduledSparkApplication",
+						"name":       "nightly",
+						"uid":        "ssa-uid-1",
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"availableReplicas": int64(2),
+			},
+		},
+	}
+
+	info := CustomWorkloadToModel(obj)
+
+	assertEqual(t, "OwnerKind", info.OwnerKind, "ScheduledSparkApplication")
+	assertEqual(t, "OwnerName", info.OwnerName, "nightly")
+	assertEqual(t, "OwnerUID", info.OwnerUID, "ssa-uid-1")
+	assertEqual(t, "OwnerAPIVersion", info.OwnerAPIVersion, "sparkoperator.k8s.io/v1beta2")
+	if info.Replicas != nil {
+		t.Errorf("Replicas = %v, want nil when spec.replicas is absent", *info.Replicas)
+	}
+	if info.ReadyReplicas == nil || *info.ReadyReplicas != 2 {
+		t.Errorf("ReadyReplicas = %v, want 2 from availableReplicas", info.ReadyReplicas)
+	}
+}
@@ -0,0 +1,73 @@
+package convert
+
+import (
+	eventsv1 "k8s.io/api/events/v1"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// maxEventNoteBytes caps event notes; scheduler notes list every node's
+// rejection reason and can grow large on big clusters.
+const maxEventNoteBytes = 1024
+
+// EventToModel converts an events.k8s.io/v1 Event to model.EventInfo. Events
+// written through the core/v1 API are served by events.k8s.io/v1 as well,
+// with their fields mapped to the Deprecated* fields.
+//
+// Count is the event's lifetime occurrence count. FirstTimestamp and
+// LastTimestamp are the first and latest occurrence as reported by the
+// event, falling back to its creation time.
+// Pure function — no side effects.
+func EventToModel(ev *eventsv1.Event) model.EventInfo {
+	info := model.EventInfo{
+		Type:                ev.Type,
+		Reason:              ev.Reason,
+		Note:                ev.Note,
+		ReportingController: ev.ReportingController,
+
+		InvolvedKind:      ev.Regarding.Kind,
+		InvolvedNamespace: ev.Regarding.Namespace,
+		InvolvedName:      ev.Regarding.Name,
+		InvolvedUID:       string(ev.Regarding.UID),
+
+		Count: 1,
+	}
+	if len(info.Note) > maxEventNoteBytes {
+		info.Note = info.Note[:maxEventNoteBytes]
+	}
+	if info.ReportingController == "" {
+		info.ReportingController = ev.DeprecatedSource.Component
+	}
+
+	switch {
+	case ev.Series != nil && ev.Series.Count > 0:
+		info.Count = ev.Series.Count
+	case ev.DeprecatedCount > 0:
+		info.Count = ev.DeprecatedCount
+	}
+
+	created := ev.CreationTimestamp.UnixMilli()
+	switch {
+	case !ev.DeprecatedFirstTimestamp.IsZero():
+		info.FirstTimestamp = ev.DeprecatedFirstTimestamp.UnixMilli()
+	case !ev.EventTime.IsZero():
+		info.FirstTimestamp = ev.EventTime.UnixMilli()
+	default:
+		info.FirstTimestamp = created
+	}
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		info.LastTimestamp = ev.Series.LastObservedTime.UnixMilli()
+	case !ev.DeprecatedLastTimestamp.IsZero():
+		info.LastTimestamp = ev.DeprecatedLastTimestamp.UnixMilli()
+	case !ev.EventTime.IsZero():
+		info.LastTimestamp = ev.EventTime.UnixMilli()
+	default:
+		info.LastTimestamp = created
+	}
+	if info.LastTimestamp < info.FirstTimestamp {
+		info.LastTimestamp = info.FirstTimestamp
+	}
+
+	return info
+}
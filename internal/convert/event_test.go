@@ -0,0 +1,122 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ---- Event Tests ----
+
+func TestEventToModel_Series(t *testing.T) {
+	first := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	last := first.Add(5 * time.Minute)
+
+	ev := &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web-1.17a",
+			Namespace:         "production",
+			CreationTimestamp: metav1.NewTime(first),
+		},
+		EventTime:           metav1.NewMicroTime(first),
+		Series:              &eventsv1.EventSeries{Count: 7, LastObservedTime: metav1.NewMicroTime(last)},
+		ReportingController: "default-scheduler",
+		Reason:              "FailedScheduling",
+		Note:                "0/3 nodes are available: 3 Insufficient cpu.",
+		Type:                corev1.EventTypeWarning,
+		Regarding: corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: "production",
+			Name:      "web-1",
+			UID:       "pod-uid-1",
+		},
+	}
+
+	info := EventToModel(ev)
+
+	assertEqual(t, "Type", info.Type, "Warning")
+	assertEqual(t, "Reason", info.Reason, "FailedScheduling")
+	assertEqual(t, "ReportingController", info.ReportingController, "default-scheduler")
+	assertEqual(t, "InvolvedKind", info.InvolvedKind, "Pod")
+	assertEqual(t, "InvolvedNamespace", info.InvolvedNamespace, "production")
+	assertEqual(t, "InvolvedName", info.InvolvedName, "web-1")
+	assertEqual(t, "InvolvedUID", info.InvolvedUID, "pod-uid-1")
+	if info.Count != 7 {
+		t.Errorf("Count = %d, want 7", info.Count)
+	}
+	if info.FirstTimestamp != first.UnixMilli() {
+		t.Errorf("FirstTimestamp = %d, want %d", info.FirstTimestamp, first.UnixMilli())
+	}
+	if info.LastTimestamp != last.UnixMilli() {
+		t.Errorf("LastTimestamp = %d, want %d", info.LastTimestamp, last.UnixMilli())
+	}
+}
+
+func TestEventToModel_CoreV1Fields(t *testing.T) {
+	first := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	last := first.Add(time.Minute)
+
+	// An event written through core/v1 has no eventTime or series; its
+	// count, timestamps and source map to the Deprecated* fields.
+	ev := &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "node-1.17b",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(first),
+		},
+		DeprecatedSource:         corev1.EventSource{Component: "node-controller"},
+		DeprecatedFirstTimestamp: metav1.NewTime(first),
+		DeprecatedLastTimestamp:  metav1.NewTime(last),
+		DeprecatedCount:          3,
+		Reason:                   "NodeNotReady",
+		Note:                     "Node node-1 status is now: NodeNotReady",
+		Type:                     corev1.EventTypeNormal,
+		Regarding:                corev1.ObjectReference{Kind: "Node", Name: "node-1"},
+	}
+
+	info := EventToModel(ev)
+
+	assertEqual(t, "ReportingController", info.ReportingController, "node-controller")
+	assertEqual(t, "InvolvedNamespace", info.InvolvedNamespace, "")
+	if info.Count != 3 {
+		t.Errorf("Count = %d, want 3", info.Count)
+	}
+	if info.FirstTimestamp != first.UnixMilli() || info.LastTimestamp != last.UnixMilli() {
+		t.Errorf("timestamps = %d..%d, want %d..%d",
+			info.FirstTimestamp, info.LastTimestamp, first.UnixMilli(), last.UnixMilli())
+	}
+}
+
+func TestEventToModel_Defaults(t *testing.T) {
+	created := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+
+	ev := &eventsv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web.17c",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Reason:    "SuccessfulRescale",
+		Note:      strings.Repeat("x", 2000),
+		Regarding: corev1.ObjectReference{Kind: "HorizontalPodAutoscaler", Namespace: "default", Name: "web"},
+	}
+
+	info := EventToModel(ev)
+
+	// 1. Count defaults to a single occurrence.
+	if info.Count != 1 {
+		t.Errorf("Count = %d, want 1", info.Count)
+	}
+	// 2. Both timestamps fall back to the creation time.
+	if info.FirstTimestamp != created.UnixMilli() || info.LastTimestamp != created.UnixMilli() {
+		t.Errorf("timestamps = %d..%d, want %d", info.FirstTimestamp, info.LastTimestamp, created.UnixMilli())
+	}
+	// 3. Long notes are truncated.
+	if len(info.Note) != maxEventNoteBytes {
+		t.Errorf("len(Note) = %d, want %d", len(info.Note), maxEventNoteBytes)
+	}
+}
@@ -0,0 +1,41 @@
+package convert
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// gpuResources are the extended resources advertised by GPU and accelerator
+// device plugins, with their vendor.
+var gpuResources = []struct {
+	name   corev1.ResourceName
+	vendor string
+}{
+	{"nvidia.com/gpu", model.GPUVendorNVIDIA},
+	{"amd.com/gpu", model.GPUVendorAMD},
+	{"gpu.intel.com/i915", model.GPUVendorIntel},
+	{"gpu.intel.com/xe", model.GPUVendorIntel},
+	{"habana.ai/gaudi", model.GPUVendorHabana},
+}
+
+// GPUCount returns the number of GPUs in a resource list, summed over the
+// resource names of all vendors.
+func GPUCount(rl corev1.ResourceList) int {
+	var n int64
+	for _, r := range gpuResources {
+		n += quantityValue(rl, r.name)
+	}
+	return int(n)
+}
+
+// GPUVendor returns the vendor of the first GPU resource with a non-zero
+// quantity in rl, or "" if there is none.
+func GPUVendor(rl corev1.ResourceList) string {
+	for _, r := range gpuResources {
+		if quantityValue(rl, r.name) > 0 {
+			return r.vendor
+		}
+	}
+	return ""
+}
@@ -1,12 +1,63 @@
 package convert
 
 import (
+	"sync/atomic"
+
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
+// redaction is the policy FilterLabels and FilterAnnotations apply. It is
+// package state rather than a converter argument so that every collector
+// picks it up without threading it through; nil redacts nothing.
+var redaction atomic.Pointer[redact.Policy]
+
+// SetRedactionPolicy sets the policy applied to labels and annotations by
+// every converter. Objects already in the store keep the policy they were
+// converted with until their next update.
+func SetRedactionPolicy(p *redact.Policy) {
+	redaction.Store(p)
+}
+
+// FilterLabels returns labels with the redaction policy applied. Without a
+// policy the map is returned as is.
+func FilterLabels(labels map[string]string) map[string]string {
+	return redaction.Load().Labels(labels)
+}
+
+// filterSelectorRequirements converts label selector requirements with the
+// redaction policy applied like FilterLabels: requirements on dropped keys
+// are removed and values are masked.
+func filterSelectorRequirements(reqs []metav1.LabelSelectorRequirement) []model.LabelSelectorRequirement {
+	p := redaction.Load()
+	var out []model.LabelSelectorRequirement
+	for _, r := range reqs {
+		if !p.AllowsLabel(r.Key) {
+			continue
+		}
+		values := r.Values
+		if p != nil && len(values) > 0 {
+			values = make([]string, len(r.Values))
+			for i, v := range r.Values {
+				values[i] = p.LabelValue(v)
+			}
+		}
+		out = append(out, model.LabelSelectorRequirement{
+			Key:      r.Key,
+			Operator: string(r.Operator),
+			Values:   values,
+		})
+	}
+	return out
+}
+
 // FilterAnnotations returns a filtered copy of the annotations map.
-// It skips kubectl.kubernetes.io/last-applied-configuration entirely
-// and truncates any value longer than 1024 bytes.
+// It skips kubectl.kubernetes.io/last-applied-configuration entirely,
+// truncates any value longer than 1024 bytes and applies the redaction
+// policy.
 func FilterAnnotations(annotations map[string]string) map[string]string {
 	if annotations == nil {
 		return nil
@@ -22,7 +73,7 @@ func FilterAnnotations(annotations map[string]string) map[string]string {
 		}
 		filtered[k] = v
 	}
-	return filtered
+	return redaction.Load().Annotations(filtered)
 }
 
 // ParseQuantity converts a K8s resource.Quantity to float64.
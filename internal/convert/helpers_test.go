@@ -4,7 +4,11 @@ import (
 	"strings"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
 )
 
 func TestFilterAnnotations_Nil(t *testing.T) {
@@ -77,6 +81,49 @@ func TestFilterAnnotations_DoesNotMutateInput(t *testing.T) {
 	}
 }
 
+func TestFilterLabels_NoPolicy(t *testing.T) {
+	in := map[string]string{"app": "web"}
+	got := FilterLabels(in)
+	if got["app"] != "web" || len(got) != 1 {
+		t.Fatalf("expected labels unchanged, got %v", got)
+	}
+}
+
+func TestFilter_RedactionPolicy(t *testing.T) {
+	p, err := redact.NewPolicy(redact.Rules{
+		LabelDeny:      []string{"owner"},
+		AnnotationDeny: []string{"example.com/*"},
+		ValuePatterns:  []string{`[\w.]+@[\w.]+`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetRedactionPolicy(p)
+	t.Cleanup(func() { SetRedactionPolicy(nil) })
+
+	labels := FilterLabels(map[string]string{"app": "web", "owner": "jane"})
+	if _, ok := labels["owner"]; ok || labels["app"] != "web" {
+		t.Errorf("FilterLabels = %v", labels)
+	}
+
+	ann := FilterAnnotations(map[string]string{
+		"example.com/customer": "Acme",
+		"contact":              "jane@example.com",
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+	})
+	if len(ann) != 1 || ann["contact"] != redact.Mask {
+		t.Errorf("FilterAnnotations = %v", ann)
+	}
+
+	// Converters pick the policy up.
+	pod := PodToModel(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "web", Labels: map[string]string{"owner": "jane"},
+	}})
+	if len(pod.Labels) != 0 {
+		t.Errorf("pod labels = %v, want owner dropped", pod.Labels)
+	}
+}
+
 func TestParseQuantity_CPU(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -21,9 +21,9 @@ func ServiceToModel(svc *corev1.Service) model.ServiceInfo {
 		ClusterIP:  svc.Spec.ClusterIP,
 		ClusterIPs: svc.Spec.ClusterIPs,
 
-		Selector: svc.Spec.Selector,
+		Selector: FilterLabels(svc.Spec.Selector),
 
-		Labels:            svc.Labels,
+		Labels:            FilterLabels(svc.Labels),
 		Annotations:       FilterAnnotations(svc.Annotations),
 		CreationTimestamp: svc.CreationTimestamp.UnixMilli(),
 
@@ -89,7 +89,7 @@ func IngressToModel(ing *networkingv1.Ingress) model.IngressInfo {
 		Name:      ing.Name,
 		Namespace: ing.Namespace,
 
-		Labels:            ing.Labels,
+		Labels:            FilterLabels(ing.Labels),
 		Annotations:       FilterAnnotations(ing.Annotations),
 		CreationTimestamp: ing.CreationTimestamp.UnixMilli(),
 	}
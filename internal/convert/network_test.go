@@ -261,3 +261,13 @@ func TestIngressToModel_RulesTLSDefaultBackend(t *testing.T) {
 	}
 	assertEqual(t, "LBHostname", info.LoadBalancerHostnames[0], "k8s-ingress.us-east-1.elb.amazonaws.com")
 }
+
+func TestServiceToModel_RedactsSelector(t *testing.T) {
+	setRedaction(t, selectorRedaction)
+
+	info := ServiceToModel(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-svc", Namespace: "production"},
+		Spec:       corev1.ServiceSpec{Selector: redactedSelector},
+	})
+	assertLabels(t, "Selector", info.Selector, wantRedactedSelector)
+}
@@ -52,13 +52,14 @@ func NodeToModel(node *corev1.Node) model.NodeInfo {
 		EphemeralStorageBytes:       quantityValue(node.Status.Capacity, corev1.ResourceEphemeralStorage),
 		EphemeralStorageAllocatable: quantityValue(node.Status.Allocatable, corev1.ResourceEphemeralStorage),
 		PodCapacity:                 int(quantityValue(node.Status.Capacity, corev1.ResourcePods)),
-		GPUCapacity:                 int(quantityValue(node.Status.Capacity, "nvidia.com/gpu")),
+		GPUCapacity:                 GPUCount(node.Status.Capacity),
 
 		// Allocatable
 		CPUAllocatable:    ParseQuantity(node.Status.Allocatable[corev1.ResourceCPU]),
 		MemoryAllocatable: quantityValue(node.Status.Allocatable, corev1.ResourceMemory),
 		PodAllocatable:    int(quantityValue(node.Status.Allocatable, corev1.ResourcePods)),
-		GPUAllocatable:    int(quantityValue(node.Status.Allocatable, "nvidia.com/gpu")),
+		GPUAllocatable:    GPUCount(node.Status.Allocatable),
+		GPUVendor:         GPUVendor(node.Status.Capacity),
 
 		// Usage left nil — merged later from metrics collector
 
@@ -67,7 +68,7 @@ func NodeToModel(node *corev1.Node) model.NodeInfo {
 		Taints:        convertTaints(node.Spec.Taints),
 		Conditions:    convertConditions(node.Status.Conditions),
 
-		Labels:            labels,
+		Labels:            FilterLabels(labels),
 		Annotations:       FilterAnnotations(node.Annotations),
 		CreationTimestamp: node.CreationTimestamp.UnixMilli(),
 	}
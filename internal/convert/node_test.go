@@ -158,6 +158,28 @@ func TestNodeToModel_GPUNode(t *testing.T) {
 
 	assertInt(t, "GPUCapacity", got.GPUCapacity, 4)
 	assertInt(t, "GPUAllocatable", got.GPUAllocatable, 4)
+	assertEqual(t, "GPUVendor", got.GPUVendor, "nvidia")
+}
+
+func TestNodeToModel_AcceleratorVendors(t *testing.T) {
+	for resourceName, vendor := range map[corev1.ResourceName]string{
+		"amd.com/gpu":        "amd",
+		"gpu.intel.com/i915": "intel",
+		"habana.ai/gaudi":    "habana",
+	} {
+		node := makeNode()
+		node.Status.Capacity[resourceName] = resource.MustParse("8")
+		node.Status.Allocatable[resourceName] = resource.MustParse("8")
+
+		got := NodeToModel(node)
+
+		assertInt(t, string(resourceName)+" GPUCapacity", got.GPUCapacity, 8)
+		assertInt(t, string(resourceName)+" GPUAllocatable", got.GPUAllocatable, 8)
+		assertEqual(t, string(resourceName)+" GPUVendor", got.GPUVendor, vendor)
+	}
+
+	got := NodeToModel(makeNode())
+	assertEqual(t, "GPUVendor", got.GPUVendor, "")
 }
 
 func TestNodeToModel_SpotInstance(t *testing.T) {
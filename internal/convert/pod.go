@@ -20,7 +20,7 @@ func PodToModel(pod *corev1.Pod) model.PodInfo {
 		Reason:    pod.Status.Reason,
 		QoSClass:  string(pod.Status.QOSClass),
 
-		Labels:            pod.Labels,
+		Labels:            FilterLabels(pod.Labels),
 		Annotations:       FilterAnnotations(pod.Annotations),
 		CreationTimestamp: pod.CreationTimestamp.UnixMilli(),
 
@@ -34,6 +34,8 @@ func PodToModel(pod *corev1.Pod) model.PodInfo {
 		HostNetwork: pod.Spec.HostNetwork,
 		HasHostPath: hasHostPathVolume(pod.Spec.Volumes),
 		HasEmptyDir: hasEmptyDirVolume(pod.Spec.Volumes),
+
+		Volumes: convertVolumes(pod.Name, pod.Spec.Volumes),
 	}
 
 	// Owner — immediate ownerReferences[0] only
@@ -42,6 +44,7 @@ func PodToModel(pod *corev1.Pod) model.PodInfo {
 		info.OwnerKind = owner.Kind
 		info.OwnerName = owner.Name
 		info.OwnerUID = string(owner.UID)
+		info.OwnerAPIVersion = owner.APIVersion
 	}
 
 	// Build status lookup maps by container name
@@ -94,8 +97,8 @@ func containerToModel(status corev1.ContainerStatus, spec corev1.Container, hasS
 		MemoryLimitBytes:        quantityValue(spec.Resources.Limits, corev1.ResourceMemory),
 		EphemeralStorageRequest: quantityValue(spec.Resources.Requests, corev1.ResourceEphemeralStorage),
 		EphemeralStorageLimit:   quantityValue(spec.Resources.Limits, corev1.ResourceEphemeralStorage),
-		GPURequest:              int(quantityValue(spec.Resources.Requests, "nvidia.com/gpu")),
-		GPULimit:                int(quantityValue(spec.Resources.Limits, "nvidia.com/gpu")),
+		GPURequest:              GPUCount(spec.Resources.Requests),
+		GPULimit:                GPUCount(spec.Resources.Limits),
 
 		// Ports from spec
 		Ports: convertContainerPorts(spec.Ports),
@@ -183,6 +186,93 @@ func hasEmptyDirVolume(volumes []corev1.Volume) bool {
 	return false
 }
 
+// convertVolumes converts pod spec volumes to model PodVolumeInfo slice.
+func convertVolumes(podName string, volumes []corev1.Volume) []model.PodVolumeInfo {
+	if len(volumes) == 0 {
+		return nil
+	}
+	out := make([]model.PodVolumeInfo, len(volumes))
+	for i, v := range volumes {
+		out[i] = volumeToModel(podName, v)
+	}
+	return out
+}
+
+// volumeToModel converts a single pod volume, recording the reference fields
+// for its source type.
+func volumeToModel(podName string, v corev1.Volume) model.PodVolumeInfo {
+	info := model.PodVolumeInfo{Name: v.Name}
+	src := v.VolumeSource
+
+	switch {
+	case src.PersistentVolumeClaim != nil:
+		info.Type = model.VolumeTypePVC
+		info.ClaimName = src.PersistentVolumeClaim.ClaimName
+		info.ReadOnly = src.PersistentVolumeClaim.ReadOnly
+	case src.Ephemeral != nil:
+		info.Type = model.VolumeTypeEphemeral
+		// The ephemeral volume controller names the PVC "<pod>-<volume>".
+		info.ClaimName = podName + "-" + v.Name
+		if tmpl := src.Ephemeral.VolumeClaimTemplate; tmpl != nil {
+			if tmpl.Spec.StorageClassName != nil {
+				info.StorageClassName = *tmpl.Spec.StorageClassName
+			}
+			info.RequestedBytes = quantityValue(tmpl.Spec.Resources.Requests, corev1.ResourceStorage)
+		}
+	case src.ConfigMap != nil:
+		info.Type = model.VolumeTypeConfigMap
+		info.ConfigMapName = src.ConfigMap.Name
+		info.ReadOnly = true
+	case src.Secret != nil:
+		info.Type = model.VolumeTypeSecret
+		info.SecretName = src.Secret.SecretName
+		info.ReadOnly = true
+	case src.Projected != nil:
+		info.Type = model.VolumeTypeProjected
+		info.ProjectedSources = projectedSourceTypes(src.Projected.Sources)
+		info.ReadOnly = true
+	case src.CSI != nil:
+		info.Type = model.VolumeTypeCSI
+		info.CSIDriver = src.CSI.Driver
+		if src.CSI.ReadOnly != nil {
+			info.ReadOnly = *src.CSI.ReadOnly
+		}
+	case src.EmptyDir != nil:
+		info.Type = model.VolumeTypeEmptyDir
+		if src.EmptyDir.SizeLimit != nil {
+			info.SizeLimitBytes = src.EmptyDir.SizeLimit.Value()
+		}
+	case src.HostPath != nil:
+		info.Type = model.VolumeTypeHostPath
+	default:
+		info.Type = model.VolumeTypeOther
+	}
+	return info
+}
+
+// projectedSourceTypes lists the source type of each projected volume source.
+func projectedSourceTypes(sources []corev1.VolumeProjection) []string {
+	if len(sources) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(sources))
+	for _, s := range sources {
+		switch {
+		case s.ConfigMap != nil:
+			out = append(out, "configMap")
+		case s.Secret != nil:
+			out = append(out, "secret")
+		case s.DownwardAPI != nil:
+			out = append(out, "downwardAPI")
+		case s.ServiceAccountToken != nil:
+			out = append(out, "serviceAccountToken")
+		case s.ClusterTrustBundle != nil:
+			out = append(out, "clusterTrustBundle")
+		}
+	}
+	return out
+}
+
 // convertPodConditions converts K8s PodConditions to model PodConditionInfo slice.
 func convertPodConditions(conditions []corev1.PodCondition) []model.PodConditionInfo {
 	if len(conditions) == 0 {
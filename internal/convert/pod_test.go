@@ -632,6 +632,17 @@ func TestPodToModel_GPUContainer(t *testing.T) {
 	assertInt64(t, "MemoryLimitBytes", c.MemoryLimitBytes, 64*1024*1024*1024)
 	assertInt(t, "GPURequest", c.GPURequest, 2)
 	assertInt(t, "GPULimit", c.GPULimit, 2)
+
+	// Other vendors' device plugin resources count as GPUs too.
+	trainer := &pod.Spec.Containers[0]
+	for _, rl := range []corev1.ResourceList{trainer.Resources.Requests, trainer.Resources.Limits} {
+		delete(rl, "nvidia.com/gpu")
+		rl["amd.com/gpu"] = resource.MustParse("1")
+		rl["habana.ai/gaudi"] = resource.MustParse("2")
+	}
+	c = PodToModel(pod).Containers[0]
+	assertInt(t, "GPURequest", c.GPURequest, 3)
+	assertInt(t, "GPULimit", c.GPULimit, 3)
 }
 
 // 9. Pod with ownerReferences (ReplicaSet owner) — covered by RunningPod test
@@ -739,3 +750,98 @@ func TestPodToModel_Conditions(t *testing.T) {
 		assertEqual(t, "Condition.Status", got.Conditions[i].Status, exp.status)
 	}
 }
+
+// 13. Volumes (PVC, ephemeral, configMap, secret, projected, CSI inline)
+func TestPodToModel_Volumes(t *testing.T) {
+	storageClass := "gp3"
+	readOnly := true
+	sizeLimit := resource.MustParse("1Gi")
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "app:v1"}},
+			Volumes: []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-worker-0"},
+				}},
+				{Name: "scratch", VolumeSource: corev1.VolumeSource{
+					Ephemeral: &corev1.EphemeralVolumeSource{
+						VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+							Spec: corev1.PersistentVolumeClaimSpec{
+								StorageClassName: &storageClass,
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+								},
+							},
+						},
+					},
+				}},
+				{Name: "config", VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"}},
+				}},
+				{Name: "creds", VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "app-creds"},
+				}},
+				{Name: "kube-api-access", VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+						{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}},
+						{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "kube-root-ca.crt"}}},
+						{DownwardAPI: &corev1.DownwardAPIProjection{}},
+					}},
+				}},
+				{Name: "secrets-store", VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{Driver: "secrets-store.csi.k8s.io", ReadOnly: &readOnly},
+				}},
+				{Name: "tmp", VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &sizeLimit},
+				}},
+				{Name: "nfs", VolumeSource: corev1.VolumeSource{
+					NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/"},
+				}},
+			},
+		},
+	}
+
+	got := PodToModel(pod)
+
+	if len(got.Volumes) != 8 {
+		t.Fatalf("expected 8 volumes, got %d", len(got.Volumes))
+	}
+
+	pvc := got.Volumes[0]
+	assertEqual(t, "PVC.Type", pvc.Type, "persistentVolumeClaim")
+	assertEqual(t, "PVC.ClaimName", pvc.ClaimName, "data-worker-0")
+
+	eph := got.Volumes[1]
+	assertEqual(t, "Ephemeral.Type", eph.Type, "ephemeral")
+	assertEqual(t, "Ephemeral.ClaimName", eph.ClaimName, "worker-0-scratch")
+	assertEqual(t, "Ephemeral.StorageClassName", eph.StorageClassName, "gp3")
+	if eph.RequestedBytes != 10<<30 {
+		t.Errorf("Ephemeral.RequestedBytes: got %d, want %d", eph.RequestedBytes, int64(10<<30))
+	}
+
+	assertEqual(t, "ConfigMap.Type", got.Volumes[2].Type, "configMap")
+	assertEqual(t, "ConfigMap.ConfigMapName", got.Volumes[2].ConfigMapName, "app-config")
+	assertEqual(t, "Secret.Type", got.Volumes[3].Type, "secret")
+	assertEqual(t, "Secret.SecretName", got.Volumes[3].SecretName, "app-creds")
+
+	proj := got.Volumes[4]
+	assertEqual(t, "Projected.Type", proj.Type, "projected")
+	if len(proj.ProjectedSources) != 3 || proj.ProjectedSources[0] != "serviceAccountToken" ||
+		proj.ProjectedSources[1] != "configMap" || proj.ProjectedSources[2] != "downwardAPI" {
+		t.Errorf("Projected.ProjectedSources: got %v", proj.ProjectedSources)
+	}
+
+	csi := got.Volumes[5]
+	assertEqual(t, "CSI.Type", csi.Type, "csi")
+	assertEqual(t, "CSI.CSIDriver", csi.CSIDriver, "secrets-store.csi.k8s.io")
+	if !csi.ReadOnly {
+		t.Error("CSI.ReadOnly: expected true")
+	}
+
+	assertEqual(t, "EmptyDir.Type", got.Volumes[6].Type, "emptyDir")
+	if got.Volumes[6].SizeLimitBytes != 1<<30 {
+		t.Errorf("EmptyDir.SizeLimitBytes: got %d, want %d", got.Volumes[6].SizeLimitBytes, int64(1<<30))
+	}
+	assertEqual(t, "NFS.Type", got.Volumes[7].Type, "other")
+}
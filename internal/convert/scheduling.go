@@ -3,6 +3,7 @@ package convert
 import (
 	corev1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
@@ -66,7 +67,7 @@ func ResourceQuotaToModel(rq *corev1.ResourceQuota) model.ResourceQuotaInfo {
 	info := model.ResourceQuotaInfo{
 		Name:      rq.Name,
 		Namespace: rq.Namespace,
-		Labels:    rq.Labels,
+		Labels:    FilterLabels(rq.Labels),
 	}
 
 	info.Hard = resourceListToStringMap(rq.Spec.Hard)
@@ -75,13 +76,19 @@ func ResourceQuotaToModel(rq *corev1.ResourceQuota) model.ResourceQuotaInfo {
 	return info
 }
 
-// NamespaceToModel converts a Kubernetes Namespace to model.NamespaceInfo.
+// NamespaceToModel converts Namespace metadata to model.NamespaceInfo.
+// Namespaces are watched metadata-only; the phase is derived from the
+// deletion timestamp, which is what the namespace controller keys it on.
 // Pure function — no side effects.
-func NamespaceToModel(ns *corev1.Namespace) model.NamespaceInfo {
+func NamespaceToModel(ns *metav1.PartialObjectMetadata) model.NamespaceInfo {
+	phase := corev1.NamespaceActive
+	if ns.DeletionTimestamp != nil {
+		phase = corev1.NamespaceTerminating
+	}
 	return model.NamespaceInfo{
 		Name:              ns.Name,
-		Phase:             string(ns.Status.Phase),
-		Labels:            ns.Labels,
+		Phase:             string(phase),
+		Labels:            FilterLabels(ns.Labels),
 		Annotations:       FilterAnnotations(ns.Annotations),
 		CreationTimestamp: ns.CreationTimestamp.UnixMilli(),
 	}
@@ -136,7 +136,7 @@ func TestResourceQuotaToModel_HardUsed(t *testing.T) {
 // ---- Namespace Tests ----
 
 func TestNamespaceToModel(t *testing.T) {
-	ns := &corev1.Namespace{
+	ns := &metav1.PartialObjectMetadata{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              "production",
 			CreationTimestamp: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
@@ -150,9 +150,6 @@ func TestNamespaceToModel(t *testing.T) {
 				"team": "platform",
 			},
 		},
-		Status: corev1.NamespaceStatus{
-			Phase: corev1.NamespaceActive,
-		},
 	}
 
 	info := NamespaceToModel(ns)
@@ -172,3 +169,17 @@ func TestNamespaceToModel(t *testing.T) {
 		t.Errorf("CreationTimestamp: want %d, got %d", expectedTS, info.CreationTimestamp)
 	}
 }
+
+func TestNamespaceToModel_Terminating(t *testing.T) {
+	deleted := metav1.NewTime(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	ns := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "old-team",
+			DeletionTimestamp: &deleted,
+		},
+	}
+
+	info := NamespaceToModel(ns)
+
+	assertEqual(t, "Phase", info.Phase, "Terminating")
+}
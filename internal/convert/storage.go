@@ -16,7 +16,7 @@ func PVToModel(pv *corev1.PersistentVolume) model.PVInfo {
 		Phase:            string(pv.Status.Phase),
 		MountOptions:     pv.Spec.MountOptions,
 
-		Labels:            pv.Labels,
+		Labels:            FilterLabels(pv.Labels),
 		Annotations:       FilterAnnotations(pv.Annotations),
 		CreationTimestamp: pv.CreationTimestamp.UnixMilli(),
 	}
@@ -105,7 +105,7 @@ func PVCToModel(pvc *corev1.PersistentVolumeClaim) model.PVCInfo {
 		Phase:      string(pvc.Status.Phase),
 		VolumeName: pvc.Spec.VolumeName,
 
-		Labels:            pvc.Labels,
+		Labels:            FilterLabels(pvc.Labels),
 		Annotations:       FilterAnnotations(pvc.Annotations),
 		CreationTimestamp: pvc.CreationTimestamp.UnixMilli(),
 	}
@@ -166,8 +166,8 @@ func StorageClassToModel(sc *storagev1.StorageClass) model.StorageClassInfo {
 		Provisioner:  sc.Provisioner,
 		Parameters:   sc.Parameters,
 		MountOptions: sc.MountOptions,
-		Labels:       sc.Labels,
-		Annotations:  sc.Annotations,
+		Labels:       FilterLabels(sc.Labels),
+		Annotations:  FilterAnnotations(sc.Annotations),
 	}
 
 	// ReclaimPolicy
@@ -1,8 +1,47 @@
 package convert
 
-import "k8s.io/apimachinery/pkg/api/resource"
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+)
 
 func quantityPtr(s string) *resource.Quantity {
 	q := resource.MustParse(s)
 	return &q
 }
+
+// selectorRedaction drops "owner" labels and masks e-mail addresses.
+var selectorRedaction = redact.Rules{
+	LabelDeny:     []string{"owner"},
+	ValuePatterns: []string{`[\w.]+@[\w.]+`},
+}
+
+// redactedSelector is a selector that selectorRedaction changes, and
+// wantRedactedSelector what is left of it.
+var (
+	redactedSelector     = map[string]string{"app": "web", "owner": "jane", "contact": "jane@example.com"}
+	wantRedactedSelector = map[string]string{"app": "web", "contact": redact.Mask}
+)
+
+// setRedaction applies the policy built from r to every converter for the
+// rest of the test.
+func setRedaction(t *testing.T, r redact.Rules) {
+	t.Helper()
+	p, err := redact.NewPolicy(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetRedactionPolicy(p)
+	t.Cleanup(func() { SetRedactionPolicy(nil) })
+}
+
+func assertLabels(t *testing.T, field string, got, want map[string]string) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", field, got, want)
+	}
+}
@@ -3,6 +3,7 @@ package convert
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
@@ -29,7 +30,7 @@ func DeploymentToModel(dep *appsv1.Deployment) model.DeploymentInfo {
 
 		ContainerSpecs: extractContainerSpecs(dep.Spec.Template.Spec.Containers),
 
-		Labels:            dep.Labels,
+		Labels:            FilterLabels(dep.Labels),
 		Annotations:       FilterAnnotations(dep.Annotations),
 		CreationTimestamp: dep.CreationTimestamp.UnixMilli(),
 
@@ -38,7 +39,7 @@ func DeploymentToModel(dep *appsv1.Deployment) model.DeploymentInfo {
 
 	// Selector
 	if dep.Spec.Selector != nil {
-		info.Selector = dep.Spec.Selector.MatchLabels
+		info.Selector = FilterLabels(dep.Spec.Selector.MatchLabels)
 	}
 
 	// RollingUpdate strategy params
@@ -81,14 +82,14 @@ func StatefulSetToModel(ss *appsv1.StatefulSet) model.StatefulSetInfo {
 
 		ContainerSpecs: extractContainerSpecs(ss.Spec.Template.Spec.Containers),
 
-		Labels:            ss.Labels,
+		Labels:            FilterLabels(ss.Labels),
 		Annotations:       FilterAnnotations(ss.Annotations),
 		CreationTimestamp: ss.CreationTimestamp.UnixMilli(),
 	}
 
 	// Selector
 	if ss.Spec.Selector != nil {
-		info.Selector = ss.Spec.Selector.MatchLabels
+		info.Selector = FilterLabels(ss.Spec.Selector.MatchLabels)
 	}
 
 	if ss.Spec.UpdateStrategy.RollingUpdate != nil && ss.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
@@ -129,14 +130,14 @@ func DaemonSetToModel(ds *appsv1.DaemonSet) model.DaemonSetInfo {
 
 		ContainerSpecs: extractContainerSpecs(ds.Spec.Template.Spec.Containers),
 
-		Labels:            ds.Labels,
+		Labels:            FilterLabels(ds.Labels),
 		Annotations:       FilterAnnotations(ds.Annotations),
 		CreationTimestamp: ds.CreationTimestamp.UnixMilli(),
 	}
 
 	// Selector
 	if ds.Spec.Selector != nil {
-		info.Selector = ds.Spec.Selector.MatchLabels
+		info.Selector = FilterLabels(ds.Spec.Selector.MatchLabels)
 	}
 
 	// Conditions
@@ -145,34 +146,24 @@ func DaemonSetToModel(ds *appsv1.DaemonSet) model.DaemonSetInfo {
 	return info
 }
 
-// ReplicaSetToModel converts a Kubernetes ReplicaSet to model.ReplicaSetInfo.
-// Pure function — no side effects.
-func ReplicaSetToModel(rs *appsv1.ReplicaSet) model.ReplicaSetInfo {
-	replicas := int32(1)
-	if rs.Spec.Replicas != nil {
-		replicas = *rs.Spec.Replicas
-	}
-
+// ReplicaSetToModel converts ReplicaSet metadata to model.ReplicaSetInfo.
+// ReplicaSets are watched metadata-only, so Replicas, ReadyReplicas and
+// Selector are left unset. Pure function — no side effects.
+func ReplicaSetToModel(rs *metav1.PartialObjectMetadata) model.ReplicaSetInfo {
 	info := model.ReplicaSetInfo{
 		Name:              rs.Name,
 		Namespace:         rs.Namespace,
-		Replicas:          replicas,
-		ReadyReplicas:     rs.Status.ReadyReplicas,
-		Labels:            rs.Labels,
+		Labels:            FilterLabels(rs.Labels),
 		CreationTimestamp: rs.CreationTimestamp.UnixMilli(),
 	}
 
-	// Selector
-	if rs.Spec.Selector != nil {
-		info.Selector = rs.Spec.Selector.MatchLabels
-	}
-
 	// Owner — immediate ownerReferences[0] only
 	if len(rs.OwnerReferences) > 0 {
 		owner := rs.OwnerReferences[0]
 		info.OwnerKind = owner.Kind
 		info.OwnerName = owner.Name
 		info.OwnerUID = string(owner.UID)
+		info.OwnerAPIVersion = owner.APIVersion
 	}
 
 	return info
@@ -193,8 +184,8 @@ func extractContainerSpecs(containers []corev1.Container) []model.ContainerSpecI
 			MemoryRequestBytes: quantityValue(c.Resources.Requests, corev1.ResourceMemory),
 			CPULimitCores:      ParseQuantity(resourceQuantity(c.Resources.Limits, corev1.ResourceCPU)),
 			MemoryLimitBytes:   quantityValue(c.Resources.Limits, corev1.ResourceMemory),
-			GPURequest:         int(quantityValue(c.Resources.Requests, "nvidia.com/gpu")),
-			GPULimit:           int(quantityValue(c.Resources.Limits, "nvidia.com/gpu")),
+			GPURequest:         GPUCount(c.Resources.Requests),
+			GPULimit:           GPUCount(c.Resources.Limits),
 		}
 	}
 	return out
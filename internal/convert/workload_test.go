@@ -503,9 +503,8 @@ func TestDaemonSetToModel_AllFields(t *testing.T) {
 
 // 9. ReplicaSet with owner (Deployment)
 func TestReplicaSetToModel_WithOwner(t *testing.T) {
-	replicas := int32(3)
 	isController := true
-	rs := &appsv1.ReplicaSet{
+	rs := &metav1.PartialObjectMetadata{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              "web-abc123",
 			Namespace:         "production",
@@ -524,37 +523,18 @@ func TestReplicaSetToModel_WithOwner(t *testing.T) {
 				},
 			},
 		},
-		Spec: appsv1.ReplicaSetSpec{
-			Replicas: &replicas,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": "web", "pod-template-hash": "abc123"},
-			},
-		},
-		Status: appsv1.ReplicaSetStatus{
-			Replicas:      3,
-			ReadyReplicas: 2,
-		},
 	}
 
 	got := ReplicaSetToModel(rs)
 
 	assertEqual(t, "Name", got.Name, "web-abc123")
 	assertEqual(t, "Namespace", got.Namespace, "production")
-	assertInt(t, "Replicas", int(got.Replicas), 3)
-	assertInt(t, "ReadyReplicas", int(got.ReadyReplicas), 2)
 
 	// Owner
 	assertEqual(t, "OwnerKind", got.OwnerKind, "Deployment")
 	assertEqual(t, "OwnerName", got.OwnerName, "web")
 	assertEqual(t, "OwnerUID", got.OwnerUID, "deploy-uid-999")
-
-	// Selector
-	if got.Selector["app"] != "web" {
-		t.Errorf("Selector[app] = %q, want %q", got.Selector["app"], "web")
-	}
-	if got.Selector["pod-template-hash"] != "abc123" {
-		t.Errorf("Selector[pod-template-hash] = %q, want %q", got.Selector["pod-template-hash"], "abc123")
-	}
+	assertEqual(t, "OwnerAPIVersion", got.OwnerAPIVersion, "apps/v1")
 
 	// Labels
 	if len(got.Labels) != 2 {
@@ -568,24 +548,13 @@ func TestReplicaSetToModel_WithOwner(t *testing.T) {
 
 // 10. ReplicaSet standalone (no owner)
 func TestReplicaSetToModel_Standalone(t *testing.T) {
-	replicas := int32(2)
-	rs := &appsv1.ReplicaSet{
+	rs := &metav1.PartialObjectMetadata{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              "standalone-rs",
 			Namespace:         "default",
 			CreationTimestamp: metav1.NewTime(time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)),
 			Labels:            map[string]string{"app": "standalone"},
 		},
-		Spec: appsv1.ReplicaSetSpec{
-			Replicas: &replicas,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": "standalone"},
-			},
-		},
-		Status: appsv1.ReplicaSetStatus{
-			Replicas:      2,
-			ReadyReplicas: 2,
-		},
 	}
 
 	got := ReplicaSetToModel(rs)
@@ -594,27 +563,24 @@ func TestReplicaSetToModel_Standalone(t *testing.T) {
 	assertEqual(t, "OwnerKind", got.OwnerKind, "")
 	assertEqual(t, "OwnerName", got.OwnerName, "")
 	assertEqual(t, "OwnerUID", got.OwnerUID, "")
-	assertInt(t, "Replicas", int(got.Replicas), 2)
-	assertInt(t, "ReadyReplicas", int(got.ReadyReplicas), 2)
 }
 
-// 11. ReplicaSet with nil Replicas defaults to 1
-func TestReplicaSetToModel_NilReplicas(t *testing.T) {
-	rs := &appsv1.ReplicaSet{
+// 11. ReplicaSet metadata carries no spec or status
+func TestReplicaSetToModel_MetadataOnly(t *testing.T) {
+	rs := &metav1.PartialObjectMetadata{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:              "nil-replicas-rs",
+			Name:              "meta-rs",
 			Namespace:         "default",
 			CreationTimestamp: metav1.NewTime(time.Now()),
 		},
-		Spec: appsv1.ReplicaSetSpec{
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": "test"},
-			},
-		},
 	}
 
 	got := ReplicaSetToModel(rs)
-	assertInt(t, "Replicas", int(got.Replicas), 1)
+	assertInt(t, "Replicas", int(got.Replicas), 0)
+	assertInt(t, "ReadyReplicas", int(got.ReadyReplicas), 0)
+	if got.Selector != nil {
+		t.Errorf("Selector = %v, want nil", got.Selector)
+	}
 }
 
 // 12. Workload conditions (Available, Progressing, ReplicaFailure)
@@ -767,3 +733,35 @@ func TestDaemonSetToModel_WithConditions(t *testing.T) {
 	assertInt(t, "DesiredNumberScheduled", int(got.DesiredNumberScheduled), 5)
 	assertInt(t, "NumberReady", int(got.NumberReady), 5)
 }
+
+func TestDeploymentToModel_RedactsSelector(t *testing.T) {
+	setRedaction(t, selectorRedaction)
+
+	dep := makeDeployment()
+	dep.Spec.Selector = &metav1.LabelSelector{MatchLabels: redactedSelector}
+	assertLabels(t, "Selector", DeploymentToModel(dep).Selector, wantRedactedSelector)
+}
+
+func TestStatefulSetToModel_RedactsSelector(t *testing.T) {
+	setRedaction(t, selectorRedaction)
+
+	info := StatefulSetToModel(&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "cache"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: redactedSelector},
+		},
+	})
+	assertLabels(t, "Selector", info.Selector, wantRedactedSelector)
+}
+
+func TestDaemonSetToModel_RedactsSelector(t *testing.T) {
+	setRedaction(t, selectorRedaction)
+
+	info := DaemonSetToModel(&appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "fluentd", Namespace: "logging"},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: redactedSelector},
+		},
+	})
+	assertLabels(t, "Selector", info.Selector, wantRedactedSelector)
+}
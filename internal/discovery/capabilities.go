@@ -9,6 +9,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
 // Well-known API groups used for capability detection.
@@ -27,6 +30,18 @@ type Capabilities struct {
 	Provider              string   // "aws", "gcp", "azure", "unknown"
 	DCGMExporter          bool     // dcgm-exporter pods found on GPU nodes
 	DCGMExporterEndpoints []string // pod IPs of discovered dcgm-exporter instances
+
+	// GPUExporters are the metrics exporters of every GPU vendor found on
+	// the cluster's nodes, dcgm-exporter included.
+	GPUExporters []GPUExporter
+}
+
+// GPUExporter is a GPU metrics exporter pod.
+type GPUExporter struct {
+	Vendor string // model.GPUVendor*
+	IP     string
+	Port   int // the vendor's default metrics port
+	Node   string
 }
 
 // Detect probes the cluster for optional capabilities and the cloud provider.
@@ -59,7 +74,13 @@ func Detect(ctx context.Context, client kubernetes.Interface, discoveryClient di
 		caps.Provider = DetectProvider([]*v1.Node{&node})
 	}
 
-	caps.DCGMExporter, caps.DCGMExporterEndpoints = detectDCGMExporter(ctx, client)
+	caps.GPUExporters = DetectGPUExporters(ctx, client)
+	for _, e := range caps.GPUExporters {
+		if e.Vendor == model.GPUVendorNVIDIA {
+			caps.DCGMExporter = true
+			caps.DCGMExporterEndpoints = append(caps.DCGMExporterEndpoints, e.IP)
+		}
+	}
 
 	return caps, nil
 }
@@ -79,62 +100,83 @@ func HasAPIGroup(discoveryClient discovery.DiscoveryInterface, group string) (bo
 	return false, nil
 }
 
+// gpuExporterSpecs lists, per vendor, the label selectors of its metrics
+// exporter pods (tried in order, first match wins) and their metrics port.
+// The AMD GPU Operator names its exporter just "metrics-exporter", so that
+// selector also requires the operator's part-of label.
+var gpuExporterSpecs = []struct {
+	vendor    string
+	selectors []string
+	port      int
+}{
+	{model.GPUVendorNVIDIA, []string{"app=nvidia-dcgm-exporter", "app.kubernetes.io/name=dcgm-exporter"}, 9400},
+	{model.GPUVendorAMD, []string{"app=amdgpu-metrics-exporter", "app.kubernetes.io/name=metrics-exporter,app.kubernetes.io/part-of=amd-gpu"}, 5000},
+	{model.GPUVendorIntel, []string{"app=intel-xpumanager", "app.kubernetes.io/name=xpumanager"}, 29999},
+	{model.GPUVendorHabana, []string{"app.kubernetes.io/name=metric-exporter-ds", "app=habana-metric-exporter"}, 41611},
+}
+
 // DetectDCGMEndpoints probes the cluster for dcgm-exporter pods on GPU nodes
 // and returns their pod IPs. Safe to call repeatedly for endpoint refresh.
 func DetectDCGMEndpoints(ctx context.Context, client kubernetes.Interface) (bool, []string) {
-	return detectDCGMExporter(ctx, client)
+	var ips []string
+	for _, e := range DetectGPUExporters(ctx, client) {
+		if e.Vendor == model.GPUVendorNVIDIA {
+			ips = append(ips, e.IP)
+		}
+	}
+	return len(ips) > 0, ips
 }
 
-func detectDCGMExporter(ctx context.Context, client kubernetes.Interface) (bool, []string) {
+// DetectGPUExporters finds the metrics exporter pods of each GPU vendor whose
+// devices some node advertises. Safe to call repeatedly for endpoint
+// refresh.
+func DetectGPUExporters(ctx context.Context, client kubernetes.Interface) []GPUExporter {
 	nodeList, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return false, nil
+		return nil
 	}
 
-	hasGPU := false
+	vendors := make(map[string]bool)
 	for i := range nodeList.Items {
 		node := &nodeList.Items[i]
-		if q, ok := node.Status.Allocatable[v1.ResourceName("nvidia.com/gpu")]; ok && q.Value() > 0 {
-			hasGPU = true
-			break
+		if v := convert.GPUVendor(node.Status.Allocatable); v != "" {
+			vendors[v] = true
 		}
 		for rName := range node.Status.Allocatable {
 			if strings.HasPrefix(string(rName), "nvidia.com/mig-") {
-				hasGPU = true
-				break
+				vendors[model.GPUVendorNVIDIA] = true
 			}
 		}
-		if hasGPU {
-			break
-		}
-	}
-
-	if !hasGPU {
-		return false, nil
 	}
 
-	selectors := []string{
-		"app=nvidia-dcgm-exporter",
-		"app.kubernetes.io/name=dcgm-exporter",
-	}
-
-	for _, sel := range selectors {
-		pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
-			LabelSelector: sel,
-		})
-		if err != nil || len(pods.Items) == 0 {
+	var exporters []GPUExporter
+	for _, spec := range gpuExporterSpecs {
+		if !vendors[spec.vendor] {
 			continue
 		}
-		var endpoints []string
-		for _, pod := range pods.Items {
-			if pod.Status.PodIP != "" {
-				endpoints = append(endpoints, pod.Status.PodIP)
+		for _, sel := range spec.selectors {
+			pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+				LabelSelector: sel,
+			})
+			if err != nil || len(pods.Items) == 0 {
+				continue
+			}
+			found := false
+			for _, pod := range pods.Items {
+				if pod.Status.PodIP != "" {
+					exporters = append(exporters, GPUExporter{
+						Vendor: spec.vendor,
+						IP:     pod.Status.PodIP,
+						Port:   spec.port,
+						Node:   pod.Spec.NodeName,
+					})
+					found = true
+				}
+			}
+			if found {
+				break
 			}
-		}
-		if len(endpoints) > 0 {
-			return true, endpoints
 		}
 	}
-
-	return false, nil
+	return exporters
 }
@@ -3,9 +3,11 @@ package discovery
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakediscovery "k8s.io/client-go/discovery/fake"
@@ -194,3 +196,85 @@ func TestDetect_NodeListError_GracefulDegradation(t *testing.T) {
 		t.Error("expected MetricsServer=true even when node list fails")
 	}
 }
+
+func TestDetectGPUExporters_Vendors(t *testing.T) {
+	gpuNode := func(name, resourceName string) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+				v1.ResourceName(resourceName): resource.MustParse("8"),
+			}},
+		}
+	}
+	exporterPod := func(name, node, ip string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring", Labels: labels},
+			Spec:       v1.PodSpec{NodeName: node},
+			Status:     v1.PodStatus{PodIP: ip},
+		}
+	}
+	client := fakeclientset.NewSimpleClientset(
+		gpuNode("a100-1", "nvidia.com/gpu"),
+		gpuNode("mi300-1", "amd.com/gpu"),
+		gpuNode("gaudi-1", "habana.ai/gaudi"),
+		exporterPod("dcgm-1", "a100-1", "10.0.0.1", map[string]string{"app": "nvidia-dcgm-exporter"}),
+		exporterPod("amd-1", "mi300-1", "10.0.0.2", map[string]string{"app": "amdgpu-metrics-exporter"}),
+		exporterPod("habana-1", "gaudi-1", "10.0.0.3", map[string]string{"app.kubernetes.io/name": "metric-exporter-ds"}),
+		// No node advertises Intel GPUs, so the XPU Manager pod is ignored.
+		exporterPod("xpum-1", "a100-1", "10.0.0.4", map[string]string{"app": "intel-xpumanager"}),
+	)
+
+	got := DetectGPUExporters(context.Background(), client)
+	want := []GPUExporter{
+		{Vendor: "nvidia", IP: "10.0.0.1", Port: 9400, Node: "a100-1"},
+		{Vendor: "amd", IP: "10.0.0.2", Port: 5000, Node: "mi300-1"},
+		{Vendor: "habana", IP: "10.0.0.3", Port: 41611, Node: "gaudi-1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectGPUExporters() = %+v, want %+v", got, want)
+	}
+
+	caps, err := Detect(context.Background(), client, newFakeDiscovery(nil))
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if !caps.DCGMExporter || !reflect.DeepEqual(caps.DCGMExporterEndpoints, []string{"10.0.0.1"}) {
+		t.Errorf("DCGMExporter = %v, DCGMExporterEndpoints = %v", caps.DCGMExporter, caps.DCGMExporterEndpoints)
+	}
+	if len(caps.GPUExporters) != 3 {
+		t.Errorf("GPUExporters = %+v, want 3", caps.GPUExporters)
+	}
+}
+
+func TestDetectGPUExporters_AMDOperatorLabels(t *testing.T) {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "mi300-1"},
+		Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+			"amd.com/gpu": resource.MustParse("8"),
+		}},
+	}
+	pod := func(name, ip string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-amd-gpu", Labels: labels},
+			Spec:       v1.PodSpec{NodeName: "mi300-1"},
+			Status:     v1.PodStatus{PodIP: ip},
+		}
+	}
+
+	// Another exporter with the same generic name is not an AMD exporter.
+	unrelated := pod("other", "10.0.0.5", map[string]string{"app.kubernetes.io/name": "metrics-exporter"})
+	client := fakeclientset.NewSimpleClientset(node, unrelated)
+	if got := DetectGPUExporters(context.Background(), client); len(got) != 0 {
+		t.Errorf("DetectGPUExporters() = %+v, want none", got)
+	}
+
+	operator := pod("amd-1", "10.0.0.2", map[string]string{
+		"app.kubernetes.io/name":    "metrics-exporter",
+		"app.kubernetes.io/part-of": "amd-gpu",
+	})
+	client = fakeclientset.NewSimpleClientset(node, unrelated, operator)
+	want := []GPUExporter{{Vendor: "amd", IP: "10.0.0.2", Port: 5000, Node: "mi300-1"}}
+	if got := DetectGPUExporters(context.Background(), client); !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectGPUExporters() = %+v, want %+v", got, want)
+	}
+}
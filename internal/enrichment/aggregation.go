@@ -5,7 +5,7 @@ import (
 )
 
 // AggregationEnricher sums pod resource requests, limits, and usage
-// per workload (Deployment, StatefulSet, DaemonSet, Job).
+// per workload (Deployment, StatefulSet, DaemonSet, Job, custom workload).
 type AggregationEnricher struct{}
 
 // NewAggregationEnricher creates a new AggregationEnricher.
@@ -100,6 +100,19 @@ func (a *AggregationEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
 		j.TotalMemoryUsage = memUse
 	}
 
+	// Aggregate custom workloads (CRD owners resolved by OwnershipEnricher).
+	for i := range snapshot.CustomWorkloads {
+		cw := &snapshot.CustomWorkloads[i]
+		key := workloadKey{namespace: cw.Namespace, kind: cw.Kind, name: cw.Name}
+		pods := podsByWorkload[key]
+		cpu, mem, _, _, cpuUse, memUse := sumPodResources(pods)
+		cw.PodCount = len(pods)
+		cw.TotalCPURequest = cpu
+		cw.TotalMemoryRequest = mem
+		cw.TotalCPUUsage = cpuUse
+		cw.TotalMemoryUsage = memUse
+	}
+
 	return nil
 }
 
@@ -202,6 +202,37 @@ func TestAggregation_PodsInDifferentNamespace(t *testing.T) {
 	}
 }
 
+func TestAggregation_CustomWorkload(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		CustomWorkloads: []model.CustomWorkloadInfo{{
+			Kind:      "Rollout",
+			Name:      "checkout",
+			Namespace: "shop",
+		}},
+		Pods: []model.PodInfo{
+			makePod("checkout-1", "shop", "Rollout", "checkout", 0.5, 256*1024*1024, 1.0, 512*1024*1024),
+			makePod("checkout-2", "shop", "Rollout", "checkout", 0.5, 256*1024*1024, 1.0, 512*1024*1024),
+			makePod("other", "shop", "Deployment", "checkout", 2.0, 1024*1024*1024, 2.0, 1024*1024*1024),
+		},
+	}
+
+	e := NewAggregationEnricher()
+	if err := e.Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	cw := snap.CustomWorkloads[0]
+	if cw.PodCount != 2 {
+		t.Errorf("expected PodCount=2, got %d", cw.PodCount)
+	}
+	if cw.TotalCPURequest != 1.0 {
+		t.Errorf("expected TotalCPURequest=1.0, got %f", cw.TotalCPURequest)
+	}
+	if cw.TotalMemoryRequest != 512*1024*1024 {
+		t.Errorf("expected TotalMemoryRequest=512Mi, got %d", cw.TotalMemoryRequest)
+	}
+}
+
 // makePod creates a PodInfo with a single container for testing.
 func makePod(name, ns, ownerKind, ownerName string, cpuReq float64, memReq int64, cpuLim float64, memLim int64) model.PodInfo {
 	return model.PodInfo{
@@ -0,0 +1,199 @@
+package enrichment
+
+import (
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// CostEnricher prices nodes from a pricing catalog and apportions each
+// node's hourly cost to the pods on it by their share of the node's
+// allocatable CPU, memory and GPUs. The unrequested remainder is the node's
+// idle cost. Workload costs sum the costs of their pods.
+type CostEnricher struct {
+	catalog   *pricing.Catalog
+	otherPods []model.PodInfo
+	podJobs   map[string]string
+}
+
+// NewCostEnricher creates a CostEnricher. otherPods are pods that occupy
+// nodes in the snapshot but are not part of it (e.g. outside the namespace
+// scope); they take their share of node cost, which is neither reported
+// nor counted as idle. podJobs maps pods that OwnershipEnricher rolled up
+// to a CronJob to their Job (see OwnershipEnricher.PodJobs), so that the
+// Job is costed as well as the CronJob.
+func NewCostEnricher(catalog *pricing.Catalog, otherPods []model.PodInfo, podJobs map[string]string) *CostEnricher {
+	return &CostEnricher{catalog: catalog, otherPods: otherPods, podJobs: podJobs}
+}
+
+// Name implements the Enricher interface.
+func (c *CostEnricher) Name() string { return "cost" }
+
+// podRequests is what a pod reserves on its node.
+type podRequests struct {
+	cpu float64
+	mem int64
+	gpu int
+}
+
+// nodeCost is a node's cost per unit of each allocatable resource.
+type nodeCost struct {
+	perCore, perByte, perGPU float64
+	attributed               float64
+}
+
+// Enrich sets HourlyCost on nodes, pods and workloads, and IdleHourlyCost
+// on nodes. Nodes the catalog cannot price, and the pods on them, are left
+// without a cost.
+func (c *CostEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
+	// Total requests per node, over every pod holding resources on it.
+	requested := make(map[string]podRequests, len(snapshot.Nodes))
+	addRequests := func(pods []model.PodInfo) {
+		for i := range pods {
+			if r, ok := requestsOf(&pods[i]); ok {
+				total := requested[pods[i].NodeName]
+				total.cpu += r.cpu
+				total.mem += r.mem
+				total.gpu += r.gpu
+				requested[pods[i].NodeName] = total
+			}
+		}
+	}
+	addRequests(snapshot.Pods)
+	addRequests(c.otherPods)
+
+	// Unit costs per node. Requests beyond allocatable (possible while a
+	// node's capacity shrinks) are scaled down so a node's pods never cost
+	// more than the node.
+	nodes := make(map[string]*nodeCost, len(snapshot.Nodes))
+	for i := range snapshot.Nodes {
+		n := &snapshot.Nodes[i]
+		cost, ok := c.catalog.NodeCost(pricing.Node{
+			Provider:     convert.ProviderName(n.ProviderID),
+			Region:       n.Region,
+			InstanceType: n.InstanceType,
+			CapacityType: n.CapacityType,
+			CPUCores:     n.CPUCapacityCores,
+			MemoryBytes:  n.MemoryCapacityBytes,
+			GPUs:         n.GPUCapacity,
+		})
+		if !ok {
+			continue
+		}
+		hourly := cost.Hourly
+		n.HourlyCost = &hourly
+		n.PricingSource = cost.Source
+
+		r := requested[n.Name]
+		nodes[n.Name] = &nodeCost{
+			perCore: perUnit(cost.CPU, n.CPUAllocatable, r.cpu),
+			perByte: perUnit(cost.Memory, float64(n.MemoryAllocatable), float64(r.mem)),
+			perGPU:  perUnit(cost.GPU, float64(n.GPUAllocatable), float64(r.gpu)),
+		}
+	}
+
+	podCost := func(p *model.PodInfo) (float64, bool) {
+		r, ok := requestsOf(p)
+		if !ok {
+			return 0, false
+		}
+		nc, ok := nodes[p.NodeName]
+		if !ok {
+			return 0, false
+		}
+		cost := r.cpu*nc.perCore + float64(r.mem)*nc.perByte + float64(r.gpu)*nc.perGPU
+		nc.attributed += cost
+		return cost, true
+	}
+
+	type workloadKey struct {
+		namespace string
+		kind      string
+		name      string
+	}
+	byWorkload := make(map[workloadKey]float64)
+	for i := range snapshot.Pods {
+		p := &snapshot.Pods[i]
+		cost, ok := podCost(p)
+		if !ok {
+			continue
+		}
+		p.HourlyCost = &cost
+		if p.OwnerKind != "" {
+			byWorkload[workloadKey{p.Namespace, p.OwnerKind, p.OwnerName}] += cost
+		}
+		if job, ok := c.podJobs[p.Namespace+"/"+p.Name]; ok {
+			byWorkload[workloadKey{p.Namespace, "Job", job}] += cost
+		}
+	}
+	for i := range c.otherPods {
+		podCost(&c.otherPods[i])
+	}
+
+	for i := range snapshot.Nodes {
+		n := &snapshot.Nodes[i]
+		if nc, ok := nodes[n.Name]; ok {
+			idle := max(*n.HourlyCost-nc.attributed, 0)
+			n.IdleHourlyCost = &idle
+		}
+	}
+
+	workloadCost := func(namespace, kind, name string) *float64 {
+		cost, ok := byWorkload[workloadKey{namespace, kind, name}]
+		if !ok {
+			return nil
+		}
+		return &cost
+	}
+	for i := range snapshot.Deployments {
+		d := &snapshot.Deployments[i]
+		d.HourlyCost = workloadCost(d.Namespace, "Deployment", d.Name)
+	}
+	for i := range snapshot.StatefulSets {
+		s := &snapshot.StatefulSets[i]
+		s.HourlyCost = workloadCost(s.Namespace, "StatefulSet", s.Name)
+	}
+	for i := range snapshot.DaemonSets {
+		ds := &snapshot.DaemonSets[i]
+		ds.HourlyCost = workloadCost(ds.Namespace, "DaemonSet", ds.Name)
+	}
+	for i := range snapshot.Jobs {
+		j := &snapshot.Jobs[i]
+		j.HourlyCost = workloadCost(j.Namespace, "Job", j.Name)
+	}
+	for i := range snapshot.CronJobs {
+		cj := &snapshot.CronJobs[i]
+		cj.HourlyCost = workloadCost(cj.Namespace, "CronJob", cj.Name)
+	}
+	for i := range snapshot.CustomWorkloads {
+		cw := &snapshot.CustomWorkloads[i]
+		cw.HourlyCost = workloadCost(cw.Namespace, cw.Kind, cw.Name)
+	}
+
+	return nil
+}
+
+// requestsOf returns the requests of a pod that holds resources on a node:
+// scheduled and not yet finished.
+func requestsOf(p *model.PodInfo) (podRequests, bool) {
+	if p.NodeName == "" || p.Phase == "Succeeded" || p.Phase == "Failed" {
+		return podRequests{}, false
+	}
+	var r podRequests
+	for _, c := range p.Containers {
+		r.cpu += c.CPURequestCores
+		r.mem += c.MemoryRequestBytes
+		r.gpu += c.GPURequest
+	}
+	return r, true
+}
+
+// perUnit spreads a resource's cost over the node's allocatable amount, or
+// over the requested amount if that is larger.
+func perUnit(cost, allocatable, requested float64) float64 {
+	units := max(allocatable, requested)
+	if units <= 0 {
+		return 0
+	}
+	return cost / units
+}
@@ -0,0 +1,150 @@
+package enrichment
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+const gib = 1 << 30
+
+func costPod(name, node, ownerKind, ownerName, phase string, cpu float64, mem int64) model.PodInfo {
+	return model.PodInfo{
+		Name: name, Namespace: "default", NodeName: node, Phase: phase,
+		OwnerKind: ownerKind, OwnerName: ownerName,
+		Containers: []model.ContainerInfo{{Name: "app", CPURequestCores: cpu, MemoryRequestBytes: mem}},
+	}
+}
+
+func TestCost_ApportionsNodeCost(t *testing.T) {
+	// CPU and memory are worth the same at these rates: 4 cores at 0.1 and
+	// 16 GiB at 0.025 give 0.4 + 0.4 per hour.
+	catalog, err := pricing.Parse("prices.json", []byte(`{"rates": {"cpuCoreHour": 0.1, "memoryGBHour": 0.025}, "prices": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap := &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{
+			{Name: "n1", CPUCapacityCores: 4, CPUAllocatable: 4, MemoryCapacityBytes: 16 * gib, MemoryAllocatable: 16 * gib},
+			{Name: "n2"}, // no capacity: priced at zero
+		},
+		Pods: []model.PodInfo{
+			costPod("web-1", "n1", "Deployment", "web", "Running", 1, 4*gib),
+			costPod("web-2", "n1", "Deployment", "web", "Running", 1, 4*gib),
+			costPod("done", "n1", "Job", "batch", "Succeeded", 2, 8*gib),
+			costPod("pending", "", "Deployment", "web", "Pending", 1, gib),
+		},
+		Deployments: []model.DeploymentInfo{{Name: "web", Namespace: "default"}, {Name: "idle", Namespace: "default"}},
+		Jobs:        []model.JobInfo{{Name: "batch", Namespace: "default"}},
+	}
+	// A pod outside the namespace scope holding a core on n1.
+	other := []model.PodInfo{costPod("hidden", "n1", "", "", "Running", 1, 0)}
+
+	if err := NewCostEnricher(catalog, other, nil).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	n1 := snap.Nodes[0]
+	if n1.HourlyCost == nil || math.Abs(*n1.HourlyCost-0.8) > 1e-9 || n1.PricingSource != pricing.SourceCustom {
+		t.Fatalf("n1 cost = %v, source %q", n1.HourlyCost, n1.PricingSource)
+	}
+	// Each web pod: 1/4 of CPU (0.1) + 1/4 of memory (0.1).
+	for _, p := range snap.Pods[:2] {
+		if p.HourlyCost == nil || math.Abs(*p.HourlyCost-0.2) > 1e-9 {
+			t.Errorf("%s cost = %v, want 0.2", p.Name, p.HourlyCost)
+		}
+	}
+	if snap.Pods[2].HourlyCost != nil || snap.Pods[3].HourlyCost != nil {
+		t.Error("finished and unscheduled pods should have no cost")
+	}
+	// Idle: 0.8 - 0.4 (web) - 0.1 (hidden pod's core).
+	if n1.IdleHourlyCost == nil || math.Abs(*n1.IdleHourlyCost-0.3) > 1e-9 {
+		t.Errorf("n1 idle cost = %v, want 0.3", n1.IdleHourlyCost)
+	}
+
+	if d := snap.Deployments[0]; d.HourlyCost == nil || math.Abs(*d.HourlyCost-0.4) > 1e-9 {
+		t.Errorf("web cost = %v, want 0.4", d.HourlyCost)
+	}
+	if snap.Deployments[1].HourlyCost != nil || snap.Jobs[0].HourlyCost != nil {
+		t.Error("workloads without costed pods should have no cost")
+	}
+}
+
+func TestCost_OvercommittedNode(t *testing.T) {
+	catalog, err := pricing.Parse("prices.json", []byte(`{"prices": [
+		{"provider": "aws", "region": "us-east-1", "instanceType": "m5.large", "hourly": 0.1}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap := &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{{
+			Name: "n1", ProviderID: "aws:///us-east-1a/i-1", Region: "us-east-1", InstanceType: "m5.large",
+			CPUCapacityCores: 2, CPUAllocatable: 2, MemoryCapacityBytes: 8 * gib, MemoryAllocatable: 8 * gib,
+		}},
+		Pods: []model.PodInfo{
+			costPod("a", "n1", "", "", "Running", 2, 8*gib),
+			costPod("b", "n1", "", "", "Running", 2, 8*gib),
+		},
+	}
+	if err := NewCostEnricher(catalog, nil, nil).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+	// Requests twice the node: each pod pays half and nothing is idle.
+	for _, p := range snap.Pods {
+		if p.HourlyCost == nil || math.Abs(*p.HourlyCost-0.05) > 1e-9 {
+			t.Errorf("%s cost = %v, want 0.05", p.Name, p.HourlyCost)
+		}
+	}
+	if idle := snap.Nodes[0].IdleHourlyCost; idle == nil || math.Abs(*idle) > 1e-9 {
+		t.Errorf("idle cost = %v, want 0", idle)
+	}
+}
+
+func TestCost_CronJobPodsCostTheirJob(t *testing.T) {
+	catalog, err := pricing.Parse("prices.json", []byte(`{"rates": {"cpuCoreHour": 0.1, "memoryGBHour": 0.025}, "prices": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// CronJob "nightly" -> Job "nightly-1" -> Pod "nightly-1-abc".
+	snap := &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{
+			{Name: "n1", CPUCapacityCores: 4, CPUAllocatable: 4, MemoryCapacityBytes: 16 * gib, MemoryAllocatable: 16 * gib},
+		},
+		Pods:     []model.PodInfo{costPod("nightly-1-abc", "n1", "Job", "nightly-1", "Running", 1, 0)},
+		Jobs:     []model.JobInfo{{Name: "nightly-1", Namespace: "default", OwnerCronJob: "nightly"}},
+		CronJobs: []model.CronJobInfo{{Name: "nightly", Namespace: "default", UID: "cj-uid"}},
+	}
+	ownership := NewOwnershipEnricher(nil)
+	if err := ownership.Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Pods[0].OwnerKind != "CronJob" {
+		t.Fatalf("pod owner = %s, want CronJob", snap.Pods[0].OwnerKind)
+	}
+	if err := NewCostEnricher(catalog, nil, ownership.PodJobs()).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	// The pod holds 1/4 of the node's CPU, which costs 0.4.
+	if c := snap.Jobs[0].HourlyCost; c == nil || math.Abs(*c-0.1) > 1e-9 {
+		t.Errorf("Job cost = %v, want 0.1", c)
+	}
+	if c := snap.CronJobs[0].HourlyCost; c == nil || math.Abs(*c-0.1) > 1e-9 {
+		t.Errorf("CronJob cost = %v, want 0.1", c)
+	}
+}
+
+func TestCost_UnpricedNode(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{{Name: "n1", CPUAllocatable: 4}},
+		Pods:  []model.PodInfo{costPod("a", "n1", "", "", "Running", 1, gib)},
+	}
+	if err := NewCostEnricher(pricing.Bundled(), nil, nil).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Nodes[0].HourlyCost != nil || snap.Pods[0].HourlyCost != nil {
+		t.Error("a node missing from the catalog without rates should have no cost")
+	}
+}
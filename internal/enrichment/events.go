@@ -0,0 +1,135 @@
+package enrichment
+
+import (
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// EventsEnricher attaches per-reason Event counts to the objects the events
+// are about, and rolls them up to the owning workload: pod events to the
+// pod's top-level owner, ReplicaSet events to the Deployment (or other
+// owner), HPA events to the scale target, and Job events to the owning
+// CronJob. It must run after OwnershipEnricher.
+type EventsEnricher struct {
+	events      []model.EventInfo
+	replicaSets []model.ReplicaSetInfo
+}
+
+// NewEventsEnricher creates an enricher for the given deduplicated events.
+// ReplicaSets are passed separately since they are not in the snapshot.
+func NewEventsEnricher(events []model.EventInfo, replicaSets []model.ReplicaSetInfo) *EventsEnricher {
+	return &EventsEnricher{events: events, replicaSets: replicaSets}
+}
+
+// Name implements the Enricher interface.
+func (e *EventsEnricher) Name() string { return "events" }
+
+// objectKey identifies an object an event count is attached to. Nodes have
+// no namespace.
+type objectKey struct {
+	namespace string
+	kind      string
+	name      string
+}
+
+// Enrich sets EventReasons on nodes, pods, and workloads.
+func (e *EventsEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
+	if len(e.events) == 0 {
+		return nil
+	}
+
+	podOwners := make(map[objectKey]objectKey, len(snapshot.Pods))
+	for _, p := range snapshot.Pods {
+		if p.OwnerKind != "" && p.OwnerKind != "Node" {
+			podOwners[objectKey{p.Namespace, "Pod", p.Name}] = objectKey{p.Namespace, p.OwnerKind, p.OwnerName}
+		}
+	}
+	rsOwners := make(map[objectKey]objectKey, len(e.replicaSets))
+	for _, rs := range e.replicaSets {
+		if rs.OwnerKind != "" {
+			rsOwners[objectKey{rs.Namespace, kindReplicaSet, rs.Name}] = objectKey{rs.Namespace, rs.OwnerKind, rs.OwnerName}
+		}
+	}
+	hpaTargets := make(map[objectKey]objectKey, len(snapshot.HPAs))
+	for _, h := range snapshot.HPAs {
+		hpaTargets[objectKey{h.Namespace, "HorizontalPodAutoscaler", h.Name}] = objectKey{h.Namespace, h.TargetKind, h.TargetName}
+	}
+	jobOwners := make(map[objectKey]objectKey, len(snapshot.Jobs))
+	for _, j := range snapshot.Jobs {
+		if j.OwnerCronJob != "" {
+			jobOwners[objectKey{j.Namespace, "Job", j.Name}] = objectKey{j.Namespace, "CronJob", j.OwnerCronJob}
+		}
+	}
+
+	counts := make(map[objectKey]map[string]int)
+	add := func(k objectKey, reason string, n int) {
+		m, ok := counts[k]
+		if !ok {
+			m = make(map[string]int)
+			counts[k] = m
+		}
+		m[reason] += n
+	}
+
+	for _, ev := range e.events {
+		k := objectKey{ev.InvolvedNamespace, ev.InvolvedKind, ev.InvolvedName}
+		if ev.InvolvedKind == "Node" {
+			k.namespace = ""
+		}
+		n := int(ev.Count)
+
+		switch ev.InvolvedKind {
+		case kindReplicaSet:
+			if owner, ok := rsOwners[k]; ok {
+				add(owner, ev.Reason, n)
+			}
+			continue
+		case "HorizontalPodAutoscaler":
+			if target, ok := hpaTargets[k]; ok {
+				add(target, ev.Reason, n)
+			}
+			continue
+		}
+
+		add(k, ev.Reason, n)
+		if owner, ok := podOwners[k]; ok {
+			add(owner, ev.Reason, n)
+		}
+		if owner, ok := jobOwners[k]; ok {
+			add(owner, ev.Reason, n)
+		}
+	}
+
+	for i := range snapshot.Nodes {
+		n := &snapshot.Nodes[i]
+		n.EventReasons = counts[objectKey{"", "Node", n.Name}]
+	}
+	for i := range snapshot.Pods {
+		p := &snapshot.Pods[i]
+		p.EventReasons = counts[objectKey{p.Namespace, "Pod", p.Name}]
+	}
+	for i := range snapshot.Deployments {
+		d := &snapshot.Deployments[i]
+		d.EventReasons = counts[objectKey{d.Namespace, "Deployment", d.Name}]
+	}
+	for i := range snapshot.StatefulSets {
+		s := &snapshot.StatefulSets[i]
+		s.EventReasons = counts[objectKey{s.Namespace, "StatefulSet", s.Name}]
+	}
+	for i := range snapshot.DaemonSets {
+		ds := &snapshot.DaemonSets[i]
+		ds.EventReasons = counts[objectKey{ds.Namespace, "DaemonSet", ds.Name}]
+	}
+	for i := range snapshot.Jobs {
+		j := &snapshot.Jobs[i]
+		j.EventReasons = counts[objectKey{j.Namespace, "Job", j.Name}]
+	}
+	for i := range snapshot.CronJobs {
+		cj := &snapshot.CronJobs[i]
+		cj.EventReasons = counts[objectKey{cj.Namespace, "CronJob", cj.Name}]
+	}
+	for i := range snapshot.CustomWorkloads {
+		cw := &snapshot.CustomWorkloads[i]
+		cw.EventReasons = counts[objectKey{cw.Namespace, cw.Kind, cw.Name}]
+	}
+	return nil
+}
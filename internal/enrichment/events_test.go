@@ -0,0 +1,93 @@
+package enrichment
+
+import (
+	"testing"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+func TestEvents_AttachesAndRollsUp(t *testing.T) {
+	rs := []model.ReplicaSetInfo{{
+		Name:      "web-abc",
+		Namespace: "default",
+		OwnerKind: "Deployment",
+		OwnerName: "web",
+	}}
+	snap := &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{{Name: "node-1"}, {Name: "node-2"}},
+		Pods: []model.PodInfo{
+			// Owners already resolved by OwnershipEnricher.
+			{Name: "web-abc-1", Namespace: "default", OwnerKind: "Deployment", OwnerName: "web"},
+			{Name: "db-0", Namespace: "default", OwnerKind: "StatefulSet", OwnerName: "db"},
+		},
+		Deployments:  []model.DeploymentInfo{{Name: "web", Namespace: "default"}},
+		StatefulSets: []model.StatefulSetInfo{{Name: "db", Namespace: "default"}},
+		Jobs:         []model.JobInfo{{Name: "report-123", Namespace: "default", OwnerCronJob: "report"}},
+		CronJobs:     []model.CronJobInfo{{Name: "report", Namespace: "default"}},
+		HPAs: []model.HPAInfo{{
+			Name: "db-hpa", Namespace: "default", TargetKind: "StatefulSet", TargetName: "db",
+		}},
+	}
+	events := []model.EventInfo{
+		{InvolvedKind: "Pod", InvolvedNamespace: "default", InvolvedName: "web-abc-1", Reason: "FailedScheduling", Count: 4},
+		{InvolvedKind: "Pod", InvolvedNamespace: "default", InvolvedName: "web-abc-1", Reason: "Evicted", Count: 1},
+		{InvolvedKind: "ReplicaSet", InvolvedNamespace: "default", InvolvedName: "web-abc", Reason: "SuccessfulCreate", Count: 2},
+		{InvolvedKind: "Deployment", InvolvedNamespace: "default", InvolvedName: "web", Reason: "ScalingReplicaSet", Count: 1},
+		{InvolvedKind: "HorizontalPodAutoscaler", InvolvedNamespace: "default", InvolvedName: "db-hpa", Reason: "SuccessfulRescale", Count: 3},
+		{InvolvedKind: "Job", InvolvedNamespace: "default", InvolvedName: "report-123", Reason: "BackoffLimitExceeded", Count: 1},
+		{InvolvedKind: "Node", InvolvedNamespace: "default", InvolvedName: "node-1", Reason: "NodeNotReady", Count: 1},
+	}
+
+	if err := NewEventsEnricher(events, rs).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	pod := snap.Pods[0].EventReasons
+	if pod["FailedScheduling"] != 4 || pod["Evicted"] != 1 || len(pod) != 2 {
+		t.Errorf("pod EventReasons = %v", pod)
+	}
+	if snap.Pods[1].EventReasons != nil {
+		t.Errorf("expected no events on db-0, got %v", snap.Pods[1].EventReasons)
+	}
+
+	deploy := snap.Deployments[0].EventReasons
+	want := map[string]int{"FailedScheduling": 4, "Evicted": 1, "SuccessfulCreate": 2, "ScalingReplicaSet": 1}
+	if len(deploy) != len(want) {
+		t.Errorf("deployment EventReasons = %v, want %v", deploy, want)
+	}
+	for reason, n := range want {
+		if deploy[reason] != n {
+			t.Errorf("deployment EventReasons[%s] = %d, want %d", reason, deploy[reason], n)
+		}
+	}
+
+	if got := snap.StatefulSets[0].EventReasons["SuccessfulRescale"]; got != 3 {
+		t.Errorf("statefulset SuccessfulRescale = %d, want 3 (from HPA)", got)
+	}
+	if got := snap.Jobs[0].EventReasons["BackoffLimitExceeded"]; got != 1 {
+		t.Errorf("job BackoffLimitExceeded = %d, want 1", got)
+	}
+	if got := snap.CronJobs[0].EventReasons["BackoffLimitExceeded"]; got != 1 {
+		t.Errorf("cronjob BackoffLimitExceeded = %d, want 1 (from Job)", got)
+	}
+
+	// Node events match by name regardless of the event's namespace.
+	if got := snap.Nodes[0].EventReasons["NodeNotReady"]; got != 1 {
+		t.Errorf("node NodeNotReady = %d, want 1", got)
+	}
+	if snap.Nodes[1].EventReasons != nil {
+		t.Errorf("expected no events on node-2, got %v", snap.Nodes[1].EventReasons)
+	}
+}
+
+func TestEvents_NoEvents(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		Pods: []model.PodInfo{{Name: "web-1", Namespace: "default"}},
+	}
+	if err := NewEventsEnricher(nil, nil).Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+	if snap.Pods[0].EventReasons != nil {
+		t.Errorf("expected nil EventReasons, got %v", snap.Pods[0].EventReasons)
+	}
+}
@@ -0,0 +1,233 @@
+package enrichment
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/gpuidle"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// migComputeSlices is the number of compute slices of a MIG-capable GPU
+// (A100, H100, H200); a "3g.40gb" slice is 3/7 of the GPU.
+const migComputeSlices = 7
+
+// GPUIdleEnricher records GPU utilization into a gpuidle.Tracker and flags
+// GPUs that stayed idle for the tracker's whole window: containers that
+// request GPUs and the workloads they belong to, allocated MIG slices, and
+// nodes with unallocated GPUs.
+type GPUIdleEnricher struct {
+	tracker         *gpuidle.Tracker
+	utilThreshold   float64
+	tensorThreshold float64
+	otherPods       []model.PodInfo
+	now             func() time.Time
+}
+
+// NewGPUIdleEnricher creates a GPUIdleEnricher. The tracker outlives the
+// enricher's snapshots and should be created once per agent. GPUs are idle
+// when their utilization stayed below utilThreshold percent, or, when
+// tensorThreshold is above zero, their tensor activity stayed below it.
+// otherPods hold GPUs on nodes in the snapshot without being part of it
+// (e.g. outside the namespace scope), so those GPUs are not unallocated.
+func NewGPUIdleEnricher(tracker *gpuidle.Tracker, utilThreshold, tensorThreshold float64, otherPods []model.PodInfo) *GPUIdleEnricher {
+	return &GPUIdleEnricher{
+		tracker:         tracker,
+		utilThreshold:   utilThreshold,
+		tensorThreshold: tensorThreshold,
+		otherPods:       otherPods,
+		now:             time.Now,
+	}
+}
+
+// Name implements the Enricher interface.
+func (e *GPUIdleEnricher) Name() string { return "gpu-idle" }
+
+// Enrich adds the snapshot's GPU utilization to the tracker and sets
+// GPUIdle on idle containers, their workloads, MIG slices and nodes.
+func (e *GPUIdleEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
+	now := e.now()
+	hours := e.tracker.Window().Hours()
+
+	type workloadKey struct {
+		namespace string
+		kind      string
+		name      string
+	}
+	byWorkload := make(map[workloadKey]*model.GPUIdleInfo)
+	for i := range snapshot.Pods {
+		p := &snapshot.Pods[i]
+		if p.Phase != "Running" {
+			continue
+		}
+		for j := range p.Containers {
+			c := &p.Containers[j]
+			if c.GPURequest == 0 {
+				continue
+			}
+			key := "container/" + p.Namespace + "/" + p.Name + "/" + c.Name
+			var s *gpuidle.Sample
+			if c.GPUUtilizationPercent != nil {
+				s = &gpuidle.Sample{Value: *c.GPUUtilizationPercent, TensorActive: c.GPUTensorActivePercent}
+			}
+			e.tracker.Observe(key, s, now)
+			c.GPUIdle = e.heldIdle(key, float64(c.GPURequest), hours, now)
+			if c.GPUIdle != nil && p.OwnerKind != "" {
+				k := workloadKey{p.Namespace, p.OwnerKind, p.OwnerName}
+				byWorkload[k] = addGPUIdle(byWorkload[k], c.GPUIdle)
+			}
+		}
+	}
+
+	// GPUs held on each node, over every pod holding resources on it.
+	held := make(map[string]int, len(snapshot.Nodes))
+	for _, pods := range [][]model.PodInfo{snapshot.Pods, e.otherPods} {
+		for i := range pods {
+			if r, ok := requestsOf(&pods[i]); ok {
+				held[pods[i].NodeName] += r.gpu
+			}
+		}
+	}
+	for i := range snapshot.Nodes {
+		n := &snapshot.Nodes[i]
+		for j := range n.GPUDevices {
+			d := &n.GPUDevices[j]
+			if d.MIGInstanceID == "" || !d.Allocated {
+				continue
+			}
+			key := "mig/" + n.Name + "/" + d.UUID + "/" + d.MIGInstanceID
+			var s *gpuidle.Sample
+			if d.UtilizationPercent != nil {
+				s = &gpuidle.Sample{Value: *d.UtilizationPercent, TensorActive: d.TensorActivePercent}
+			}
+			e.tracker.Observe(key, s, now)
+			d.GPUIdle = e.heldIdle(key, migShare(d.MIGProfile), hours, now)
+		}
+
+		// A node is only tracked while it has unallocated GPUs, so any
+		// allocation restarts its window.
+		unallocated := n.GPUAllocatable - held[n.Name]
+		if unallocated <= 0 {
+			continue
+		}
+		key := "node/" + n.Name
+		e.tracker.Observe(key, &gpuidle.Sample{Value: float64(unallocated)}, now)
+		if st, ok := e.tracker.Stats(key, now); ok {
+			n.GPUIdle = &model.GPUIdleInfo{
+				Reason:         model.GPUIdleUnallocated,
+				GPUs:           st.Min,
+				WindowHours:    hours,
+				WastedGPUHours: st.Avg * hours,
+			}
+		}
+	}
+	e.tracker.Sweep(now)
+
+	workloadIdle := func(namespace, kind, name string) *model.GPUIdleInfo {
+		return byWorkload[workloadKey{namespace, kind, name}]
+	}
+	for i := range snapshot.Deployments {
+		d := &snapshot.Deployments[i]
+		d.GPUIdle = workloadIdle(d.Namespace, "Deployment", d.Name)
+	}
+	for i := range snapshot.StatefulSets {
+		s := &snapshot.StatefulSets[i]
+		s.GPUIdle = workloadIdle(s.Namespace, "StatefulSet", s.Name)
+	}
+	for i := range snapshot.DaemonSets {
+		ds := &snapshot.DaemonSets[i]
+		ds.GPUIdle = workloadIdle(ds.Namespace, "DaemonSet", ds.Name)
+	}
+	for i := range snapshot.Jobs {
+		j := &snapshot.Jobs[i]
+		j.GPUIdle = workloadIdle(j.Namespace, "Job", j.Name)
+	}
+	for i := range snapshot.CronJobs {
+		cj := &snapshot.CronJobs[i]
+		cj.GPUIdle = workloadIdle(cj.Namespace, "CronJob", cj.Name)
+	}
+	for i := range snapshot.CustomWorkloads {
+		cw := &snapshot.CustomWorkloads[i]
+		cw.GPUIdle = workloadIdle(cw.Namespace, cw.Kind, cw.Name)
+	}
+	return nil
+}
+
+// heldIdle returns the idle finding for gpus held under key, or nil if
+// they were busy at some point in the window or the window is not covered.
+// Wasted GPU-hours scale with the average unused utilization or tensor
+// activity, whichever flagged the GPUs.
+func (e *GPUIdleEnricher) heldIdle(key string, gpus, hours float64, now time.Time) *model.GPUIdleInfo {
+	st, ok := e.tracker.Stats(key, now)
+	if !ok {
+		return nil
+	}
+	idle := &model.GPUIdleInfo{GPUs: gpus, WindowHours: hours}
+	maxUtil := st.Max
+	idle.MaxUtilizationPercent = &maxUtil
+	if st.HasTensor {
+		maxTensor := st.MaxTensor
+		idle.MaxTensorActivePercent = &maxTensor
+	}
+	switch {
+	case st.Max < e.utilThreshold:
+		idle.Reason = model.GPUIdleLowUtilization
+		idle.WastedGPUHours = gpus * hours * (1 - st.Avg/100)
+	case e.tensorThreshold > 0 && st.HasTensor && st.MaxTensor < e.tensorThreshold:
+		idle.Reason = model.GPUIdleLowTensorActivity
+		idle.WastedGPUHours = gpus * hours * (1 - st.AvgTensor/100)
+	default:
+		return nil
+	}
+	return idle
+}
+
+// addGPUIdle adds a container's finding to its workload's. The workload is
+// reported as low_utilization if any of its containers is.
+func addGPUIdle(total, c *model.GPUIdleInfo) *model.GPUIdleInfo {
+	if total == nil {
+		out := *c
+		if c.MaxUtilizationPercent != nil {
+			v := *c.MaxUtilizationPercent
+			out.MaxUtilizationPercent = &v
+		}
+		if c.MaxTensorActivePercent != nil {
+			v := *c.MaxTensorActivePercent
+			out.MaxTensorActivePercent = &v
+		}
+		return &out
+	}
+	total.GPUs += c.GPUs
+	total.WastedGPUHours += c.WastedGPUHours
+	if c.Reason == model.GPUIdleLowUtilization {
+		total.Reason = model.GPUIdleLowUtilization
+	}
+	if c.MaxUtilizationPercent != nil && (total.MaxUtilizationPercent == nil || *c.MaxUtilizationPercent > *total.MaxUtilizationPercent) {
+		v := *c.MaxUtilizationPercent
+		total.MaxUtilizationPercent = &v
+	}
+	if c.MaxTensorActivePercent != nil && (total.MaxTensorActivePercent == nil || *c.MaxTensorActivePercent > *total.MaxTensorActivePercent) {
+		v := *c.MaxTensorActivePercent
+		total.MaxTensorActivePercent = &v
+	}
+	return total
+}
+
+// migShare returns the fraction of a GPU a MIG profile such as "3g.40gb" or
+// "1c.3g.40gb" (a compute instance) provides. Unknown profiles count as
+// one slice.
+func migShare(profile string) float64 {
+	slices := 1
+	for part := range strings.SplitSeq(profile, ".") {
+		if n, err := strconv.Atoi(strings.TrimSuffix(part, "c")); err == nil && strings.HasSuffix(part, "c") {
+			slices = n
+			break
+		}
+		if n, err := strconv.Atoi(strings.TrimSuffix(part, "g")); err == nil && strings.HasSuffix(part, "g") {
+			slices = n
+			break
+		}
+	}
+	return float64(min(max(slices, 1), migComputeSlices)) / migComputeSlices
+}
@@ -0,0 +1,161 @@
+package enrichment
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/gpuidle"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+func gpuPod(name, ownerName string, gpus int, util, tensor float64) model.PodInfo {
+	return model.PodInfo{
+		Name: name, Namespace: "ml", Phase: "Running", NodeName: "gpu-1", OwnerKind: "Deployment", OwnerName: ownerName,
+		Containers: []model.ContainerInfo{{
+			Name: "server", GPURequest: gpus, GPUUtilizationPercent: &util, GPUTensorActivePercent: &tensor,
+		}},
+	}
+}
+
+// gpuIdleSnapshot is a node with 8 GPUs: idle-1 and idle-2 hold 2 idle GPUs
+// between them, busy-1 uses 2 GPUs with little tensor activity, and a
+// 1g.10gb MIG slice is allocated but idle.
+func gpuIdleSnapshot() *model.ClusterSnapshot {
+	migUtil := 0.0
+	return &model.ClusterSnapshot{
+		Nodes: []model.NodeInfo{{
+			Name: "gpu-1", GPUAllocatable: 8,
+			GPUDevices: []model.GPUDeviceInfo{
+				{UUID: "GPU-a", MIGInstanceID: "1", MIGProfile: "1g.10gb", Allocated: true, UtilizationPercent: &migUtil},
+				{UUID: "GPU-a", MIGInstanceID: "2", MIGProfile: "3g.40gb"},
+			},
+		}},
+		Pods: []model.PodInfo{
+			gpuPod("idle-1", "idle", 1, 2, 0),
+			gpuPod("idle-2", "idle", 1, 4, 0),
+			gpuPod("busy-1", "busy", 2, 80, 1),
+		},
+		Deployments: []model.DeploymentInfo{
+			{Name: "idle", Namespace: "ml"},
+			{Name: "busy", Namespace: "ml"},
+		},
+	}
+}
+
+func TestGPUIdle_FlagsAfterFullWindow(t *testing.T) {
+	tracker := gpuidle.NewTracker(time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var snap *model.ClusterSnapshot
+	for i := range 7 {
+		now := start.Add(time.Duration(i) * 10 * time.Minute)
+		e := NewGPUIdleEnricher(tracker, 10, 5, nil)
+		e.now = func() time.Time { return now }
+		snap = gpuIdleSnapshot()
+		if err := e.Enrich(snap); err != nil {
+			t.Fatal(err)
+		}
+		if i < 6 && snap.Pods[0].Containers[0].GPUIdle != nil {
+			t.Fatalf("flagged after %v, before the window is covered", now.Sub(start))
+		}
+	}
+
+	c := snap.Pods[0].Containers[0].GPUIdle
+	if c == nil || c.Reason != model.GPUIdleLowUtilization || c.GPUs != 1 || c.WindowHours != 1 {
+		t.Fatalf("idle-1 GPUIdle = %+v", c)
+	}
+	if math.Abs(c.WastedGPUHours-0.98) > 1e-9 || *c.MaxUtilizationPercent != 2 {
+		t.Errorf("idle-1 WastedGPUHours = %v, MaxUtilizationPercent = %v", c.WastedGPUHours, *c.MaxUtilizationPercent)
+	}
+
+	// busy-1 keeps its GPUs busy without tensor cores.
+	b := snap.Pods[2].Containers[0].GPUIdle
+	if b == nil || b.Reason != model.GPUIdleLowTensorActivity || math.Abs(b.WastedGPUHours-1.98) > 1e-9 {
+		t.Errorf("busy-1 GPUIdle = %+v", b)
+	}
+
+	w := snap.Deployments[0].GPUIdle
+	if w == nil || w.Reason != model.GPUIdleLowUtilization || w.GPUs != 2 || *w.MaxUtilizationPercent != 4 {
+		t.Fatalf("Deployment idle GPUIdle = %+v", w)
+	}
+	if math.Abs(w.WastedGPUHours-(0.98+0.96)) > 1e-9 {
+		t.Errorf("Deployment idle WastedGPUHours = %v", w.WastedGPUHours)
+	}
+	if snap.Deployments[1].GPUIdle == nil {
+		t.Error("expected Deployment busy to be flagged for low tensor activity")
+	}
+
+	mig := snap.Nodes[0].GPUDevices[0].GPUIdle
+	if mig == nil || math.Abs(mig.GPUs-1.0/7) > 1e-9 || math.Abs(mig.WastedGPUHours-1.0/7) > 1e-9 {
+		t.Errorf("MIG slice GPUIdle = %+v", mig)
+	}
+	if snap.Nodes[0].GPUDevices[1].GPUIdle != nil {
+		t.Error("unallocated MIG slice should not be flagged")
+	}
+
+	// 4 of 8 GPUs are requested.
+	n := snap.Nodes[0].GPUIdle
+	if n == nil || n.Reason != model.GPUIdleUnallocated || n.GPUs != 4 || n.WastedGPUHours != 4 {
+		t.Errorf("node GPUIdle = %+v", n)
+	}
+}
+
+func TestGPUIdle_BusyOrMissingResetsFindings(t *testing.T) {
+	tracker := gpuidle.NewTracker(time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	enrich := func(now time.Time, snap *model.ClusterSnapshot, otherPods []model.PodInfo) {
+		e := NewGPUIdleEnricher(tracker, 10, 0, otherPods)
+		e.now = func() time.Time { return now }
+		if err := e.Enrich(snap); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// idle-1 spikes once; the tensor threshold is off.
+	for i := range 7 {
+		snap := gpuIdleSnapshot()
+		if i == 3 {
+			*snap.Pods[0].Containers[0].GPUUtilizationPercent = 50
+		}
+		enrich(start.Add(time.Duration(i)*10*time.Minute), snap, nil)
+		if i == 6 {
+			if snap.Pods[0].Containers[0].GPUIdle != nil {
+				t.Error("idle-1 was busy within the window")
+			}
+			if snap.Pods[2].Containers[0].GPUIdle != nil {
+				t.Error("low tensor activity flagged with the threshold off")
+			}
+		}
+	}
+
+	// Pods outside the snapshot take the remaining GPUs, so the node is no
+	// longer tracked, and must be unallocated for a new full window.
+	other := []model.PodInfo{{NodeName: "gpu-1", Phase: "Running", Containers: []model.ContainerInfo{{GPURequest: 4}}}}
+	now := start.Add(70 * time.Minute)
+	snap := gpuIdleSnapshot()
+	enrich(now, snap, other)
+	if snap.Nodes[0].GPUIdle != nil {
+		t.Error("node with all GPUs allocated was flagged")
+	}
+	snap = gpuIdleSnapshot()
+	enrich(now.Add(10*time.Minute), snap, nil)
+	if snap.Nodes[0].GPUIdle != nil {
+		t.Error("node flagged before a new full window")
+	}
+}
+
+func TestMIGShare(t *testing.T) {
+	for profile, want := range map[string]float64{
+		"1g.10gb":    1.0 / 7,
+		"3g.40gb":    3.0 / 7,
+		"7g.80gb":    1,
+		"1c.3g.40gb": 1.0 / 7,
+		"":           1.0 / 7,
+	} {
+		if got := migShare(profile); math.Abs(got-want) > 1e-9 {
+			t.Errorf("migShare(%q) = %v, want %v", profile, got, want)
+		}
+	}
+}
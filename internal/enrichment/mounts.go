@@ -1,13 +1,14 @@
 package enrichment
 
 import (
+	"sort"
+
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
-// MountsEnricher resolves PVC → Pod mount relationships.
-// Currently a no-op because PodInfo does not include volume data.
-// The mount resolution will be implemented when pod volume information
-// is added to the model.
+// MountsEnricher resolves PVC → Pod mount relationships from pod volumes and
+// rolls the capacity of mounted PVCs up to Deployments and StatefulSets.
+// It must run after ownership resolution so pods carry their top-level owner.
 type MountsEnricher struct{}
 
 // NewMountsEnricher creates a new MountsEnricher.
@@ -18,7 +19,88 @@ func NewMountsEnricher() *MountsEnricher {
 // Name implements the Enricher interface.
 func (m *MountsEnricher) Name() string { return "mounts" }
 
-// Enrich is a no-op until pod volume data is available in the model.
-func (m *MountsEnricher) Enrich(_ *model.ClusterSnapshot) error {
+// Enrich populates MountedByPods on PVCs and TotalPVCCapacityBytes on
+// Deployments and StatefulSets. Pods in a terminal phase no longer hold their
+// volumes and are ignored, so a PVC with no mounting pods is unattached.
+func (m *MountsEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
+	pvcIndex := make(map[string]int, len(snapshot.PVCs))
+	for i, pvc := range snapshot.PVCs {
+		pvcIndex[pvc.Namespace+"/"+pvc.Name] = i
+	}
+
+	mountedBy := make(map[int][]string)
+	// owner key ("Kind/namespace/name") → set of PVC indexes
+	ownerPVCs := make(map[string]map[int]struct{})
+
+	for _, pod := range snapshot.Pods {
+		if pod.Phase == "Succeeded" || pod.Phase == "Failed" {
+			continue
+		}
+		for _, v := range pod.Volumes {
+			if v.ClaimName == "" {
+				continue
+			}
+			idx, ok := pvcIndex[pod.Namespace+"/"+v.ClaimName]
+			if !ok {
+				continue
+			}
+			mountedBy[idx] = append(mountedBy[idx], pod.Name)
+
+			if pod.OwnerKind != "Deployment" && pod.OwnerKind != "StatefulSet" {
+				continue
+			}
+			key := pod.OwnerKind + "/" + pod.Namespace + "/" + pod.OwnerName
+			if ownerPVCs[key] == nil {
+				ownerPVCs[key] = make(map[int]struct{})
+			}
+			ownerPVCs[key][idx] = struct{}{}
+		}
+	}
+
+	for i := range snapshot.PVCs {
+		pods := mountedBy[i]
+		sort.Strings(pods)
+		snapshot.PVCs[i].MountedByPods = dedupeSorted(pods)
+	}
+
+	capacity := func(kind, namespace, name string) int64 {
+		var total int64
+		for idx := range ownerPVCs[kind+"/"+namespace+"/"+name] {
+			total += pvcSize(snapshot.PVCs[idx])
+		}
+		return total
+	}
+	for i := range snapshot.Deployments {
+		d := &snapshot.Deployments[i]
+		d.TotalPVCCapacityBytes = capacity("Deployment", d.Namespace, d.Name)
+	}
+	for i := range snapshot.StatefulSets {
+		s := &snapshot.StatefulSets[i]
+		s.TotalPVCCapacityBytes = capacity("StatefulSet", s.Namespace, s.Name)
+	}
+
 	return nil
 }
+
+// pvcSize returns the bound capacity of a PVC, or its requested size while
+// it is still pending.
+func pvcSize(pvc model.PVCInfo) int64 {
+	if pvc.CapacityBytes > 0 {
+		return pvc.CapacityBytes
+	}
+	return pvc.RequestedBytes
+}
+
+// dedupeSorted removes adjacent duplicates (a pod may mount one claim twice).
+func dedupeSorted(s []string) []string {
+	if len(s) < 2 {
+		return s
+	}
+	out := s[:1]
+	for _, v := range s[1:] {
+		if v != out[len(out)-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
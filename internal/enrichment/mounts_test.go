@@ -6,7 +6,7 @@ import (
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
-func TestMounts_NoOp(t *testing.T) {
+func TestMounts_PodWithoutVolumes(t *testing.T) {
 	snap := &model.ClusterSnapshot{
 		PVCs: []model.PVCInfo{{
 			Name:      "data-pvc",
@@ -20,15 +20,108 @@ func TestMounts_NoOp(t *testing.T) {
 
 	e := NewMountsEnricher()
 	if err := e.Enrich(snap); err != nil {
-		t.Fatalf("expected no error from no-op enricher, got %v", err)
+		t.Fatalf("expected no error, got %v", err)
 	}
 
-	// MountedByPods should remain nil/empty since this is a no-op.
+	// MountedByPods should remain empty since the pod declares no volumes.
 	if len(snap.PVCs[0].MountedByPods) != 0 {
 		t.Errorf("expected MountedByPods to be empty, got %v", snap.PVCs[0].MountedByPods)
 	}
 }
 
+func TestMounts_MountedByPods(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		PVCs: []model.PVCInfo{
+			{Name: "shared", Namespace: "default"},
+			{Name: "unattached", Namespace: "default"},
+			{Name: "shared", Namespace: "other"},
+		},
+		Pods: []model.PodInfo{
+			{Name: "b-pod", Namespace: "default", Phase: "Running", Volumes: []model.PodVolumeInfo{
+				{Name: "data", Type: model.VolumeTypePVC, ClaimName: "shared"},
+				{Name: "config", Type: model.VolumeTypeConfigMap, ConfigMapName: "shared"},
+			}},
+			{Name: "a-pod", Namespace: "default", Phase: "Running", Volumes: []model.PodVolumeInfo{
+				{Name: "data", Type: model.VolumeTypePVC, ClaimName: "shared"},
+				{Name: "data-ro", Type: model.VolumeTypePVC, ClaimName: "shared", ReadOnly: true},
+			}},
+			{Name: "done-pod", Namespace: "default", Phase: "Succeeded", Volumes: []model.PodVolumeInfo{
+				{Name: "data", Type: model.VolumeTypePVC, ClaimName: "unattached"},
+			}},
+		},
+	}
+
+	if err := NewMountsEnricher().Enrich(snap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := snap.PVCs[0].MountedByPods
+	if len(got) != 2 || got[0] != "a-pod" || got[1] != "b-pod" {
+		t.Errorf("expected MountedByPods=[a-pod b-pod], got %v", got)
+	}
+	if len(snap.PVCs[1].MountedByPods) != 0 {
+		t.Errorf("expected unattached PVC (terminal pod only) to have no mounts, got %v", snap.PVCs[1].MountedByPods)
+	}
+	if len(snap.PVCs[2].MountedByPods) != 0 {
+		t.Errorf("expected PVC in another namespace to have no mounts, got %v", snap.PVCs[2].MountedByPods)
+	}
+}
+
+func TestMounts_EphemeralVolumeClaim(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		PVCs: []model.PVCInfo{{Name: "worker-0-scratch", Namespace: "default"}},
+		Pods: []model.PodInfo{{
+			Name: "worker-0", Namespace: "default", Phase: "Running",
+			Volumes: []model.PodVolumeInfo{{Name: "scratch", Type: model.VolumeTypeEphemeral, ClaimName: "worker-0-scratch"}},
+		}},
+	}
+
+	if err := NewMountsEnricher().Enrich(snap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := snap.PVCs[0].MountedByPods; len(got) != 1 || got[0] != "worker-0" {
+		t.Errorf("expected MountedByPods=[worker-0], got %v", got)
+	}
+}
+
+func TestMounts_WorkloadPVCCapacity(t *testing.T) {
+	pvcVolume := func(claim string) []model.PodVolumeInfo {
+		return []model.PodVolumeInfo{{Name: "data", Type: model.VolumeTypePVC, ClaimName: claim}}
+	}
+	snap := &model.ClusterSnapshot{
+		PVCs: []model.PVCInfo{
+			{Name: "data-db-0", Namespace: "default", CapacityBytes: 10 << 30},
+			{Name: "data-db-1", Namespace: "default", CapacityBytes: 10 << 30},
+			{Name: "uploads", Namespace: "default", CapacityBytes: 50 << 30},
+			{Name: "pending", Namespace: "default", RequestedBytes: 5 << 30},
+		},
+		Pods: []model.PodInfo{
+			{Name: "db-0", Namespace: "default", Phase: "Running", OwnerKind: "StatefulSet", OwnerName: "db", Volumes: pvcVolume("data-db-0")},
+			{Name: "db-1", Namespace: "default", Phase: "Running", OwnerKind: "StatefulSet", OwnerName: "db", Volumes: pvcVolume("data-db-1")},
+			// Two replicas sharing one RWX claim count it once.
+			{Name: "web-a", Namespace: "default", Phase: "Running", OwnerKind: "Deployment", OwnerName: "web", Volumes: pvcVolume("uploads")},
+			{Name: "web-b", Namespace: "default", Phase: "Running", OwnerKind: "Deployment", OwnerName: "web", Volumes: pvcVolume("uploads")},
+			{Name: "web-c", Namespace: "default", Phase: "Pending", OwnerKind: "Deployment", OwnerName: "web", Volumes: pvcVolume("pending")},
+		},
+		Deployments:  []model.DeploymentInfo{{Name: "web", Namespace: "default"}, {Name: "idle", Namespace: "default"}},
+		StatefulSets: []model.StatefulSetInfo{{Name: "db", Namespace: "default"}},
+	}
+
+	if err := NewMountsEnricher().Enrich(snap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := snap.StatefulSets[0].TotalPVCCapacityBytes, int64(20<<30); got != want {
+		t.Errorf("expected StatefulSet PVC capacity=%d, got %d", want, got)
+	}
+	if got, want := snap.Deployments[0].TotalPVCCapacityBytes, int64(55<<30); got != want {
+		t.Errorf("expected Deployment PVC capacity=%d, got %d", want, got)
+	}
+	if got := snap.Deployments[1].TotalPVCCapacityBytes; got != 0 {
+		t.Errorf("expected Deployment without pods to have 0 PVC capacity, got %d", got)
+	}
+}
+
 func TestMounts_Name(t *testing.T) {
 	e := NewMountsEnricher()
 	if e.Name() != "mounts" {
@@ -13,8 +13,11 @@ const kindReplicaSet = "ReplicaSet"
 // OwnershipEnricher resolves pod ownership chains.
 // It walks from Pod → ReplicaSet → Deployment (or other top-level owner),
 // updating the pod's OwnerKind/OwnerName/OwnerUID to the top-level owner.
+// Custom workloads (CRDs) owned by other custom workloads are walked too,
+// e.g. Pod → ReplicaSet → Rollout or Pod → SparkApplication → ScheduledSparkApplication.
 type OwnershipEnricher struct {
 	replicaSets []model.ReplicaSetInfo
+	podJobs     map[string]string
 }
 
 // NewOwnershipEnricher creates an enricher with the given ReplicaSets
@@ -32,14 +35,22 @@ func (o *OwnershipEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
 	rsMap := o.buildReplicaSetMap()
 	jobMap := buildJobMap(snapshot.Jobs)
 	cronJobMap := buildCronJobMap(snapshot.CronJobs)
+	customMap := buildCustomWorkloadMap(snapshot.CustomWorkloads)
+	o.podJobs = make(map[string]string)
 
 	for i := range snapshot.Pods {
 		pod := &snapshot.Pods[i]
-		o.resolveOwner(pod, rsMap, jobMap, cronJobMap)
+		o.resolveOwner(pod, rsMap, jobMap, cronJobMap, customMap)
 	}
 	return nil
 }
 
+// PodJobs returns the Job that directly owns each pod the last Enrich
+// resolved to a CronJob, keyed by "namespace/name" of the pod.
+func (o *OwnershipEnricher) PodJobs() map[string]string {
+	return o.podJobs
+}
+
 // buildReplicaSetMap indexes ReplicaSets by "namespace/name".
 func (o *OwnershipEnricher) buildReplicaSetMap() map[string]model.ReplicaSetInfo {
 	m := make(map[string]model.ReplicaSetInfo, len(o.replicaSets))
@@ -70,6 +81,18 @@ func buildCronJobMap(cronJobs []model.CronJobInfo) map[string]model.CronJobInfo
 	return m
 }
 
+// buildCustomWorkloadMap indexes custom workloads by "namespace/Kind/name".
+// Kinds are unique enough within a namespace that the API group is not
+// needed to disambiguate owner references.
+func buildCustomWorkloadMap(workloads []model.CustomWorkloadInfo) map[string]model.CustomWorkloadInfo {
+	m := make(map[string]model.CustomWorkloadInfo, len(workloads))
+	for _, cw := range workloads {
+		key := fmt.Sprintf("%s/%s/%s", cw.Namespace, cw.Kind, cw.Name)
+		m[key] = cw
+	}
+	return m
+}
+
 // resolveOwner walks the ownership chain for a pod, stopping at the
 // top-level owner or after maxOwnerDepth hops to prevent infinite loops.
 func (o *OwnershipEnricher) resolveOwner(
@@ -77,6 +100,7 @@ func (o *OwnershipEnricher) resolveOwner(
 	rsMap map[string]model.ReplicaSetInfo,
 	jobMap map[string]model.JobInfo,
 	cronJobMap map[string]model.CronJobInfo,
+	customMap map[string]model.CustomWorkloadInfo,
 ) {
 	if pod.OwnerKind == "" {
 		return // orphan pod
@@ -88,6 +112,7 @@ func (o *OwnershipEnricher) resolveOwner(
 	kind := pod.OwnerKind
 	name := pod.OwnerName
 	uid := pod.OwnerUID
+	apiVersion := pod.OwnerAPIVersion
 	ns := pod.Namespace
 
 	for depth := 0; depth < maxOwnerDepth; depth++ {
@@ -106,6 +131,7 @@ func (o *OwnershipEnricher) resolveOwner(
 			kind = rs.OwnerKind
 			name = rs.OwnerName
 			uid = rs.OwnerUID
+			apiVersion = rs.OwnerAPIVersion
 			resolved = true
 
 		case "Job":
@@ -117,13 +143,34 @@ func (o *OwnershipEnricher) resolveOwner(
 			if job.OwnerCronJob == "" {
 				break // standalone Job
 			}
+			o.podJobs[fmt.Sprintf("%s/%s", ns, pod.Name)] = name
 			kind = "CronJob"
 			name = job.OwnerCronJob
+			apiVersion = "batch/v1"
 			cjKey := fmt.Sprintf("%s/%s", ns, job.OwnerCronJob)
 			if cj, ok := cronJobMap[cjKey]; ok {
 				uid = cj.UID
 			}
 			resolved = true
+
+		default:
+			// Custom workload owned by another custom workload. Only follow
+			// the chain while the owner is itself a known custom workload,
+			// so pods never resolve to objects missing from the snapshot.
+			key := fmt.Sprintf("%s/%s/%s", ns, kind, name)
+			cw, ok := customMap[key]
+			if !ok || cw.OwnerKind == "" {
+				break
+			}
+			ownerKey := fmt.Sprintf("%s/%s/%s", ns, cw.OwnerKind, cw.OwnerName)
+			if _, ok := customMap[ownerKey]; !ok {
+				break
+			}
+			kind = cw.OwnerKind
+			name = cw.OwnerName
+			uid = cw.OwnerUID
+			apiVersion = cw.OwnerAPIVersion
+			resolved = true
 		}
 
 		if !resolved {
@@ -143,4 +190,5 @@ func (o *OwnershipEnricher) resolveOwner(
 	pod.OwnerKind = kind
 	pod.OwnerName = name
 	pod.OwnerUID = uid
+	pod.OwnerAPIVersion = apiVersion
 }
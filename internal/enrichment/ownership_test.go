@@ -289,3 +289,69 @@ func rsName(i int) string {
 func rsUID(i int) string {
 	return fmt.Sprintf("rs-uid-%d", i)
 }
+
+func TestOwnership_CustomWorkloadChain(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		CustomWorkloads: []model.CustomWorkloadInfo{
+			{
+				APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication",
+				Name: "nightly-20250601", Namespace: "data", UID: "sa-uid",
+				OwnerKind: "ScheduledSparkApplication", OwnerName: "nightly",
+				OwnerUID: "ssa-uid", OwnerAPIVersion: "sparkoperator.k8s.io/v1beta2",
+			},
+			{
+				APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "ScheduledSparkApplication",
+				Name: "nightly", Namespace: "data", UID: "ssa-uid",
+			},
+		},
+		Pods: []model.PodInfo{{
+			Name:            "nightly-20250601-driver",
+			Namespace:       "data",
+			OwnerKind:       "SparkApplication",
+			OwnerName:       "nightly-20250601",
+			OwnerUID:        "sa-uid",
+			OwnerAPIVersion: "sparkoperator.k8s.io/v1beta2",
+		}},
+	}
+
+	e := NewOwnershipEnricher(nil)
+	if err := e.Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	pod := snap.Pods[0]
+	if pod.OwnerKind != "ScheduledSparkApplication" || pod.OwnerName != "nightly" || pod.OwnerUID != "ssa-uid" {
+		t.Errorf("expected ScheduledSparkApplication/nightly (ssa-uid), got %s/%s (%s)",
+			pod.OwnerKind, pod.OwnerName, pod.OwnerUID)
+	}
+	if pod.OwnerAPIVersion != "sparkoperator.k8s.io/v1beta2" {
+		t.Errorf("expected OwnerAPIVersion to follow the chain, got %s", pod.OwnerAPIVersion)
+	}
+}
+
+func TestOwnership_CustomWorkloadUnknownOwnerStops(t *testing.T) {
+	snap := &model.ClusterSnapshot{
+		CustomWorkloads: []model.CustomWorkloadInfo{{
+			APIVersion: "serving.kserve.io/v1beta1", Kind: "InferenceService",
+			Name: "llm", Namespace: "ml", UID: "isvc-uid",
+			// Owner is not a watched custom workload (not in the snapshot).
+			OwnerKind: "Model", OwnerName: "llm-model", OwnerAPIVersion: "ml.example.com/v1",
+		}},
+		Pods: []model.PodInfo{{
+			Name:      "llm-predictor",
+			Namespace: "ml",
+			OwnerKind: "InferenceService",
+			OwnerName: "llm",
+			OwnerUID:  "isvc-uid",
+		}},
+	}
+
+	e := NewOwnershipEnricher(nil)
+	if err := e.Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := snap.Pods[0].OwnerKind; got != "InferenceService" {
+		t.Errorf("expected OwnerKind=InferenceService, got %s", got)
+	}
+}
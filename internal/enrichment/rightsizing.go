@@ -0,0 +1,146 @@
+package enrichment
+
+import (
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/rightsizing"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// RightsizingEnricher records container usage of Deployments, StatefulSets
+// and DaemonSets into a rightsizing.History and sets request recommendations
+// for their container specs. Resources an HPA scales the workload on are
+// not recommended, since changing their requests changes the HPA's
+// utilization and so the replica count.
+type RightsizingEnricher struct {
+	history *rightsizing.History
+	now     func() time.Time
+}
+
+// NewRightsizingEnricher creates a RightsizingEnricher. The history outlives
+// the enricher's snapshots and should be created once per agent.
+func NewRightsizingEnricher(history *rightsizing.History) *RightsizingEnricher {
+	return &RightsizingEnricher{history: history, now: time.Now}
+}
+
+// Name implements the Enricher interface.
+func (r *RightsizingEnricher) Name() string { return "rightsizing" }
+
+// Enrich adds the snapshot's usage to the history and sets Recommendations
+// on Deployments, StatefulSets and DaemonSets.
+func (r *RightsizingEnricher) Enrich(snapshot *model.ClusterSnapshot) error {
+	now := r.now()
+	for i := range snapshot.Pods {
+		p := &snapshot.Pods[i]
+		if p.Phase != "Running" || !isRightsizedKind(p.OwnerKind) {
+			continue
+		}
+		for j := range p.Containers {
+			c := &p.Containers[j]
+			if s, ok := rightsizing.SampleOf(c); ok {
+				r.history.Add(rightsizing.Key{
+					Namespace: p.Namespace, Kind: p.OwnerKind, Name: p.OwnerName, Container: c.Name,
+				}, s, now)
+			}
+		}
+	}
+	r.history.Prune(now)
+
+	hpa := hpaControlled(snapshot.HPAs)
+	for i := range snapshot.Deployments {
+		d := &snapshot.Deployments[i]
+		d.Recommendations = r.recommend(d.Namespace, "Deployment", d.Name, d.ContainerSpecs, hpa)
+	}
+	for i := range snapshot.StatefulSets {
+		s := &snapshot.StatefulSets[i]
+		s.Recommendations = r.recommend(s.Namespace, "StatefulSet", s.Name, s.ContainerSpecs, hpa)
+	}
+	for i := range snapshot.DaemonSets {
+		ds := &snapshot.DaemonSets[i]
+		ds.Recommendations = r.recommend(ds.Namespace, "DaemonSet", ds.Name, ds.ContainerSpecs, hpa)
+	}
+	return nil
+}
+
+func isRightsizedKind(kind string) bool {
+	return kind == "Deployment" || kind == "StatefulSet" || kind == "DaemonSet"
+}
+
+// hpaTarget identifies the workload an HPA scales.
+type hpaTarget struct {
+	namespace string
+	kind      string
+	name      string
+}
+
+// hpaResources are the resources an HPA scales on: for every container, and
+// for named containers only.
+type hpaResources struct {
+	all        map[string]bool
+	containers map[string]map[string]bool
+}
+
+func (h hpaResources) controls(container, resource string) bool {
+	return h.all[resource] || h.containers[container][resource]
+}
+
+// hpaControlled indexes the cpu and memory metrics of HPAs by target.
+func hpaControlled(hpas []model.HPAInfo) map[hpaTarget]hpaResources {
+	out := make(map[hpaTarget]hpaResources)
+	for _, h := range hpas {
+		t := hpaTarget{h.Namespace, h.TargetKind, h.TargetName}
+		for _, m := range h.Metrics {
+			if m.ResourceName != "cpu" && m.ResourceName != "memory" {
+				continue
+			}
+			res := out[t]
+			switch m.Type {
+			case "Resource":
+				if res.all == nil {
+					res.all = make(map[string]bool)
+				}
+				res.all[m.ResourceName] = true
+			case "ContainerResource":
+				if res.containers == nil {
+					res.containers = make(map[string]map[string]bool)
+				}
+				if res.containers[m.ContainerName] == nil {
+					res.containers[m.ContainerName] = make(map[string]bool)
+				}
+				res.containers[m.ContainerName][m.ResourceName] = true
+			}
+			out[t] = res
+		}
+	}
+	return out
+}
+
+func (r *RightsizingEnricher) recommend(namespace, kind, name string, specs []model.ContainerSpecInfo, hpa map[hpaTarget]hpaResources) []model.ResourceRecommendation {
+	controlled := hpa[hpaTarget{namespace, kind, name}]
+	var out []model.ResourceRecommendation
+	for _, spec := range specs {
+		rec, ok := r.history.Recommend(rightsizing.Key{Namespace: namespace, Kind: kind, Name: name, Container: spec.Name})
+		if !ok {
+			continue
+		}
+		rr := model.ResourceRecommendation{
+			ContainerName: spec.Name,
+			Confidence:    rec.Confidence,
+			HistoryHours:  rec.Hours,
+		}
+		if controlled.controls(spec.Name, "cpu") {
+			rr.HPAControlled = append(rr.HPAControlled, "cpu")
+		} else {
+			cpu := rec.CPUCores
+			rr.CPURequestCores = &cpu
+		}
+		if controlled.controls(spec.Name, "memory") {
+			rr.HPAControlled = append(rr.HPAControlled, "memory")
+		} else {
+			mem := rec.MemoryBytes
+			rr.MemoryRequestBytes = &mem
+		}
+		out = append(out, rr)
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package enrichment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/rightsizing"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+func usagePod(name, ownerKind, ownerName string, cpu float64, mem int64) model.PodInfo {
+	return model.PodInfo{
+		Name: name, Namespace: "default", Phase: "Running", OwnerKind: ownerKind, OwnerName: ownerName,
+		Containers: []model.ContainerInfo{{Name: "app", CPUUsageCores: &cpu, MemoryUsageBytes: &mem}},
+	}
+}
+
+func TestRightsizing_Recommendations(t *testing.T) {
+	history := rightsizing.NewHistory(24 * time.Hour)
+	e := NewRightsizingEnricher(history)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	e.now = func() time.Time { return now }
+
+	specs := []model.ContainerSpecInfo{{Name: "app"}, {Name: "sidecar"}}
+	snap := &model.ClusterSnapshot{
+		Pods: []model.PodInfo{
+			usagePod("web-1", "Deployment", "web", 0.2, 100<<20),
+			usagePod("web-2", "Deployment", "web", 0.4, 200<<20),
+			usagePod("api-1", "Deployment", "api", 0.2, 100<<20),
+			usagePod("db-0", "StatefulSet", "db", 1.0, 1<<30),
+			usagePod("job-1", "Job", "batch", 4.0, 4<<30),
+		},
+		Deployments: []model.DeploymentInfo{
+			{Name: "web", Namespace: "default", ContainerSpecs: specs},
+			{Name: "api", Namespace: "default", ContainerSpecs: specs},
+		},
+		StatefulSets: []model.StatefulSetInfo{{Name: "db", Namespace: "default", ContainerSpecs: specs[:1]}},
+		HPAs: []model.HPAInfo{{
+			Namespace: "default", TargetKind: "Deployment", TargetName: "api",
+			Metrics: []model.HPAMetricInfo{
+				{Type: "Resource", ResourceName: "cpu"},
+				{Type: "ContainerResource", ContainerName: "app", ResourceName: "memory"},
+			},
+		}},
+	}
+	if err := e.Enrich(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pods of a workload share the container's history: web uses the
+	// larger of its two pods. The sidecar has no usage and is left out.
+	web := snap.Deployments[0].Recommendations
+	if len(web) != 1 || web[0].ContainerName != "app" {
+		t.Fatalf("web recommendations = %+v", web)
+	}
+	if web[0].CPURequestCores == nil || *web[0].CPURequestCores != 0.46 {
+		t.Errorf("web cpu = %v, want 0.46", web[0].CPURequestCores)
+	}
+	if web[0].MemoryRequestBytes == nil || *web[0].MemoryRequestBytes != 230<<20 {
+		t.Errorf("web memory = %v, want %d", web[0].MemoryRequestBytes, 230<<20)
+	}
+	if web[0].Confidence != model.ConfidenceLow || web[0].HistoryHours != 1 {
+		t.Errorf("web confidence = %q over %d hours", web[0].Confidence, web[0].HistoryHours)
+	}
+
+	// api scales on CPU, and on memory of its app container.
+	api := snap.Deployments[1].Recommendations
+	if len(api) != 1 || api[0].CPURequestCores != nil || api[0].MemoryRequestBytes != nil {
+		t.Fatalf("api recommendations = %+v", api)
+	}
+	if got := api[0].HPAControlled; len(got) != 2 || got[0] != "cpu" || got[1] != "memory" {
+		t.Errorf("api HPAControlled = %v", got)
+	}
+
+	if len(snap.StatefulSets[0].Recommendations) != 1 {
+		t.Errorf("db recommendations = %+v", snap.StatefulSets[0].Recommendations)
+	}
+	// Only Deployments, StatefulSets and DaemonSets are recorded.
+	if history.Len() != 3 {
+		t.Errorf("history has %d containers, want 3", history.Len())
+	}
+}
@@ -22,6 +22,8 @@ const (
 	ErrDiscoveryFailed     Code = "DISCOVERY_FAILED"
 	ErrTimeout             Code = "TIMEOUT"
 	ErrPartialData         Code = "PARTIAL_DATA"
+	ErrGPUUnhealthy        Code = "GPU_UNHEALTHY"
+	ErrTLSHandshakeFailed  Code = "TLS_HANDSHAKE_FAILED"
 )
 
 // defaultTTL is the auto-expiry duration for errors not re-reported.
@@ -0,0 +1,157 @@
+// Package gpuidle keeps a rolling window of GPU utilization samples per
+// container, MIG slice or node, from which idle GPUs are detected.
+package gpuidle
+
+import (
+	"sync"
+	"time"
+)
+
+// Sample is one observation of a tracked GPU holder. Value is the GPU
+// utilization in percent, or the number of unallocated GPUs for a node.
+// TensorActive is nil when the exporter does not report tensor activity.
+type Sample struct {
+	Value        float64
+	TensorActive *float64
+}
+
+// Stats summarizes the samples of a key over the window.
+type Stats struct {
+	Min, Max, Avg float64
+	// HasTensor is set when every sample reported tensor activity.
+	HasTensor            bool
+	MaxTensor, AvgTensor float64
+}
+
+type sample struct {
+	at        time.Time
+	value     float64
+	tensor    float64
+	hasTensor bool
+}
+
+// series holds the samples of one key. since is when the key was first
+// observed; it is kept while the key is observed on every sweep.
+type series struct {
+	since    time.Time
+	samples  []sample
+	observed bool
+}
+
+// Tracker keeps samples per key over a sliding window. Keys not observed
+// between two sweeps are forgotten, so a key must be present for the whole
+// window before it has Stats. It is safe for concurrent use.
+type Tracker struct {
+	window time.Duration
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+// NewTracker creates a Tracker covering window.
+func NewTracker(window time.Duration) *Tracker {
+	return &Tracker{
+		window: window,
+		series: make(map[string]*series),
+	}
+}
+
+// Window returns the window the tracker covers.
+func (t *Tracker) Window() time.Duration { return t.window }
+
+// Observe marks key as present at now and records s, if not nil. A key
+// present without metrics keeps its history but adds no sample.
+func (t *Tracker) Observe(key string, s *Sample, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ser, ok := t.series[key]
+	if !ok {
+		ser = &series{since: now}
+		t.series[key] = ser
+	}
+	ser.observed = true
+	if s == nil {
+		return
+	}
+	smp := sample{at: now, value: s.Value}
+	if s.TensorActive != nil {
+		smp.tensor, smp.hasTensor = *s.TensorActive, true
+	}
+	ser.samples = append(ser.samples, smp)
+}
+
+// Stats returns the statistics of key over the window ending at now, or
+// false if the key has not been observed for the whole window or has no
+// samples in it.
+func (t *Tracker) Stats(key string, now time.Time) (Stats, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ser, ok := t.series[key]
+	if !ok || now.Sub(ser.since) < t.window {
+		return Stats{}, false
+	}
+	oldest := now.Add(-t.window)
+	var (
+		st        = Stats{HasTensor: true}
+		n         int
+		sum, tsum float64
+	)
+	for _, s := range ser.samples {
+		if !s.at.After(oldest) {
+			continue
+		}
+		if n == 0 {
+			st.Min, st.Max, st.MaxTensor = s.value, s.value, s.tensor
+		}
+		n++
+		st.Min = min(st.Min, s.value)
+		st.Max = max(st.Max, s.value)
+		sum += s.value
+		if s.hasTensor {
+			st.MaxTensor = max(st.MaxTensor, s.tensor)
+			tsum += s.tensor
+		} else {
+			st.HasTensor = false
+		}
+	}
+	if n == 0 {
+		return Stats{}, false
+	}
+	st.Avg = sum / float64(n)
+	if st.HasTensor {
+		st.AvgTensor = tsum / float64(n)
+	} else {
+		st.MaxTensor = 0
+	}
+	return st, true
+}
+
+// Sweep forgets keys not observed since the previous sweep and drops
+// samples that have left the window.
+func (t *Tracker) Sweep(now time.Time) {
+	oldest := now.Add(-t.window)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, ser := range t.series {
+		if !ser.observed {
+			delete(t.series, key)
+			continue
+		}
+		ser.observed = false
+		i := 0
+		for i < len(ser.samples) && !ser.samples[i].at.After(oldest) {
+			i++
+		}
+		if i > 0 {
+			ser.samples = append(ser.samples[:0], ser.samples[i:]...)
+		}
+	}
+}
+
+// Len returns the number of tracked keys.
+func (t *Tracker) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.series)
+}
@@ -0,0 +1,74 @@
+package gpuidle
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTracker_Stats(t *testing.T) {
+	tr := NewTracker(time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tensor := func(v float64) *float64 { return &v }
+
+	for i := range 7 {
+		now := start.Add(time.Duration(i) * 10 * time.Minute)
+		tr.Observe("c", &Sample{Value: float64(i), TensorActive: tensor(float64(i) / 2)}, now)
+		tr.Sweep(now)
+		if _, ok := tr.Stats("c", now); ok != (i == 6) {
+			t.Fatalf("sample %d: Stats ok = %v, want a full window first", i, ok)
+		}
+	}
+
+	// The first sample (at start) has left the window.
+	st, _ := tr.Stats("c", start.Add(time.Hour))
+	if st.Min != 1 || st.Max != 6 || st.Avg != 3.5 {
+		t.Errorf("Min, Max, Avg = %v, %v, %v; want 1, 6, 3.5", st.Min, st.Max, st.Avg)
+	}
+	if !st.HasTensor || st.MaxTensor != 3 || st.AvgTensor != 1.75 {
+		t.Errorf("HasTensor, MaxTensor, AvgTensor = %v, %v, %v", st.HasTensor, st.MaxTensor, st.AvgTensor)
+	}
+
+	// A sample without tensor activity clears HasTensor.
+	now := start.Add(70 * time.Minute)
+	tr.Observe("c", &Sample{Value: 2}, now)
+	if st, _ := tr.Stats("c", now); st.HasTensor || st.MaxTensor != 0 {
+		t.Errorf("HasTensor = %v, MaxTensor = %v after a sample without tensor activity", st.HasTensor, st.MaxTensor)
+	}
+}
+
+func TestTracker_SweepForgetsAbsentKeys(t *testing.T) {
+	tr := NewTracker(time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tr.Observe("a", &Sample{Value: 1}, start)
+	tr.Observe("b", &Sample{Value: 1}, start)
+	tr.Sweep(start)
+
+	// "b" is present without metrics and keeps its history; "a" is gone.
+	now := start.Add(30 * time.Minute)
+	tr.Observe("b", nil, now)
+	tr.Sweep(now)
+	if tr.Len() != 1 {
+		t.Fatalf("Len = %d, want 1", tr.Len())
+	}
+
+	// "a" restarts its window when it returns.
+	now = start.Add(time.Hour)
+	tr.Observe("a", &Sample{Value: 1}, now)
+	tr.Observe("b", &Sample{Value: 3}, now)
+	if _, ok := tr.Stats("a", now); ok {
+		t.Error("expected no stats for a key that restarted its window")
+	}
+	st, ok := tr.Stats("b", now)
+	if !ok || st.Max != 3 {
+		t.Errorf("Stats(b) = %+v, %v", st, ok)
+	}
+
+	// A key covering the window without samples in it has no stats.
+	tr.Sweep(now)
+	now = start.Add(3 * time.Hour)
+	tr.Observe("b", nil, now)
+	if _, ok := tr.Stats("b", now); ok {
+		t.Error("expected no stats without samples in the window")
+	}
+}
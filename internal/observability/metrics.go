@@ -16,6 +16,7 @@ type Metrics struct {
 	SnapshotSendDuration  prometheus.Histogram
 	SnapshotSizeBytes     *prometheus.HistogramVec
 	SnapshotSendTotal     *prometheus.CounterVec
+	SnapshotTypeTotal     *prometheus.CounterVec
 	OrphanPodNodeRefs     prometheus.Counter
 
 	// Informer metrics
@@ -28,14 +29,21 @@ type Metrics struct {
 	EnricherDuration *prometheus.HistogramVec
 
 	// Transport metrics
-	TransportRetries     prometheus.Counter
-	TransportBufferBytes prometheus.Gauge
+	TransportRetries         prometheus.Counter
+	TransportBufferBytes     prometheus.Gauge
+	TransportBufferEvictions prometheus.Counter
 
 	// State metrics
 	AgentState *prometheus.GaugeVec
 
+	// Leader election metrics
+	IsLeader               prometheus.Gauge
+	LeaderTransitionsTotal prometheus.Counter
+
 	// Metrics API metrics
-	MetricsAPIDuration prometheus.Histogram
+	MetricsAPIDuration     prometheus.Histogram
+	KubeletSummaryDuration prometheus.Histogram
+	CAdvisorScrapeDuration prometheus.Histogram
 
 	// Compression metrics
 	CompressionRatio    prometheus.Gauge
@@ -74,6 +82,10 @@ func NewMetrics() *Metrics {
 			Name: "kubeadapt_agent_snapshot_send_total",
 			Help: "Total number of snapshot send attempts.",
 		}, []string{"status"}),
+		SnapshotTypeTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "kubeadapt_agent_snapshot_type_total",
+			Help: "Total number of snapshots prepared for sending, by type (full, delta).",
+		}, []string{"type"}),
 		OrphanPodNodeRefs: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "kubeadapt_agent_orphan_pod_node_refs_total",
 			Help: "Total pod-to-node references where the node was missing from the snapshot after backfill.",
@@ -103,17 +115,40 @@ func NewMetrics() *Metrics {
 			Name: "kubeadapt_agent_transport_buffer_bytes",
 			Help: "Current size of the transport buffer in bytes.",
 		}),
+		TransportBufferEvictions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "kubeadapt_agent_transport_buffer_evictions_total",
+			Help: "Total number of buffered snapshots evicted because the spool was full.",
+		}),
 
 		AgentState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "kubeadapt_agent_state",
 			Help: "Current agent state (1 = active, 0 = inactive).",
 		}, []string{"state"}),
 
+		IsLeader: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "kubeadapt_agent_leader",
+			Help: "Whether this replica holds the leader election lease (1 = leader, 0 = standby).",
+		}),
+		LeaderTransitionsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "kubeadapt_agent_leader_transitions_total",
+			Help: "Total number of times this replica gained or lost leadership.",
+		}),
+
 		MetricsAPIDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name:    "kubeadapt_agent_metrics_api_duration_seconds",
 			Help:    "Duration of metrics API calls in seconds.",
 			Buckets: prometheus.DefBuckets,
 		}),
+		KubeletSummaryDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "kubeadapt_agent_kubelet_summary_duration_seconds",
+			Help:    "Duration of a kubelet Summary API poll across all nodes in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		CAdvisorScrapeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "kubeadapt_agent_cadvisor_scrape_duration_seconds",
+			Help:    "Duration of a kubelet cAdvisor scrape across all nodes in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}),
 
 		CompressionRatio: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "kubeadapt_agent_compression_ratio",
@@ -132,14 +167,20 @@ func NewMetrics() *Metrics {
 		m.SnapshotSendDuration,
 		m.SnapshotSizeBytes,
 		m.SnapshotSendTotal,
+		m.SnapshotTypeTotal,
 		m.OrphanPodNodeRefs,
 		m.InformerEventsTotal,
 		m.StoreItems,
 		m.EnricherDuration,
 		m.TransportRetries,
 		m.TransportBufferBytes,
+		m.TransportBufferEvictions,
 		m.AgentState,
+		m.IsLeader,
+		m.LeaderTransitionsTotal,
 		m.MetricsAPIDuration,
+		m.KubeletSummaryDuration,
+		m.CAdvisorScrapeDuration,
 		m.CompressionRatio,
 		m.CompressionDuration,
 	)
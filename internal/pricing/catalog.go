@@ -0,0 +1,332 @@
+// Package pricing prices nodes from a catalog of hourly instance prices and
+// per-resource rates, for cost attribution without a backend.
+package pricing
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Pricing sources reported in NodeInfo.PricingSource.
+const (
+	SourceCatalog = "catalog" // instance price from the catalog
+	SourceCustom  = "custom"  // per-resource rates, e.g. for on-prem nodes
+)
+
+// Capacity types used as catalog keys. Nodes without a capacity type are
+// priced as on-demand, and GKE preemptible nodes as spot.
+const (
+	CapacityOnDemand = "on-demand"
+	CapacitySpot     = "spot"
+)
+
+const bytesPerGiB = 1 << 30
+
+//go:embed catalog.json
+var bundled []byte
+
+// defaultRates split an instance price between CPU, memory and GPUs when
+// the catalog has no rates of its own. Only their ratios matter.
+var defaultRates = Rates{CPUCoreHour: 0.031611, MemoryGBHour: 0.004237, GPUHour: 0.95}
+
+// Rates are hourly prices per resource. Nodes missing from the catalog are
+// priced from them when CPUCoreHour and MemoryGBHour are both set. Memory is
+// priced per GiB.
+type Rates struct {
+	CPUCoreHour  float64 `json:"cpuCoreHour,omitempty"`
+	MemoryGBHour float64 `json:"memoryGBHour,omitempty"`
+	GPUHour      float64 `json:"gpuHour,omitempty"`
+}
+
+func (r Rates) complete() bool {
+	return r.CPUCoreHour > 0 && r.MemoryGBHour > 0
+}
+
+// Price is the hourly price of one instance type.
+type Price struct {
+	Provider     string  `json:"provider"`
+	Region       string  `json:"region"`
+	InstanceType string  `json:"instanceType"`
+	CapacityType string  `json:"capacityType,omitempty"`
+	Hourly       float64 `json:"hourly"`
+}
+
+// Node is what the catalog needs to know about a node to price it.
+type Node struct {
+	Provider     string
+	Region       string
+	InstanceType string
+	CapacityType string
+	CPUCores     float64
+	MemoryBytes  int64
+	GPUs         int
+}
+
+// Cost is the hourly cost of a node and its split between resources.
+type Cost struct {
+	Hourly           float64
+	CPU, Memory, GPU float64
+	Source           string
+}
+
+type key struct {
+	provider, region, instanceType, capacityType string
+}
+
+// Catalog holds instance prices keyed by provider, region, instance type and
+// capacity type, and per-resource rates. A nil *Catalog prices nothing.
+type Catalog struct {
+	currency string
+	prices   map[key]float64
+	rates    Rates
+}
+
+type catalogFile struct {
+	Currency string  `json:"currency,omitempty"`
+	Rates    Rates   `json:"rates,omitempty"`
+	Prices   []Price `json:"prices"`
+}
+
+// Bundled returns the catalog compiled into the agent: list on-demand prices
+// for common instance types in a few regions of each provider, in USD.
+func Bundled() *Catalog {
+	c, err := Parse("catalog.json", bundled)
+	if err != nil {
+		panic(fmt.Sprintf("pricing: bundled catalog: %v", err))
+	}
+	return c
+}
+
+// Load reads a catalog from a .json or .csv file, or returns the bundled
+// catalog when path is empty.
+func Load(path string) (*Catalog, error) {
+	if path == "" {
+		return Bundled(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: read %s: %w", path, err)
+	}
+	c, err := Parse(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Parse decodes a catalog in the format given by name's extension.
+//
+// JSON catalogs have a currency, optional per-resource rates and a list of
+// prices. CSV catalogs have a header row naming the columns provider, region,
+// instance_type, capacity_type (optional), hourly and currency (optional);
+// they carry no rates.
+func Parse(name string, data []byte) (*Catalog, error) {
+	var (
+		f   catalogFile
+		err error
+	)
+	switch ext := strings.ToLower(filepath.Ext(name)); ext {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&f)
+	case ".csv":
+		f, err = parseCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported catalog format %q, want .json or .csv", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newCatalog(f)
+}
+
+func newCatalog(f catalogFile) (*Catalog, error) {
+	if f.Rates.CPUCoreHour < 0 || f.Rates.MemoryGBHour < 0 || f.Rates.GPUHour < 0 {
+		return nil, errors.New("rates must not be negative")
+	}
+	c := &Catalog{
+		currency: f.Currency,
+		prices:   make(map[key]float64, len(f.Prices)),
+		rates:    f.Rates,
+	}
+	if c.currency == "" {
+		c.currency = "USD"
+	}
+	for i, p := range f.Prices {
+		if p.Provider == "" || p.Region == "" || p.InstanceType == "" {
+			return nil, fmt.Errorf("price %d: provider, region and instance type are required", i+1)
+		}
+		if p.Hourly < 0 {
+			return nil, fmt.Errorf("price %d: hourly price must not be negative", i+1)
+		}
+		k := key{p.Provider, p.Region, p.InstanceType, normalizeCapacityType(p.CapacityType)}
+		if _, dup := c.prices[k]; dup {
+			return nil, fmt.Errorf("price %d: duplicate entry for %s/%s/%s/%s", i+1, k.provider, k.region, k.instanceType, k.capacityType)
+		}
+		c.prices[k] = p.Hourly
+	}
+	return c, nil
+}
+
+func parseCSV(data []byte) (catalogFile, error) {
+	var f catalogFile
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return f, fmt.Errorf("read header: %w", err)
+	}
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"provider", "region", "instance_type", "hourly"} {
+		if _, ok := col[required]; !ok {
+			return f, fmt.Errorf("missing column %q", required)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := col[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return f, err
+		}
+		hourly, err := strconv.ParseFloat(field(record, "hourly"), 64)
+		if err != nil {
+			return f, fmt.Errorf("line %d: invalid hourly price: %w", line, err)
+		}
+		if currency := field(record, "currency"); currency != "" {
+			if f.Currency != "" && f.Currency != currency {
+				return f, fmt.Errorf("line %d: currency %s differs from %s", line, currency, f.Currency)
+			}
+			f.Currency = currency
+		}
+		f.Prices = append(f.Prices, Price{
+			Provider:     field(record, "provider"),
+			Region:       field(record, "region"),
+			InstanceType: field(record, "instance_type"),
+			CapacityType: field(record, "capacity_type"),
+			Hourly:       hourly,
+		})
+	}
+	return f, nil
+}
+
+func normalizeCapacityType(capacityType string) string {
+	switch capacityType {
+	case "":
+		return CapacityOnDemand
+	case "preemptible":
+		return CapacitySpot
+	default:
+		return capacityType
+	}
+}
+
+// WithRates returns a copy of c whose rates are replaced by the non-zero
+// fields of r.
+func (c *Catalog) WithRates(r Rates) *Catalog {
+	if c == nil {
+		return nil
+	}
+	out := *c
+	if r.CPUCoreHour > 0 {
+		out.rates.CPUCoreHour = r.CPUCoreHour
+	}
+	if r.MemoryGBHour > 0 {
+		out.rates.MemoryGBHour = r.MemoryGBHour
+	}
+	if r.GPUHour > 0 {
+		out.rates.GPUHour = r.GPUHour
+	}
+	return &out
+}
+
+// Currency returns the currency of all prices in the catalog.
+func (c *Catalog) Currency() string {
+	if c == nil {
+		return ""
+	}
+	return c.currency
+}
+
+// Len returns the number of instance prices in the catalog.
+func (c *Catalog) Len() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.prices)
+}
+
+// NodeCost returns the hourly cost of a node and how it divides between the
+// node's CPU, memory and GPUs. Nodes in the catalog get the instance price,
+// split in proportion to the resources' value at the catalog's rates (or
+// default rates), so a GPU node's cost mostly follows its GPUs. Other nodes
+// are priced from the catalog's rates. ok is false when neither applies.
+func (c *Catalog) NodeCost(n Node) (cost Cost, ok bool) {
+	if c == nil {
+		return Cost{}, false
+	}
+	if n.Provider != "" && n.InstanceType != "" {
+		k := key{n.Provider, n.Region, n.InstanceType, normalizeCapacityType(n.CapacityType)}
+		if hourly, found := c.prices[k]; found {
+			weights := defaultRates
+			if c.rates.complete() {
+				weights = c.rates
+				if weights.GPUHour == 0 {
+					weights.GPUHour = defaultRates.GPUHour
+				}
+			}
+			cost = weights.cost(n)
+			cost.scale(hourly)
+			cost.Source = SourceCatalog
+			return cost, true
+		}
+	}
+	if !c.rates.complete() {
+		return Cost{}, false
+	}
+	cost = c.rates.cost(n)
+	cost.Source = SourceCustom
+	return cost, true
+}
+
+func (r Rates) cost(n Node) Cost {
+	c := Cost{
+		CPU:    n.CPUCores * r.CPUCoreHour,
+		Memory: float64(n.MemoryBytes) / bytesPerGiB * r.MemoryGBHour,
+		GPU:    float64(n.GPUs) * r.GPUHour,
+	}
+	c.Hourly = c.CPU + c.Memory + c.GPU
+	return c
+}
+
+// scale rescales the split so the parts add up to hourly.
+func (c *Cost) scale(hourly float64) {
+	if c.Hourly > 0 {
+		f := hourly / c.Hourly
+		c.CPU *= f
+		c.Memory *= f
+		c.GPU *= f
+	}
+	c.Hourly = hourly
+}
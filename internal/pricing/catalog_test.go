@@ -0,0 +1,132 @@
+package pricing
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const gib = 1 << 30
+
+func approx(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestBundled(t *testing.T) {
+	c := Bundled()
+	if c.Len() == 0 || c.Currency() != "USD" {
+		t.Fatalf("bundled catalog: %d prices, currency %q", c.Len(), c.Currency())
+	}
+	cost, ok := c.NodeCost(Node{Provider: "aws", Region: "us-east-1", InstanceType: "m5.large", CPUCores: 2, MemoryBytes: 8 * gib})
+	if !ok || cost.Source != SourceCatalog || !approx(cost.Hourly, 0.096) {
+		t.Fatalf("m5.large: %+v, ok=%v", cost, ok)
+	}
+	if !approx(cost.CPU+cost.Memory+cost.GPU, cost.Hourly) || cost.GPU != 0 {
+		t.Errorf("split does not add up: %+v", cost)
+	}
+}
+
+func TestNodeCost_Lookup(t *testing.T) {
+	c, err := Parse("prices.json", []byte(`{
+		"currency": "EUR",
+		"prices": [
+			{"provider": "gcp", "region": "europe-west1", "instanceType": "n2-standard-4", "hourly": 0.2},
+			{"provider": "gcp", "region": "europe-west1", "instanceType": "n2-standard-4", "capacityType": "spot", "hourly": 0.05},
+			{"provider": "aws", "region": "us-east-1", "instanceType": "g5.xlarge", "hourly": 1.0}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Currency() != "EUR" {
+		t.Errorf("Currency = %q", c.Currency())
+	}
+
+	n := Node{Provider: "gcp", Region: "europe-west1", InstanceType: "n2-standard-4", CPUCores: 4, MemoryBytes: 16 * gib}
+	if cost, _ := c.NodeCost(n); !approx(cost.Hourly, 0.2) {
+		t.Errorf("on-demand = %v, want 0.2", cost.Hourly)
+	}
+	n.CapacityType = "preemptible"
+	if cost, _ := c.NodeCost(n); !approx(cost.Hourly, 0.05) {
+		t.Errorf("preemptible = %v, want the spot price 0.05", cost.Hourly)
+	}
+	n.Region = "us-central1"
+	if _, ok := c.NodeCost(n); ok {
+		t.Error("expected no price for an unknown region without rates")
+	}
+
+	// A GPU node's cost mostly follows its GPU.
+	cost, ok := c.NodeCost(Node{Provider: "aws", Region: "us-east-1", InstanceType: "g5.xlarge", CPUCores: 4, MemoryBytes: 16 * gib, GPUs: 1})
+	if !ok || cost.GPU < cost.CPU+cost.Memory {
+		t.Errorf("g5.xlarge split = %+v", cost)
+	}
+}
+
+func TestNodeCost_Rates(t *testing.T) {
+	c := Bundled().WithRates(Rates{CPUCoreHour: 0.02, MemoryGBHour: 0.003})
+
+	cost, ok := c.NodeCost(Node{CPUCores: 8, MemoryBytes: 32 * gib})
+	if !ok || cost.Source != SourceCustom {
+		t.Fatalf("on-prem node: %+v, ok=%v", cost, ok)
+	}
+	if !approx(cost.CPU, 0.16) || !approx(cost.Memory, 0.096) || !approx(cost.Hourly, 0.256) {
+		t.Errorf("on-prem cost = %+v", cost)
+	}
+
+	// Catalog prices still win for known instance types.
+	cost, _ = c.NodeCost(Node{Provider: "aws", Region: "us-east-1", InstanceType: "m5.large", CPUCores: 2, MemoryBytes: 8 * gib})
+	if cost.Source != SourceCatalog {
+		t.Errorf("Source = %q, want catalog", cost.Source)
+	}
+
+	// Without rates, unknown nodes have no price.
+	if _, ok := Bundled().NodeCost(Node{CPUCores: 8, MemoryBytes: 32 * gib}); ok {
+		t.Error("expected no price without rates")
+	}
+	var nilCatalog *Catalog
+	if _, ok := nilCatalog.NodeCost(Node{}); ok {
+		t.Error("nil catalog should price nothing")
+	}
+}
+
+func TestLoad_CSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	data := "provider,region,instance_type,capacity_type,hourly,currency\n" +
+		"aws,eu-central-1,m6i.large,,0.115,EUR\n" +
+		"aws,eu-central-1,m6i.large,spot,0.04,EUR\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Len() != 2 || c.Currency() != "EUR" {
+		t.Fatalf("Len = %d, Currency = %q", c.Len(), c.Currency())
+	}
+	cost, ok := c.NodeCost(Node{Provider: "aws", Region: "eu-central-1", InstanceType: "m6i.large", CapacityType: "spot", CPUCores: 2, MemoryBytes: 8 * gib})
+	if !ok || !approx(cost.Hourly, 0.04) {
+		t.Errorf("spot price = %+v, ok=%v", cost, ok)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"prices.yaml": `prices: []`,
+		"prices.json": `{"prices": [{"provider": "aws", "region": "us-east-1", "instanceType": "m5.large", "hourly": 0.1, "typo": 1}]}`,
+		"dup.json": `{"prices": [
+			{"provider": "aws", "region": "us-east-1", "instanceType": "m5.large", "hourly": 0.1},
+			{"provider": "aws", "region": "us-east-1", "instanceType": "m5.large", "capacityType": "on-demand", "hourly": 0.2}]}`,
+		"neg.json":     `{"rates": {"cpuCoreHour": -1}, "prices": []}`,
+		"missing.csv":  "provider,region,hourly\naws,us-east-1,0.1\n",
+		"badprice.csv": "provider,region,instance_type,hourly\naws,us-east-1,m5.large,cheap\n",
+		"currency.csv": "provider,region,instance_type,hourly,currency\naws,us-east-1,m5.large,0.1,USD\naws,us-east-1,m5.xlarge,0.2,EUR\n",
+	}
+	for name, data := range tests {
+		if _, err := Parse(name, []byte(data)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
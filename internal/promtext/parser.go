@@ -0,0 +1,160 @@
+package promtext
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// maxLineBytes bounds a single exposition line. cAdvisor lines carry long
+// cgroup ids and image references.
+const maxLineBytes = 1024 * 1024
+
+// Sample is a single parsed Prometheus metric sample.
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Parse parses Prometheus exposition text format line-by-line. Comments,
+// blank lines and malformed lines are skipped. If keep is non-nil, only
+// samples whose metric name it accepts are returned; the labels of other
+// lines are never parsed.
+func Parse(data []byte, keep func(name string) bool) []Sample {
+	var samples []Sample
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		s, ok := parseLine(line, keep)
+		if !ok {
+			continue
+		}
+		samples = append(samples, s)
+	}
+
+	return samples
+}
+
+// ParseLine parses a single Prometheus metric line:
+//
+//	metric_name{label1="val1",label2="val2"} value [timestamp]
+func ParseLine(line string) (Sample, bool) {
+	return parseLine(line, nil)
+}
+
+func parseLine(line string, keep func(string) bool) (Sample, bool) {
+	var s Sample
+
+	braceStart := strings.IndexByte(line, '{')
+	if braceStart < 0 {
+		// No labels: "name value"
+		parts := strings.Fields(line)
+		if len(parts) < 2 {
+			return s, false
+		}
+		if keep != nil && !keep(parts[0]) {
+			return s, false
+		}
+		v, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return s, false
+		}
+		s.Name = parts[0]
+		s.Value = v
+		return s, true
+	}
+
+	s.Name = line[:braceStart]
+	if keep != nil && !keep(s.Name) {
+		return Sample{}, false
+	}
+
+	braceEnd := strings.LastIndexByte(line, '}')
+	if braceEnd <= braceStart {
+		return s, false
+	}
+
+	parts := strings.Fields(line[braceEnd+1:])
+	if len(parts) == 0 {
+		return s, false
+	}
+	v, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return s, false
+	}
+	s.Value = v
+	s.Labels = parseLabels(line[braceStart+1 : braceEnd])
+
+	return s, true
+}
+
+// parseLabels parses the label portion of a Prometheus metric line:
+//
+//	label1="val1",label2="val2"
+//
+// It handles escaped characters within quoted label values.
+func parseLabels(s string) map[string]string {
+	l := make(map[string]string)
+	for len(s) > 0 {
+		// Find key=
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			break
+		}
+		key := strings.TrimSpace(s[:eq])
+		s = s[eq+1:]
+
+		// Expect opening quote
+		if len(s) == 0 || s[0] != '"' {
+			break
+		}
+		s = s[1:]
+
+		// Read value until unescaped closing quote
+		var val strings.Builder
+		i := 0
+		for i < len(s) {
+			if s[i] == '\\' && i+1 < len(s) {
+				switch s[i+1] {
+				case '"':
+					val.WriteByte('"')
+				case '\\':
+					val.WriteByte('\\')
+				case 'n':
+					val.WriteByte('\n')
+				default:
+					val.WriteByte('\\')
+					val.WriteByte(s[i+1])
+				}
+				i += 2
+				continue
+			}
+			if s[i] == '"' {
+				break
+			}
+			val.WriteByte(s[i])
+			i++
+		}
+
+		l[key] = val.String()
+		if i < len(s) {
+			s = s[i+1:] // skip closing quote
+		} else {
+			s = ""
+		}
+
+		// Skip comma separator
+		if len(s) > 0 && s[0] == ',' {
+			s = s[1:]
+		}
+	}
+	return l
+}
@@ -0,0 +1,89 @@
+package promtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantOK    bool
+		wantName  string
+		wantValue float64
+	}{
+		{
+			name:      "metric with labels",
+			line:      `DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-123"} 42`,
+			wantOK:    true,
+			wantName:  "DCGM_FI_DEV_GPU_UTIL",
+			wantValue: 42,
+		},
+		{
+			name:      "metric without labels",
+			line:      `some_metric 3.14`,
+			wantOK:    true,
+			wantName:  "some_metric",
+			wantValue: 3.14,
+		},
+		{
+			name:      "metric with timestamp",
+			line:      `DCGM_FI_DEV_GPU_UTIL{gpu="0"} 42 1234567890`,
+			wantOK:    true,
+			wantName:  "DCGM_FI_DEV_GPU_UTIL",
+			wantValue: 42,
+		},
+		{
+			name:   "empty line",
+			line:   "",
+			wantOK: false,
+		},
+		{
+			name:   "malformed",
+			line:   "no_value_here",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := ParseLine(tt.line)
+			assert.Equal(t, tt.wantOK, ok)
+			if ok {
+				assert.Equal(t, tt.wantName, s.Name)
+				assert.InDelta(t, tt.wantValue, s.Value, 0.001)
+			}
+		})
+	}
+}
+
+func TestParse_UnescapesLabelValues(t *testing.T) {
+	samples := Parse([]byte(`m{a="say \"hi\"",b="back\\slash",c="multi\nline"} 1`), nil)
+	require.Len(t, samples, 1)
+	assert.Equal(t, `say "hi"`, samples[0].Labels["a"])
+	assert.Equal(t, `back\slash`, samples[0].Labels["b"])
+	assert.Equal(t, "multi\nline", samples[0].Labels["c"])
+}
+
+func TestParse_KeepFiltersByName(t *testing.T) {
+	data := []byte(`# HELP wanted A wanted metric.
+# TYPE wanted counter
+wanted{pod="a"} 1
+other{pod="b"} 2
+other_bare 3
+wanted_bare 4
+`)
+	keep := func(name string) bool { return name == "wanted" || name == "wanted_bare" }
+
+	samples := Parse(data, keep)
+	require.Len(t, samples, 2)
+	assert.Equal(t, "wanted", samples[0].Name)
+	assert.Equal(t, "a", samples[0].Labels["pod"])
+	assert.Equal(t, "wanted_bare", samples[1].Name)
+	assert.InDelta(t, 4.0, samples[1].Value, 0.001)
+
+	assert.Len(t, Parse(data, nil), 4)
+}
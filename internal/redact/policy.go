@@ -0,0 +1,228 @@
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// Mask replaces the parts of label and annotation values matched by a
+// value pattern.
+const Mask = "[REDACTED]"
+
+// hashLen is the number of hex characters kept from a name's HMAC.
+const hashLen = 32
+
+// Rules is the configuration a Policy is built from.
+type Rules struct {
+	// Key patterns are globs where * matches any run of characters,
+	// including "/". When an allow list is set, only matching keys are
+	// kept; keys matching a deny list are always dropped.
+	LabelAllow      []string
+	LabelDeny       []string
+	AnnotationAllow []string
+	AnnotationDeny  []string
+
+	// ValuePatterns are regular expressions; matches in label and
+	// annotation values are replaced with Mask.
+	ValuePatterns []string
+
+	// HashNames replaces namespace, pod, workload, autoscaler, PDB,
+	// service, ingress and PVC names with an HMAC-SHA256 keyed by HashSalt.
+	HashNames bool
+	HashSalt  string
+}
+
+// Policy redacts labels, annotations and object names before they leave the
+// cluster. A nil *Policy leaves everything unchanged.
+type Policy struct {
+	rules           Rules
+	labelAllow      []*regexp.Regexp
+	labelDeny       []*regexp.Regexp
+	annotationAllow []*regexp.Regexp
+	annotationDeny  []*regexp.Regexp
+	values          []*regexp.Regexp
+	salt            []byte
+}
+
+// NewPolicy compiles rules into a Policy. It returns nil when the rules
+// redact nothing.
+func NewPolicy(r Rules) (*Policy, error) {
+	if len(r.LabelAllow) == 0 && len(r.LabelDeny) == 0 &&
+		len(r.AnnotationAllow) == 0 && len(r.AnnotationDeny) == 0 &&
+		len(r.ValuePatterns) == 0 && !r.HashNames {
+		return nil, nil
+	}
+
+	p := &Policy{rules: r}
+	var err error
+	if p.labelAllow, err = compileGlobs(r.LabelAllow); err != nil {
+		return nil, fmt.Errorf("label allow list: %w", err)
+	}
+	if p.labelDeny, err = compileGlobs(r.LabelDeny); err != nil {
+		return nil, fmt.Errorf("label deny list: %w", err)
+	}
+	if p.annotationAllow, err = compileGlobs(r.AnnotationAllow); err != nil {
+		return nil, fmt.Errorf("annotation allow list: %w", err)
+	}
+	if p.annotationDeny, err = compileGlobs(r.AnnotationDeny); err != nil {
+		return nil, fmt.Errorf("annotation deny list: %w", err)
+	}
+	for _, expr := range r.ValuePatterns {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value pattern %q: %w", expr, err)
+		}
+		p.values = append(p.values, re)
+	}
+	if r.HashNames {
+		if r.HashSalt == "" {
+			return nil, fmt.Errorf("name hashing requires a salt")
+		}
+		p.salt = []byte(r.HashSalt)
+	}
+	return p, nil
+}
+
+// compileGlobs turns key globs into anchored regular expressions.
+func compileGlobs(globs []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(globs))
+	for _, g := range globs {
+		if g == "" {
+			return nil, fmt.Errorf("empty key pattern")
+		}
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(g), `\*`, ".*") + "$"
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key pattern %q: %w", g, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func matchAny(res []*regexp.Regexp, s string) bool {
+	for _, re := range res {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Labels returns the labels the policy lets through, with values masked.
+// The input map is not modified; it is returned as is when the policy has
+// no label or value rules.
+func (p *Policy) Labels(labels map[string]string) map[string]string {
+	if p == nil {
+		return labels
+	}
+	return p.filter(labels, p.labelAllow, p.labelDeny)
+}
+
+// Annotations is Labels for annotations.
+func (p *Policy) Annotations(annotations map[string]string) map[string]string {
+	if p == nil {
+		return annotations
+	}
+	return p.filter(annotations, p.annotationAllow, p.annotationDeny)
+}
+
+// AllowsLabel reports whether Labels keeps the label key, e.g. for the
+// keys of selector requirements.
+func (p *Policy) AllowsLabel(key string) bool {
+	if p == nil {
+		return true
+	}
+	if len(p.labelAllow) > 0 && !matchAny(p.labelAllow, key) {
+		return false
+	}
+	return !matchAny(p.labelDeny, key)
+}
+
+// LabelValue returns a label value masked like Labels masks it.
+func (p *Policy) LabelValue(v string) string {
+	if p == nil {
+		return v
+	}
+	return p.maskValue(v)
+}
+
+func (p *Policy) filter(m map[string]string, allow, deny []*regexp.Regexp) map[string]string {
+	if m == nil || (len(allow) == 0 && len(deny) == 0 && len(p.values) == 0) {
+		return m
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if len(allow) > 0 && !matchAny(allow, k) {
+			continue
+		}
+		if matchAny(deny, k) {
+			continue
+		}
+		out[k] = p.maskValue(v)
+	}
+	return out
+}
+
+func (p *Policy) maskValue(v string) string {
+	for _, re := range p.values {
+		v = re.ReplaceAllLiteralString(v, Mask)
+	}
+	return v
+}
+
+// HashesNames reports whether Name replaces names with their hash.
+func (p *Policy) HashesNames() bool {
+	return p != nil && p.salt != nil
+}
+
+// NamesFingerprint identifies how Name hashes names: policies with the same
+// salt give the same value, and it is 0 when names are not hashed.
+func (p *Policy) NamesFingerprint() int64 {
+	if !p.HashesNames() {
+		return 0
+	}
+	sum := sha256.Sum256(p.salt)
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
+
+// Name returns the keyed hash of an object name, or the name itself when
+// hashing is off. The same name and salt always give the
+// same hash, so objects can still be joined across snapshots.
+func (p *Policy) Name(name string) string {
+	if !p.HashesNames() || name == "" {
+		return name
+	}
+	mac := hmac.New(sha256.New, p.salt)
+	mac.Write([]byte(name))
+	return hex.EncodeToString(mac.Sum(nil))[:hashLen]
+}
+
+// Info describes the policy for AgentHealth. Value patterns are counted but
+// not listed, since they often spell out the data they mask, and the salt
+// is identified only by a fingerprint. Returns nil for a nil policy.
+func (p *Policy) Info() *model.RedactionInfo {
+	if p == nil {
+		return nil
+	}
+	info := &model.RedactionInfo{
+		LabelAllow:      p.rules.LabelAllow,
+		LabelDeny:       p.rules.LabelDeny,
+		AnnotationAllow: p.rules.AnnotationAllow,
+		AnnotationDeny:  p.rules.AnnotationDeny,
+		ValuePatterns:   len(p.values),
+		NamesHashed:     p.HashesNames(),
+	}
+	if p.HashesNames() {
+		sum := sha256.Sum256(p.salt)
+		info.SaltFingerprint = hex.EncodeToString(sum[:4])
+	}
+	return info
+}
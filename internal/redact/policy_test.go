@@ -0,0 +1,144 @@
+package redact
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewPolicy_Empty(t *testing.T) {
+	p, err := NewPolicy(Rules{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != nil {
+		t.Fatalf("expected nil policy, got %+v", p)
+	}
+	// A nil policy passes everything through.
+	in := map[string]string{"owner": "jane@example.com"}
+	if got := p.Labels(in); got["owner"] != "jane@example.com" {
+		t.Errorf("Labels = %v, want unchanged", got)
+	}
+	if got := p.Name("payments"); got != "payments" {
+		t.Errorf("Name = %q, want unchanged", got)
+	}
+	if p.Info() != nil {
+		t.Error("nil policy should report no info")
+	}
+}
+
+func TestPolicy_KeyPatterns(t *testing.T) {
+	p, err := NewPolicy(Rules{
+		LabelAllow:     []string{"app.kubernetes.io/*", "team"},
+		LabelDeny:      []string{"app.kubernetes.io/instance"},
+		AnnotationDeny: []string{"*customer*"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":     "checkout",
+		"app.kubernetes.io/instance": "acme-corp",
+		"team":                       "payments",
+		"ticket":                     "PAY-1234",
+	}
+	got := p.Labels(labels)
+	want := map[string]string{"app.kubernetes.io/name": "checkout", "team": "payments"}
+	if len(got) != len(want) {
+		t.Fatalf("Labels = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("label %q = %q, want %q", k, got[k], v)
+		}
+	}
+	if len(labels) != 4 {
+		t.Error("input labels were modified")
+	}
+
+	ann := p.Annotations(map[string]string{"example.com/customer-name": "Acme", "prometheus.io/scrape": "true"})
+	if _, ok := ann["example.com/customer-name"]; ok || ann["prometheus.io/scrape"] != "true" {
+		t.Errorf("Annotations = %v", ann)
+	}
+
+	// Selector requirement keys follow the label rules.
+	for key, want := range map[string]bool{"team": true, "app.kubernetes.io/name": true, "app.kubernetes.io/instance": false, "ticket": false} {
+		if got := p.AllowsLabel(key); got != want {
+			t.Errorf("AllowsLabel(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestPolicy_ValuePatterns(t *testing.T) {
+	p, err := NewPolicy(Rules{ValuePatterns: []string{`[\w.+-]+@[\w-]+\.[\w.]+`, `[A-Z]{2,5}-\d+`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := p.Annotations(map[string]string{"owner": "jane@example.com, see OPS-42", "tier": "web"})
+	if want := Mask + ", see " + Mask; got["owner"] != want {
+		t.Errorf("owner = %q, want %q", got["owner"], want)
+	}
+	if got["tier"] != "web" {
+		t.Errorf("tier = %q, want unchanged", got["tier"])
+	}
+	if got := p.LabelValue("OPS-42"); got != Mask {
+		t.Errorf("LabelValue = %q, want %q", got, Mask)
+	}
+}
+
+func TestPolicy_HashNames(t *testing.T) {
+	p, err := NewPolicy(Rules{HashNames: true, HashSalt: "cluster-local-salt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := p.Name("payments")
+	if h == "payments" || len(h) != hashLen {
+		t.Fatalf("Name = %q, want a %d-character hash", h, hashLen)
+	}
+	if p.Name("payments") != h {
+		t.Error("hash is not deterministic")
+	}
+	if p.Name("") != "" {
+		t.Error("empty names should stay empty")
+	}
+
+	other, err := NewPolicy(Rules{HashNames: true, HashSalt: "another-cluster-salt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Name("payments") == h {
+		t.Error("different salts should give different hashes")
+	}
+
+	info := p.Info()
+	if !info.NamesHashed || info.SaltFingerprint == "" || strings.Contains(info.SaltFingerprint, "salt") {
+		t.Errorf("Info = %+v", info)
+	}
+	if info.SaltFingerprint == other.Info().SaltFingerprint {
+		t.Error("salt fingerprints should differ")
+	}
+
+	if p.NamesFingerprint() == 0 || p.NamesFingerprint() == other.NamesFingerprint() {
+		t.Errorf("NamesFingerprint = %d and %d, want distinct non-zero values", p.NamesFingerprint(), other.NamesFingerprint())
+	}
+	var none *Policy
+	if none.NamesFingerprint() != 0 {
+		t.Error("a nil policy should not fingerprint names")
+	}
+}
+
+func TestNewPolicy_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules Rules
+	}{
+		{"empty glob", Rules{LabelDeny: []string{""}}},
+		{"bad regex", Rules{ValuePatterns: []string{"(unclosed"}}},
+		{"hash without salt", Rules{HashNames: true}},
+	}
+	for _, tt := range tests {
+		if _, err := NewPolicy(tt.rules); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package rightsizing keeps a per-container usage history in the agent and
+// derives request recommendations from it.
+package rightsizing
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+const (
+	// headroom is added on top of observed usage.
+	headroom = 0.15
+	// cpuPercentile of hourly CPU peaks is the CPU target; memory targets
+	// the highest peak because memory is not compressible.
+	cpuPercentile = 0.9
+
+	minCPUCores    = 0.01     // 10m
+	minMemoryBytes = 32 << 20 // 32Mi
+	cpuStep        = 0.001    // 1m
+	memoryStep     = 1 << 20  // 1Mi
+
+	// oomFactor raises the memory peak of an hour with an OOM kill above
+	// the limit the container was killed at.
+	oomFactor = 1.2
+)
+
+// Key identifies a container of a workload.
+type Key struct {
+	Namespace string
+	Kind      string
+	Name      string
+	Container string
+}
+
+// Sample is one usage observation of a container: its CPU p95 and memory
+// peak over a metrics interval.
+type Sample struct {
+	CPUCores    float64
+	MemoryBytes int64
+}
+
+// Recommendation is a request recommendation derived from a history.
+type Recommendation struct {
+	CPUCores    float64
+	MemoryBytes int64
+	Hours       int
+	Confidence  string
+}
+
+// bucket holds the peaks of one hour.
+type bucket struct {
+	hour   int64
+	cpu    float64
+	memory int64
+}
+
+// History keeps hourly CPU and memory peaks per workload container over a
+// sliding window. Samples of all pods of a workload share the container's
+// buckets. It is safe for concurrent use.
+type History struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	buckets map[Key][]bucket
+}
+
+// NewHistory creates a History covering window.
+func NewHistory(window time.Duration) *History {
+	return &History{
+		window:  window,
+		buckets: make(map[Key][]bucket),
+	}
+}
+
+// Add records a sample taken at now. Samples in the same hour keep only
+// their peaks, so adding the same sample twice has no effect. Buckets are
+// kept in hour order.
+func (h *History) Add(k Key, s Sample, now time.Time) {
+	hour := now.Unix() / 3600
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	bs := h.buckets[k]
+	i, found := slices.BinarySearchFunc(bs, hour, func(b bucket, hour int64) int {
+		return cmp.Compare(b.hour, hour)
+	})
+	if found {
+		bs[i].cpu = max(bs[i].cpu, s.CPUCores)
+		bs[i].memory = max(bs[i].memory, s.MemoryBytes)
+		return
+	}
+	h.buckets[k] = slices.Insert(bs, i, bucket{hour: hour, cpu: s.CPUCores, memory: s.MemoryBytes})
+}
+
+// Prune drops buckets older than the window, and containers left without any.
+func (h *History) Prune(now time.Time) {
+	oldest := now.Add(-h.window).Unix() / 3600
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for k, bs := range h.buckets {
+		i := 0
+		for i < len(bs) && bs[i].hour <= oldest {
+			i++
+		}
+		switch {
+		case i == len(bs):
+			delete(h.buckets, k)
+		case i > 0:
+			h.buckets[k] = slices.Clone(bs[i:])
+		}
+	}
+}
+
+// Len returns the number of containers with history.
+func (h *History) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.buckets)
+}
+
+// Recommend returns the recommendation for a container, or false if it has
+// no history. CPU targets the 90th percentile of hourly peaks and memory the
+// highest peak, both with 15% headroom. Confidence is high once three
+// quarters of the window have data, and low below a quarter.
+func (h *History) Recommend(k Key) (Recommendation, bool) {
+	h.mu.Lock()
+	bs := slices.Clone(h.buckets[k])
+	h.mu.Unlock()
+	if len(bs) == 0 {
+		return Recommendation{}, false
+	}
+
+	cpus := make([]float64, len(bs))
+	var memory int64
+	for i, b := range bs {
+		cpus[i] = b.cpu
+		memory = max(memory, b.memory)
+	}
+	slices.Sort(cpus)
+	cpu := cpus[int(math.Ceil(cpuPercentile*float64(len(cpus))))-1]
+
+	r := Recommendation{
+		CPUCores:    max(roundUp(cpu*(1+headroom), cpuStep), minCPUCores),
+		MemoryBytes: max(int64(roundUp(float64(memory)*(1+headroom), memoryStep)), minMemoryBytes),
+		Hours:       len(bs),
+	}
+	switch coverage := float64(len(bs)) / h.window.Hours(); {
+	case coverage >= 0.75:
+		r.Confidence = model.ConfidenceHigh
+	case coverage >= 0.25:
+		r.Confidence = model.ConfidenceMedium
+	default:
+		r.Confidence = model.ConfidenceLow
+	}
+	return r, true
+}
+
+// SampleOf returns the usage sample of a container, or false if it lacks
+// CPU or memory usage metrics. CPU is the p95 over the metrics interval when
+// sampled, and memory the interval peak. A container OOM-killed in the
+// interval used at least oomFactor times its memory limit (or request,
+// without a limit).
+func SampleOf(c *model.ContainerInfo) (Sample, bool) {
+	var s Sample
+	switch {
+	case c.CPUUsageStats != nil:
+		s.CPUCores = c.CPUUsageStats.P95Cores
+	case c.CPUUsageCores != nil:
+		s.CPUCores = *c.CPUUsageCores
+	default:
+		return Sample{}, false
+	}
+	switch {
+	case c.MemoryUsageStats != nil:
+		s.MemoryBytes = c.MemoryUsageStats.MaxBytes
+	case c.MemoryUsageBytes != nil:
+		s.MemoryBytes = *c.MemoryUsageBytes
+	default:
+		return Sample{}, false
+	}
+	if c.OOMEvents != nil && *c.OOMEvents > 0 {
+		killedAt := c.MemoryLimitBytes
+		if killedAt == 0 {
+			killedAt = c.MemoryRequestBytes
+		}
+		s.MemoryBytes = max(s.MemoryBytes, int64(float64(killedAt)*oomFactor))
+	}
+	return s, true
+}
+
+func roundUp(v, step float64) float64 {
+	return math.Ceil(v/step-1e-9) * step
+}
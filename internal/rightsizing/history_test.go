@@ -0,0 +1,89 @@
+package rightsizing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+var key = Key{Namespace: "default", Kind: "Deployment", Name: "web", Container: "app"}
+
+func TestHistory_Recommend(t *testing.T) {
+	h := NewHistory(10 * time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, ok := h.Recommend(key); ok {
+		t.Fatal("expected no recommendation without history")
+	}
+
+	// Hourly CPU peaks 0.1 .. 1.0 cores; memory peaks at 100Mi in hour 3.
+	for i := range 10 {
+		now := start.Add(time.Duration(i) * time.Hour)
+		h.Add(key, Sample{CPUCores: float64(i+1) / 10, MemoryBytes: 50 << 20}, now)
+		h.Add(key, Sample{CPUCores: 0.05, MemoryBytes: 10 << 20}, now.Add(30*time.Minute))
+	}
+	h.Add(key, Sample{CPUCores: 0.3, MemoryBytes: 100 << 20}, start.Add(3*time.Hour+10*time.Minute))
+
+	r, ok := h.Recommend(key)
+	if !ok {
+		t.Fatal("expected a recommendation")
+	}
+	// p90 of the hourly peaks is 0.9 cores, plus 15%.
+	if math.Abs(r.CPUCores-1.035) > 1e-9 {
+		t.Errorf("CPUCores = %v, want 1.035", r.CPUCores)
+	}
+	if want := int64(115 << 20); r.MemoryBytes != want {
+		t.Errorf("MemoryBytes = %d, want %d", r.MemoryBytes, want)
+	}
+	if r.Hours != 10 || r.Confidence != model.ConfidenceHigh {
+		t.Errorf("Hours = %d, Confidence = %q", r.Hours, r.Confidence)
+	}
+
+	// Old hours fall out of the window.
+	h.Prune(start.Add(17 * time.Hour))
+	r, _ = h.Recommend(key)
+	if r.Hours != 2 || r.Confidence != model.ConfidenceLow {
+		t.Errorf("after prune: Hours = %d, Confidence = %q", r.Hours, r.Confidence)
+	}
+	h.Prune(start.Add(30 * time.Hour))
+	if h.Len() != 0 {
+		t.Errorf("expected containers without history to be dropped, got %d", h.Len())
+	}
+}
+
+func TestHistory_Minimums(t *testing.T) {
+	h := NewHistory(24 * time.Hour)
+	h.Add(key, Sample{CPUCores: 0.001, MemoryBytes: 1 << 20}, time.Now())
+	r, _ := h.Recommend(key)
+	if r.CPUCores != minCPUCores || r.MemoryBytes != minMemoryBytes {
+		t.Errorf("got %v cores, %d bytes; want the minimums", r.CPUCores, r.MemoryBytes)
+	}
+}
+
+func TestSampleOf(t *testing.T) {
+	cpu, mem := 0.2, int64(64<<20)
+	c := model.ContainerInfo{CPUUsageCores: &cpu, MemoryUsageBytes: &mem}
+	if s, ok := SampleOf(&c); !ok || s.CPUCores != 0.2 || s.MemoryBytes != mem {
+		t.Errorf("point-in-time sample = %+v, %v", s, ok)
+	}
+
+	c.CPUUsageStats = &model.CPUUsageStats{P95Cores: 0.4, MaxCores: 0.9}
+	c.MemoryUsageStats = &model.MemoryUsageStats{MaxBytes: 80 << 20}
+	if s, _ := SampleOf(&c); s.CPUCores != 0.4 || s.MemoryBytes != 80<<20 {
+		t.Errorf("interval sample = %+v", s)
+	}
+
+	// An OOM kill at a 100Mi limit means the container needed more.
+	ooms := int64(1)
+	c.OOMEvents = &ooms
+	c.MemoryLimitBytes = 100 << 20
+	if s, _ := SampleOf(&c); s.MemoryBytes != 120<<20 {
+		t.Errorf("OOM sample memory = %d, want %d", s.MemoryBytes, 120<<20)
+	}
+
+	if _, ok := SampleOf(&model.ContainerInfo{CPUUsageCores: &cpu}); ok {
+		t.Error("expected no sample without memory usage")
+	}
+}
@@ -0,0 +1,122 @@
+package scope
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Namespaces decides which namespaces the agent collects. A namespace is in
+// scope when it is in the include list (or the list is empty), is not in the
+// exclude list, and its labels match the label selector (if set).
+//
+// A nil *Namespaces puts every namespace in scope.
+type Namespaces struct {
+	include  map[string]struct{}
+	exclude  map[string]struct{}
+	selector labels.Selector
+}
+
+// NewNamespaces builds a scope from include and exclude lists and a label
+// selector in kubectl syntax (e.g. "team in (a,b),!restricted"). It returns
+// nil when nothing is restricted.
+func NewNamespaces(include, exclude []string, labelSelector string) (*Namespaces, error) {
+	if len(include) == 0 && len(exclude) == 0 && labelSelector == "" {
+		return nil, nil
+	}
+
+	n := &Namespaces{
+		include: toSet(include),
+		exclude: toSet(exclude),
+	}
+	for ns := range n.exclude {
+		if _, ok := n.include[ns]; ok {
+			return nil, fmt.Errorf("namespace %q is both included and excluded", ns)
+		}
+	}
+	if labelSelector != "" {
+		sel, err := labels.Parse(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace label selector %q: %w", labelSelector, err)
+		}
+		n.selector = sel
+	}
+	return n, nil
+}
+
+func toSet(names []string) map[string]struct{} {
+	if len(names) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
+
+// AllowsName reports whether the namespace passes the include and exclude
+// lists. It ignores the label selector, so it can be applied where the
+// namespace's labels are not known.
+func (n *Namespaces) AllowsName(namespace string) bool {
+	if n == nil {
+		return true
+	}
+	if _, ok := n.exclude[namespace]; ok {
+		return false
+	}
+	if n.include != nil {
+		_, ok := n.include[namespace]
+		return ok
+	}
+	return true
+}
+
+// Allows reports whether a namespace with the given labels is in scope.
+func (n *Namespaces) Allows(namespace string, nsLabels map[string]string) bool {
+	if !n.AllowsName(namespace) {
+		return false
+	}
+	if n == nil || n.selector == nil {
+		return true
+	}
+	return n.selector.Matches(labels.Set(nsLabels))
+}
+
+// HasLabelSelector reports whether scope decisions depend on namespace labels.
+func (n *Namespaces) HasLabelSelector() bool {
+	return n != nil && n.selector != nil
+}
+
+// SingleNamespace returns the namespace an informer can be restricted to:
+// the only entry of the include list, or "" if there is none or several.
+func (n *Namespaces) SingleNamespace() string {
+	if n == nil || len(n.include) != 1 {
+		return ""
+	}
+	for ns := range n.include {
+		return ns
+	}
+	return ""
+}
+
+// FieldSelector returns a field selector that excludes the excluded
+// namespaces server-side, e.g. "metadata.namespace!=a,metadata.namespace!=b".
+// It is only valid for namespaced resources. Returns "" if nothing is excluded.
+func (n *Namespaces) FieldSelector() string {
+	if n == nil || len(n.exclude) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(n.exclude))
+	for ns := range n.exclude {
+		names = append(names, ns)
+	}
+	sort.Strings(names)
+	terms := make([]string, len(names))
+	for i, ns := range names {
+		terms[i] = "metadata.namespace!=" + ns
+	}
+	return strings.Join(terms, ",")
+}
@@ -0,0 +1,92 @@
+package scope
+
+import "testing"
+
+func TestNewNamespaces_Unrestricted(t *testing.T) {
+	n, err := NewNamespaces(nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != nil {
+		t.Fatalf("expected nil scope, got %+v", n)
+	}
+	// A nil scope allows everything.
+	if !n.Allows("kube-system", nil) || !n.AllowsName("default") {
+		t.Error("nil scope should allow every namespace")
+	}
+	if n.SingleNamespace() != "" || n.FieldSelector() != "" || n.HasLabelSelector() {
+		t.Error("nil scope should not restrict informers")
+	}
+}
+
+func TestNamespaces_IncludeExclude(t *testing.T) {
+	n, err := NewNamespaces([]string{"team-a", "team-b"}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !n.AllowsName("team-a") || n.AllowsName("team-c") {
+		t.Error("include list not applied")
+	}
+	if n.SingleNamespace() != "" {
+		t.Errorf("SingleNamespace = %q, want empty for two includes", n.SingleNamespace())
+	}
+
+	n, err = NewNamespaces(nil, []string{"regulated", "audit"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.AllowsName("regulated") || !n.AllowsName("default") {
+		t.Error("exclude list not applied")
+	}
+	want := "metadata.namespace!=audit,metadata.namespace!=regulated"
+	if got := n.FieldSelector(); got != want {
+		t.Errorf("FieldSelector = %q, want %q", got, want)
+	}
+
+	n, err = NewNamespaces([]string{"dev"}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := n.SingleNamespace(); got != "dev" {
+		t.Errorf("SingleNamespace = %q, want %q", got, "dev")
+	}
+}
+
+func TestNamespaces_LabelSelector(t *testing.T) {
+	n, err := NewNamespaces(nil, []string{"kube-system"}, "team,env!=prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !n.HasLabelSelector() {
+		t.Fatal("expected a label selector")
+	}
+
+	tests := []struct {
+		namespace string
+		labels    map[string]string
+		want      bool
+	}{
+		{"payments", map[string]string{"team": "payments", "env": "staging"}, true},
+		{"payments-prod", map[string]string{"team": "payments", "env": "prod"}, false},
+		{"scratch", nil, false},
+		{"kube-system", map[string]string{"team": "platform"}, false},
+	}
+	for _, tt := range tests {
+		if got := n.Allows(tt.namespace, tt.labels); got != tt.want {
+			t.Errorf("Allows(%q, %v) = %v, want %v", tt.namespace, tt.labels, got, tt.want)
+		}
+	}
+	// The selector is not consulted when labels are unknown.
+	if !n.AllowsName("scratch") {
+		t.Error("AllowsName should ignore the label selector")
+	}
+}
+
+func TestNewNamespaces_Errors(t *testing.T) {
+	if _, err := NewNamespaces([]string{"a"}, []string{"a"}, ""); err == nil {
+		t.Error("expected error for a namespace both included and excluded")
+	}
+	if _, err := NewNamespaces(nil, nil, "team in (a"); err == nil {
+		t.Error("expected error for an invalid label selector")
+	}
+}
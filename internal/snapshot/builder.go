@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector/gpu"
 	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/internal/convert"
 	"github.com/kubeadapt/kubeadapt-agent/internal/enrichment"
 	"github.com/kubeadapt/kubeadapt-agent/internal/errors"
+	"github.com/kubeadapt/kubeadapt-agent/internal/gpuidle"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/pricing"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
@@ -34,6 +41,17 @@ type SnapshotBuilder struct {
 	pipeline       *enrichment.Pipeline
 	gpuCollector   GPUMetricsProvider
 	cloudAccountID string
+	namespaces     *scope.Namespaces
+	redaction      atomic.Pointer[redact.Policy]
+	interval       atomic.Int64 // snapshot interval as a time.Duration
+	pricing        *pricing.Catalog
+	gpuIdle        *gpuidle.Tracker
+
+	// eventsSince, guarded by eventsMu, is the time events were last read
+	// up to by BuildWithWatermarks; zero before the first one and after
+	// ResetEvents.
+	eventsMu    sync.Mutex
+	eventsSince time.Time
 }
 
 // NewSnapshotBuilder creates a SnapshotBuilder with all required dependencies.
@@ -47,7 +65,31 @@ func NewSnapshotBuilder(
 	gpuCollector GPUMetricsProvider,
 	cloudAccountID string,
 ) *SnapshotBuilder {
-	return &SnapshotBuilder{
+	// Validate has already rejected an invalid scope.
+	namespaces, err := scope.NewNamespaces(cfg.NamespaceInclude, cfg.NamespaceExclude, cfg.NamespaceLabelSelector)
+	if err != nil {
+		slog.Error("invalid namespace scope, collecting all namespaces", "error", err)
+	}
+	redaction, err := redact.NewPolicy(cfg.RedactionRules())
+	if err != nil {
+		slog.Error("invalid redaction policy, names are not hashed", "error", err)
+	}
+	var catalog *pricing.Catalog
+	if cfg.PricingEnabled {
+		catalog, err = pricing.Load(cfg.PricingCatalogFile)
+		if err != nil {
+			slog.Error("invalid pricing catalog, costs are not attributed", "error", err)
+		}
+		catalog = catalog.WithRates(cfg.PricingRates())
+		if catalog != nil {
+			slog.Info("cost attribution enabled", "prices", catalog.Len(), "currency", catalog.Currency())
+		}
+	}
+	var gpuIdle *gpuidle.Tracker
+	if cfg.GPUIdleEnabled {
+		gpuIdle = gpuidle.NewTracker(cfg.GPUIdleWindow)
+	}
+	b := &SnapshotBuilder{
 		store:          store,
 		metricsStore:   metricsStore,
 		config:         cfg,
@@ -56,19 +98,98 @@ func NewSnapshotBuilder(
 		pipeline:       pipeline,
 		gpuCollector:   gpuCollector,
 		cloudAccountID: cloudAccountID,
+		namespaces:     namespaces,
+		pricing:        catalog,
+		gpuIdle:        gpuIdle,
 	}
+	b.redaction.Store(redaction)
+	b.interval.Store(int64(cfg.SnapshotInterval))
+	return b
+}
+
+// Redaction returns the policy used to hash names in Build, or nil.
+func (b *SnapshotBuilder) Redaction() *redact.Policy {
+	return b.redaction.Load()
+}
+
+// SetRedaction replaces the policy used to hash names, e.g. after a config
+// file reload. Safe to call while Build runs.
+func (b *SnapshotBuilder) SetRedaction(p *redact.Policy) {
+	b.redaction.Store(p)
+}
+
+// StoreCounts returns the number of items in each store, without building a
+// snapshot.
+func (b *SnapshotBuilder) StoreCounts() map[string]int {
+	return b.store.ItemCounts()
+}
+
+// SetSnapshotInterval replaces the snapshot interval used for the events
+// window and the staleness threshold, e.g. after a config file reload or a
+// backend directive. Safe to call while Build runs.
+func (b *SnapshotBuilder) SetSnapshotInterval(d time.Duration) {
+	b.interval.Store(int64(d))
+}
+
+// ResetEvents makes the next build report the events of the last snapshot
+// interval instead of those received since the previous build, e.g. after
+// this replica was elected leader and others sent the snapshots in between.
+func (b *SnapshotBuilder) ResetEvents() {
+	b.eventsMu.Lock()
+	b.eventsSince = time.Time{}
+	b.eventsMu.Unlock()
 }
 
 // Build reads all stores concurrently, merges metrics, runs enrichment,
-// computes summary, and returns the complete snapshot.
+// computes summary, and returns the complete snapshot. It reports the same
+// events as the next BuildWithWatermarks would, without consuming them, but
+// still feeds the GPU idle tracker and the rightsizing history; use
+// StoreCounts to only count what the stores hold.
 func (b *SnapshotBuilder) Build(ctx context.Context) *model.ClusterSnapshot {
+	snap, _ := b.build(ctx, false)
+	return snap
+}
+
+// BuildWithWatermarks is Build that also returns the per-store LastUpdated
+// times captured before the stores were read. A store whose watermark is
+// unchanged between two builds has not been written in between, which the
+// DeltaTracker uses to skip diffing it. The namespace scope (the namespace
+// labels' watermark, when a label selector is set) and a fingerprint of the
+// redaction salt are returned as extra watermark keys, so a change to
+// either also forces a diff. Events are counted from the previous
+// BuildWithWatermarks, so each occurrence is reported once whatever the
+// snapshot cadence.
+func (b *SnapshotBuilder) BuildWithWatermarks(ctx context.Context) (*model.ClusterSnapshot, map[string]int64) {
+	return b.build(ctx, true)
+}
+
+func (b *SnapshotBuilder) build(ctx context.Context, consumeEvents bool) (*model.ClusterSnapshot, map[string]int64) {
 	start := time.Now()
 
 	snap := &model.ClusterSnapshot{}
 
+	// Step 0: Capture store watermarks before reading, so a write racing the
+	// read below always shows up as a newer watermark on the next build.
+	// The builder's own inputs are captured with them; see builderInputs.
+	watermarks := b.store.LastUpdatedTimes()
+	redaction := b.redaction.Load()
+	inputs := map[string]int64{redactionInput: redaction.NamesFingerprint()}
+	if b.namespaces.HasLabelSelector() {
+		inputs[namespaceScopeInput] = b.store.NamespaceLabels.LastUpdated()
+	}
+
 	// Step 1: Read all TypedStores concurrently.
 	replicaSets := b.readStores(snap)
 
+	// Step 1a: Drop objects in namespaces outside the configured scope, so
+	// metrics merges and enrichment only see what is sent. Pods, PVCs, PVs
+	// and namespaces are kept aside for the cluster totals in Step 6.
+	inScope := namespaceFilter(b.namespaces, b.store.NamespaceLabels)
+	var outOfScope *model.ClusterSnapshot
+	if inScope != nil {
+		outOfScope, replicaSets = applyNamespaceScope(snap, replicaSets, inScope)
+	}
+
 	// Step 2: Read metrics.
 	nodeMetrics := b.metricsStore.NodeMetrics.Values()
 	podMetrics := b.metricsStore.PodMetrics.Values()
@@ -76,12 +197,20 @@ func (b *SnapshotBuilder) Build(ctx context.Context) *model.ClusterSnapshot {
 	// Step 3: Merge metrics into nodes and pods.
 	mergeNodeMetrics(snap.Nodes, nodeMetrics)
 	mergePodMetrics(snap.Pods, podMetrics)
+	mergeContainerUsageStats(snap.Pods, b.metricsStore.ContainerUsage.Stats())
 
-	// Step 3b: Merge GPU metrics (from dcgm-exporter collector).
+	// Step 3a: Merge kubelet Summary API stats (filesystem and network).
+	mergeNodeStats(snap.Nodes, b.metricsStore.NodeStats.Values())
+	mergePodStats(snap.Pods, b.metricsStore.PodStats.Values())
+	mergeVolumeStats(snap.PVCs, b.metricsStore.VolumeStats.Values())
+	mergeContainerSignals(snap.Pods, b.metricsStore.ContainerSignals.Values())
+
+	// Step 3b: Merge GPU metrics (from the GPU exporter collector).
 	if b.gpuCollector != nil {
 		gpuMetrics := b.gpuCollector.GetGPUMetrics()
 		mergeGPUNodeMetrics(snap.Nodes, gpuMetrics)
 		mergeGPUContainerMetrics(snap.Pods, gpuMetrics)
+		b.reportUnhealthyGPUs(snap.Nodes)
 	}
 
 	// Step 3c: Backfill nodes referenced by pods but missing from the
@@ -95,13 +224,67 @@ func (b *SnapshotBuilder) Build(ctx context.Context) *model.ClusterSnapshot {
 		slog.Warn("ownership enrichment failed", "error", err)
 	}
 
+	// Step 4a: Attach event reason counts since the previous build.
+	// Runs after ownership so pod events roll up to top-level owners.
+	if b.config.EventsEnabled {
+		events := b.readEvents(consumeEvents)
+		if inScope != nil {
+			// Node events carry no namespace and are always kept.
+			events, _ = partition(events, func(ev *model.EventInfo) bool {
+				return ev.InvolvedNamespace == "" || inScope(ev.InvolvedNamespace)
+			})
+		}
+		if err := enrichment.NewEventsEnricher(events, replicaSets).Enrich(snap); err != nil {
+			slog.Warn("events enrichment failed", "error", err)
+		}
+		if b.config.EventsRaw {
+			snap.Events = events
+		}
+	}
+
 	// Step 5: Run enrichment pipeline (aggregation, targets, mounts).
 	if b.pipeline != nil {
 		b.pipeline.Run(snap)
 	}
 
-	// Step 6: Compute summary.
+	// Step 5a: Attribute node cost to pods and workloads. Pods outside the
+	// namespace scope still occupy their nodes and take their share.
+	if b.pricing != nil {
+		var otherPods []model.PodInfo
+		if outOfScope != nil {
+			otherPods = outOfScope.Pods
+		}
+		if err := enrichment.NewCostEnricher(b.pricing, otherPods, ownershipEnricher.PodJobs()).Enrich(snap); err != nil {
+			slog.Warn("cost enrichment failed", "error", err)
+		}
+	}
+
+	// Step 5b: Flag idle GPUs. Like cost, GPUs held by pods outside the
+	// namespace scope are not unallocated.
+	if b.gpuIdle != nil {
+		var otherPods []model.PodInfo
+		if outOfScope != nil {
+			otherPods = outOfScope.Pods
+		}
+		e := enrichment.NewGPUIdleEnricher(b.gpuIdle, b.config.GPUIdleUtilizationThreshold, b.config.GPUIdleTensorThreshold, otherPods)
+		if err := e.Enrich(snap); err != nil {
+			slog.Warn("gpu idle enrichment failed", "error", err)
+		}
+	}
+
+	// Step 6: Compute summary. Pod, PVC, PV and namespace totals cover the
+	// whole cluster, including objects dropped by namespace scoping.
 	snap.Summary = ComputeSummary(snap)
+	if outOfScope != nil {
+		addClusterTotals(&snap.Summary, ComputeSummary(outOfScope))
+	}
+	if snap.Summary.TotalHourlyCost != nil {
+		snap.Summary.CostCurrency = b.pricing.Currency()
+	}
+
+	// Step 6a: Hash namespace, pod and workload names if configured. Every
+	// join on names happens above this point.
+	hashNames(snap, redaction)
 
 	// Step 7: Set identity fields.
 	snap.SnapshotID = uuid.New().String()
@@ -110,27 +293,47 @@ func (b *SnapshotBuilder) Build(ctx context.Context) *model.ClusterSnapshot {
 	snap.KubernetesVersion = b.config.KubernetesVersion
 
 	if len(snap.Nodes) > 0 {
-		snap.Provider = deriveProvider(snap.Nodes[0].ProviderID)
+		snap.Provider = convert.ProviderName(snap.Nodes[0].ProviderID)
 		snap.Region = snap.Nodes[0].Region
 	}
 	snap.CloudAccountID = b.cloudAccountID
 
 	// Step 8: Check for stale resources (no update in >3x snapshot interval).
-	stalenessThreshold := 3 * b.config.SnapshotInterval
+	stalenessThreshold := 3 * time.Duration(b.interval.Load())
 	now := time.Now().UnixMilli()
-	for resource, lastUpdated := range b.store.LastUpdatedTimes() {
+	for resource, lastUpdated := range watermarks {
 		age := time.Duration(now-lastUpdated) * time.Millisecond
 		if age > stalenessThreshold {
 			snap.Health.StaleResources = append(snap.Health.StaleResources, resource)
 		}
 	}
 
+	// The builder inputs are returned with the watermarks but are not
+	// stores, so they are added after the staleness check.
+	maps.Copy(watermarks, inputs)
+
 	// Step 9: Track build duration.
 	if b.metrics != nil {
 		b.metrics.SnapshotBuildDuration.Observe(time.Since(start).Seconds())
 	}
 
-	return snap
+	return snap, watermarks
+}
+
+// readEvents summarizes the events received since the previous consuming
+// read, or during the last snapshot interval if there was none.
+func (b *SnapshotBuilder) readEvents(consume bool) []model.EventInfo {
+	b.eventsMu.Lock()
+	defer b.eventsMu.Unlock()
+	since := b.eventsSince
+	if since.IsZero() {
+		since = time.Now().Add(-time.Duration(b.interval.Load()))
+	}
+	events, readAt := b.store.Events.Summarize(since)
+	if consume {
+		b.eventsSince = readAt
+	}
+	return events
 }
 
 // readStores reads all TypedStores concurrently via a WaitGroup.
@@ -269,6 +472,137 @@ func mergePodMetrics(pods []model.PodInfo, metrics []model.PodMetrics) {
 	}
 }
 
+// mergeContainerUsageStats sets the CPU and memory usage distribution over
+// the metrics interval on pod containers. stats is keyed by
+// "namespace/pod/container".
+func mergeContainerUsageStats(pods []model.PodInfo, stats map[string]model.ContainerUsageStats) {
+	if len(stats) == 0 {
+		return
+	}
+	for i := range pods {
+		prefix := pods[i].Namespace + "/" + pods[i].Name + "/"
+		for j := range pods[i].Containers {
+			s, ok := stats[prefix+pods[i].Containers[j].Name]
+			if !ok {
+				continue
+			}
+			cpu := s.CPU
+			mem := s.Memory
+			pods[i].Containers[j].CPUUsageStats = &cpu
+			pods[i].Containers[j].MemoryUsageStats = &mem
+		}
+	}
+}
+
+// mergeNodeStats sets filesystem and network usage on nodes from kubelet
+// Summary API data.
+func mergeNodeStats(nodes []model.NodeInfo, stats []model.NodeStats) {
+	if len(stats) == 0 {
+		return
+	}
+	lookup := make(map[string]model.NodeStats, len(stats))
+	for _, s := range stats {
+		lookup[s.Name] = s
+	}
+	for i := range nodes {
+		if s, ok := lookup[nodes[i].Name]; ok {
+			fs := s.FSUsedBytes
+			imageFS := s.ImageFSUsedBytes
+			rx := s.NetworkRxBytes
+			tx := s.NetworkTxBytes
+			nodes[i].EphemeralStorageUsedBytes = &fs
+			nodes[i].ImageFSUsedBytes = &imageFS
+			nodes[i].NetworkRxBytes = &rx
+			nodes[i].NetworkTxBytes = &tx
+		}
+	}
+}
+
+// mergePodStats sets ephemeral storage and network usage on pods, and rootfs
+// and log usage on their containers, from kubelet Summary API data.
+func mergePodStats(pods []model.PodInfo, stats []model.PodStats) {
+	if len(stats) == 0 {
+		return
+	}
+	lookup := make(map[string]model.PodStats, len(stats))
+	for _, s := range stats {
+		lookup[s.Namespace+"/"+s.Name] = s
+	}
+	for i := range pods {
+		ps, ok := lookup[pods[i].Namespace+"/"+pods[i].Name]
+		if !ok {
+			continue
+		}
+		eph := ps.EphemeralStorageUsedBytes
+		rx := ps.NetworkRxBytes
+		tx := ps.NetworkTxBytes
+		pods[i].EphemeralStorageUsedBytes = &eph
+		pods[i].NetworkRxBytes = &rx
+		pods[i].NetworkTxBytes = &tx
+
+		csLookup := make(map[string]model.ContainerStats, len(ps.Containers))
+		for _, cs := range ps.Containers {
+			csLookup[cs.Name] = cs
+		}
+		for j := range pods[i].Containers {
+			if cs, found := csLookup[pods[i].Containers[j].Name]; found {
+				rootfs := cs.RootfsUsedBytes
+				logs := cs.LogsUsedBytes
+				pods[i].Containers[j].RootfsUsedBytes = &rootfs
+				pods[i].Containers[j].LogsUsedBytes = &logs
+			}
+		}
+	}
+}
+
+// mergeVolumeStats sets used and available bytes on PVCs from the kubelet
+// volume stats of the pods mounting them.
+func mergeVolumeStats(pvcs []model.PVCInfo, stats []model.VolumeStats) {
+	if len(stats) == 0 {
+		return
+	}
+	lookup := make(map[string]model.VolumeStats, len(stats))
+	for _, s := range stats {
+		lookup[s.Namespace+"/"+s.PVCName] = s
+	}
+	for i := range pvcs {
+		if s, ok := lookup[pvcs[i].Namespace+"/"+pvcs[i].Name]; ok {
+			used := s.UsedBytes
+			avail := s.AvailableBytes
+			pvcs[i].UsedBytes = &used
+			pvcs[i].AvailableBytes = &avail
+		}
+	}
+}
+
+// mergeContainerSignals sets the CFS throttle ratio and OOM event count on
+// pod containers from kubelet cAdvisor data. The throttle ratio is left nil
+// when the container ran no CFS periods (no CPU limit, or idle).
+func mergeContainerSignals(pods []model.PodInfo, signals []model.ContainerSignals) {
+	if len(signals) == 0 {
+		return
+	}
+	lookup := make(map[string]model.ContainerSignals, len(signals))
+	for _, s := range signals {
+		lookup[s.Namespace+"/"+s.Pod+"/"+s.Container] = s
+	}
+	for i := range pods {
+		prefix := pods[i].Namespace + "/" + pods[i].Name + "/"
+		for j := range pods[i].Containers {
+			s, ok := lookup[prefix+pods[i].Containers[j].Name]
+			if !ok {
+				continue
+			}
+			oom := s.OOMEvents
+			pods[i].Containers[j].OOMEvents = &oom
+			if s.CPUPeriods > 0 {
+				ratio := float64(s.CPUThrottledPeriods) / float64(s.CPUPeriods)
+				pods[i].Containers[j].CPUThrottledRatio = &ratio
+			}
+		}
+	}
+}
+
 func mergeGPUNodeMetrics(nodes []model.NodeInfo, metrics []gpu.GPUDeviceMetrics) {
 	if len(metrics) == 0 {
 		return
@@ -311,6 +645,7 @@ func mergeGPUNodeMetrics(nodes []model.NodeInfo, metrics []gpu.GPUDeviceMetrics)
 				ModelName:     d.ModelName,
 				MIGProfile:    d.GPUProfile,
 				MIGInstanceID: d.GPUInstanceID,
+				Allocated:     d.PodName != "",
 			}
 
 			if d.GPUUtilization != nil {
@@ -358,11 +693,50 @@ func mergeGPUNodeMetrics(nodes []model.NodeInfo, metrics []gpu.GPUDeviceMetrics)
 			if d.MIGEnabled != nil && *d.MIGEnabled {
 				migDetected = true
 			}
+			if d.SMClockMHz != nil {
+				v := *d.SMClockMHz
+				info.SMClockMHz = &v
+			}
+			if d.XIDError != nil {
+				v := *d.XIDError
+				info.XIDError = &v
+			}
+			if d.ECCSingleBitErrors != nil {
+				v := *d.ECCSingleBitErrors
+				info.ECCSingleBitErrors = &v
+			}
+			if d.ECCDoubleBitErrors != nil {
+				v := *d.ECCDoubleBitErrors
+				info.ECCDoubleBitErrors = &v
+			}
+			if d.ThrottleReasons != nil {
+				info.ThrottleReasons = gpu.ThrottleReasonNames(*d.ThrottleReasons)
+			}
+			if d.NVLinkBandwidthTotal != nil {
+				v := *d.NVLinkBandwidthTotal
+				info.NVLinkBandwidthTotal = &v
+			}
+			if d.NVLinkTxBytesPerSec != nil {
+				v := *d.NVLinkTxBytesPerSec
+				info.NVLinkTxBytesPerSec = &v
+			}
+			if d.NVLinkRxBytesPerSec != nil {
+				v := *d.NVLinkRxBytesPerSec
+				info.NVLinkRxBytesPerSec = &v
+			}
+			info.Health, info.HealthReasons = gpu.DeviceHealth(&d)
+			nodes[i].GPUHealth = worseGPUHealth(nodes[i].GPUHealth, info.Health)
+			if info.Health == model.GPUHealthUnhealthy {
+				nodes[i].GPUUnhealthyDevices++
+			}
 
 			gpuDevices = append(gpuDevices, info)
 		}
 
 		nodes[i].GPUDevices = gpuDevices
+		if nodes[i].GPUVendor == "" {
+			nodes[i].GPUVendor = devs[0].Vendor
+		}
 		if devs[0].ModelName != "" {
 			nodes[i].GPUModel = devs[0].ModelName
 		}
@@ -398,6 +772,47 @@ func mergeGPUNodeMetrics(nodes []model.NodeInfo, metrics []gpu.GPUDeviceMetrics)
 	}
 }
 
+// gpuHealthRank orders GPU health values from best to worst.
+var gpuHealthRank = map[string]int{
+	model.GPUHealthHealthy:   1,
+	model.GPUHealthDegraded:  2,
+	model.GPUHealthUnhealthy: 3,
+}
+
+func worseGPUHealth(a, b string) string {
+	if gpuHealthRank[b] > gpuHealthRank[a] {
+		return b
+	}
+	return a
+}
+
+// reportUnhealthyGPUs reports a GPU_UNHEALTHY error while any node has an
+// unhealthy GPU device. The error expires once the devices recover.
+func (b *SnapshotBuilder) reportUnhealthyGPUs(nodes []model.NodeInfo) {
+	if b.errorCollector == nil {
+		return
+	}
+	var (
+		devices   int
+		unhealthy []string
+	)
+	for i := range nodes {
+		if nodes[i].GPUUnhealthyDevices > 0 {
+			devices += nodes[i].GPUUnhealthyDevices
+			unhealthy = append(unhealthy, nodes[i].Name)
+		}
+	}
+	if devices == 0 {
+		return
+	}
+	b.errorCollector.Report(errors.AgentError{
+		Code:      errors.ErrGPUUnhealthy,
+		Message:   fmt.Sprintf("%d unhealthy GPU devices on nodes %s", devices, strings.Join(unhealthy, ", ")),
+		Component: "gpu",
+		Timestamp: time.Now().UnixMilli(),
+	})
+}
+
 func mergeGPUContainerMetrics(pods []model.PodInfo, metrics []gpu.GPUDeviceMetrics) {
 	if len(metrics) == 0 {
 		return
@@ -409,11 +824,13 @@ func mergeGPUContainerMetrics(pods []model.PodInfo, metrics []gpu.GPUDeviceMetri
 		container string
 	}
 	type containerGPU struct {
-		utilSum   float64
-		utilCount int
-		memUsed   int64
-		hasUtil   bool
-		hasMem    bool
+		utilSum     float64
+		utilCount   int
+		tensorSum   float64
+		tensorCount int
+		memUsed     int64
+		hasUtil     bool
+		hasMem      bool
 	}
 
 	lookup := make(map[containerKey]*containerGPU)
@@ -432,6 +849,10 @@ func mergeGPUContainerMetrics(pods []model.PodInfo, metrics []gpu.GPUDeviceMetri
 			cg.utilCount++
 			cg.hasUtil = true
 		}
+		if m.TensorActivePercent != nil {
+			cg.tensorSum += *m.TensorActivePercent
+			cg.tensorCount++
+		}
 		if m.MemoryUsedBytes != nil {
 			cg.memUsed += *m.MemoryUsedBytes
 			cg.hasMem = true
@@ -453,22 +874,13 @@ func mergeGPUContainerMetrics(pods []model.PodInfo, metrics []gpu.GPUDeviceMetri
 				avg := cg.utilSum / float64(cg.utilCount)
 				pods[i].Containers[j].GPUUtilizationPercent = &avg
 			}
+			if cg.tensorCount > 0 {
+				avg := cg.tensorSum / float64(cg.tensorCount)
+				pods[i].Containers[j].GPUTensorActivePercent = &avg
+			}
 			if cg.hasMem {
 				pods[i].Containers[j].GPUMemoryUsedBytes = &cg.memUsed
 			}
 		}
 	}
 }
-
-func deriveProvider(providerID string) string {
-	switch {
-	case strings.HasPrefix(providerID, "aws://"):
-		return "aws"
-	case strings.HasPrefix(providerID, "gce://"):
-		return "gcp"
-	case strings.HasPrefix(providerID, "azure://"):
-		return "azure"
-	default:
-		return ""
-	}
-}
@@ -2,9 +2,13 @@ package snapshot
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
+
 	"github.com/kubeadapt/kubeadapt-agent/internal/config"
 	"github.com/kubeadapt/kubeadapt-agent/internal/enrichment"
 	"github.com/kubeadapt/kubeadapt-agent/internal/errors"
@@ -236,3 +240,57 @@ func BenchmarkBuild_100Nodes_2000Pods(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkBuild_CompressionRatio reports the zstd compression ratio of
+// built snapshots as JSON at every encoder level, with and without the
+// dictionary bundled with the transport (KUBEADAPT_COMPRESSION_DICTIONARY).
+// The dictionary gains most on small bodies such as deltas and chunk parts,
+// which have little repetition of their own to draw on.
+func BenchmarkBuild_CompressionRatio(b *testing.B) {
+	dict, err := os.ReadFile("../transport/snapshot.zdict")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, size := range []struct {
+		name                                              string
+		nodes, pods, deploys, replicaSets, services, hpas int
+	}{
+		{"100Nodes_2000Pods", 100, 2000, 50, 50, 20, 10},
+		{"2Nodes_10Pods", 2, 10, 2, 2, 2, 1},
+	} {
+		s, ms, cfg, metrics, errCollector := benchDeps()
+		populateBenchStore(s, size.nodes, size.pods, size.deploys, size.replicaSets, size.services, size.hpas)
+		pipeline := enrichment.NewPipeline(metrics, enrichment.NewOwnershipEnricher(s.ReplicaSets.Values()))
+		builder := NewSnapshotBuilder(s, ms, cfg, metrics, errCollector, pipeline, nil, "")
+		raw, err := json.Marshal(builder.Build(context.Background()))
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for level := zstd.SpeedFastest; level <= zstd.SpeedBestCompression; level++ {
+			for _, withDict := range []bool{false, true} {
+				name := size.name + "/" + level.String()
+				opts := []zstd.EOption{zstd.WithEncoderLevel(level)}
+				if withDict {
+					name += "/dict"
+					opts = append(opts, zstd.WithEncoderDict(dict))
+				}
+				b.Run(name, func(b *testing.B) {
+					zw, err := zstd.NewWriter(nil, opts...)
+					if err != nil {
+						b.Fatal(err)
+					}
+					defer zw.Close()
+					b.SetBytes(int64(len(raw)))
+					var compressed []byte
+					for b.Loop() {
+						compressed = zw.EncodeAll(raw, compressed[:0])
+					}
+					b.ReportMetric(float64(len(compressed)), "compressed-bytes")
+					b.ReportMetric(float64(len(raw))/float64(len(compressed)), "ratio")
+				})
+			}
+		}
+	}
+}
@@ -2,13 +2,17 @@ package snapshot
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/collector/gpu"
 	"github.com/kubeadapt/kubeadapt-agent/internal/config"
 	"github.com/kubeadapt/kubeadapt-agent/internal/enrichment"
 	"github.com/kubeadapt/kubeadapt-agent/internal/errors"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
 	"github.com/kubeadapt/kubeadapt-agent/internal/store"
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 	"github.com/stretchr/testify/assert"
@@ -163,6 +167,223 @@ func TestBuild_MergesPodContainerMetrics(t *testing.T) {
 	}
 }
 
+func TestBuild_MergesContainerUsageStats(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+
+	s.Pods.Set("default/p1", model.PodInfo{
+		Name:       "p1",
+		Namespace:  "default",
+		Phase:      "Running",
+		Containers: []model.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	})
+
+	now := time.Now().UnixMilli()
+	ms.ContainerUsage.Add("default/p1/app", now, now, 0.2, 100_000_000)
+	ms.ContainerUsage.Add("default/p1/app", now+1, now+1, 0.4, 300_000_000)
+
+	pipeline := enrichment.NewPipeline(m)
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, pipeline, nil, "")
+	snap := builder.Build(context.Background())
+
+	require.Len(t, snap.Pods, 1)
+	for _, c := range snap.Pods[0].Containers {
+		switch c.Name {
+		case "app":
+			require.NotNil(t, c.CPUUsageStats)
+			assert.InDelta(t, 0.3, c.CPUUsageStats.AvgCores, 0.0001)
+			assert.InDelta(t, 0.4, c.CPUUsageStats.MaxCores, 0.0001)
+			require.NotNil(t, c.MemoryUsageStats)
+			assert.Equal(t, int64(300_000_000), c.MemoryUsageStats.MaxBytes)
+			assert.Equal(t, int64(100_000_000), c.MemoryUsageStats.MinBytes)
+			assert.Equal(t, 2, c.MemoryUsageStats.Samples)
+		case "sidecar":
+			assert.Nil(t, c.CPUUsageStats)
+			assert.Nil(t, c.MemoryUsageStats)
+		}
+	}
+}
+
+func TestBuild_MergesKubeletStats(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+
+	s.Nodes.Set("n1", model.NodeInfo{Name: "n1"})
+	s.Nodes.Set("n2", model.NodeInfo{Name: "n2"})
+	s.Pods.Set("default/p1", model.PodInfo{
+		Name:       "p1",
+		Namespace:  "default",
+		Phase:      "Running",
+		Containers: []model.ContainerInfo{{Name: "app"}},
+	})
+	s.PVCs.Set("default/data", model.PVCInfo{Name: "data", Namespace: "default", CapacityBytes: 10_000_000_000})
+	s.PVCs.Set("default/unmounted", model.PVCInfo{Name: "unmounted", Namespace: "default"})
+
+	ms.NodeStats.Set("n1", model.NodeStats{Name: "n1", FSUsedBytes: 40_000, ImageFSUsedBytes: 12_000, NetworkRxBytes: 100, NetworkTxBytes: 200})
+	ms.PodStats.Set("default/p1", model.PodStats{
+		Name:                      "p1",
+		Namespace:                 "default",
+		EphemeralStorageUsedBytes: 4_096,
+		NetworkRxBytes:            10,
+		NetworkTxBytes:            20,
+		Containers:                []model.ContainerStats{{Name: "app", RootfsUsedBytes: 1_024, LogsUsedBytes: 512}},
+	})
+	ms.VolumeStats.Set("default/data", model.VolumeStats{PVCName: "data", Namespace: "default", UsedBytes: 3_000_000_000, AvailableBytes: 7_000_000_000})
+
+	pipeline := enrichment.NewPipeline(m)
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, pipeline, nil, "")
+	snap := builder.Build(context.Background())
+
+	for _, n := range snap.Nodes {
+		switch n.Name {
+		case "n1":
+			require.NotNil(t, n.EphemeralStorageUsedBytes)
+			assert.Equal(t, int64(40_000), *n.EphemeralStorageUsedBytes)
+			require.NotNil(t, n.ImageFSUsedBytes)
+			assert.Equal(t, int64(12_000), *n.ImageFSUsedBytes)
+			require.NotNil(t, n.NetworkTxBytes)
+			assert.Equal(t, int64(200), *n.NetworkTxBytes)
+		case "n2":
+			assert.Nil(t, n.EphemeralStorageUsedBytes, "n2 has no kubelet stats")
+		}
+	}
+
+	require.Len(t, snap.Pods, 1)
+	pod := snap.Pods[0]
+	require.NotNil(t, pod.EphemeralStorageUsedBytes)
+	assert.Equal(t, int64(4_096), *pod.EphemeralStorageUsedBytes)
+	require.NotNil(t, pod.NetworkRxBytes)
+	assert.Equal(t, int64(10), *pod.NetworkRxBytes)
+	require.NotNil(t, pod.Containers[0].RootfsUsedBytes)
+	assert.Equal(t, int64(1_024), *pod.Containers[0].RootfsUsedBytes)
+	require.NotNil(t, pod.Containers[0].LogsUsedBytes)
+	assert.Equal(t, int64(512), *pod.Containers[0].LogsUsedBytes)
+
+	for _, pvc := range snap.PVCs {
+		switch pvc.Name {
+		case "data":
+			require.NotNil(t, pvc.UsedBytes)
+			assert.Equal(t, int64(3_000_000_000), *pvc.UsedBytes)
+			require.NotNil(t, pvc.AvailableBytes)
+			assert.Equal(t, int64(7_000_000_000), *pvc.AvailableBytes)
+		case "unmounted":
+			assert.Nil(t, pvc.UsedBytes, "unmounted PVC has no volume stats")
+		}
+	}
+}
+
+func TestBuild_MergesContainerSignals(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+
+	s.Pods.Set("default/p1", model.PodInfo{
+		Name:      "p1",
+		Namespace: "default",
+		Phase:     "Running",
+		Containers: []model.ContainerInfo{
+			{Name: "app", CPULimitCores: 1},
+			{Name: "unlimited"},
+			{Name: "new"},
+		},
+	})
+
+	ms.ContainerSignals.Set("default/p1/app", model.ContainerSignals{
+		Namespace: "default", Pod: "p1", Container: "app",
+		CPUPeriods: 600, CPUThrottledPeriods: 150, OOMEvents: 2,
+	})
+	ms.ContainerSignals.Set("default/p1/unlimited", model.ContainerSignals{
+		Namespace: "default", Pod: "p1", Container: "unlimited",
+	})
+
+	pipeline := enrichment.NewPipeline(m)
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, pipeline, nil, "")
+	snap := builder.Build(context.Background())
+
+	require.Len(t, snap.Pods, 1)
+	for _, c := range snap.Pods[0].Containers {
+		switch c.Name {
+		case "app":
+			require.NotNil(t, c.CPUThrottledRatio)
+			assert.InDelta(t, 0.25, *c.CPUThrottledRatio, 0.0001)
+			require.NotNil(t, c.OOMEvents)
+			assert.Equal(t, int64(2), *c.OOMEvents)
+		case "unlimited":
+			assert.Nil(t, c.CPUThrottledRatio, "no CFS periods means no throttle ratio")
+			require.NotNil(t, c.OOMEvents)
+			assert.Equal(t, int64(0), *c.OOMEvents)
+		case "new":
+			assert.Nil(t, c.CPUThrottledRatio)
+			assert.Nil(t, c.OOMEvents)
+		}
+	}
+}
+
+func TestBuild_AttachesEvents(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.EventsEnabled = true
+	cfg.SnapshotInterval = 60 * time.Second
+
+	s.Pods.Set("default/web-1", model.PodInfo{Name: "web-1", Namespace: "default", OwnerKind: "ReplicaSet", OwnerName: "web-abc"})
+	s.ReplicaSets.Set("default/web-abc", model.ReplicaSetInfo{Name: "web-abc", Namespace: "default", OwnerKind: "Deployment", OwnerName: "web"})
+	s.Deployments.Set("default/web", model.DeploymentInfo{Name: "web", Namespace: "default"})
+
+	now := time.Now().UnixMilli()
+	evicted := model.EventInfo{
+		Reason: "Evicted", InvolvedKind: "Pod", InvolvedNamespace: "default", InvolvedName: "web-1",
+		Count: 1, FirstTimestamp: now, LastTimestamp: now,
+	}
+	s.Events.Add(evicted)
+	s.Events.Add(evicted)
+
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), nil, "")
+	snap := builder.Build(context.Background())
+
+	require.Len(t, snap.Pods, 1)
+	assert.Equal(t, map[string]int{"Evicted": 2}, snap.Pods[0].EventReasons)
+	require.Len(t, snap.Deployments, 1)
+	assert.Equal(t, map[string]int{"Evicted": 2}, snap.Deployments[0].EventReasons)
+	assert.Nil(t, snap.Events, "raw events are off by default")
+
+	cfg.EventsRaw = true
+	snap = builder.Build(context.Background())
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, int32(2), snap.Events[0].Count)
+}
+
+func TestBuildWithWatermarks_CountsEventsSincePreviousBuild(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.EventsEnabled = true
+	cfg.EventsRaw = true
+	cfg.SnapshotInterval = 60 * time.Second
+
+	backOff := func(count int32, last int64) model.EventInfo {
+		return model.EventInfo{
+			Reason: "BackOff", InvolvedKind: "Pod", InvolvedNamespace: "default", InvolvedName: "web-1",
+			Count: count, FirstTimestamp: last, LastTimestamp: last,
+		}
+	}
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), nil, "")
+
+	now := time.Now().UnixMilli()
+	s.Events.Add(backOff(2, now))
+	snap, _ := builder.BuildWithWatermarks(context.Background())
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, int32(2), snap.Events[0].Count)
+
+	// Whatever the time since the previous build, and even when the event
+	// reports an older time, only occurrences received since are counted.
+	s.Events.Add(backOff(1, now-10*cfg.SnapshotInterval.Milliseconds()))
+	snap, _ = builder.BuildWithWatermarks(context.Background())
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, int32(1), snap.Events[0].Count)
+
+	snap, _ = builder.BuildWithWatermarks(context.Background())
+	assert.Empty(t, snap.Events)
+
+	// After a reset, the last snapshot interval is reported again.
+	builder.ResetEvents()
+	snap, _ = builder.BuildWithWatermarks(context.Background())
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, int32(3), snap.Events[0].Count)
+}
+
 func TestBuild_SummaryCountsMatchSliceLengths(t *testing.T) {
 	s, ms, cfg, m, ec := newTestDeps()
 
@@ -225,6 +446,280 @@ func TestBuild_SummaryResourceTotals(t *testing.T) {
 	assert.Equal(t, int64(50_000_000_000), snap.Summary.TotalStorageRequested)
 }
 
+func TestBuild_NamespaceScope(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.EventsEnabled = true
+	cfg.EventsRaw = true
+	cfg.SnapshotInterval = 60 * time.Second
+	cfg.NamespaceExclude = []string{"kube-system"}
+	cfg.NamespaceLabelSelector = "team"
+
+	// The selector key is denied by redaction, so only the unredacted
+	// labels carry it.
+	s.Namespaces.Set("payments", model.NamespaceInfo{Name: "payments"})
+	s.Namespaces.Set("regulated", model.NamespaceInfo{Name: "regulated"})
+	s.Namespaces.Set("kube-system", model.NamespaceInfo{Name: "kube-system"})
+	s.NamespaceLabels.Set("payments", map[string]string{"team": "payments"})
+	s.NamespaceLabels.Set("kube-system", map[string]string{"team": "platform"})
+
+	for _, ns := range []string{"payments", "regulated", "kube-system"} {
+		s.Pods.Set(ns+"/app", model.PodInfo{
+			Name: "app", Namespace: ns, Phase: "Running",
+			Containers: []model.ContainerInfo{{Name: "app", CPURequestCores: 1, MemoryRequestBytes: 1_000}},
+		})
+		s.Deployments.Set(ns+"/app", model.DeploymentInfo{Name: "app", Namespace: ns})
+		s.PVCs.Set(ns+"/data", model.PVCInfo{Name: "data", Namespace: ns, RequestedBytes: 10})
+		s.PVs.Set("pv-"+ns, model.PVInfo{
+			Name: "pv-" + ns, Capacity: 100,
+			ClaimRef: &model.PVClaimRefInfo{Namespace: ns, Name: "data"},
+		})
+	}
+	s.PVs.Set("pv-unbound", model.PVInfo{Name: "pv-unbound", Capacity: 100})
+
+	now := time.Now().UnixMilli()
+	s.Events.Add(model.EventInfo{Reason: "Evicted", InvolvedKind: "Pod", InvolvedNamespace: "regulated", InvolvedName: "app", Count: 1, LastTimestamp: now})
+	s.Events.Add(model.EventInfo{Reason: "NodeNotReady", InvolvedKind: "Node", InvolvedName: "n1", Count: 1, LastTimestamp: now})
+
+	util := 50.0
+	gpuMock := &mockGPUProvider{metrics: []gpu.GPUDeviceMetrics{{
+		GPU: "0", UUID: "GPU-aaa", Hostname: "n1",
+		PodName: "app", Namespace: "regulated", ContainerName: "app", GPUUtilization: &util,
+	}}}
+
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), gpuMock, "")
+	snap := builder.Build(context.Background())
+
+	// Only "payments" matches the selector and is not excluded.
+	require.Len(t, snap.Namespaces, 1)
+	assert.Equal(t, "payments", snap.Namespaces[0].Name)
+	require.Len(t, snap.Pods, 1)
+	assert.Equal(t, "payments", snap.Pods[0].Namespace)
+	assert.Nil(t, snap.Pods[0].Containers[0].GPUUtilizationPercent)
+	require.Len(t, snap.Deployments, 1)
+	require.Len(t, snap.PVCs, 1)
+	assert.ElementsMatch(t, []string{"pv-payments", "pv-unbound"}, []string{snap.PVs[0].Name, snap.PVs[1].Name})
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, "NodeNotReady", snap.Events[0].Reason)
+
+	// Pod, PVC, PV and namespace totals still cover the whole cluster.
+	assert.Equal(t, 3, snap.Summary.PodCount)
+	assert.Equal(t, 3, snap.Summary.RunningPodCount)
+	assert.Equal(t, 3, snap.Summary.ContainerCount)
+	assert.Equal(t, 3, snap.Summary.NamespaceCount)
+	assert.Equal(t, 3, snap.Summary.PVCCount)
+	assert.Equal(t, 4, snap.Summary.PVCount)
+	assert.InDelta(t, 3.0, snap.Summary.TotalCPURequested, 0.001)
+	assert.Equal(t, int64(3_000), snap.Summary.TotalMemoryRequested)
+	assert.Equal(t, int64(30), snap.Summary.TotalStorageRequested)
+	assert.Equal(t, int64(400), snap.Summary.TotalStorageCapacity)
+	// Other counts describe what is sent.
+	assert.Equal(t, 1, snap.Summary.DeploymentCount)
+
+	// Building again gives the same result; the stores are not modified.
+	again := builder.Build(context.Background())
+	assert.Len(t, again.Pods, 1)
+	assert.Equal(t, 3, again.Summary.PodCount)
+}
+
+func TestBuild_Cost(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.PricingEnabled = true
+	cfg.NamespaceExclude = []string{"kube-system"}
+
+	s.Nodes.Set("n1", model.NodeInfo{
+		Name: "n1", ProviderID: "aws:///us-east-1a/i-1", Region: "us-east-1", InstanceType: "m5.large",
+		CPUCapacityCores: 2, CPUAllocatable: 2, MemoryCapacityBytes: 8 << 30, MemoryAllocatable: 8 << 30,
+	})
+	s.Nodes.Set("n2", model.NodeInfo{Name: "n2", InstanceType: "custom-box", CPUAllocatable: 8})
+	for _, ns := range []string{"payments", "kube-system"} {
+		s.Pods.Set(ns+"/app", model.PodInfo{
+			Name: "app", Namespace: ns, NodeName: "n1", Phase: "Running", OwnerKind: "Deployment", OwnerName: "app",
+			Containers: []model.ContainerInfo{{Name: "app", CPURequestCores: 0.5, MemoryRequestBytes: 2 << 30}},
+		})
+		s.Deployments.Set(ns+"/app", model.DeploymentInfo{Name: "app", Namespace: ns})
+	}
+
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), nil, "")
+	snap := builder.Build(context.Background())
+
+	var n1 model.NodeInfo
+	for _, n := range snap.Nodes {
+		if n.Name == "n1" {
+			n1 = n
+		} else {
+			assert.Nil(t, n.HourlyCost, "node missing from the bundled catalog")
+		}
+	}
+	require.NotNil(t, n1.HourlyCost)
+	assert.InDelta(t, 0.096, *n1.HourlyCost, 1e-9)
+	assert.Equal(t, "catalog", n1.PricingSource)
+
+	// Each pod holds a quarter of the node; the excluded one is not sent
+	// but its share is not idle either.
+	require.Len(t, snap.Pods, 1)
+	require.NotNil(t, snap.Pods[0].HourlyCost)
+	assert.InDelta(t, 0.024, *snap.Pods[0].HourlyCost, 1e-9)
+	require.NotNil(t, snap.Deployments[0].HourlyCost)
+	assert.InDelta(t, 0.024, *snap.Deployments[0].HourlyCost, 1e-9)
+	require.NotNil(t, n1.IdleHourlyCost)
+	assert.InDelta(t, 0.048, *n1.IdleHourlyCost, 1e-9)
+
+	require.NotNil(t, snap.Summary.TotalHourlyCost)
+	assert.InDelta(t, 0.096, *snap.Summary.TotalHourlyCost, 1e-9)
+	assert.InDelta(t, 0.048, *snap.Summary.IdleHourlyCost, 1e-9)
+	assert.Equal(t, "USD", snap.Summary.CostCurrency)
+
+	// Stored objects are not modified.
+	stored, ok := s.Pods.Get("payments/app")
+	require.True(t, ok)
+	assert.Nil(t, stored.HourlyCost)
+}
+
+func TestBuild_HashNames(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.RedactHashNames = true
+	cfg.RedactHashSalt = "0123456789abcdef"
+	cfg.EventsEnabled = true
+	cfg.EventsRaw = true
+	cfg.SnapshotInterval = time.Minute
+	policy, err := redact.NewPolicy(cfg.RedactionRules())
+	require.NoError(t, err)
+	h := policy.Name
+
+	s.Namespaces.Set("payments", model.NamespaceInfo{Name: "payments"})
+	s.Deployments.Set("payments/checkout", model.DeploymentInfo{
+		Name: "checkout", Namespace: "payments", Selector: map[string]string{"app": "shop"},
+		Conditions: []model.WorkloadConditionInfo{{
+			Type: "Progressing", Status: "True", Reason: "NewReplicaSetAvailable",
+			Message: `ReplicaSet "checkout-abc" has successfully progressed.`,
+		}},
+	})
+	s.ReplicaSets.Set("payments/checkout-abc", model.ReplicaSetInfo{
+		Name: "checkout-abc", Namespace: "payments", OwnerKind: "Deployment", OwnerName: "checkout",
+	})
+	s.Pods.Set("payments/checkout-abc-1", model.PodInfo{
+		Name: "checkout-abc-1", Namespace: "payments", Phase: "Running", NodeName: "n1",
+		OwnerKind: "ReplicaSet", OwnerName: "checkout-abc",
+		Labels:     map[string]string{"app": "shop"},
+		Containers: []model.ContainerInfo{{Name: "app", CPURequestCores: 0.5}},
+		Volumes: []model.PodVolumeInfo{
+			{Name: "data", Type: model.VolumeTypePVC, ClaimName: "checkout-data"},
+			{Name: "scratch", Type: model.VolumeTypeEphemeral, ClaimName: "checkout-abc-1-scratch"},
+		},
+	})
+	s.StatefulSets.Set("payments/ledger", model.StatefulSetInfo{Name: "ledger", Namespace: "payments", ServiceName: "ledger-headless"})
+	s.Pods.Set("payments/ledger-0", model.PodInfo{
+		Name: "ledger-0", Namespace: "payments", Phase: "Running", NodeName: "n1",
+		OwnerKind: "StatefulSet", OwnerName: "ledger",
+		Labels: map[string]string{
+			"app":                                "shop",
+			"statefulset.kubernetes.io/pod-name": "ledger-0",
+			"controller-revision-hash":           "ledger-5d8c7b9f4",
+		},
+		Volumes: []model.PodVolumeInfo{{Name: "data", Type: model.VolumeTypePVC, ClaimName: "data-ledger-0"}},
+	})
+	s.CronJobs.Set("payments/report", model.CronJobInfo{Name: "report", Namespace: "payments", ActiveJobs: []string{"report-28990"}})
+	s.Jobs.Set("payments/report-28990", model.JobInfo{Name: "report-28990", Namespace: "payments", OwnerCronJob: "report"})
+	s.Pods.Set("payments/report-28990-x", model.PodInfo{
+		Name: "report-28990-x", Namespace: "payments", Phase: "Running", NodeName: "n1",
+		OwnerKind: "Job", OwnerName: "report-28990",
+		Labels: map[string]string{"job-name": "report-28990", "batch.kubernetes.io/job-name": "report-28990"},
+	})
+	s.HPAs.Set("payments/checkout-hpa", model.HPAInfo{
+		Name: "checkout-hpa", Namespace: "payments", TargetKind: "Deployment", TargetName: "checkout",
+		Conditions: []model.HPAConditionInfo{{
+			Type: "AbleToScale", Status: "False", Reason: "FailedGetScale",
+			Message: `deployments/scale.apps "checkout" not found`,
+		}},
+	})
+	s.PDBs.Set("payments/checkout-pdb", model.PDBInfo{
+		Name: "checkout-pdb", Namespace: "payments", MatchLabels: map[string]string{"app": "shop"},
+	})
+	s.Services.Set("payments/checkout-svc", model.ServiceInfo{
+		Name: "checkout-svc", Namespace: "payments", Selector: map[string]string{"app": "shop"},
+	})
+	s.Ingresses.Set("payments/checkout-ing", model.IngressInfo{
+		Name: "checkout-ing", Namespace: "payments",
+		Rules: []model.IngressRuleInfo{{
+			Host:  "shop.example.com",
+			Paths: []model.IngressPathInfo{{Path: "/", BackendService: "checkout-svc", BackendPort: "80"}},
+		}},
+		DefaultBackend: &model.IngressBackendInfo{ServiceName: "checkout-svc", ServicePort: "80"},
+	})
+	for i, claim := range []string{"checkout-data", "checkout-abc-1-scratch", "data-ledger-0"} {
+		pv := fmt.Sprintf("pv-%d", i)
+		s.PVCs.Set("payments/"+claim, model.PVCInfo{Name: claim, Namespace: "payments", VolumeName: pv})
+		s.PVs.Set(pv, model.PVInfo{Name: pv, ClaimRef: &model.PVClaimRefInfo{Namespace: "payments", Name: claim}})
+	}
+	ms.PodMetrics.Set("payments/checkout-abc-1", model.PodMetrics{
+		Name: "checkout-abc-1", Namespace: "payments",
+		Containers: []model.ContainerMetrics{{Name: "app", CPUUsageCores: 0.25}},
+	})
+	now := time.Now().UnixMilli()
+	s.Events.Add(model.EventInfo{
+		Reason: "Killing", InvolvedKind: "Pod", InvolvedNamespace: "payments", InvolvedName: "checkout-abc-1",
+		Note: "Stopping container app", Count: 1, FirstTimestamp: now, LastTimestamp: now,
+	})
+	s.Events.Add(model.EventInfo{
+		Reason: "SuccessfulRescale", InvolvedKind: "HorizontalPodAutoscaler", InvolvedNamespace: "payments",
+		InvolvedName: "checkout-hpa", Count: 1, FirstTimestamp: now, LastTimestamp: now,
+	})
+
+	pipeline := enrichment.NewPipeline(m, enrichment.NewTargetsEnricher(), enrichment.NewMountsEnricher())
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, pipeline, nil, "")
+	snap := builder.Build(context.Background())
+
+	var pod model.PodInfo
+	for _, p := range snap.Pods {
+		if p.Name == h("checkout-abc-1") {
+			pod = p
+		}
+	}
+	require.NotEmpty(t, pod.Name)
+	assert.Equal(t, h("payments"), pod.Namespace)
+	assert.Equal(t, h("checkout"), pod.OwnerName)
+	assert.Equal(t, "n1", pod.NodeName, "node names are not hashed")
+	assert.Equal(t, h("checkout-abc-1-scratch"), pod.Volumes[1].ClaimName)
+	// Metrics were joined on the real names before hashing.
+	require.NotNil(t, pod.Containers[0].CPUUsageCores)
+	assert.InDelta(t, 0.25, *pod.Containers[0].CPUUsageCores, 0.001)
+
+	assert.Equal(t, h("payments"), snap.Namespaces[0].Name)
+	assert.Equal(t, h("checkout"), snap.Deployments[0].Name)
+	assert.Equal(t, h("checkout-svc"), snap.Services[0].Name)
+	assert.Equal(t, []model.WorkloadReference{{Kind: "Deployment", Name: h("checkout"), Namespace: h("payments")}},
+		snap.Services[0].TargetWorkloads)
+	assert.Equal(t, h("checkout-hpa"), snap.HPAs[0].Name)
+	assert.Equal(t, h("checkout"), snap.HPAs[0].TargetName)
+	assert.Equal(t, h("report-28990"), snap.Jobs[0].Name)
+	assert.Equal(t, []string{h("report-28990")}, snap.CronJobs[0].ActiveJobs)
+
+	// No raw name is left anywhere in what is sent.
+	body, err := json.Marshal(snap)
+	require.NoError(t, err)
+	for _, name := range []string{
+		"payments", "checkout", "checkout-abc", "checkout-abc-1", "checkout-data", "checkout-abc-1-scratch",
+		"ledger", "ledger-0", "ledger-headless", "data-ledger-0", "report", "report-28990",
+		"checkout-hpa", "checkout-pdb", "checkout-svc", "checkout-ing",
+	} {
+		assert.NotContains(t, string(body), `"`+name, "raw name %q in the snapshot", name)
+		assert.NotContains(t, string(body), name+`"`, "raw name %q in the snapshot", name)
+	}
+
+	// The stores still hold the real names.
+	pv, ok := s.PVs.Get("pv-2")
+	require.True(t, ok)
+	assert.Equal(t, "payments", pv.ClaimRef.Namespace)
+	assert.Equal(t, "data-ledger-0", pv.ClaimRef.Name)
+	stored, ok := s.Pods.Get("payments/ledger-0")
+	require.True(t, ok)
+	assert.Equal(t, "ledger-0", stored.Labels["statefulset.kubernetes.io/pod-name"])
+	dep, ok := s.Deployments.Get("payments/checkout")
+	require.True(t, ok)
+	assert.NotEmpty(t, dep.Conditions[0].Message)
+	assert.Equal(t, policy.Info(), builder.Redaction().Info())
+}
+
 func TestBuild_MetricsAvailableFlag(t *testing.T) {
 	t.Run("no metrics", func(t *testing.T) {
 		s, ms, cfg, m, ec := newTestDeps()
@@ -336,6 +831,73 @@ func TestBuild_MergesGPUNodeMetrics(t *testing.T) {
 	assert.Nil(t, n2.GPUDevices)
 }
 
+func TestBuild_GPUHealthReportsUnhealthyDevices(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+
+	s.Nodes.Set("n1", model.NodeInfo{Name: "n1", GPUCapacity: 2})
+	s.Nodes.Set("n2", model.NodeInfo{Name: "n2", GPUCapacity: 1})
+
+	xid79, xid0 := 79, 0
+	dbe := int64(0)
+	thermal := uint64(0x40)
+	clock := 1410.0
+	gpuMock := &mockGPUProvider{
+		metrics: []gpu.GPUDeviceMetrics{
+			{GPU: "0", UUID: "GPU-aaa", Hostname: "n1", XIDError: &xid79, ECCDoubleBitErrors: &dbe},
+			{GPU: "1", UUID: "GPU-bbb", Hostname: "n1", XIDError: &xid0, ThrottleReasons: &thermal, SMClockMHz: &clock},
+			{GPU: "0", UUID: "GPU-ccc", Hostname: "n2", XIDError: &xid0, ThrottleReasons: &thermal},
+		},
+	}
+
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), gpuMock, "")
+	snap := builder.Build(context.Background())
+
+	nodes := make(map[string]model.NodeInfo)
+	for _, n := range snap.Nodes {
+		nodes[n.Name] = n
+	}
+	n1 := nodes["n1"]
+	assert.Equal(t, model.GPUHealthUnhealthy, n1.GPUHealth)
+	assert.Equal(t, 1, n1.GPUUnhealthyDevices)
+	require.Len(t, n1.GPUDevices, 2)
+	devices := make(map[string]model.GPUDeviceInfo)
+	for _, d := range n1.GPUDevices {
+		devices[d.UUID] = d
+	}
+	assert.Equal(t, model.GPUHealthUnhealthy, devices["GPU-aaa"].Health)
+	assert.Equal(t, []string{"xid_79"}, devices["GPU-aaa"].HealthReasons)
+	require.NotNil(t, devices["GPU-aaa"].XIDError)
+	assert.Equal(t, 79, *devices["GPU-aaa"].XIDError)
+	assert.Equal(t, model.GPUHealthDegraded, devices["GPU-bbb"].Health)
+	assert.Equal(t, []string{"hw_thermal_slowdown"}, devices["GPU-bbb"].ThrottleReasons)
+	require.NotNil(t, devices["GPU-bbb"].SMClockMHz)
+	assert.InDelta(t, 1410.0, *devices["GPU-bbb"].SMClockMHz, 0.001)
+
+	assert.Equal(t, model.GPUHealthDegraded, nodes["n2"].GPUHealth)
+	assert.Zero(t, nodes["n2"].GPUUnhealthyDevices)
+
+	assert.Contains(t, ec.GetActiveErrorCodes(), string(errors.ErrGPUUnhealthy))
+	for _, e := range ec.GetActiveErrors() {
+		if e.Code == errors.ErrGPUUnhealthy {
+			assert.Equal(t, "1 unhealthy GPU devices on nodes n1", e.Message)
+		}
+	}
+}
+
+func TestBuild_GPUHealthyDevicesReportNoError(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	s.Nodes.Set("n1", model.NodeInfo{Name: "n1", GPUCapacity: 1})
+
+	xid := 0
+	gpuMock := &mockGPUProvider{metrics: []gpu.GPUDeviceMetrics{{GPU: "0", UUID: "GPU-aaa", Hostname: "n1", XIDError: &xid}}}
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), gpuMock, "")
+	snap := builder.Build(context.Background())
+
+	require.Len(t, snap.Nodes, 1)
+	assert.Equal(t, model.GPUHealthHealthy, snap.Nodes[0].GPUHealth)
+	assert.NotContains(t, ec.GetActiveErrorCodes(), string(errors.ErrGPUUnhealthy))
+}
+
 func TestBuild_MergesGPUContainerMetrics(t *testing.T) {
 	s, ms, cfg, m, ec := newTestDeps()
 
@@ -431,3 +993,39 @@ func TestBuild_EmptyCloudAccountID(t *testing.T) {
 
 	assert.Empty(t, snap.CloudAccountID)
 }
+
+func TestBuildWithWatermarks_ReportsBuilderInputs(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.NamespaceLabelSelector = "team"
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), nil, "")
+
+	_, first := builder.BuildWithWatermarks(context.Background())
+	assert.Equal(t, int64(0), first[redactionInput])
+
+	// A namespace relabel moves objects in or out of scope without a write
+	// to their stores, and a new salt changes every hashed name.
+	time.Sleep(2 * time.Millisecond)
+	s.NamespaceLabels.Set("payments", map[string]string{"team": "payments"})
+	policy, err := redact.NewPolicy(redact.Rules{HashNames: true, HashSalt: "salt"})
+	require.NoError(t, err)
+	builder.SetRedaction(policy)
+
+	snap, second := builder.BuildWithWatermarks(context.Background())
+	assert.Greater(t, second[namespaceScopeInput], first[namespaceScopeInput])
+	assert.Equal(t, policy.NamesFingerprint(), second[redactionInput])
+	assert.NotContains(t, snap.Health.StaleResources, namespaceScopeInput)
+	assert.NotContains(t, snap.Health.StaleResources, redactionInput)
+}
+
+func TestBuild_StalenessFollowsSnapshotInterval(t *testing.T) {
+	s, ms, cfg, m, ec := newTestDeps()
+	cfg.SnapshotInterval = time.Hour
+	builder := NewSnapshotBuilder(s, ms, cfg, m, ec, enrichment.NewPipeline(m), nil, "")
+
+	time.Sleep(5 * time.Millisecond)
+	assert.Empty(t, builder.Build(context.Background()).Health.StaleResources)
+
+	// A reloaded interval shortens the threshold without a new builder.
+	builder.SetSnapshotInterval(time.Millisecond)
+	assert.Contains(t, builder.Build(context.Background()).Health.StaleResources, "nodes")
+}
@@ -0,0 +1,318 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"hash/fnv"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// familyHashes maps resource family -> entity key -> content hash.
+type familyHashes map[string]map[string]uint64
+
+// DeltaTracker turns full snapshots into deltas against the last snapshot the
+// backend acknowledged. A full keyframe is sent every keyframeInterval
+// snapshots, after any failed send, and whenever the backend reports a gap.
+//
+// Change detection hashes the built (post-merge, post-enrichment) entities
+// rather than relying on TypedStore.LastUpdated alone: metrics merges and
+// enrichers rewrite nodes, pods, and workloads every interval even when no
+// informer event fired. LastUpdated is still used to skip hashing families
+// whose entities the builder never rewrites, while neither their store nor
+// the builder inputs that scope and redact them have changed.
+//
+// DeltaTracker is not safe for concurrent use; the agent calls it from the
+// main loop goroutine only.
+type DeltaTracker struct {
+	keyframeInterval int
+
+	seq             uint64
+	sinceKeyframe   int
+	forceFull       bool
+	baseSnapshotID  string
+	acked           familyHashes
+	ackedWatermarks map[string]int64
+
+	// Pending state for the snapshot currently in flight.
+	pendingID         string
+	pendingHashes     familyHashes
+	pendingWatermarks map[string]int64
+	pendingKeyframe   bool
+}
+
+// NewDeltaTracker creates a DeltaTracker. keyframeInterval <= 0 disables
+// delta encoding: Prepare returns snapshots unchanged.
+func NewDeltaTracker(keyframeInterval int) *DeltaTracker {
+	return &DeltaTracker{keyframeInterval: keyframeInterval}
+}
+
+// Enabled reports whether delta encoding is active.
+func (t *DeltaTracker) Enabled() bool {
+	return t != nil && t.keyframeInterval > 0
+}
+
+// Prepare returns the snapshot to put on the wire. watermarks are the
+// per-store LastUpdated times captured when snap was built (see
+// Store.LastUpdatedTimes). snap itself is never modified; a delta is a
+// shallow copy whose resource slices hold only added/updated entities.
+func (t *DeltaTracker) Prepare(snap *model.ClusterSnapshot, watermarks map[string]int64) *model.ClusterSnapshot {
+	if !t.Enabled() {
+		return snap
+	}
+
+	t.seq++
+	keyframe := t.forceFull || t.acked == nil || t.sinceKeyframe+1 >= t.keyframeInterval
+
+	out := *snap
+	out.Sequence = t.seq
+
+	var delta *model.SnapshotDelta
+	var prev familyHashes
+	if !keyframe {
+		delta = &model.SnapshotDelta{}
+		prev = t.acked
+	}
+	next := make(familyHashes, len(deltaFamilies))
+	d := &differ{
+		prev:           prev,
+		next:           next,
+		delta:          delta,
+		watermarks:     watermarks,
+		prevWatermarks: t.ackedWatermarks,
+	}
+	d.apply(&out)
+
+	if keyframe {
+		out.SnapshotType = model.SnapshotTypeFull
+	} else {
+		out.SnapshotType = model.SnapshotTypeDelta
+		out.BaseSnapshotID = t.baseSnapshotID
+		out.Delta = delta
+	}
+
+	t.pendingID = snap.SnapshotID
+	t.pendingHashes = next
+	t.pendingWatermarks = watermarks
+	t.pendingKeyframe = keyframe
+	return &out
+}
+
+// Ack records that the backend accepted the snapshot returned by the last
+// Prepare call; it becomes the base for the next delta.
+func (t *DeltaTracker) Ack() {
+	if !t.Enabled() || t.pendingHashes == nil {
+		return
+	}
+	t.baseSnapshotID = t.pendingID
+	t.acked = t.pendingHashes
+	t.ackedWatermarks = t.pendingWatermarks
+	if t.pendingKeyframe {
+		t.sinceKeyframe = 0
+		t.forceFull = false
+	} else {
+		t.sinceKeyframe++
+	}
+	t.clearPending()
+}
+
+// Reset forces the next snapshot to be a full keyframe. Called when a send
+// fails (the backend's view is unknown) or the backend reports a gap.
+func (t *DeltaTracker) Reset() {
+	if !t.Enabled() {
+		return
+	}
+	t.forceFull = true
+	t.clearPending()
+}
+
+func (t *DeltaTracker) clearPending() {
+	t.pendingID = ""
+	t.pendingHashes = nil
+	t.pendingWatermarks = nil
+	t.pendingKeyframe = false
+}
+
+// deltaFamilies lists every resource family in ClusterSnapshot, by JSON name.
+// Events cover only the last interval and are sent in full every time.
+var deltaFamilies = []string{
+	"nodes", "pods", "namespaces", "deployments", "statefulsets", "daemonsets",
+	"jobs", "cronjobs", "custom_workloads", "hpas", "vpas", "pdbs", "services",
+	"ingresses", "pvs", "pvcs", "storage_classes", "priority_classes",
+	"limit_ranges", "resource_quotas", "node_pools",
+}
+
+// staticFamilies are copied verbatim from their store by the builder (no
+// metrics merge or enrichment), so an unchanged store watermark and
+// unchanged builder inputs mean the family is unchanged. PDBs and Services are excluded because
+// TargetsEnricher derives their targets from pods. Keys are JSON family
+// names, values are store names as reported by Store.LastUpdatedTimes.
+var staticFamilies = map[string]string{
+	"namespaces":       "namespaces",
+	"hpas":             "hpas",
+	"vpas":             "vpas",
+	"ingresses":        "ingresses",
+	"pvs":              "pvs",
+	"storage_classes":  "storageclasses",
+	"priority_classes": "priorityclasses",
+	"limit_ranges":     "limitranges",
+	"resource_quotas":  "resourcequotas",
+	"node_pools":       "nodepools",
+}
+
+// Builder inputs reported next to the store watermarks by
+// BuildWithWatermarks. They change families without a store write: the
+// namespace labels decide which namespaces are in scope when a label
+// selector is set, and the redaction policy decides how names are hashed.
+const (
+	namespaceScopeInput = "namespace_scope"
+	redactionInput      = "redaction"
+)
+
+// builderInputs lists the builder inputs every static family depends on.
+var builderInputs = []string{namespaceScopeInput, redactionInput}
+
+// differ computes per-family changes for one Prepare call.
+type differ struct {
+	prev           familyHashes // nil for keyframes
+	next           familyHashes
+	delta          *model.SnapshotDelta // nil for keyframes
+	watermarks     map[string]int64
+	prevWatermarks map[string]int64
+}
+
+func (d *differ) apply(s *model.ClusterSnapshot) {
+	s.Nodes = diffFamily(d, "nodes", s.Nodes, func(v *model.NodeInfo) string { return uidKey(v.UID, "", v.Name) })
+	s.Pods = diffFamily(d, "pods", s.Pods, func(v *model.PodInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.Namespaces = diffFamily(d, "namespaces", s.Namespaces, func(v *model.NamespaceInfo) string { return v.Name })
+	s.Deployments = diffFamily(d, "deployments", s.Deployments, func(v *model.DeploymentInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.StatefulSets = diffFamily(d, "statefulsets", s.StatefulSets, func(v *model.StatefulSetInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.DaemonSets = diffFamily(d, "daemonsets", s.DaemonSets, func(v *model.DaemonSetInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.Jobs = diffFamily(d, "jobs", s.Jobs, func(v *model.JobInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.CronJobs = diffFamily(d, "cronjobs", s.CronJobs, func(v *model.CronJobInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.CustomWorkloads = diffFamily(d, "custom_workloads", s.CustomWorkloads, func(v *model.CustomWorkloadInfo) string {
+		return uidKey(v.UID, v.Namespace, v.APIVersion+"/"+v.Kind+"/"+v.Name)
+	})
+	s.HPAs = diffFamily(d, "hpas", s.HPAs, func(v *model.HPAInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.VPAs = diffFamily(d, "vpas", s.VPAs, func(v *model.VPAInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.PDBs = diffFamily(d, "pdbs", s.PDBs, func(v *model.PDBInfo) string { return uidKey(v.UID, v.Namespace, v.Name) })
+	s.Services = diffFamily(d, "services", s.Services, func(v *model.ServiceInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.Ingresses = diffFamily(d, "ingresses", s.Ingresses, func(v *model.IngressInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.PVs = diffFamily(d, "pvs", s.PVs, func(v *model.PVInfo) string { return v.Name })
+	s.PVCs = diffFamily(d, "pvcs", s.PVCs, func(v *model.PVCInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.StorageClasses = diffFamily(d, "storage_classes", s.StorageClasses, func(v *model.StorageClassInfo) string { return v.Name })
+	s.PriorityClasses = diffFamily(d, "priority_classes", s.PriorityClasses, func(v *model.PriorityClassInfo) string { return v.Name })
+	s.LimitRanges = diffFamily(d, "limit_ranges", s.LimitRanges, func(v *model.LimitRangeInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.ResourceQuotas = diffFamily(d, "resource_quotas", s.ResourceQuotas, func(v *model.ResourceQuotaInfo) string { return nsNameKey(v.Namespace, v.Name) })
+	s.NodePools = diffFamily(d, "node_pools", s.NodePools, func(v *model.NodePoolInfo) string { return uidKey(v.UID, "", v.Name) })
+}
+
+// unchanged reports whether a static family's store has not been written
+// and the builder inputs have not changed since the acknowledged snapshot,
+// so its previous hashes can be reused.
+func (d *differ) unchanged(family string) bool {
+	if d.prev == nil || d.prevWatermarks == nil || d.watermarks == nil {
+		return false
+	}
+	storeName, ok := staticFamilies[family]
+	if !ok {
+		return false
+	}
+	for _, input := range builderInputs {
+		if d.watermarks[input] != d.prevWatermarks[input] {
+			return false
+		}
+	}
+	cur, ok1 := d.watermarks[storeName]
+	prev, ok2 := d.prevWatermarks[storeName]
+	return ok1 && ok2 && cur == prev && d.prev[family] != nil
+}
+
+// diffFamily records content hashes for items under family and, for deltas,
+// returns only the added/updated items while recording keys in d.delta.
+// For keyframes items are returned unchanged.
+func diffFamily[T any](d *differ, family string, items []T, key func(*T) string) []T {
+	if d.unchanged(family) {
+		d.next[family] = d.prev[family]
+		return []T{}
+	}
+
+	hashes := make(map[string]uint64, len(items))
+	d.next[family] = hashes
+
+	var prev map[string]uint64
+	if d.prev != nil {
+		prev = d.prev[family]
+	}
+
+	var changed []T
+	for i := range items {
+		k := key(&items[i])
+		h := hashEntity(&items[i])
+		hashes[k] = h
+
+		if d.delta == nil {
+			continue
+		}
+		old, existed := prev[k]
+		switch {
+		case !existed:
+			d.delta.Added = appendKey(d.delta.Added, family, k)
+		case old != h:
+			d.delta.Updated = appendKey(d.delta.Updated, family, k)
+		default:
+			continue
+		}
+		changed = append(changed, items[i])
+	}
+
+	if d.delta == nil {
+		return items
+	}
+	for k := range prev {
+		if _, ok := hashes[k]; !ok {
+			d.delta.Deleted = appendKey(d.delta.Deleted, family, k)
+		}
+	}
+	if changed == nil {
+		// Keep JSON output as [] rather than null, matching full snapshots.
+		changed = []T{}
+	}
+	return changed
+}
+
+// hashEntity returns a content hash of v's JSON encoding. encoding/json sorts
+// map keys, so equal values always hash equally. Encoding failures yield a
+// zero hash, which at worst resends the entity.
+func hashEntity(v any) uint64 {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(b)
+	return h.Sum64()
+}
+
+func appendKey(m map[string][]string, family, key string) map[string][]string {
+	if m == nil {
+		m = make(map[string][]string)
+	}
+	m[family] = append(m[family], key)
+	return m
+}
+
+// uidKey identifies an entity by UID, falling back to namespace/name when the
+// UID is unset (e.g. objects built in tests).
+func uidKey(uid, namespace, name string) string {
+	if uid != "" {
+		return uid
+	}
+	return nsNameKey(namespace, name)
+}
+
+func nsNameKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
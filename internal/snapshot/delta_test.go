@@ -0,0 +1,179 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func deltaTestSnapshot(id string, pods ...model.PodInfo) *model.ClusterSnapshot {
+	return &model.ClusterSnapshot{
+		SnapshotID: id,
+		Nodes:      []model.NodeInfo{{Name: "n1", UID: "node-uid-1"}},
+		Pods:       pods,
+		Namespaces: []model.NamespaceInfo{{Name: "default"}},
+	}
+}
+
+func TestDeltaTracker_DisabledPassesThrough(t *testing.T) {
+	tr := NewDeltaTracker(0)
+	snap := deltaTestSnapshot("s1")
+
+	out := tr.Prepare(snap, nil)
+
+	assert.Same(t, snap, out)
+	assert.Empty(t, out.SnapshotType)
+	assert.Zero(t, out.Sequence)
+}
+
+func TestDeltaTracker_FirstSnapshotIsKeyframe(t *testing.T) {
+	tr := NewDeltaTracker(5)
+
+	out := tr.Prepare(deltaTestSnapshot("s1", model.PodInfo{Name: "p1", UID: "pod-1"}), nil)
+
+	assert.Equal(t, model.SnapshotTypeFull, out.SnapshotType)
+	assert.Equal(t, uint64(1), out.Sequence)
+	assert.Empty(t, out.BaseSnapshotID)
+	assert.Nil(t, out.Delta)
+	assert.Len(t, out.Pods, 1)
+}
+
+func TestDeltaTracker_DeltaCarriesOnlyChanges(t *testing.T) {
+	tr := NewDeltaTracker(5)
+
+	tr.Prepare(deltaTestSnapshot("s1",
+		model.PodInfo{Name: "p1", UID: "pod-1", Phase: "Pending"},
+		model.PodInfo{Name: "p2", UID: "pod-2", Phase: "Running"},
+		model.PodInfo{Name: "p3", UID: "pod-3", Phase: "Running"},
+	), nil)
+	tr.Ack()
+
+	full := deltaTestSnapshot("s2",
+		model.PodInfo{Name: "p1", UID: "pod-1", Phase: "Running"}, // updated
+		model.PodInfo{Name: "p2", UID: "pod-2", Phase: "Running"}, // unchanged
+		model.PodInfo{Name: "p4", UID: "pod-4", Phase: "Pending"}, // added
+	)
+	out := tr.Prepare(full, nil)
+
+	assert.Equal(t, model.SnapshotTypeDelta, out.SnapshotType)
+	assert.Equal(t, uint64(2), out.Sequence)
+	assert.Equal(t, "s1", out.BaseSnapshotID)
+	require.NotNil(t, out.Delta)
+	assert.Equal(t, []string{"pod-4"}, out.Delta.Added["pods"])
+	assert.Equal(t, []string{"pod-1"}, out.Delta.Updated["pods"])
+	assert.Equal(t, []string{"pod-3"}, out.Delta.Deleted["pods"])
+
+	names := make([]string, 0, len(out.Pods))
+	for _, p := range out.Pods {
+		names = append(names, p.Name)
+	}
+	assert.ElementsMatch(t, []string{"p1", "p4"}, names)
+
+	// Unchanged families are sent as empty slices, not null.
+	assert.NotNil(t, out.Nodes)
+	assert.Empty(t, out.Nodes)
+
+	// The full snapshot is left untouched.
+	assert.Len(t, full.Pods, 3)
+	assert.Empty(t, full.SnapshotType)
+}
+
+func TestDeltaTracker_KeyframeEveryN(t *testing.T) {
+	tr := NewDeltaTracker(3)
+
+	var types []string
+	for i := 0; i < 7; i++ {
+		out := tr.Prepare(deltaTestSnapshot("s"), nil)
+		types = append(types, out.SnapshotType)
+		tr.Ack()
+	}
+
+	assert.Equal(t, []string{
+		model.SnapshotTypeFull, model.SnapshotTypeDelta, model.SnapshotTypeDelta,
+		model.SnapshotTypeFull, model.SnapshotTypeDelta, model.SnapshotTypeDelta,
+		model.SnapshotTypeFull,
+	}, types)
+}
+
+func TestDeltaTracker_ResetForcesKeyframe(t *testing.T) {
+	tr := NewDeltaTracker(10)
+
+	tr.Prepare(deltaTestSnapshot("s1"), nil)
+	tr.Ack()
+
+	// A failed send: the backend may not have the delta, so resync.
+	out := tr.Prepare(deltaTestSnapshot("s2"), nil)
+	require.Equal(t, model.SnapshotTypeDelta, out.SnapshotType)
+	tr.Reset()
+
+	out = tr.Prepare(deltaTestSnapshot("s3"), nil)
+	assert.Equal(t, model.SnapshotTypeFull, out.SnapshotType)
+	assert.Equal(t, uint64(3), out.Sequence, "sequence keeps increasing across resets")
+	tr.Ack()
+
+	out = tr.Prepare(deltaTestSnapshot("s4"), nil)
+	assert.Equal(t, model.SnapshotTypeDelta, out.SnapshotType)
+	assert.Equal(t, "s3", out.BaseSnapshotID)
+}
+
+func TestDeltaTracker_UnackedDeltaIsNotBase(t *testing.T) {
+	tr := NewDeltaTracker(10)
+
+	tr.Prepare(deltaTestSnapshot("s1", model.PodInfo{Name: "p1", UID: "pod-1"}), nil)
+	tr.Ack()
+
+	// s2 adds a pod but is never acknowledged (and Reset is not called).
+	tr.Prepare(deltaTestSnapshot("s2",
+		model.PodInfo{Name: "p1", UID: "pod-1"},
+		model.PodInfo{Name: "p2", UID: "pod-2"},
+	), nil)
+
+	out := tr.Prepare(deltaTestSnapshot("s3",
+		model.PodInfo{Name: "p1", UID: "pod-1"},
+		model.PodInfo{Name: "p2", UID: "pod-2"},
+	), nil)
+	assert.Equal(t, "s1", out.BaseSnapshotID)
+	assert.Equal(t, []string{"pod-2"}, out.Delta.Added["pods"])
+}
+
+func TestDeltaTracker_StaticFamilySkippedWhenWatermarkUnchanged(t *testing.T) {
+	tr := NewDeltaTracker(10)
+	wm := map[string]int64{"namespaces": 100, "pods": 100}
+
+	tr.Prepare(deltaTestSnapshot("s1"), wm)
+	tr.Ack()
+
+	// Same watermark: namespaces are not diffed, and stay absent from the delta
+	// even though the slice content differs (it cannot, in practice).
+	snap := deltaTestSnapshot("s2")
+	snap.Namespaces = []model.NamespaceInfo{{Name: "other"}}
+	out := tr.Prepare(snap, map[string]int64{"namespaces": 100, "pods": 200})
+	assert.Empty(t, out.Namespaces)
+	assert.Nil(t, out.Delta.Added["namespaces"])
+	tr.Ack()
+
+	// Newer watermark: namespaces are diffed against the reused hashes.
+	out = tr.Prepare(snap, map[string]int64{"namespaces": 300, "pods": 200})
+	assert.Equal(t, []string{"other"}, out.Delta.Added["namespaces"])
+	assert.Equal(t, []string{"default"}, out.Delta.Deleted["namespaces"])
+}
+
+func TestDeltaTracker_StaticFamilyDiffedWhenBuilderInputsChange(t *testing.T) {
+	for _, input := range builderInputs {
+		t.Run(input, func(t *testing.T) {
+			tr := NewDeltaTracker(10)
+			tr.Prepare(deltaTestSnapshot("s1"), map[string]int64{"namespaces": 100, input: 1})
+			tr.Ack()
+
+			// The namespaces store is unchanged, but the builder now scopes
+			// or hashes it differently.
+			snap := deltaTestSnapshot("s2")
+			snap.Namespaces = []model.NamespaceInfo{{Name: "other"}}
+			out := tr.Prepare(snap, map[string]int64{"namespaces": 100, input: 2})
+			assert.Equal(t, []string{"other"}, out.Delta.Added["namespaces"])
+			assert.Equal(t, []string{"default"}, out.Delta.Deleted["namespaces"])
+		})
+	}
+}
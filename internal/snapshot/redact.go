@@ -0,0 +1,250 @@
+package snapshot
+
+import (
+	"github.com/kubeadapt/kubeadapt-agent/internal/redact"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// hashedKinds are the owner, target and event kinds whose names are hashed.
+// Custom workload kinds are added per snapshot.
+var hashedKinds = map[string]bool{
+	"Namespace":               true,
+	"Pod":                     true,
+	"Deployment":              true,
+	"StatefulSet":             true,
+	"DaemonSet":               true,
+	"ReplicaSet":              true,
+	"Job":                     true,
+	"CronJob":                 true,
+	"HorizontalPodAutoscaler": true,
+	"VerticalPodAutoscaler":   true,
+	"PodDisruptionBudget":     true,
+	"Service":                 true,
+	"Ingress":                 true,
+	"PersistentVolumeClaim":   true,
+}
+
+// nameLabels are the labels Kubernetes sets on pods whose values are object
+// names: the StatefulSet pod name, the Job name, and the StatefulSet
+// revision ("<statefulset>-<hash>").
+var nameLabels = []string{
+	"statefulset.kubernetes.io/pod-name",
+	"job-name",
+	"batch.kubernetes.io/job-name",
+	"controller-revision-hash",
+}
+
+// hashNames replaces the name of every namespace, pod, workload, autoscaler,
+// PDB, service, ingress and PVC in snap, including references to them from
+// other objects and the pod labels that hold them, with its keyed hash. It
+// runs after all joins on names (metrics, ownership, enrichment) are done.
+// Event notes and condition messages are cleared because they routinely
+// spell out the names. Slices, maps and pointers that may be shared with the
+// stores are copied before they are rewritten.
+func hashNames(snap *model.ClusterSnapshot, p *redact.Policy) {
+	if !p.HashesNames() {
+		return
+	}
+	h := p.Name
+	kinds := make(map[string]bool, len(hashedKinds)+len(snap.CustomWorkloads))
+	for k := range hashedKinds {
+		kinds[k] = true
+	}
+	for i := range snap.CustomWorkloads {
+		kinds[snap.CustomWorkloads[i].Kind] = true
+	}
+	refs := func(ws []model.WorkloadReference) []model.WorkloadReference {
+		if ws == nil {
+			return nil
+		}
+		out := make([]model.WorkloadReference, len(ws))
+		for i, w := range ws {
+			w.Name, w.Namespace = h(w.Name), h(w.Namespace)
+			out[i] = w
+		}
+		return out
+	}
+	names := func(ns []string) []string {
+		if ns == nil {
+			return nil
+		}
+		out := make([]string, len(ns))
+		for i, n := range ns {
+			out[i] = h(n)
+		}
+		return out
+	}
+	labels := func(l map[string]string) map[string]string {
+		var out map[string]string
+		for _, k := range nameLabels {
+			v, ok := l[k]
+			if !ok {
+				continue
+			}
+			if out == nil {
+				out = make(map[string]string, len(l))
+				for lk, lv := range l {
+					out[lk] = lv
+				}
+			}
+			out[k] = h(v)
+		}
+		if out == nil {
+			return l
+		}
+		return out
+	}
+
+	for i := range snap.Namespaces {
+		snap.Namespaces[i].Name = h(snap.Namespaces[i].Name)
+	}
+	for i := range snap.Pods {
+		p := &snap.Pods[i]
+		p.Name, p.Namespace = h(p.Name), h(p.Namespace)
+		if kinds[p.OwnerKind] {
+			p.OwnerName = h(p.OwnerName)
+		}
+		p.Labels = labels(p.Labels)
+		p.Containers = withoutStateMessages(p.Containers)
+		p.InitContainers = withoutStateMessages(p.InitContainers)
+		p.Conditions = withoutMessages(p.Conditions, func(c *model.PodConditionInfo) { c.Message = "" })
+		if p.Volumes != nil {
+			vols := make([]model.PodVolumeInfo, len(p.Volumes))
+			for j, v := range p.Volumes {
+				v.ClaimName = h(v.ClaimName)
+				vols[j] = v
+			}
+			p.Volumes = vols
+		}
+	}
+	clearWorkload := func(c *model.WorkloadConditionInfo) { c.Message = "" }
+	for i := range snap.Deployments {
+		d := &snap.Deployments[i]
+		d.Name, d.Namespace = h(d.Name), h(d.Namespace)
+		d.Conditions = withoutMessages(d.Conditions, clearWorkload)
+	}
+	for i := range snap.StatefulSets {
+		s := &snap.StatefulSets[i]
+		s.Name, s.Namespace, s.ServiceName = h(s.Name), h(s.Namespace), h(s.ServiceName)
+		s.Conditions = withoutMessages(s.Conditions, clearWorkload)
+	}
+	for i := range snap.DaemonSets {
+		d := &snap.DaemonSets[i]
+		d.Name, d.Namespace = h(d.Name), h(d.Namespace)
+		d.Conditions = withoutMessages(d.Conditions, clearWorkload)
+	}
+	for i := range snap.Jobs {
+		j := &snap.Jobs[i]
+		j.Name, j.Namespace, j.OwnerCronJob = h(j.Name), h(j.Namespace), h(j.OwnerCronJob)
+		j.Labels = labels(j.Labels)
+		j.Conditions = withoutMessages(j.Conditions, func(c *model.JobConditionInfo) { c.Message = "" })
+	}
+	for i := range snap.CronJobs {
+		c := &snap.CronJobs[i]
+		c.Name, c.Namespace = h(c.Name), h(c.Namespace)
+		c.ActiveJobs = names(c.ActiveJobs)
+	}
+	for i := range snap.CustomWorkloads {
+		c := &snap.CustomWorkloads[i]
+		c.Name, c.Namespace = h(c.Name), h(c.Namespace)
+		if kinds[c.OwnerKind] {
+			c.OwnerName = h(c.OwnerName)
+		}
+	}
+	for i := range snap.HPAs {
+		a := &snap.HPAs[i]
+		a.Name, a.Namespace = h(a.Name), h(a.Namespace)
+		if kinds[a.TargetKind] {
+			a.TargetName = h(a.TargetName)
+		}
+		a.Conditions = withoutMessages(a.Conditions, func(c *model.HPAConditionInfo) { c.Message = "" })
+	}
+	for i := range snap.VPAs {
+		v := &snap.VPAs[i]
+		v.Name, v.Namespace = h(v.Name), h(v.Namespace)
+		if kinds[v.TargetKind] {
+			v.TargetName = h(v.TargetName)
+		}
+		v.Conditions = withoutMessages(v.Conditions, func(c *model.VPAConditionInfo) { c.Message = "" })
+	}
+	for i := range snap.PDBs {
+		d := &snap.PDBs[i]
+		d.Name, d.Namespace = h(d.Name), h(d.Namespace)
+		d.TargetWorkloads = refs(d.TargetWorkloads)
+		d.Conditions = withoutMessages(d.Conditions, func(c *model.PDBConditionInfo) { c.Message = "" })
+	}
+	for i := range snap.Services {
+		s := &snap.Services[i]
+		s.Name, s.Namespace = h(s.Name), h(s.Namespace)
+		s.TargetWorkloads = refs(s.TargetWorkloads)
+	}
+	for i := range snap.Ingresses {
+		ing := &snap.Ingresses[i]
+		ing.Name, ing.Namespace = h(ing.Name), h(ing.Namespace)
+		if ing.Rules != nil {
+			rules := make([]model.IngressRuleInfo, len(ing.Rules))
+			for j, r := range ing.Rules {
+				if r.Paths != nil {
+					paths := make([]model.IngressPathInfo, len(r.Paths))
+					for k, path := range r.Paths {
+						path.BackendService = h(path.BackendService)
+						paths[k] = path
+					}
+					r.Paths = paths
+				}
+				rules[j] = r
+			}
+			ing.Rules = rules
+		}
+		if ing.DefaultBackend != nil {
+			b := *ing.DefaultBackend
+			b.ServiceName = h(b.ServiceName)
+			ing.DefaultBackend = &b
+		}
+	}
+	for i := range snap.PVs {
+		if snap.PVs[i].ClaimRef != nil {
+			ref := *snap.PVs[i].ClaimRef
+			ref.Namespace, ref.Name = h(ref.Namespace), h(ref.Name)
+			snap.PVs[i].ClaimRef = &ref
+		}
+	}
+	for i := range snap.PVCs {
+		c := &snap.PVCs[i]
+		c.Name, c.Namespace = h(c.Name), h(c.Namespace)
+		c.MountedByPods = names(c.MountedByPods)
+		c.Conditions = withoutMessages(c.Conditions, func(c *model.PVCConditionInfo) { c.Message = "" })
+	}
+	for i := range snap.LimitRanges {
+		snap.LimitRanges[i].Namespace = h(snap.LimitRanges[i].Namespace)
+	}
+	for i := range snap.ResourceQuotas {
+		snap.ResourceQuotas[i].Namespace = h(snap.ResourceQuotas[i].Namespace)
+	}
+	for i := range snap.Events {
+		e := &snap.Events[i]
+		e.InvolvedNamespace = h(e.InvolvedNamespace)
+		if kinds[e.InvolvedKind] {
+			e.InvolvedName = h(e.InvolvedName)
+		}
+		e.Note = ""
+	}
+}
+
+// withoutMessages returns a copy of conditions with clear applied to each.
+func withoutMessages[T any](conditions []T, clear func(*T)) []T {
+	if conditions == nil {
+		return nil
+	}
+	out := make([]T, len(conditions))
+	copy(out, conditions)
+	for i := range out {
+		clear(&out[i])
+	}
+	return out
+}
+
+// withoutStateMessages returns a copy of containers without state messages.
+func withoutStateMessages(containers []model.ContainerInfo) []model.ContainerInfo {
+	return withoutMessages(containers, func(c *model.ContainerInfo) { c.StateMessage = "" })
+}
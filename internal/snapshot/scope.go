@@ -0,0 +1,76 @@
+package snapshot
+
+import (
+	"github.com/kubeadapt/kubeadapt-agent/internal/scope"
+	"github.com/kubeadapt/kubeadapt-agent/internal/store"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// namespaceFilter returns a function reporting whether a namespace is in
+// scope, using the unredacted namespace labels for the label selector. It
+// returns nil when every namespace is in scope.
+func namespaceFilter(ns *scope.Namespaces, nsLabels *store.TypedStore[map[string]string]) func(string) bool {
+	if ns == nil {
+		return nil
+	}
+	decided := make(map[string]bool)
+	return func(namespace string) bool {
+		allowed, ok := decided[namespace]
+		if !ok {
+			labels, _ := nsLabels.Get(namespace)
+			allowed = ns.Allows(namespace, labels)
+			decided[namespace] = allowed
+		}
+		return allowed
+	}
+}
+
+// applyNamespaceScope removes every object in a namespace that is not in
+// scope from snap and replicaSets, including the Namespace objects themselves
+// and PVs bound to claims in those namespaces. Collectors restrict their
+// informers where they can; this covers include lists of several
+// namespaces, label selectors, and collectors that are never restricted.
+//
+// The removed pods, PVCs, PVs and namespaces are returned in a snapshot of
+// their own so that cluster totals in the summary still include them.
+func applyNamespaceScope(snap *model.ClusterSnapshot, replicaSets []model.ReplicaSetInfo, inScope func(string) bool) (*model.ClusterSnapshot, []model.ReplicaSetInfo) {
+	out := &model.ClusterSnapshot{}
+
+	snap.Pods, out.Pods = partition(snap.Pods, func(p *model.PodInfo) bool { return inScope(p.Namespace) })
+	snap.PVCs, out.PVCs = partition(snap.PVCs, func(p *model.PVCInfo) bool { return inScope(p.Namespace) })
+	snap.PVs, out.PVs = partition(snap.PVs, func(p *model.PVInfo) bool {
+		return p.ClaimRef == nil || inScope(p.ClaimRef.Namespace)
+	})
+	snap.Namespaces, out.Namespaces = partition(snap.Namespaces, func(n *model.NamespaceInfo) bool { return inScope(n.Name) })
+
+	snap.Deployments, _ = partition(snap.Deployments, func(d *model.DeploymentInfo) bool { return inScope(d.Namespace) })
+	snap.StatefulSets, _ = partition(snap.StatefulSets, func(s *model.StatefulSetInfo) bool { return inScope(s.Namespace) })
+	snap.DaemonSets, _ = partition(snap.DaemonSets, func(d *model.DaemonSetInfo) bool { return inScope(d.Namespace) })
+	snap.Jobs, _ = partition(snap.Jobs, func(j *model.JobInfo) bool { return inScope(j.Namespace) })
+	snap.CronJobs, _ = partition(snap.CronJobs, func(c *model.CronJobInfo) bool { return inScope(c.Namespace) })
+	snap.CustomWorkloads, _ = partition(snap.CustomWorkloads, func(c *model.CustomWorkloadInfo) bool { return inScope(c.Namespace) })
+	snap.HPAs, _ = partition(snap.HPAs, func(h *model.HPAInfo) bool { return inScope(h.Namespace) })
+	snap.VPAs, _ = partition(snap.VPAs, func(v *model.VPAInfo) bool { return inScope(v.Namespace) })
+	snap.PDBs, _ = partition(snap.PDBs, func(p *model.PDBInfo) bool { return inScope(p.Namespace) })
+	snap.Services, _ = partition(snap.Services, func(s *model.ServiceInfo) bool { return inScope(s.Namespace) })
+	snap.Ingresses, _ = partition(snap.Ingresses, func(i *model.IngressInfo) bool { return inScope(i.Namespace) })
+	snap.LimitRanges, _ = partition(snap.LimitRanges, func(l *model.LimitRangeInfo) bool { return inScope(l.Namespace) })
+	snap.ResourceQuotas, _ = partition(snap.ResourceQuotas, func(r *model.ResourceQuotaInfo) bool { return inScope(r.Namespace) })
+
+	replicaSets, _ = partition(replicaSets, func(r *model.ReplicaSetInfo) bool { return inScope(r.Namespace) })
+	return out, replicaSets
+}
+
+// partition splits items into those for which keep returns true and the
+// rest. The kept slice reuses the backing array of items.
+func partition[T any](items []T, keep func(*T) bool) (kept, dropped []T) {
+	kept = items[:0]
+	for i := range items {
+		if keep(&items[i]) {
+			kept = append(kept, items[i])
+		} else {
+			dropped = append(dropped, items[i])
+		}
+	}
+	return kept, dropped
+}
@@ -54,6 +54,10 @@ func ComputeSummary(snapshot *model.ClusterSnapshot) model.ClusterSummary {
 		gpuTensorCount  int
 		gpuMemUtilCount int
 		hasGPUMetrics   bool
+
+		costSum     float64
+		idleCostSum float64
+		hasCost     bool
 	)
 	for i := range snapshot.Nodes {
 		n := &snapshot.Nodes[i]
@@ -92,6 +96,14 @@ func ComputeSummary(snapshot *model.ClusterSnapshot) model.ClusterSummary {
 		if n.GPUMemoryTotalBytes != nil {
 			gpuMemTotalSum += *n.GPUMemoryTotalBytes
 		}
+
+		if n.HourlyCost != nil {
+			costSum += *n.HourlyCost
+			hasCost = true
+		}
+		if n.IdleHourlyCost != nil {
+			idleCostSum += *n.IdleHourlyCost
+		}
 	}
 	s.MetricsAvailable = metricsAvailable
 	s.GPUMetricsAvailable = hasGPUMetrics
@@ -116,6 +128,10 @@ func ComputeSummary(snapshot *model.ClusterSnapshot) model.ClusterSummary {
 		avg := gpuMemUtilSum / float64(gpuMemUtilCount)
 		s.TotalGPUMemoryUtil = &avg
 	}
+	if hasCost {
+		s.TotalHourlyCost = &costSum
+		s.IdleHourlyCost = &idleCostSum
+	}
 
 	// Pod container resource requests and GPU.
 	for i := range snapshot.Pods {
@@ -137,3 +153,25 @@ func ComputeSummary(snapshot *model.ClusterSnapshot) model.ClusterSummary {
 
 	return s
 }
+
+// addClusterTotals adds the pod, container, namespace, PV and PVC counts and
+// the requested and storage totals of other to s. It is used to count objects
+// dropped by namespace scoping toward the cluster totals; node totals are
+// never scoped and are left alone.
+func addClusterTotals(s *model.ClusterSummary, other model.ClusterSummary) {
+	s.PodCount += other.PodCount
+	s.RunningPodCount += other.RunningPodCount
+	s.PendingPodCount += other.PendingPodCount
+	s.FailedPodCount += other.FailedPodCount
+	s.SucceededPodCount += other.SucceededPodCount
+	s.ContainerCount += other.ContainerCount
+	s.NamespaceCount += other.NamespaceCount
+	s.PVCount += other.PVCount
+	s.PVCCount += other.PVCCount
+
+	s.TotalCPURequested += other.TotalCPURequested
+	s.TotalMemoryRequested += other.TotalMemoryRequested
+	s.TotalGPURequested += other.TotalGPURequested
+	s.TotalStorageCapacity += other.TotalStorageCapacity
+	s.TotalStorageRequested += other.TotalStorageRequested
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// DefaultEventRingSize is the capacity of the event ring created by NewStore.
+const DefaultEventRingSize = 5000
+
+// EventRing is a fixed-capacity ring of Kubernetes Event occurrences. Each
+// entry records Count new occurrences of an event and the time it was
+// added, so a reader can collect exactly the occurrences received since its
+// previous read. Once full, the oldest entries are overwritten.
+type EventRing struct {
+	mu      sync.RWMutex
+	entries []model.EventInfo
+	added   []time.Time
+	next    int
+	full    bool
+	// lastRead is the latest time returned by Summarize. Entries are
+	// stamped strictly after it, so none is missed by the next read.
+	lastRead time.Time
+}
+
+// NewEventRing creates an EventRing holding at most capacity entries.
+func NewEventRing(capacity int) *EventRing {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &EventRing{
+		entries: make([]model.EventInfo, capacity),
+		added:   make([]time.Time, capacity),
+	}
+}
+
+// Add records an occurrence entry, overwriting the oldest one when full.
+func (r *EventRing) Add(e model.EventInfo) {
+	r.mu.Lock()
+	r.entries[r.next] = e
+	now := time.Now()
+	if !now.After(r.lastRead) {
+		now = r.lastRead.Add(time.Nanosecond)
+	}
+	r.added[r.next] = now
+	r.next++
+	if r.next == len(r.entries) {
+		r.next = 0
+		r.full = true
+	}
+	r.mu.Unlock()
+}
+
+// Len returns the number of entries in the ring.
+func (r *EventRing) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.full {
+		return len(r.entries)
+	}
+	return r.next
+}
+
+// Summarize deduplicates the entries added after since by involved object
+// and reason. Counts are summed, the timestamps span all merged entries, and
+// the note is the most recent one. It also returns the read time, to pass as
+// since on the next call so every entry is summarized exactly once; a zero
+// since selects all entries. The ring is left unchanged. Results are ordered
+// by LastTimestamp.
+func (r *EventRing) Summarize(since time.Time) ([]model.EventInfo, time.Time) {
+	type eventKey struct {
+		kind, namespace, name, reason string
+	}
+
+	r.mu.Lock()
+	readAt := time.Now()
+	if readAt.After(r.lastRead) {
+		r.lastRead = readAt
+	}
+	n := r.next
+	if r.full {
+		n = len(r.entries)
+	}
+	byKey := make(map[eventKey]*model.EventInfo)
+	for i := 0; i < n; i++ {
+		if !r.added[i].After(since) {
+			continue
+		}
+		e := r.entries[i]
+		k := eventKey{e.InvolvedKind, e.InvolvedNamespace, e.InvolvedName, e.Reason}
+		agg, ok := byKey[k]
+		if !ok {
+			agg = &e
+			byKey[k] = agg
+			continue
+		}
+		agg.Count += e.Count
+		agg.FirstTimestamp = min(agg.FirstTimestamp, e.FirstTimestamp)
+		if e.LastTimestamp >= agg.LastTimestamp {
+			agg.LastTimestamp = e.LastTimestamp
+			agg.Type = e.Type
+			agg.Note = e.Note
+			agg.ReportingController = e.ReportingController
+			agg.InvolvedUID = e.InvolvedUID
+		}
+	}
+	r.mu.Unlock()
+
+	out := make([]model.EventInfo, 0, len(byKey))
+	for _, e := range byKey {
+		out = append(out, *e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].LastTimestamp != out[j].LastTimestamp {
+			return out[i].LastTimestamp < out[j].LastTimestamp
+		}
+		if out[i].InvolvedName != out[j].InvolvedName {
+			return out[i].InvolvedName < out[j].InvolvedName
+		}
+		return out[i].Reason < out[j].Reason
+	})
+	return out, readAt
+}
+
+// Clear removes all entries.
+func (r *EventRing) Clear() {
+	r.mu.Lock()
+	clear(r.entries)
+	clear(r.added)
+	r.next = 0
+	r.full = false
+	r.mu.Unlock()
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+func podEvent(name, reason, note string, count int32, first, last int64) model.EventInfo {
+	return model.EventInfo{
+		Type:              "Warning",
+		Reason:            reason,
+		Note:              note,
+		InvolvedKind:      "Pod",
+		InvolvedNamespace: "default",
+		InvolvedName:      name,
+		Count:             count,
+		FirstTimestamp:    first,
+		LastTimestamp:     last,
+	}
+}
+
+func TestEventRing_SummarizeDedupes(t *testing.T) {
+	r := NewEventRing(10)
+	r.Add(podEvent("web-1", "FailedScheduling", "0/3 nodes available", 1, 1000, 1000))
+	r.Add(podEvent("web-1", "FailedScheduling", "0/4 nodes available", 2, 1000, 3000))
+	r.Add(podEvent("web-1", "BackOff", "back-off restarting", 1, 2000, 2000))
+	r.Add(podEvent("web-2", "FailedScheduling", "0/3 nodes available", 1, 1500, 1500))
+
+	got, _ := r.Summarize(time.Time{})
+	if len(got) != 3 {
+		t.Fatalf("expected 3 deduped events, got %d: %+v", len(got), got)
+	}
+
+	var sched model.EventInfo
+	for _, e := range got {
+		if e.InvolvedName == "web-1" && e.Reason == "FailedScheduling" {
+			sched = e
+		}
+	}
+	if sched.Count != 3 {
+		t.Errorf("count = %d, want 3", sched.Count)
+	}
+	if sched.FirstTimestamp != 1000 || sched.LastTimestamp != 3000 {
+		t.Errorf("timestamps = %d..%d, want 1000..3000", sched.FirstTimestamp, sched.LastTimestamp)
+	}
+	if sched.Note != "0/4 nodes available" {
+		t.Errorf("note = %q, want the most recent one", sched.Note)
+	}
+
+	// Ordered by last timestamp.
+	for i := 1; i < len(got); i++ {
+		if got[i].LastTimestamp < got[i-1].LastTimestamp {
+			t.Errorf("events not ordered by LastTimestamp: %+v", got)
+		}
+	}
+}
+
+func TestEventRing_SummarizeSince(t *testing.T) {
+	r := NewEventRing(10)
+	r.Add(podEvent("web-1", "Evicted", "old", 1, 1000, 1000))
+	_, readAt := r.Summarize(time.Time{})
+
+	// Entries are selected by when they were added, not by the time the
+	// event reports.
+	r.Add(podEvent("web-1", "Evicted", "late", 1, 500, 500))
+	got, next := r.Summarize(readAt)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(got))
+	}
+	if got[0].Count != 1 || got[0].Note != "late" {
+		t.Errorf("expected only the entry added after the previous read, got %+v", got[0])
+	}
+	if got, _ := r.Summarize(next); len(got) != 0 {
+		t.Errorf("Summarize since the last read = %+v, want empty", got)
+	}
+
+	// Summarize does not consume entries.
+	if again, _ := r.Summarize(time.Time{}); len(again) != 1 || again[0].Count != 2 {
+		t.Errorf("Summarize of all entries = %+v, want one event with count 2", again)
+	}
+}
+
+func TestEventRing_OverwritesOldest(t *testing.T) {
+	r := NewEventRing(3)
+	for i := int64(1); i <= 5; i++ {
+		r.Add(podEvent("web-1", "Unhealthy", "probe failed", 1, i*1000, i*1000))
+	}
+
+	if r.Len() != 3 {
+		t.Fatalf("Len = %d, want 3", r.Len())
+	}
+	got, _ := r.Summarize(time.Time{})
+	if len(got) != 1 || got[0].Count != 3 {
+		t.Fatalf("expected one event with count 3, got %+v", got)
+	}
+	if got[0].FirstTimestamp != 3000 || got[0].LastTimestamp != 5000 {
+		t.Errorf("timestamps = %d..%d, want 3000..5000", got[0].FirstTimestamp, got[0].LastTimestamp)
+	}
+}
+
+func TestEventRing_Clear(t *testing.T) {
+	r := NewEventRing(2)
+	r.Add(podEvent("web-1", "Killing", "stopping", 1, 1000, 1000))
+	r.Add(podEvent("web-1", "Killing", "stopping", 1, 2000, 2000))
+	r.Clear()
+
+	if r.Len() != 0 {
+		t.Errorf("Len after Clear = %d, want 0", r.Len())
+	}
+	if got, _ := r.Summarize(time.Time{}); len(got) != 0 {
+		t.Errorf("Summarize after Clear = %+v, want empty", got)
+	}
+}
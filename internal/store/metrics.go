@@ -2,16 +2,43 @@ package store
 
 import "github.com/kubeadapt/kubeadapt-agent/pkg/model"
 
-// MetricsStore holds metrics-server data separately from the main resource store.
+// MetricsStore holds metrics-server and kubelet (Summary API and cAdvisor)
+// data separately from the main resource store.
 type MetricsStore struct {
 	NodeMetrics *TypedStore[model.NodeMetrics]
 	PodMetrics  *TypedStore[model.PodMetrics]
+
+	// Rolling per-container CPU/memory samples from metrics-server, keyed by
+	// "namespace/pod/container".
+	ContainerUsage *UsageWindow
+
+	// Kubelet Summary API data, keyed by node name, "namespace/pod", and
+	// "namespace/pvc" respectively.
+	NodeStats   *TypedStore[model.NodeStats]
+	PodStats    *TypedStore[model.PodStats]
+	VolumeStats *TypedStore[model.VolumeStats]
+
+	// Kubelet cAdvisor throttling/OOM signals, keyed by
+	// "namespace/pod/container".
+	ContainerSignals *TypedStore[model.ContainerSignals]
 }
 
-// NewMetricsStore creates a MetricsStore with both typed stores initialized.
+// maxUsageSamples bounds the samples kept per container (one hour at the
+// minimum 15s metrics-server resolution).
+const maxUsageSamples = 240
+
+// NewMetricsStore creates a MetricsStore with all typed stores initialized.
 func NewMetricsStore() *MetricsStore {
 	return &MetricsStore{
 		NodeMetrics: NewTypedStore[model.NodeMetrics](),
 		PodMetrics:  NewTypedStore[model.PodMetrics](),
+
+		ContainerUsage: NewUsageWindow(maxUsageSamples),
+
+		NodeStats:   NewTypedStore[model.NodeStats](),
+		PodStats:    NewTypedStore[model.PodStats](),
+		VolumeStats: NewTypedStore[model.VolumeStats](),
+
+		ContainerSignals: NewTypedStore[model.ContainerSignals](),
 	}
 }
@@ -28,6 +28,16 @@ type Store struct {
 	LimitRanges     *TypedStore[model.LimitRangeInfo]
 	ResourceQuotas  *TypedStore[model.ResourceQuotaInfo]
 	NodePools       *TypedStore[model.NodePoolInfo]
+
+	// NamespaceLabels holds the unredacted labels of each namespace, keyed
+	// by name. The namespace label selector is evaluated against these so
+	// that redaction rules cannot change which namespaces are in scope; they
+	// are never sent.
+	NamespaceLabels *TypedStore[map[string]string]
+
+	// Events is a ring of recent Event occurrences rather than a set of live
+	// objects, so it is left out of staleness detection.
+	Events *EventRing
 }
 
 // LastUpdatedTimes returns the UnixMilli timestamp of the last update for each typed store.
@@ -85,10 +95,12 @@ func (s *Store) ItemCounts() map[string]int {
 		"limitranges":      s.LimitRanges.Len(),
 		"resourcequotas":   s.ResourceQuotas.Len(),
 		"nodepools":        s.NodePools.Len(),
+		"events":           s.Events.Len(),
 	}
 }
 
-// NewStore creates a Store with all 22 TypedStores initialized.
+// NewStore creates a Store with all 22 TypedStores and an event ring of
+// DefaultEventRingSize initialized.
 func NewStore() *Store {
 	return &Store{
 		Nodes:           NewTypedStore[model.NodeInfo](),
@@ -113,5 +125,7 @@ func NewStore() *Store {
 		LimitRanges:     NewTypedStore[model.LimitRangeInfo](),
 		ResourceQuotas:  NewTypedStore[model.ResourceQuotaInfo](),
 		NodePools:       NewTypedStore[model.NodePoolInfo](),
+		NamespaceLabels: NewTypedStore[map[string]string](),
+		Events:          NewEventRing(DefaultEventRingSize),
 	}
 }
@@ -10,18 +10,19 @@ import (
 func TestNewStore(t *testing.T) {
 	s := NewStore()
 
-	// Use reflection to verify all 22 fields are non-nil TypedStore pointers.
+	// Use reflection to verify all 24 fields (22 TypedStores, the namespace
+	// labels and the event ring) are non-nil pointers.
 	v := reflect.ValueOf(s).Elem()
 	typ := v.Type()
 
-	if typ.NumField() != 22 {
-		t.Fatalf("expected Store to have 22 fields, got %d", typ.NumField())
+	if typ.NumField() != 24 {
+		t.Fatalf("expected Store to have 24 fields, got %d", typ.NumField())
 	}
 
 	for i := 0; i < typ.NumField(); i++ {
 		field := v.Field(i)
 		if field.IsNil() {
-			t.Errorf("Store.%s is nil, expected initialized TypedStore", typ.Field(i).Name)
+			t.Errorf("Store.%s is nil, expected initialized store", typ.Field(i).Name)
 		}
 	}
 }
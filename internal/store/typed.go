@@ -12,7 +12,7 @@ import (
 type TypedStore[T any] struct {
 	mu          sync.RWMutex
 	items       map[string]T
-	lastUpdated atomic.Int64 // UnixMilli timestamp of last Set/Delete
+	lastUpdated atomic.Int64 // UnixMilli timestamp of last Set/Delete/Clear
 }
 
 // NewTypedStore creates a new, empty TypedStore.
@@ -32,6 +32,21 @@ func (s *TypedStore[T]) Set(key string, value T) {
 	s.lastUpdated.Store(time.Now().UnixMilli())
 }
 
+// Replace updates the value for key if the key is present and reports
+// whether it was. Unlike Set it never brings back a key deleted concurrently.
+func (s *TypedStore[T]) Replace(key string, value T) bool {
+	s.mu.Lock()
+	_, ok := s.items[key]
+	if ok {
+		s.items[key] = value
+	}
+	s.mu.Unlock()
+	if ok {
+		s.lastUpdated.Store(time.Now().UnixMilli())
+	}
+	return ok
+}
+
 // Delete removes a key from the store. No-op if the key doesn't exist.
 func (s *TypedStore[T]) Delete(key string) {
 	s.mu.Lock()
@@ -85,9 +100,23 @@ func (s *TypedStore[T]) Values() []T {
 	return vals
 }
 
+// Range calls fn for each item while holding the read lock, stopping early
+// if fn returns false. Use it instead of Values for read-only scans of large
+// stores; fn must not call back into the store.
+func (s *TypedStore[T]) Range(fn func(key string, value T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for k, v := range s.items {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
 // Clear removes all items from the store.
 func (s *TypedStore[T]) Clear() {
 	s.mu.Lock()
 	s.items = make(map[string]T)
 	s.mu.Unlock()
+	s.lastUpdated.Store(time.Now().UnixMilli())
 }
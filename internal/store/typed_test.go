@@ -48,6 +48,25 @@ func TestTypedStore_Delete(t *testing.T) {
 	s.Delete("nonexistent")
 }
 
+func TestTypedStore_Replace(t *testing.T) {
+	s := NewTypedStore[testItem]()
+
+	if s.Replace("key1", testItem{Name: "alpha"}) {
+		t.Fatal("Replace added a missing key")
+	}
+	if _, ok := s.Get("key1"); ok {
+		t.Fatal("expected key1 to stay absent")
+	}
+
+	s.Set("key1", testItem{Name: "alpha", Value: 1})
+	if !s.Replace("key1", testItem{Name: "alpha", Value: 2}) {
+		t.Fatal("Replace did not update a present key")
+	}
+	if got, _ := s.Get("key1"); got.Value != 2 {
+		t.Errorf("Value = %d, want 2", got.Value)
+	}
+}
+
 func TestTypedStore_Len(t *testing.T) {
 	s := NewTypedStore[testItem]()
 
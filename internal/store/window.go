@@ -0,0 +1,148 @@
+package store
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// usageSample is one CPU/memory reading of a container.
+type usageSample struct {
+	at     int64 // UnixMilli when the sample was taken by the agent
+	source int64 // UnixMilli timestamp reported by the metrics source
+	cpu    float64
+	mem    int64
+}
+
+// UsageWindow keeps a rolling window of CPU/memory samples per container,
+// keyed by "namespace/pod/container". The writer drops samples that fall
+// out of the window with Prune, so containers that disappear age out on
+// their own. Each key holds at most maxSamples samples.
+type UsageWindow struct {
+	mu         sync.RWMutex
+	samples    map[string][]usageSample
+	maxSamples int
+}
+
+// NewUsageWindow creates a UsageWindow holding at most maxSamples samples
+// per key.
+func NewUsageWindow(maxSamples int) *UsageWindow {
+	if maxSamples < 1 {
+		maxSamples = 1
+	}
+	return &UsageWindow{
+		samples:    make(map[string][]usageSample),
+		maxSamples: maxSamples,
+	}
+}
+
+// Add records a sample taken at `at`. source is the timestamp the metrics
+// source attached to the reading; a sample with the same source timestamp as
+// the latest one for key is a repeat of an unrefreshed reading and is ignored.
+func (w *UsageWindow) Add(key string, at, source int64, cpuCores float64, memBytes int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	s := w.samples[key]
+	if n := len(s); n > 0 && source != 0 && s[n-1].source == source {
+		return
+	}
+	s = append(s, usageSample{at: at, source: source, cpu: cpuCores, mem: memBytes})
+	if len(s) > w.maxSamples {
+		s = append(s[:0], s[len(s)-w.maxSamples:]...)
+	}
+	w.samples[key] = s
+}
+
+// Prune drops samples taken before cutoff (UnixMilli), and keys left
+// without samples.
+func (w *UsageWindow) Prune(cutoff int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for key, s := range w.samples {
+		i := 0
+		for i < len(s) && s[i].at < cutoff {
+			i++
+		}
+		switch {
+		case i == len(s):
+			delete(w.samples, key)
+		case i > 0:
+			w.samples[key] = append(s[:0], s[i:]...)
+		}
+	}
+}
+
+// Len returns the number of keys with samples.
+func (w *UsageWindow) Len() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return len(w.samples)
+}
+
+// Stats returns the usage summary of every key with samples.
+func (w *UsageWindow) Stats() map[string]model.ContainerUsageStats {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	out := make(map[string]model.ContainerUsageStats, len(w.samples))
+	var cpu []float64
+	var mem []int64
+	for key, s := range w.samples {
+		cpu, mem = cpu[:0], mem[:0]
+		for _, smp := range s {
+			cpu = append(cpu, smp.cpu)
+			mem = append(mem, smp.mem)
+		}
+		out[key] = model.ContainerUsageStats{
+			CPU:    cpuStats(cpu),
+			Memory: memoryStats(mem),
+		}
+	}
+	return out
+}
+
+// Clear removes all samples.
+func (w *UsageWindow) Clear() {
+	w.mu.Lock()
+	w.samples = make(map[string][]usageSample)
+	w.mu.Unlock()
+}
+
+func cpuStats(v []float64) model.CPUUsageStats {
+	sort.Float64s(v)
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	return model.CPUUsageStats{
+		MinCores: v[0],
+		AvgCores: sum / float64(len(v)),
+		MaxCores: v[len(v)-1],
+		P95Cores: v[p95Index(len(v))],
+		Samples:  len(v),
+	}
+}
+
+func memoryStats(v []int64) model.MemoryUsageStats {
+	sort.Slice(v, func(i, j int) bool { return v[i] < v[j] })
+	var sum float64
+	for _, x := range v {
+		sum += float64(x)
+	}
+	return model.MemoryUsageStats{
+		MinBytes: v[0],
+		AvgBytes: int64(math.Round(sum / float64(len(v)))),
+		MaxBytes: v[len(v)-1],
+		P95Bytes: v[p95Index(len(v))],
+		Samples:  len(v),
+	}
+}
+
+// p95Index returns the nearest-rank index of the 95th percentile in a sorted
+// slice of length n.
+func p95Index(n int) int {
+	return int(math.Ceil(0.95*float64(n))) - 1
+}
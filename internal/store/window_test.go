@@ -0,0 +1,91 @@
+package store
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUsageWindow_Stats(t *testing.T) {
+	w := NewUsageWindow(100)
+
+	// 20 samples: CPU 0.1..2.0 cores, memory 100..2000 MB.
+	for i := 1; i <= 20; i++ {
+		w.Add("ns/pod/app", int64(i*1000), int64(i*1000), float64(i)/10, int64(i)*100_000_000)
+	}
+
+	stats := w.Stats()
+	got, ok := stats["ns/pod/app"]
+	if !ok {
+		t.Fatal("expected stats for ns/pod/app")
+	}
+
+	if got.CPU.Samples != 20 || got.Memory.Samples != 20 {
+		t.Fatalf("samples = %d/%d, want 20/20", got.CPU.Samples, got.Memory.Samples)
+	}
+	if math.Abs(got.CPU.MinCores-0.1) > 1e-9 || math.Abs(got.CPU.MaxCores-2.0) > 1e-9 {
+		t.Errorf("CPU min/max = %v/%v, want 0.1/2.0", got.CPU.MinCores, got.CPU.MaxCores)
+	}
+	if math.Abs(got.CPU.AvgCores-1.05) > 1e-9 {
+		t.Errorf("CPU avg = %v, want 1.05", got.CPU.AvgCores)
+	}
+	// Nearest rank: ceil(0.95*20) = 19th value.
+	if math.Abs(got.CPU.P95Cores-1.9) > 1e-9 {
+		t.Errorf("CPU p95 = %v, want 1.9", got.CPU.P95Cores)
+	}
+	if got.Memory.MinBytes != 100_000_000 || got.Memory.MaxBytes != 2_000_000_000 {
+		t.Errorf("memory min/max = %d/%d", got.Memory.MinBytes, got.Memory.MaxBytes)
+	}
+	if got.Memory.AvgBytes != 1_050_000_000 {
+		t.Errorf("memory avg = %d, want 1050000000", got.Memory.AvgBytes)
+	}
+	if got.Memory.P95Bytes != 1_900_000_000 {
+		t.Errorf("memory p95 = %d, want 1900000000", got.Memory.P95Bytes)
+	}
+}
+
+func TestUsageWindow_SingleSample(t *testing.T) {
+	w := NewUsageWindow(10)
+	w.Add("k", 1000, 1000, 0.5, 64)
+
+	got := w.Stats()["k"]
+	if got.CPU.MinCores != 0.5 || got.CPU.P95Cores != 0.5 || got.CPU.MaxCores != 0.5 {
+		t.Errorf("single sample CPU stats = %+v", got.CPU)
+	}
+	if got.Memory.P95Bytes != 64 {
+		t.Errorf("single sample memory p95 = %d, want 64", got.Memory.P95Bytes)
+	}
+}
+
+func TestUsageWindow_IgnoresRepeatedSourceTimestamp(t *testing.T) {
+	w := NewUsageWindow(10)
+	w.Add("k", 1000, 500, 0.5, 64)
+	w.Add("k", 2000, 500, 0.9, 128) // metrics-server has not refreshed yet
+
+	if got := w.Stats()["k"]; got.CPU.Samples != 1 {
+		t.Errorf("samples = %d, want 1", got.CPU.Samples)
+	}
+}
+
+func TestUsageWindow_PruneAndCap(t *testing.T) {
+	w := NewUsageWindow(3)
+	for i := 1; i <= 5; i++ {
+		w.Add("capped", int64(i*1000), int64(i*1000), float64(i), int64(i))
+	}
+	if got := w.Stats()["capped"]; got.CPU.Samples != 3 || got.CPU.MinCores != 3 {
+		t.Errorf("capped window = %+v, want the 3 newest samples", got.CPU)
+	}
+
+	w.Add("old", 1000, 1000, 1, 1)
+	w.Prune(4500)
+	if w.Len() != 1 {
+		t.Fatalf("Len = %d after prune, want 1 (old key dropped)", w.Len())
+	}
+	if got := w.Stats()["capped"]; got.CPU.Samples != 1 || got.CPU.MinCores != 5 {
+		t.Errorf("pruned window = %+v, want only the sample at 5000", got.CPU)
+	}
+
+	w.Clear()
+	if w.Len() != 0 {
+		t.Errorf("Len = %d after Clear, want 0", w.Len())
+	}
+}
@@ -0,0 +1,173 @@
+package transport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// Chunked upload headers. Every part repeats X-Snapshot-ID; the server stages
+// parts until it holds all X-Snapshot-Part-Count of them with matching
+// checksums, then commits the snapshot as one. Parts of a snapshot that is
+// never completed are discarded one hour after the first of them arrived,
+// so an upload abandoned part-way leaves nothing behind, and a buffered
+// part set replayed later is resent whole.
+const (
+	PartIndexHeader        = "X-Snapshot-Part-Index"
+	PartCountHeader        = "X-Snapshot-Part-Count"
+	PartChecksumHeader     = "X-Snapshot-Part-Checksum"
+	SnapshotChecksumHeader = "X-Snapshot-Checksum"
+)
+
+// encodedPart is one compressed part of a chunked snapshot.
+type encodedPart struct {
+	index    int
+	count    int
+	body     payload
+	checksum string // hex sha256 of body
+	total    string // hex sha256 over the checksums of all parts, in order
+}
+
+// encodeParts splits a snapshot into parts that each compress to within the
+// cap, returning them with the total pre-compression size. Pods are split
+// into twice as many shards until every pod part fits; any other part over
+// the cap cannot be split further and fails with ErrPayloadTooLarge.
+func (c *Client) encodeParts(cd *codec, snapshot *model.ClusterSnapshot, wireFormat string) ([]encodedPart, int64, error) {
+	for shards := 1; ; shards = min(shards*2, len(snapshot.Pods)) {
+		parts, original, oversize, err := c.encodePartSet(cd, splitSnapshot(snapshot, shards), wireFormat)
+		if err != nil {
+			return nil, 0, err
+		}
+		if oversize == nil {
+			return parts, original, nil
+		}
+		if oversize.Family != model.PartFamilyPods || shards >= len(snapshot.Pods) {
+			return nil, 0, fmt.Errorf("%w (part=%s, limit=%d)",
+				ErrPayloadTooLarge, oversize.Family, c.maxCompressedBodyBytes)
+		}
+	}
+}
+
+// encodePartSet encodes every part, into temp files with streaming encode
+// enabled, and checksums the set. It stops at the first part over the cap
+// and returns its SnapshotPart instead. The caller releases the parts with
+// releaseParts.
+func (c *Client) encodePartSet(cd *codec, snapshots []*model.ClusterSnapshot, wireFormat string) ([]encodedPart, int64, *model.SnapshotPart, error) {
+	parts := make([]encodedPart, 0, len(snapshots))
+	total := sha256.New()
+	var original int64
+	for _, s := range snapshots {
+		body, orig, err := c.encodeBody(cd, s, wireFormat)
+		if errors.Is(err, errBodyOverLimit) {
+			releaseParts(parts)
+			return nil, 0, s.Part, nil
+		}
+		if err != nil {
+			releaseParts(parts)
+			return nil, 0, nil, err
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, io.NewSectionReader(body, 0, body.Size())); err != nil {
+			releaseBody(body)
+			releaseParts(parts)
+			return nil, 0, nil, fmt.Errorf("transport: checksum part: %w", err)
+		}
+		sum := h.Sum(nil)
+		total.Write(sum)
+		parts = append(parts, encodedPart{
+			index:    s.Part.Index,
+			count:    s.Part.Count,
+			body:     body,
+			checksum: hex.EncodeToString(sum),
+		})
+		original += orig
+	}
+	totalSum := hex.EncodeToString(total.Sum(nil))
+	for i := range parts {
+		parts[i].total = totalSum
+	}
+	return parts, original, nil, nil
+}
+
+// releaseParts deletes the temp files behind encoded parts.
+func releaseParts(parts []encodedPart) {
+	for _, p := range parts {
+		releaseBody(p.body)
+	}
+}
+
+// splitSnapshot splits a snapshot by resource family, with the pods in
+// podShards contiguous shards. Part 0 carries the summary, health and delta
+// along with the nodes and node pools; every part carries the identity
+// fields so the backend can match it to the snapshot.
+func splitSnapshot(s *model.ClusterSnapshot, podShards int) []*model.ClusterSnapshot {
+	var parts []*model.ClusterSnapshot
+	add := func(family string) *model.ClusterSnapshot {
+		p := partHeader(s)
+		p.Part = &model.SnapshotPart{Index: len(parts), Family: family}
+		parts = append(parts, p)
+		return p
+	}
+
+	nodes := add(model.PartFamilyNodes)
+	nodes.Nodes = s.Nodes
+	nodes.NodePools = s.NodePools
+	nodes.Summary = s.Summary
+	nodes.Health = s.Health
+	nodes.Delta = s.Delta
+
+	n := min(podShards, len(s.Pods))
+	for i := range n {
+		add(model.PartFamilyPods).Pods = s.Pods[i*len(s.Pods)/n : (i+1)*len(s.Pods)/n]
+	}
+
+	w := add(model.PartFamilyWorkloads)
+	w.Namespaces = s.Namespaces
+	w.Deployments = s.Deployments
+	w.StatefulSets = s.StatefulSets
+	w.DaemonSets = s.DaemonSets
+	w.Jobs = s.Jobs
+	w.CronJobs = s.CronJobs
+	w.CustomWorkloads = s.CustomWorkloads
+	w.HPAs = s.HPAs
+	w.VPAs = s.VPAs
+	w.PDBs = s.PDBs
+
+	ns := add(model.PartFamilyNetworkStorage)
+	ns.Services = s.Services
+	ns.Ingresses = s.Ingresses
+	ns.PVs = s.PVs
+	ns.PVCs = s.PVCs
+	ns.StorageClasses = s.StorageClasses
+	ns.PriorityClasses = s.PriorityClasses
+	ns.LimitRanges = s.LimitRanges
+	ns.ResourceQuotas = s.ResourceQuotas
+	ns.Events = s.Events
+
+	for _, p := range parts {
+		p.Part.Count = len(parts)
+	}
+	return parts
+}
+
+// partHeader returns a snapshot holding only the identity, delta encoding
+// and provider fields of s.
+func partHeader(s *model.ClusterSnapshot) *model.ClusterSnapshot {
+	return &model.ClusterSnapshot{
+		SnapshotID:        s.SnapshotID,
+		ClusterID:         s.ClusterID,
+		Timestamp:         s.Timestamp,
+		AgentVersion:      s.AgentVersion,
+		SnapshotType:      s.SnapshotType,
+		Sequence:          s.Sequence,
+		BaseSnapshotID:    s.BaseSnapshotID,
+		Provider:          s.Provider,
+		Region:            s.Region,
+		CloudAccountID:    s.CloudAccountID,
+		KubernetesVersion: s.KubernetesVersion,
+	}
+}
@@ -0,0 +1,347 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// largeSnapshot returns a snapshot whose pods compress to far more than 4 KiB.
+// Random pod names keep zstd from shrinking them.
+func largeSnapshot(pods int) *model.ClusterSnapshot {
+	snap := testSnapshot()
+	for range pods {
+		snap.Pods = append(snap.Pods, model.PodInfo{Name: rand.Text(), Namespace: "default"})
+	}
+	snap.Deployments = []model.DeploymentInfo{{Name: "web", Namespace: "default"}}
+	snap.Services = []model.ServiceInfo{{Name: "web", Namespace: "default"}}
+	return snap
+}
+
+type receivedPart struct {
+	header http.Header
+	size   int
+	snap   model.ClusterSnapshot
+}
+
+// partServer decodes every request, part or whole snapshot, into a
+// receivedPart. fail, if set, is consulted per request and may reply with an
+// error status instead.
+func partServer(t *testing.T, fail func(index string) int) (*httptest.Server, func() []receivedPart) {
+	t.Helper()
+	var mu sync.Mutex
+	var parts []receivedPart
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if fail != nil {
+			if code := fail(r.Header.Get(PartIndexHeader)); code != 0 {
+				w.WriteHeader(code)
+				return
+			}
+		}
+		dec, err := zstd.NewReader(bytes.NewReader(body))
+		if err != nil {
+			t.Errorf("part is not valid zstd: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer dec.Close()
+		var snap model.ClusterSnapshot
+		if err := json.NewDecoder(dec).Decode(&snap); err != nil {
+			t.Errorf("failed to decode part: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sum := sha256.Sum256(body)
+		if got := r.Header.Get(PartChecksumHeader); r.Header.Get(PartIndexHeader) != "" && got != hex.EncodeToString(sum[:]) {
+			t.Errorf("part checksum = %q, want sha256 of body", got)
+		}
+		mu.Lock()
+		parts = append(parts, receivedPart{header: r.Header.Clone(), size: len(body), snap: snap})
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true, Message: "part " + r.Header.Get(PartIndexHeader)})
+	}))
+	return srv, func() []receivedPart {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedPart(nil), parts...)
+	}
+}
+
+func TestSplitSnapshot_PartsCoverSnapshot(t *testing.T) {
+	snap := largeSnapshot(10)
+	snap.Delta = &model.SnapshotDelta{Deleted: map[string][]string{"pods": {"uid-1"}}}
+
+	parts := splitSnapshot(snap, 3)
+	wantFamilies := []string{
+		model.PartFamilyNodes,
+		model.PartFamilyPods, model.PartFamilyPods, model.PartFamilyPods,
+		model.PartFamilyWorkloads,
+		model.PartFamilyNetworkStorage,
+	}
+	if len(parts) != len(wantFamilies) {
+		t.Fatalf("got %d parts, want %d", len(parts), len(wantFamilies))
+	}
+
+	var pods []model.PodInfo
+	for i, p := range parts {
+		if p.Part.Index != i || p.Part.Count != len(parts) || p.Part.Family != wantFamilies[i] {
+			t.Errorf("part %d = %+v, want index %d of %d, family %s", i, *p.Part, i, len(parts), wantFamilies[i])
+		}
+		if p.SnapshotID != snap.SnapshotID || p.ClusterID != snap.ClusterID || p.Timestamp != snap.Timestamp {
+			t.Errorf("part %d does not carry the snapshot identity", i)
+		}
+		if i > 0 && (p.Summary.NodeCount != 0 || p.Delta != nil) {
+			t.Errorf("part %d carries the summary or delta; only part 0 should", i)
+		}
+		pods = append(pods, p.Pods...)
+	}
+	if len(pods) != len(snap.Pods) {
+		t.Fatalf("parts hold %d pods, want %d", len(pods), len(snap.Pods))
+	}
+	for i := range pods {
+		if pods[i].Name != snap.Pods[i].Name {
+			t.Fatalf("pod %d = %q, want %q", i, pods[i].Name, snap.Pods[i].Name)
+		}
+	}
+	if parts[0].Summary.NodeCount != 1 || parts[0].Delta == nil || len(parts[0].Nodes) != 1 {
+		t.Error("part 0 should carry the nodes, summary and delta")
+	}
+	if len(parts[4].Deployments) != 1 || len(parts[5].Services) != 1 {
+		t.Error("workloads and services should travel in their family's part")
+	}
+}
+
+func TestSplitSnapshot_NoPods(t *testing.T) {
+	parts := splitSnapshot(testSnapshot(), 4)
+	if len(parts) != 3 {
+		t.Fatalf("got %d parts, want 3 (nodes, workloads, network/storage)", len(parts))
+	}
+}
+
+func TestClient_Send_ChunkedUpload(t *testing.T) {
+	srv, received := partServer(t, nil)
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	cfg.ChunkedUploadEnabled = true
+	client := NewClient(cfg, nil, nil)
+
+	snap := largeSnapshot(500)
+	resp, err := client.Send(context.Background(), snap)
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	parts := received()
+	if len(parts) < 4 {
+		t.Fatalf("got %d parts, want the pods split over several parts", len(parts))
+	}
+	if want := "part " + strconv.Itoa(len(parts)-1); resp.Message != want {
+		t.Errorf("response = %q, want the last part's %q", resp.Message, want)
+	}
+
+	total := sha256.New()
+	var pods int
+	for i, p := range parts {
+		if p.size > 4096 {
+			t.Errorf("part %d is %d bytes, over the 4096 byte cap", i, p.size)
+		}
+		if got := p.header.Get("X-Snapshot-Id"); got != snap.SnapshotID {
+			t.Errorf("part %d X-Snapshot-ID = %q, want %q", i, got, snap.SnapshotID)
+		}
+		if got, want := p.header.Get(PartIndexHeader), strconv.Itoa(i); got != want {
+			t.Errorf("part %d index header = %q, want %q", i, got, want)
+		}
+		if got, want := p.header.Get(PartCountHeader), strconv.Itoa(len(parts)); got != want {
+			t.Errorf("part %d count header = %q, want %q", i, got, want)
+		}
+		if got, want := p.header.Get("Idempotency-Key"), snap.SnapshotID+"/"+strconv.Itoa(i); got != want {
+			t.Errorf("part %d Idempotency-Key = %q, want %q", i, got, want)
+		}
+		if p.snap.Part == nil || p.snap.Part.Index != i || p.snap.Part.Count != len(parts) {
+			t.Errorf("part %d body Part = %+v", i, p.snap.Part)
+		}
+		sum, _ := hex.DecodeString(p.header.Get(PartChecksumHeader))
+		total.Write(sum)
+		pods += len(p.snap.Pods)
+	}
+	wantTotal := hex.EncodeToString(total.Sum(nil))
+	for i, p := range parts {
+		if got := p.header.Get(SnapshotChecksumHeader); got != wantTotal {
+			t.Errorf("part %d snapshot checksum = %q, want %q", i, got, wantTotal)
+		}
+	}
+	if pods != len(snap.Pods) {
+		t.Errorf("parts hold %d pods, want %d", pods, len(snap.Pods))
+	}
+	if stats := client.LastSendStats(); stats.CompressedBytes <= 4096 {
+		t.Errorf("LastSendStats.CompressedBytes = %d, want the size of all parts", stats.CompressedBytes)
+	}
+}
+
+func TestClient_Send_ChunkedUploadDisabled(t *testing.T) {
+	srv, received := partServer(t, nil)
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	client := NewClient(cfg, nil, nil)
+
+	_, err := client.Send(context.Background(), largeSnapshot(500))
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("err = %v, want ErrPayloadTooLarge", err)
+	}
+	if n := len(received()); n != 0 {
+		t.Errorf("server received %d requests, want none", n)
+	}
+}
+
+func TestClient_Send_ChunkedUploadPartTooLarge(t *testing.T) {
+	srv, received := partServer(t, nil)
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	cfg.ChunkedUploadEnabled = true
+	client := NewClient(cfg, nil, nil)
+
+	// Nodes cannot be split, so a node part over the cap fails the send.
+	snap := largeSnapshot(0)
+	for range 500 {
+		snap.Nodes = append(snap.Nodes, model.NodeInfo{Name: rand.Text()})
+	}
+	_, err := client.Send(context.Background(), snap)
+	if !errors.Is(err, ErrPayloadTooLarge) {
+		t.Fatalf("err = %v, want ErrPayloadTooLarge", err)
+	}
+	if n := len(received()); n != 0 {
+		t.Errorf("server received %d requests, want none", n)
+	}
+}
+
+// TestClient_Send_ChunkedRetryResumesFromFailedPart verifies a retry resends
+// only the failed part, not the parts already accepted.
+func TestClient_Send_ChunkedRetryResumesFromFailedPart(t *testing.T) {
+	var failed atomic.Bool
+	srv, received := partServer(t, func(index string) int {
+		if index == "1" && !failed.Swap(true) {
+			return http.StatusServiceUnavailable
+		}
+		return 0
+	})
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	cfg.ChunkedUploadEnabled = true
+	cfg.MaxRetries = 1
+	client := NewClient(cfg, nil, nil)
+
+	if _, err := client.Send(context.Background(), largeSnapshot(500)); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	if !failed.Load() {
+		t.Fatal("part 1 was never sent")
+	}
+	parts := received()
+	for i, p := range parts {
+		if got := p.header.Get(PartIndexHeader); got != strconv.Itoa(i) {
+			t.Fatalf("accepted part %d has index %s; parts were resent or skipped", i, got)
+		}
+	}
+	if count, _ := strconv.Atoi(parts[0].header.Get(PartCountHeader)); count != len(parts) {
+		t.Errorf("accepted %d parts, want %d", len(parts), count)
+	}
+}
+
+// TestClient_Send_ChunkedSpoolsPartSet verifies a chunked snapshot that fails
+// part-way is buffered whole and replayed with the same part headers.
+func TestClient_Send_ChunkedSpoolsPartSet(t *testing.T) {
+	var healthy atomic.Bool
+	srv, received := partServer(t, func(index string) int {
+		if index == "2" && !healthy.Load() {
+			return http.StatusServiceUnavailable
+		}
+		return 0
+	})
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	cfg.ChunkedUploadEnabled = true
+	cfg.MaxRetries = 0
+	client := NewClient(cfg, nil, nil)
+	spool, err := NewSpool(t.TempDir(), 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	snap := largeSnapshot(500)
+	if _, err := client.Send(context.Background(), snap); err == nil {
+		t.Fatal("expected the send to fail at part 2")
+	}
+	staged := received()
+	if len(staged) != 2 {
+		t.Fatalf("backend staged %d parts before the failure, want 2", len(staged))
+	}
+	if n, _ := client.SpoolStats(); n != 1 {
+		t.Fatalf("expected the part set buffered as 1 entry, got %d", n)
+	}
+
+	healthy.Store(true)
+	live := testSnapshot()
+	live.SnapshotID = "snap-live"
+	if _, err := client.Send(context.Background(), live); err != nil {
+		t.Fatalf("Send failed after recovery: %v", err)
+	}
+	if n, _ := client.SpoolStats(); n != 0 {
+		t.Fatalf("expected spool drained, got %d entries", n)
+	}
+
+	var replayed []receivedPart
+	for _, p := range received()[len(staged):] {
+		if p.header.Get("X-Snapshot-Id") == snap.SnapshotID {
+			replayed = append(replayed, p)
+		}
+	}
+	count, _ := strconv.Atoi(staged[0].header.Get(PartCountHeader))
+	if len(replayed) != count {
+		t.Fatalf("replayed %d parts, want all %d", len(replayed), count)
+	}
+	var pods int
+	for i, p := range replayed {
+		if got := p.header.Get(PartIndexHeader); got != strconv.Itoa(i) {
+			t.Errorf("replayed part %d has index %s", i, got)
+		}
+		if got, want := p.header.Get(SnapshotChecksumHeader), staged[0].header.Get(SnapshotChecksumHeader); got != want {
+			t.Errorf("replayed part %d snapshot checksum = %q, want %q", i, got, want)
+		}
+		if i < len(staged) && p.header.Get(PartChecksumHeader) != staged[i].header.Get(PartChecksumHeader) {
+			t.Errorf("replayed part %d checksum differs from the staged part", i)
+		}
+		pods += len(p.snap.Pods)
+	}
+	if pods != len(snap.Pods) {
+		t.Errorf("replayed parts hold %d pods, want %d", pods, len(snap.Pods))
+	}
+}
@@ -3,11 +3,17 @@ package transport
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
-	"strings"
+	"os"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/klauspost/compress/zstd"
@@ -24,9 +30,18 @@ const (
 	// ProtocolHeader is the handshake header the server pre-filter requires.
 	ProtocolHeader = "X-Kubeadapt-Protocol"
 
-	// ProtocolVersion is the wire-protocol version this agent speaks.
+	// ProtocolVersion is the wire-protocol version of JSON snapshots.
 	ProtocolVersion = "v1"
 
+	// ProtocolVersionProtobuf is the wire-protocol version of protobuf
+	// snapshots (pkg/modelpb). Responses are JSON in both versions.
+	ProtocolVersionProtobuf = "v2"
+
+	// ContentTypeJSON and ContentTypeProtobuf are the snapshot content types
+	// of protocol v1 and v2.
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+
 	// ContentEncoding is the only encoding the ingest endpoint accepts.
 	ContentEncoding = "zstd"
 
@@ -36,6 +51,10 @@ const (
 
 	// IngestPath is the REST path we POST snapshots to.
 	IngestPath = "/api/v1/metrics/ingest"
+
+	// maxReplayPerSend bounds how many spooled snapshots are replayed after a
+	// successful live send so a long backlog can't stall the snapshot loop.
+	maxReplayPerSend = 10
 )
 
 // ErrPayloadTooLarge is returned when the compressed snapshot exceeds the
@@ -60,6 +79,24 @@ type Client struct {
 	errorCollector         *agenterrors.ErrorCollector
 	maxCompressedBodyBytes int64
 	lastSendStats          SendStats // updated after each successful send
+	spool                  *Spool    // optional; nil disables durable buffering
+
+	// protobufRejected is set once the backend rejects protocol v2; later
+	// snapshots are sent as JSON.
+	protobufRejected atomic.Bool
+
+	// codec compresses snapshots. It is replaced when the backend names a
+	// new dictionary or rejects the current one.
+	codec            atomic.Pointer[codec]
+	compressionLevel zstd.EncoderLevel
+
+	baseTransport *http.Transport // under the auth middleware
+}
+
+// bodyEncoding is how a request body is encoded, as announced in its headers.
+type bodyEncoding struct {
+	wireFormat string
+	dictID     uint32 // zstd dictionary ID, 0 for none
 }
 
 // NewClient creates a transport Client with middleware applied.
@@ -75,6 +112,9 @@ func NewClient(cfg *config.Config, metrics *observability.Metrics, errCollector
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 		ResponseHeaderTimeout: 30 * time.Second,
+		// SetTLSConfig installs a custom TLS config, which otherwise turns
+		// off net/http's automatic HTTP/2.
+		ForceAttemptHTTP2: true,
 	}
 
 	// Auth middleware decorates every request with the bearer token.
@@ -86,7 +126,21 @@ func NewClient(cfg *config.Config, metrics *observability.Metrics, errCollector
 		maxCompressed = DefaultMaxCompressedBodyBytes
 	}
 
-	return &Client{
+	// Validate keeps CompressionLevel within the zstd encoder levels; an
+	// unset level gets the encoder default.
+	level := zstd.EncoderLevel(cfg.CompressionLevel)
+	if level < zstd.SpeedFastest || level > zstd.SpeedBestCompression {
+		level = zstd.SpeedDefault
+	}
+	// The backend source starts from the bundled dictionary until the
+	// backend names its own.
+	var dict *dictionary
+	if cfg.CompressionDictionary == config.CompressionDictionaryBundled ||
+		cfg.CompressionDictionary == config.CompressionDictionaryBackend {
+		dict = bundledDictionary
+	}
+
+	c := &Client{
 		httpClient: &http.Client{
 			Timeout:   cfg.RequestTimeout,
 			Transport: transport,
@@ -95,63 +149,118 @@ func NewClient(cfg *config.Config, metrics *observability.Metrics, errCollector
 		metrics:                metrics,
 		errorCollector:         errCollector,
 		maxCompressedBodyBytes: maxCompressed,
+		compressionLevel:       level,
+		baseTransport:          base,
+	}
+	c.codec.Store(newCodec(level, dict))
+	return c
+}
+
+// SetSpool enables durable buffering: snapshots that fail with a retryable
+// error are written to the spool and replayed oldest-first after the next
+// successful send.
+func (c *Client) SetSpool(s *Spool) {
+	c.spool = s
+}
+
+// SetTLSConfig sets the TLS configuration of the backend connection, built
+// by NewTLSConfig. Call it before the first Send.
+func (c *Client) SetTLSConfig(tc *tls.Config) {
+	c.baseTransport.TLSClientConfig = tc
+}
+
+// SpoolStats returns the number and total size of buffered snapshots.
+// Returns zeros when no spool is configured.
+func (c *Client) SpoolStats() (entries int, sizeBytes int64) {
+	if c.spool == nil {
+		return 0, 0
 	}
+	return c.spool.Stats()
 }
 
 // Send serializes, zstd-compresses, and POSTs a ClusterSnapshot.
 // Retries are applied at this layer; protocol/size/auth/quota errors are
-// treated as terminal (see isNonRetryableError).
+// treated as terminal (see isNonRetryableError). When a spool is configured,
+// payloads that exhaust retries are buffered on disk, and buffered payloads
+// are replayed after a successful send.
 func (c *Client) Send(ctx context.Context, snapshot *model.ClusterSnapshot) (*model.SnapshotResponse, error) {
 	start := time.Now()
 
 	// Encode + compress once before the retry loop; output is identical across
 	// attempts and lets us enforce the size cap before sending.
+	// With streaming encode the body goes to a temp file instead of memory.
 	encodeStart := time.Now()
-	compressed, originalBytes, encodeErr := c.encodeSnapshot(snapshot)
-	encodeDurationMs := time.Since(encodeStart).Milliseconds()
-	if encodeErr != nil {
-		return nil, encodeErr
+	format := c.wireFormat()
+	cd := c.codec.Load()
+	enc := bodyEncoding{wireFormat: format, dictID: cd.dictID()}
+	body, originalBytes, err := c.encodeBody(cd, snapshot, format)
+	if err != nil && !errors.Is(err, errBodyOverLimit) {
+		return nil, err
 	}
-	compressedBytes := int64(len(compressed))
-
-	// Reject locally if over the server's declared cap to avoid an HTTP 413 round-trip.
-	if c.maxCompressedBodyBytes > 0 && compressedBytes > c.maxCompressedBodyBytes {
-		return nil, fmt.Errorf("%w (compressed=%d, limit=%d)",
-			ErrPayloadTooLarge, compressedBytes, c.maxCompressedBodyBytes)
+	if body != nil {
+		defer releaseBody(body)
 	}
+	encodeDurationMs := time.Since(encodeStart).Milliseconds()
 
-	var result *model.SnapshotResponse
-	var lastErr error
-
-	maxAttempts := c.config.MaxRetries + 1
-	for attempt := 0; attempt < maxAttempts; attempt++ {
-		if attempt > 0 {
-			// Record retry metric.
-			if c.metrics != nil {
-				c.metrics.TransportRetries.Inc()
-			}
-			sleepWithBackoff(attempt - 1)
+	// Over the server's declared cap: split into parts when chunked upload is
+	// enabled, otherwise reject locally to avoid an HTTP 413 round-trip. The
+	// encode stopped at the cap, so the snapshot is encoded in full only once.
+	var parts []encodedPart
+	var compressedBytes int64
+	if body != nil {
+		compressedBytes = body.Size()
+	} else {
+		if !c.config.ChunkedUploadEnabled {
+			return nil, fmt.Errorf("%w (limit=%d)", ErrPayloadTooLarge, c.maxCompressedBodyBytes)
 		}
-
-		// Check context before each attempt.
-		if err := ctx.Err(); err != nil {
-			lastErr = fmt.Errorf("transport: context canceled before attempt %d: %w", attempt+1, err)
-			break
+		parts, originalBytes, err = c.encodeParts(cd, snapshot, format)
+		encodeDurationMs = time.Since(encodeStart).Milliseconds()
+		if err != nil {
+			return nil, err
+		}
+		defer releaseParts(parts)
+		for _, p := range parts {
+			compressedBytes += p.body.Size()
 		}
+		slog.Info("snapshot exceeds compressed-body cap, sending in parts",
+			"snapshot_id", snapshot.SnapshotID,
+			"parts", len(parts),
+			"compressed_bytes", compressedBytes,
+			"limit", c.maxCompressedBodyBytes,
+		)
+	}
 
-		resp, err := c.doSend(ctx, snapshot.SnapshotID, compressed)
-		if err != nil {
-			lastErr = err
-			// Don't retry auth failures, payload-too-large, or protocol errors.
-			if isNonRetryableError(err) {
+	var result *model.SnapshotResponse
+	var lastErr error
+	if parts == nil {
+		result, lastErr = c.sendWithRetry(ctx, snapshot.SnapshotID, enc, body, nil)
+	} else {
+		// Parts are sent in order, each with its own retry budget, so a
+		// failure resumes from the failed part. The backend commits the
+		// snapshot on receiving the last part; its response is the result.
+		for i := range parts {
+			result, lastErr = c.sendWithRetry(ctx, snapshot.SnapshotID, enc, parts[i].body, &parts[i])
+			if lastErr != nil {
+				lastErr = fmt.Errorf("part %d/%d: %w", parts[i].index+1, parts[i].count, lastErr)
 				break
 			}
-			continue
 		}
+	}
 
-		result = resp
-		lastErr = nil
-		break
+	// A backend without protocol v2 rejects it in its pre-filter; resend the
+	// snapshot, and everything after it, as JSON.
+	if lastErr != nil && format == config.WireFormatProtobuf && errors.Is(lastErr, ErrProtocolMismatch) {
+		c.protobufRejected.Store(true)
+		slog.Warn("backend rejected protobuf snapshots, falling back to JSON", "error", lastErr)
+		return c.Send(ctx, snapshot)
+	}
+	// Likewise for a backend that does not hold the dictionary: continue
+	// without one.
+	if lastErr != nil && cd.dict != nil && errors.Is(lastErr, ErrUnsupportedEncoding) {
+		c.codec.CompareAndSwap(cd, newCodec(c.compressionLevel, nil))
+		slog.Warn("backend rejected zstd dictionary, compressing without it",
+			"dictionary_id", cd.dict.id, "error", lastErr)
+		return c.Send(ctx, snapshot)
 	}
 
 	elapsed := time.Since(start)
@@ -171,14 +280,25 @@ func (c *Client) Send(ctx context.Context, snapshot *model.ClusterSnapshot) (*mo
 
 	if lastErr != nil {
 		if c.errorCollector != nil {
+			code := agenterrors.ErrBackendUnreachable
+			if errors.Is(lastErr, ErrTLSHandshake) {
+				code = agenterrors.ErrTLSHandshakeFailed
+			}
 			c.errorCollector.Report(agenterrors.AgentError{
-				Code:      agenterrors.ErrBackendUnreachable,
+				Code:      code,
 				Message:   fmt.Sprintf("snapshot send failed: %v", lastErr),
 				Component: "transport",
 				Timestamp: time.Now().UnixMilli(),
 				Err:       lastErr,
 			})
 		}
+		// Deltas are only meaningful against the base the backend holds now;
+		// the agent follows a failed send with a full keyframe instead.
+		// A chunked snapshot is buffered as its whole part set: the parts the
+		// backend already staged may have expired by the time it is replayed.
+		if snapshot.SnapshotType != model.SnapshotTypeDelta {
+			c.spoolPayload(snapshot.SnapshotID, format, body, parts, lastErr)
+		}
 		return nil, lastErr
 	}
 
@@ -189,66 +309,314 @@ func (c *Client) Send(ctx context.Context, snapshot *model.ClusterSnapshot) (*mo
 		EncodeDurationMs: encodeDurationMs,
 	}
 
+	if c.config.CompressionDictionary == config.CompressionDictionaryBackend {
+		c.updateDictionary(ctx, result.Directives.CompressionDictionaryID)
+	}
+
+	// The backend is reachable again; drain anything buffered during the outage.
+	c.replaySpool(ctx)
+
 	return result, nil
 }
 
+// sendWithRetry POSTs a compressed body (or one part of a chunked snapshot)
+// with up to MaxRetries retries. Terminal errors are returned immediately.
+func (c *Client) sendWithRetry(ctx context.Context, snapshotID string, enc bodyEncoding, body payload, part *encodedPart) (*model.SnapshotResponse, error) {
+	var lastErr error
+	maxAttempts := c.config.MaxRetries + 1
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			// Record retry metric.
+			if c.metrics != nil {
+				c.metrics.TransportRetries.Inc()
+			}
+			sleepWithBackoff(attempt - 1)
+		}
+
+		// Check context before each attempt.
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("transport: context canceled before attempt %d: %w", attempt+1, err)
+		}
+
+		resp, err := c.doSend(ctx, snapshotID, enc, body, part)
+		if err != nil {
+			lastErr = err
+			// Don't retry auth failures, payload-too-large, or protocol errors.
+			if isNonRetryableError(err) {
+				break
+			}
+			continue
+		}
+		return resp, nil
+	}
+	return nil, lastErr
+}
+
+// spoolPayload buffers a failed payload, or the parts of a chunked one, on
+// disk when the failure is transient. Terminal failures (auth, quota,
+// protocol, size) are not buffered because replaying them would fail
+// identically.
+func (c *Client) spoolPayload(snapshotID, wireFormat string, body payload, parts []encodedPart, sendErr error) {
+	if c.spool == nil || isNonRetryableError(sendErr) {
+		return
+	}
+	var err error
+	if parts != nil {
+		err = c.spool.putParts(snapshotID, wireFormat, parts)
+	} else {
+		err = c.spool.PutReader(snapshotID, wireFormat, io.NewSectionReader(body, 0, body.Size()), body.Size())
+	}
+	if err != nil {
+		slog.Warn("failed to buffer snapshot for replay", "snapshot_id", snapshotID, "error", err)
+		return
+	}
+	entries, size := c.spool.Stats()
+	slog.Info("snapshot buffered for replay",
+		"snapshot_id", snapshotID,
+		"buffered_entries", entries,
+		"buffered_bytes", size,
+	)
+}
+
+// replaySpool sends buffered payloads oldest-first, one attempt each, and
+// stops at the first failure so ordering is preserved for the next drain.
+// Entries the server rejects as too large are dropped since they can never succeed.
+func (c *Client) replaySpool(ctx context.Context) {
+	if c.spool == nil {
+		return
+	}
+	for i := 0; i < maxReplayPerSend; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+		entry, payload, ok, err := c.spool.Oldest()
+		if !ok {
+			return
+		}
+		if err != nil {
+			slog.Warn("dropping unreadable spool entry", "path", entry.Path, "error", err)
+			c.spool.Remove(entry)
+			continue
+		}
+
+		enc := bodyEncoding{wireFormat: entry.WireFormat, dictID: frameDictionaryID(payload)}
+		if entry.Parts != nil {
+			enc.dictID, err = c.replayParts(ctx, entry)
+		} else {
+			_, err = c.doSend(ctx, entry.SnapshotID, enc, bytes.NewReader(payload), nil)
+		}
+		if err != nil {
+			if errors.Is(err, errSpoolEntryUnreadable) {
+				slog.Warn("dropping unreadable spool entry", "path", entry.Path, "error", err)
+				c.spool.Remove(entry)
+				continue
+			}
+			if errors.Is(err, ErrPayloadTooLarge) {
+				slog.Warn("dropping oversize spool entry", "snapshot_id", entry.SnapshotID, "error", err)
+				c.spool.Remove(entry)
+				continue
+			}
+			if entry.WireFormat == config.WireFormatProtobuf && errors.Is(err, ErrProtocolMismatch) {
+				slog.Warn("dropping protobuf spool entry the backend does not accept", "snapshot_id", entry.SnapshotID, "error", err)
+				c.protobufRejected.Store(true)
+				c.spool.Remove(entry)
+				continue
+			}
+			if enc.dictID != 0 && errors.Is(err, ErrUnsupportedEncoding) {
+				slog.Warn("dropping spool entry compressed with a dictionary the backend does not hold",
+					"snapshot_id", entry.SnapshotID, "dictionary_id", enc.dictID, "error", err)
+				c.spool.Remove(entry)
+				continue
+			}
+			slog.Warn("spool replay failed, will retry after next send",
+				"snapshot_id", entry.SnapshotID,
+				"error", err,
+			)
+			return
+		}
+
+		c.spool.Remove(entry)
+		if c.metrics != nil {
+			c.metrics.SnapshotSendTotal.WithLabelValues("replayed").Inc()
+		}
+		slog.Info("replayed buffered snapshot",
+			"snapshot_id", entry.SnapshotID,
+			"spooled_at", entry.SpooledAt,
+		)
+	}
+}
+
+// errSpoolEntryUnreadable marks a spooled part that could not be read back.
+var errSpoolEntryUnreadable = errors.New("transport: unreadable spool entry")
+
+// replayParts sends the parts of a buffered chunked snapshot in order, one
+// attempt each, and returns the dictionary ID they were compressed with.
+// Every part is resent, since the backend may have dropped the parts it
+// staged before the outage; it dedupes those it still holds by
+// Idempotency-Key.
+func (c *Client) replayParts(ctx context.Context, entry SpoolEntry) (uint32, error) {
+	total := sha256.New()
+	for _, p := range entry.Parts {
+		sum, err := hex.DecodeString(p.Checksum)
+		if err != nil {
+			return 0, fmt.Errorf("%w: part %d checksum: %w", errSpoolEntryUnreadable, p.Index, err)
+		}
+		total.Write(sum)
+	}
+	totalSum := hex.EncodeToString(total.Sum(nil))
+
+	var dictID uint32
+	for _, p := range entry.Parts {
+		body, err := os.ReadFile(p.Path)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %w", errSpoolEntryUnreadable, err)
+		}
+		dictID = frameDictionaryID(body)
+		enc := bodyEncoding{wireFormat: entry.WireFormat, dictID: dictID}
+		part := &encodedPart{
+			index:    p.Index,
+			count:    len(entry.Parts),
+			checksum: p.Checksum,
+			total:    totalSum,
+		}
+		if _, err := c.doSend(ctx, entry.SnapshotID, enc, bytes.NewReader(body), part); err != nil {
+			return dictID, fmt.Errorf("part %d/%d: %w", p.Index+1, len(entry.Parts), err)
+		}
+	}
+	return dictID, nil
+}
+
 // LastSendStats returns payload size info from the most recent successful send.
 func (c *Client) LastSendStats() SendStats {
 	return c.lastSendStats
 }
 
-// encodeSnapshot marshals the snapshot as JSON and wraps it in zstd.
+// encodeSnapshot encodes the snapshot in wireFormat and compresses it with cd.
 // Returns compressed bytes plus pre-compression byte count for observability.
-func (c *Client) encodeSnapshot(snapshot *model.ClusterSnapshot) ([]byte, int64, error) {
+func encodeSnapshot(cd *codec, snapshot *model.ClusterSnapshot, wireFormat string) ([]byte, int64, error) {
+	return encodeSnapshotLimit(cd, snapshot, wireFormat, 0)
+}
+
+// encodeSnapshotLimit is encodeSnapshot failing with errBodyOverLimit once
+// the compressed bytes pass limit.
+func encodeSnapshotLimit(cd *codec, snapshot *model.ClusterSnapshot, wireFormat string, limit int64) ([]byte, int64, error) {
 	var compressed bytes.Buffer
+	originalBytes, err := cd.compressSnapshot(limitBody(&compressed, limit), snapshot, wireFormat)
+	if err != nil {
+		return nil, 0, err
+	}
+	return compressed.Bytes(), originalBytes, nil
+}
 
-	zw, err := zstd.NewWriter(&compressed, zstd.WithEncoderLevel(zstd.SpeedDefault))
+// encodeBody compresses a snapshot, or one part of it, into a temp file with
+// streaming encode enabled and into memory otherwise. A body over the
+// compressed-body cap fails with errBodyOverLimit as soon as the cap is
+// passed. The caller releases the body with releaseBody.
+func (c *Client) encodeBody(cd *codec, snapshot *model.ClusterSnapshot, wireFormat string) (payload, int64, error) {
+	if c.config.StreamingEncodeEnabled {
+		fb, orig, err := cd.encodeSnapshotFile(c.tempDir(), snapshot, wireFormat, c.maxCompressedBodyBytes)
+		if err != nil {
+			return nil, 0, err
+		}
+		return fb, orig, nil
+	}
+	compressed, orig, err := encodeSnapshotLimit(cd, snapshot, wireFormat, c.maxCompressedBodyBytes)
 	if err != nil {
-		return nil, 0, fmt.Errorf("transport: failed to create zstd encoder: %w", err)
+		return nil, 0, err
 	}
+	return bytes.NewReader(compressed), orig, nil
+}
 
-	// Tee through CountingWriter to capture pre-compression byte count.
-	orig := NewCountingWriter(zw)
+// wireFormat returns the format to encode the next snapshot in.
+func (c *Client) wireFormat() string {
+	if c.config.WireFormat == config.WireFormatProtobuf && !c.protobufRejected.Load() {
+		return config.WireFormatProtobuf
+	}
+	return config.WireFormatJSON
+}
 
-	if err := json.NewEncoder(orig).Encode(snapshot); err != nil {
-		_ = zw.Close()
-		return nil, 0, fmt.Errorf("transport: JSON encode failed: %w", err)
+// updateDictionary switches to the dictionary the backend named, fetching it
+// first. A failed fetch keeps the current dictionary and is retried after
+// the next send.
+func (c *Client) updateDictionary(ctx context.Context, id uint32) {
+	cd := c.codec.Load()
+	if id == 0 || id == cd.dictID() {
+		return
 	}
-	if err := zw.Close(); err != nil {
-		return nil, 0, fmt.Errorf("transport: zstd close failed: %w", err)
+	var dict *dictionary
+	if id == bundledDictionary.id {
+		dict = bundledDictionary
+	} else {
+		d, err := c.fetchDictionary(ctx, id)
+		if err != nil {
+			slog.Warn("failed to fetch zstd dictionary, keeping the current one",
+				"dictionary_id", id, "error", err)
+			return
+		}
+		dict = d
 	}
+	c.codec.CompareAndSwap(cd, newCodec(c.compressionLevel, dict))
+	slog.Info("switched zstd dictionary", "dictionary_id", id)
+}
 
-	return compressed.Bytes(), orig.Count(), nil
+// tempDir returns the directory streamed snapshots are written to: the spool
+// directory when disk buffering is enabled, else the default temp directory.
+func (c *Client) tempDir() string {
+	if c.spool != nil {
+		return c.spool.dir
+	}
+	return ""
 }
 
 // doSend performs a single HTTP POST of the already-compressed body.
 // Separated from encodeSnapshot so retries don't re-run JSON+zstd.
-func (c *Client) doSend(ctx context.Context, snapshotID string, compressed []byte) (*model.SnapshotResponse, error) {
-	// bytes.NewReader is an io.ReadSeeker so net/http auto-sets Content-Length
-	// and can replay the body on redirect; required by the server pre-filter.
-	body := bytes.NewReader(compressed)
-
+// part is nil unless the body is one part of a chunked snapshot.
+func (c *Client) doSend(ctx context.Context, snapshotID string, enc bodyEncoding, body payload, part *encodedPart) (*model.SnapshotResponse, error) {
 	url := c.config.BackendURL + IngestPath
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, io.NewSectionReader(body, 0, body.Size()))
 	if err != nil {
 		return nil, fmt.Errorf("transport: failed to create request: %w", err)
 	}
 
-	// Set explicitly so middleware that wraps the body can't drop it.
-	req.ContentLength = int64(len(compressed))
+	// The body's size is known up front, so Content-Length (required by the
+	// server pre-filter) is set even when it is read from a file. GetBody
+	// lets net/http replay it on redirect.
+	req.ContentLength = body.Size()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(io.NewSectionReader(body, 0, body.Size())), nil
+	}
 
 	// Protocol handshake; remaining headers are observability metadata.
-	req.Header.Set(ProtocolHeader, ProtocolVersion)
-	req.Header.Set("Content-Type", "application/json")
+	if enc.wireFormat == config.WireFormatProtobuf {
+		req.Header.Set(ProtocolHeader, ProtocolVersionProtobuf)
+		req.Header.Set("Content-Type", ContentTypeProtobuf)
+	} else {
+		req.Header.Set(ProtocolHeader, ProtocolVersion)
+		req.Header.Set("Content-Type", ContentTypeJSON)
+	}
 	req.Header.Set("Content-Encoding", ContentEncoding)
+	if enc.dictID != 0 {
+		req.Header.Set(DictionaryHeader, strconv.FormatUint(uint64(enc.dictID), 10))
+	}
 	req.Header.Set("X-Agent-Version", c.config.AgentVersion)
 	req.Header.Set("X-Snapshot-ID", snapshotID)
 	// Idempotency-Key lets the server dedupe retries; harmless if unsupported.
-	req.Header.Set("Idempotency-Key", snapshotID)
+	idempotencyKey := snapshotID
+	if part != nil {
+		idempotencyKey += "/" + strconv.Itoa(part.index)
+		req.Header.Set(PartIndexHeader, strconv.Itoa(part.index))
+		req.Header.Set(PartCountHeader, strconv.Itoa(part.count))
+		req.Header.Set(PartChecksumHeader, part.checksum)
+		req.Header.Set(SnapshotChecksumHeader, part.total)
+	}
+	req.Header.Set("Idempotency-Key", idempotencyKey)
 	req.Header.Set("User-Agent", fmt.Sprintf("kubeadapt-agent/%s", c.config.AgentVersion))
 
 	resp, err := c.httpClient.Do(req) //nolint:gosec // URL is from agent config
 	if err != nil {
+		if isTLSHandshakeError(err) {
+			return nil, fmt.Errorf("%w: %w", ErrTLSHandshake, err)
+		}
 		return nil, fmt.Errorf("transport: HTTP request failed: %w", err)
 	}
 	defer resp.Body.Close()
@@ -256,18 +624,24 @@ func (c *Client) doSend(ctx context.Context, snapshotID string, compressed []byt
 	return ParseResponse(resp)
 }
 
+// nonRetryableErrors fail identically on retry: auth, quota, protocol, size.
+var nonRetryableErrors = []error{
+	ErrPayloadTooLarge,
+	ErrAuthFailed,
+	ErrQuotaExceeded,
+	ErrAgentDeprecated,
+	ErrProtocolMismatch,
+	ErrUnsupportedEncoding,
+	ErrLengthRequired,
+}
+
 // isNonRetryableError returns true for errors where retry would fail identically
 // (auth, quota, protocol, size). Retry only transient network / 5xx / 429 errors.
 func isNonRetryableError(err error) bool {
-	if errors.Is(err, ErrPayloadTooLarge) {
-		return true
-	}
-	msg := err.Error()
-	return strings.Contains(msg, "authentication failed") ||
-		strings.Contains(msg, "quota exceeded") ||
-		strings.Contains(msg, "agent deprecated") ||
-		strings.Contains(msg, "protocol mismatch") ||
-		strings.Contains(msg, "unsupported encoding") ||
-		strings.Contains(msg, "length required") ||
-		strings.Contains(msg, "payload too large")
+	for _, target := range nonRetryableErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
 }
@@ -7,17 +7,21 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/kubeadapt/kubeadapt-agent/internal/config"
 	agenterrors "github.com/kubeadapt/kubeadapt-agent/internal/errors"
 	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/modelpb"
 )
 
 func testSnapshot() *model.ClusterSnapshot {
@@ -149,8 +153,83 @@ func TestClient_Send_Headers(t *testing.T) {
 	}
 }
 
+// TestClient_Send_Protobuf verifies protobuf mode sends protocol v2 with a
+// body that decodes to the snapshot.
+func TestClient_Send_Protobuf(t *testing.T) {
+	var headers http.Header
+	var msg modelpb.ClusterSnapshot
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Clone()
+		dec, err := zstd.NewReader(r.Body)
+		if err != nil {
+			t.Errorf("body is not valid zstd: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer dec.Close()
+		raw, err := io.ReadAll(dec)
+		if err != nil {
+			t.Errorf("failed to read body: %v", err)
+		}
+		if err := proto.Unmarshal(raw, &msg); err != nil {
+			t.Errorf("body is not a protobuf snapshot: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.WireFormat = config.WireFormatProtobuf
+	client := NewClient(cfg, nil, nil)
+
+	if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if got := headers.Get(ProtocolHeader); got != ProtocolVersionProtobuf {
+		t.Errorf("%s = %q, want %q", ProtocolHeader, got, ProtocolVersionProtobuf)
+	}
+	if got := headers.Get("Content-Type"); got != ContentTypeProtobuf {
+		t.Errorf("Content-Type = %q, want %q", got, ContentTypeProtobuf)
+	}
+	if msg.GetSnapshotId() != "snap-001" {
+		t.Errorf("decoded snapshot_id = %q, want snap-001", msg.GetSnapshotId())
+	}
+}
+
+// TestClient_Send_ProtobufFallsBackToJSON verifies a backend rejecting
+// protocol v2 gets the snapshot, and every later one, as JSON.
+func TestClient_Send_ProtobufFallsBackToJSON(t *testing.T) {
+	var protocols []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		protocols = append(protocols, r.Header.Get(ProtocolHeader))
+		if r.Header.Get(ProtocolHeader) != ProtocolVersion {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.WireFormat = config.WireFormatProtobuf
+	client := NewClient(cfg, nil, nil)
+
+	for range 2 {
+		if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	want := []string{ProtocolVersionProtobuf, ProtocolVersion, ProtocolVersion}
+	if !slices.Equal(protocols, want) {
+		t.Errorf("protocols sent = %v, want %v", protocols, want)
+	}
+}
+
 // TestClient_Send_200_ParsesResponse verifies response is parsed correctly.
 func TestClient_Send_200_ParsesResponse(t *testing.T) {
+	collectVPAs := true
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Drain the request body to prevent broken pipe.
 		io.Copy(io.Discard, r.Body)
@@ -169,7 +248,7 @@ func TestClient_Send_200_ParsesResponse(t *testing.T) {
 			},
 			Directives: model.Directives{
 				NextSnapshotInSeconds: 60,
-				CollectVPAs:           true,
+				CollectVPAs:           &collectVPAs,
 			},
 		})
 	}))
@@ -461,3 +540,118 @@ func TestClient_Send_ContextCancellation(t *testing.T) {
 		t.Fatal("expected error from canceled context")
 	}
 }
+
+// TestClient_Send_SpoolsAndReplaysOldestFirst verifies failed payloads are
+// buffered on disk and replayed in order after the backend recovers.
+func TestClient_Send_SpoolsAndReplaysOldestFirst(t *testing.T) {
+	var healthy atomic.Bool
+	var mu sync.Mutex
+	var delivered []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		mu.Lock()
+		delivered = append(delivered, r.Header.Get("X-Snapshot-ID"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	client := NewClient(cfg, nil, nil)
+	spool, err := NewSpool(t.TempDir(), 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	for _, id := range []string{"snap-outage-1", "snap-outage-2"} {
+		snap := testSnapshot()
+		snap.SnapshotID = id
+		if _, err := client.Send(context.Background(), snap); err == nil {
+			t.Fatalf("expected error while backend is down (%s)", id)
+		}
+	}
+	if n, _ := client.SpoolStats(); n != 2 {
+		t.Fatalf("expected 2 buffered snapshots, got %d", n)
+	}
+
+	healthy.Store(true)
+	live := testSnapshot()
+	live.SnapshotID = "snap-live"
+	if _, err := client.Send(context.Background(), live); err != nil {
+		t.Fatalf("Send failed after recovery: %v", err)
+	}
+
+	want := []string{"snap-live", "snap-outage-1", "snap-outage-2"}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(delivered) != len(want) {
+		t.Fatalf("delivered = %v, want %v", delivered, want)
+	}
+	for i := range want {
+		if delivered[i] != want[i] {
+			t.Fatalf("delivered = %v, want %v", delivered, want)
+		}
+	}
+	if n, _ := client.SpoolStats(); n != 0 {
+		t.Fatalf("expected spool drained, got %d entries", n)
+	}
+}
+
+// TestClient_Send_AuthFailureNotSpooled verifies terminal errors are not buffered.
+func TestClient_Send_AuthFailureNotSpooled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	client := NewClient(testConfig(srv.URL), nil, nil)
+	spool, err := NewSpool(t.TempDir(), 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	if _, err := client.Send(context.Background(), testSnapshot()); err == nil {
+		t.Fatal("expected auth error")
+	}
+	if n, _ := client.SpoolStats(); n != 0 {
+		t.Fatalf("expected auth failure not to be buffered, got %d entries", n)
+	}
+}
+
+// TestClient_Send_DeltaNotSpooled verifies deltas are never buffered for
+// replay; they depend on a base the backend may no longer hold.
+func TestClient_Send_DeltaNotSpooled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := NewClient(testConfig(srv.URL), nil, nil)
+	spool, err := NewSpool(t.TempDir(), 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	snap := testSnapshot()
+	snap.SnapshotType = model.SnapshotTypeDelta
+	if _, err := client.Send(context.Background(), snap); err == nil {
+		t.Fatal("expected send error")
+	}
+	if n, _ := client.SpoolStats(); n != 0 {
+		t.Fatalf("expected delta not to be buffered, got %d entries", n)
+	}
+}
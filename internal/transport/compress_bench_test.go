@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"runtime"
 	"testing"
 
 	"github.com/klauspost/compress/zstd"
 
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
 	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
 )
 
@@ -113,8 +115,8 @@ func benchSnapshot(numNodes, numPods int) *model.ClusterSnapshot {
 }
 
 // BenchmarkStreamingCompress measures streaming zstd compression of a realistic
-// ClusterSnapshot (100 nodes, 2000 pods) using io.Pipe, matching the production
-// code path in Client.doSend.
+// ClusterSnapshot (100 nodes, 2000 pods) using io.Pipe and a single
+// json.Encoder call; a baseline for BenchmarkCompressSnapshot.
 func BenchmarkStreamingCompress(b *testing.B) {
 	b.ReportAllocs()
 
@@ -175,3 +177,86 @@ func BenchmarkStreamingCompress(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkCompressSnapshot measures the production encode path, a snapshot
+// written element by element through a pooled zstd encoder, in each wire
+// format.
+func BenchmarkCompressSnapshot(b *testing.B) {
+	snap := benchSnapshot(100, 2000)
+	cd := newCodec(zstd.SpeedDefault, nil)
+	for _, format := range []string{config.WireFormatJSON, config.WireFormatProtobuf} {
+		b.Run(format, func(b *testing.B) {
+			b.ReportAllocs()
+			var out *CountingWriter
+			for b.Loop() {
+				out = NewCountingWriter(io.Discard)
+				n, err := cd.compressSnapshot(out, snap, format)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportMetric(float64(n), "raw-bytes")
+			}
+			b.ReportMetric(float64(out.Count()), "compressed-bytes")
+		})
+	}
+}
+
+// heapSampler passes writes through to w and records the peak live heap
+// after every 16th of them.
+type heapSampler struct {
+	w      io.Writer
+	writes int
+	peak   uint64
+}
+
+func (h *heapSampler) Write(p []byte) (int, error) {
+	n, err := h.w.Write(p)
+	if h.writes++; h.writes%16 == 1 {
+		h.peak = max(h.peak, liveHeap())
+	}
+	return n, err
+}
+
+// liveHeap returns the heap still in use after a full collection.
+func liveHeap() uint64 {
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return ms.HeapAlloc
+}
+
+// BenchmarkCompressSnapshotHeap reports the live heap an encode adds on top
+// of the built snapshot, at two pod counts. The streaming encode stays flat
+// at the size of the zstd encoder; marshaling the snapshot first, as
+// encoding/json does, grows with the pods. The built snapshot, which both
+// read from, is not counted.
+func BenchmarkCompressSnapshotHeap(b *testing.B) {
+	cd := newCodec(zstd.SpeedDefault, nil)
+	for _, pods := range []int{2000, 20000} {
+		snap := benchSnapshot(100, pods)
+		for _, mode := range []string{"stream", "marshal"} {
+			b.Run(fmt.Sprintf("pods=%d/%s", pods, mode), func(b *testing.B) {
+				b.ReportAllocs()
+				var extra uint64
+				for b.Loop() {
+					base := liveHeap()
+					hs := &heapSampler{w: io.Discard}
+					if mode == "marshal" {
+						raw, err := json.Marshal(snap)
+						if err != nil {
+							b.Fatal(err)
+						}
+						hs.peak = liveHeap()
+						runtime.KeepAlive(raw)
+					} else if _, err := cd.compressSnapshot(hs, snap, config.WireFormatJSON); err != nil {
+						b.Fatal(err)
+					}
+					if hs.peak > base {
+						extra = max(extra, hs.peak-base)
+					}
+				}
+				b.ReportMetric(float64(extra), "extra-heap-bytes")
+			})
+		}
+	}
+}
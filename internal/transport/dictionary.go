@@ -0,0 +1,140 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// DictionaryHeader carries the ID of the zstd dictionary a body was
+	// compressed with. It is absent when no dictionary was used.
+	DictionaryHeader = "X-Kubeadapt-Dictionary-ID"
+
+	// DictionaryPath is the REST path dictionaries are fetched from, by ID.
+	DictionaryPath = "/api/v1/metrics/dictionaries/"
+
+	// maxDictionaryBytes bounds a dictionary fetched from the backend.
+	maxDictionaryBytes = 1 << 20
+)
+
+// bundledDictionaryData is trained by hack/zstddict; run `make dict`.
+//
+//go:embed snapshot.zdict
+var bundledDictionaryData []byte
+
+// bundledDictionary is the dictionary compiled into the agent. The backend
+// keeps a copy of every bundled dictionary, looked up by ID.
+var bundledDictionary = func() *dictionary {
+	d, err := parseDictionary(bundledDictionaryData)
+	if err != nil {
+		panic("transport: bundled zstd dictionary: " + err.Error())
+	}
+	return d
+}()
+
+// dictionary is a zstd dictionary in the format the zstd CLI trains.
+type dictionary struct {
+	id   uint32
+	data []byte
+}
+
+// parseDictionary validates data as a zstd dictionary with a non-zero ID.
+func parseDictionary(data []byte) (*dictionary, error) {
+	d, err := zstd.InspectDictionary(data)
+	if err != nil {
+		return nil, err
+	}
+	if d.ID() == 0 {
+		return nil, fmt.Errorf("dictionary has no ID")
+	}
+	return &dictionary{id: d.ID(), data: data}, nil
+}
+
+// codec compresses snapshot bodies at one zstd level with an optional
+// dictionary. Encoder options are fixed at creation, so each codec pools its
+// own encoders; each keeps its window and block buffers between snapshots.
+// The client swaps in a new codec when the dictionary changes.
+type codec struct {
+	dict     *dictionary // nil without a dictionary
+	encoders sync.Pool
+}
+
+// newCodec returns a codec for a zstd encoder level between SpeedFastest
+// and SpeedBestCompression and a parsed dictionary, or nil for none.
+func newCodec(level zstd.EncoderLevel, dict *dictionary) *codec {
+	opts := []zstd.EOption{zstd.WithEncoderLevel(level)}
+	if dict != nil {
+		opts = append(opts, zstd.WithEncoderDict(dict.data))
+	}
+	cd := &codec{dict: dict}
+	cd.encoders.New = func() any {
+		// The level is in range and the dictionary parsed, so the
+		// options are valid.
+		zw, _ := zstd.NewWriter(nil, opts...)
+		return zw
+	}
+	return cd
+}
+
+// dictID returns the ID of the codec's dictionary, or 0 without one.
+func (cd *codec) dictID() uint32 {
+	if cd.dict == nil {
+		return 0
+	}
+	return cd.dict.id
+}
+
+// frameDictionaryID returns the dictionary ID in the header of the zstd
+// frame body starts with, or 0 if it names none.
+func frameDictionaryID(body []byte) uint32 {
+	var h zstd.Header
+	if err := h.Decode(body); err != nil {
+		return 0
+	}
+	return h.DictionaryID
+}
+
+// fetchDictionary downloads the dictionary with the given ID from the backend.
+func (c *Client) fetchDictionary(ctx context.Context, id uint32) (*dictionary, error) {
+	url := c.config.BackendURL + DictionaryPath + strconv.FormatUint(uint64(id), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("transport: failed to create request: %w", err)
+	}
+	req.Header.Set(ProtocolHeader, ProtocolVersion)
+	req.Header.Set("X-Agent-Version", c.config.AgentVersion)
+	req.Header.Set("User-Agent", fmt.Sprintf("kubeadapt-agent/%s", c.config.AgentVersion))
+
+	resp, err := c.httpClient.Do(req) //nolint:gosec // URL is from agent config
+	if err != nil {
+		return nil, fmt.Errorf("transport: HTTP request failed: %w", err)
+	}
+	defer drainAndClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("transport: dictionary %d unavailable (HTTP %d)", id, resp.StatusCode)
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(resp.Body, maxDictionaryBytes+1)); err != nil {
+		return nil, fmt.Errorf("transport: read dictionary %d: %w", id, err)
+	}
+	if buf.Len() > maxDictionaryBytes {
+		return nil, fmt.Errorf("transport: dictionary %d exceeds %d bytes", id, maxDictionaryBytes)
+	}
+	d, err := parseDictionary(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("transport: dictionary %d: %w", id, err)
+	}
+	if d.id != id {
+		return nil, fmt.Errorf("transport: backend returned dictionary %d for %d", d.id, id)
+	}
+	return d, nil
+}
@@ -0,0 +1,198 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// decodeWith decompresses body with the given dictionaries.
+func decodeWith(t *testing.T, body []byte, dicts ...[]byte) (*model.ClusterSnapshot, error) {
+	t.Helper()
+	dec, err := zstd.NewReader(bytes.NewReader(body), zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		t.Fatalf("zstd.NewReader: %v", err)
+	}
+	defer dec.Close()
+	var snap model.ClusterSnapshot
+	if err := json.NewDecoder(dec).Decode(&snap); err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+// testDictionary trains a small dictionary with the given ID.
+func testDictionary(t *testing.T, id uint32) []byte {
+	t.Helper()
+	var samples [][]byte
+	for i := range 32 {
+		snap := benchSnapshot(1, 4)
+		snap.SnapshotID = "snap-" + strconv.Itoa(i)
+		raw, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatal(err)
+		}
+		samples = append(samples, raw)
+	}
+	d, err := dict.BuildZstdDict(samples, dict.Options{MaxDictSize: 8 << 10, HashBytes: 6, ZstdDictID: id})
+	if err != nil {
+		t.Fatalf("BuildZstdDict: %v", err)
+	}
+	return d
+}
+
+func TestCodec_BundledDictionary(t *testing.T) {
+	cd := newCodec(zstd.SpeedDefault, bundledDictionary)
+	body, _, err := encodeSnapshot(cd, testSnapshot(), config.WireFormatJSON)
+	if err != nil {
+		t.Fatalf("encodeSnapshot failed: %v", err)
+	}
+
+	if got := frameDictionaryID(body); got != bundledDictionary.id {
+		t.Errorf("frame dictionary ID = %d, want %d", got, bundledDictionary.id)
+	}
+	snap, err := decodeWith(t, body, bundledDictionaryData)
+	if err != nil {
+		t.Fatalf("decode with dictionary: %v", err)
+	}
+	if snap.SnapshotID != "snap-001" {
+		t.Errorf("SnapshotID = %q, want snap-001", snap.SnapshotID)
+	}
+	if _, err := decodeWith(t, body); err == nil {
+		t.Error("body decoded without its dictionary")
+	}
+}
+
+func TestParseDictionary_RejectsInvalid(t *testing.T) {
+	if _, err := parseDictionary([]byte("not a dictionary")); err == nil {
+		t.Error("expected error for invalid dictionary")
+	}
+}
+
+func TestNewClient_CompressionLevel(t *testing.T) {
+	for level, want := range map[int]zstd.EncoderLevel{
+		0: zstd.SpeedDefault,
+		1: zstd.SpeedFastest,
+		3: zstd.SpeedBetterCompression,
+		4: zstd.SpeedBestCompression,
+	} {
+		cfg := testConfig("http://unused")
+		cfg.CompressionLevel = level
+		if got := NewClient(cfg, nil, nil).compressionLevel; got != want {
+			t.Errorf("CompressionLevel %d: encoder level = %v, want %v", level, got, want)
+		}
+	}
+}
+
+// TestClient_Send_BundledDictionary verifies the dictionary ID is sent with
+// a body compressed with it.
+func TestClient_Send_BundledDictionary(t *testing.T) {
+	var header string
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(DictionaryHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.CompressionDictionary = config.CompressionDictionaryBundled
+	client := NewClient(cfg, nil, nil)
+
+	if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if want := strconv.FormatUint(uint64(bundledDictionary.id), 10); header != want {
+		t.Errorf("%s = %q, want %q", DictionaryHeader, header, want)
+	}
+	if _, err := decodeWith(t, body, bundledDictionaryData); err != nil {
+		t.Errorf("body does not decode with the bundled dictionary: %v", err)
+	}
+}
+
+// TestClient_Send_BackendDictionary verifies the client fetches the
+// dictionary the backend names and compresses the next snapshot with it.
+func TestClient_Send_BackendDictionary(t *testing.T) {
+	const id = 4242
+	data := testDictionary(t, id)
+
+	var headers []string
+	var last []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if r.URL.Path != DictionaryPath+"4242" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+			return
+		}
+		headers = append(headers, r.Header.Get(DictionaryHeader))
+		last, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{
+			Success:    true,
+			Directives: model.Directives{CompressionDictionaryID: id},
+		})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.CompressionDictionary = config.CompressionDictionaryBackend
+	client := NewClient(cfg, nil, nil)
+
+	for range 2 {
+		if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	bundled := strconv.FormatUint(uint64(bundledDictionary.id), 10)
+	if len(headers) != 2 || headers[0] != bundled || headers[1] != "4242" {
+		t.Errorf("%s per send = %v, want [%s 4242]", DictionaryHeader, headers, bundled)
+	}
+	if _, err := decodeWith(t, last, data); err != nil {
+		t.Errorf("second body does not decode with the backend dictionary: %v", err)
+	}
+}
+
+// TestClient_Send_DictionaryRejectedFallsBack verifies a backend that cannot
+// decode the dictionary gets the snapshot, and later ones, without it.
+func TestClient_Send_DictionaryRejectedFallsBack(t *testing.T) {
+	var headers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get(DictionaryHeader))
+		if r.Header.Get(DictionaryHeader) != "" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.CompressionDictionary = config.CompressionDictionaryBundled
+	client := NewClient(cfg, nil, nil)
+
+	for range 2 {
+		if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	if len(headers) != 3 || headers[0] == "" || headers[1] != "" || headers[2] != "" {
+		t.Errorf("%s per request = %q, want the dictionary on the first only", DictionaryHeader, headers)
+	}
+}
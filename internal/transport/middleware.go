@@ -2,6 +2,7 @@ package transport
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -160,9 +161,21 @@ func drainAndClose(body io.ReadCloser) {
 	body.Close()
 }
 
-// ParseResponse decodes the HTTP response or returns a categorized error.
-// Error message prefixes ("authentication failed", "protocol mismatch", etc.)
-// are load-bearing — isNonRetryableError in client.go matches on them.
+// Errors returned by ParseResponse for non-200 statuses, wrapped with the
+// status code and any detail from the body. Match them with errors.Is.
+var (
+	ErrAuthFailed          = errors.New("transport: authentication failed")
+	ErrQuotaExceeded       = errors.New("transport: quota exceeded")
+	ErrProtocolMismatch    = errors.New("transport: protocol mismatch")
+	ErrAgentDeprecated     = errors.New("transport: agent deprecated")
+	ErrLengthRequired      = errors.New("transport: length required")
+	ErrUnsupportedEncoding = errors.New("transport: unsupported encoding")
+	ErrRateLimited         = errors.New("transport: rate limited")
+	ErrServerError         = errors.New("transport: server error")
+)
+
+// ParseResponse decodes the HTTP response or returns a categorized error
+// wrapping one of the status errors above, or ErrPayloadTooLarge for 413.
 func ParseResponse(resp *http.Response) (*model.SnapshotResponse, error) {
 	defer drainAndClose(resp.Body)
 
@@ -175,7 +188,7 @@ func ParseResponse(resp *http.Response) (*model.SnapshotResponse, error) {
 		return &result, nil
 
 	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
-		return nil, fmt.Errorf("transport: authentication failed (HTTP %d)", resp.StatusCode)
+		return nil, fmt.Errorf("%w (HTTP %d)", ErrAuthFailed, resp.StatusCode)
 
 	case resp.StatusCode == http.StatusPaymentRequired:
 		// 402 may be deprecated server-side in favor of 429; kept for back-compat.
@@ -185,34 +198,34 @@ func ParseResponse(resp *http.Response) (*model.SnapshotResponse, error) {
 			if errResp.RetryAfterSeconds != nil {
 				msg = fmt.Sprintf("%s (retry after %ds)", msg, *errResp.RetryAfterSeconds)
 			}
-			return nil, fmt.Errorf("transport: quota exceeded: %s", msg)
+			return nil, fmt.Errorf("%w: %s", ErrQuotaExceeded, msg)
 		}
-		return nil, fmt.Errorf("transport: quota exceeded (HTTP 402)")
+		return nil, fmt.Errorf("%w (HTTP 402)", ErrQuotaExceeded)
 
 	case resp.StatusCode == http.StatusNotFound:
 		// Pre-filter rejects when X-Kubeadapt-Protocol is missing or wrong.
-		return nil, fmt.Errorf("transport: protocol mismatch (HTTP 404) — check X-Kubeadapt-Protocol header and backend URL")
+		return nil, fmt.Errorf("%w (HTTP 404) — check X-Kubeadapt-Protocol header and backend URL", ErrProtocolMismatch)
 
 	case resp.StatusCode == http.StatusGone:
-		return nil, fmt.Errorf("transport: agent deprecated (HTTP 410)")
+		return nil, fmt.Errorf("%w (HTTP 410)", ErrAgentDeprecated)
 
 	case resp.StatusCode == http.StatusLengthRequired:
 		// 411: agent bug — Content-Length missing (likely chunked body).
-		return nil, fmt.Errorf("transport: length required (HTTP 411) — agent misconfigured, Content-Length missing")
+		return nil, fmt.Errorf("%w (HTTP 411) — agent misconfigured, Content-Length missing", ErrLengthRequired)
 
 	case resp.StatusCode == http.StatusRequestEntityTooLarge:
 		// 413: exceeds server compressed-body cap; reduce scope or raise cap.
-		return nil, fmt.Errorf("transport: payload too large (HTTP 413) — snapshot exceeds server compressed-body cap")
+		return nil, fmt.Errorf("%w (HTTP 413)", ErrPayloadTooLarge)
 
 	case resp.StatusCode == http.StatusUnsupportedMediaType:
 		// 415: Content-Encoding != zstd; agent bug or middleware stripped header.
-		return nil, fmt.Errorf("transport: unsupported encoding (HTTP 415) — server requires Content-Encoding: zstd")
+		return nil, fmt.Errorf("%w (HTTP 415) — server requires Content-Encoding: zstd", ErrUnsupportedEncoding)
 
 	case resp.StatusCode == http.StatusTooManyRequests:
-		return nil, fmt.Errorf("transport: rate limited (HTTP 429)")
+		return nil, fmt.Errorf("%w (HTTP 429)", ErrRateLimited)
 
 	case resp.StatusCode >= 500:
-		return nil, fmt.Errorf("transport: server error (HTTP %d)", resp.StatusCode)
+		return nil, fmt.Errorf("%w (HTTP %d)", ErrServerError, resp.StatusCode)
 
 	default:
 		return nil, fmt.Errorf("transport: unexpected status (HTTP %d)", resp.StatusCode)
@@ -2,6 +2,7 @@ package transport
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"sync/atomic"
@@ -200,3 +201,28 @@ func TestParseResponse_5xx(t *testing.T) {
 		t.Fatal("expected error for 500")
 	}
 }
+
+func TestParseResponse_StatusErrors(t *testing.T) {
+	for status, want := range map[int]error{
+		http.StatusUnauthorized:          ErrAuthFailed,
+		http.StatusForbidden:             ErrAuthFailed,
+		http.StatusPaymentRequired:       ErrQuotaExceeded,
+		http.StatusNotFound:              ErrProtocolMismatch,
+		http.StatusGone:                  ErrAgentDeprecated,
+		http.StatusLengthRequired:        ErrLengthRequired,
+		http.StatusRequestEntityTooLarge: ErrPayloadTooLarge,
+		http.StatusUnsupportedMediaType:  ErrUnsupportedEncoding,
+		http.StatusTooManyRequests:       ErrRateLimited,
+		http.StatusBadGateway:            ErrServerError,
+	} {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(status)
+		_, err := ParseResponse(rec.Result())
+		if !errors.Is(err, want) {
+			t.Errorf("HTTP %d: error = %v, want %v", status, err, want)
+		}
+		if retryable := !isNonRetryableError(err); retryable != (status == http.StatusTooManyRequests || status >= 500) {
+			t.Errorf("HTTP %d: retryable = %v", status, retryable)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	agenterrors "github.com/kubeadapt/kubeadapt-agent/internal/errors"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+)
+
+const (
+	// spoolFileExt marks a fully written, fsynced spool entry.
+	spoolFileExt = ".zst"
+
+	// spoolTempExt marks an in-progress write. Leftovers are removed on open.
+	spoolTempExt = ".tmp"
+
+	// spoolProtobufExt marks an entry encoded as protobuf rather than JSON.
+	spoolProtobufExt = ".pb" + spoolFileExt
+
+	// spoolPartsExt marks the directory holding the parts of a chunked
+	// snapshot, one spoolFileExt file per part.
+	spoolPartsExt = ".parts"
+
+	// spoolProtobufPartsExt marks a part directory encoded as protobuf.
+	spoolProtobufPartsExt = ".pb" + spoolPartsExt
+)
+
+// ErrSpoolEntryTooLarge is returned when a single payload exceeds the spool cap.
+var ErrSpoolEntryTooLarge = errors.New("transport: payload exceeds spool capacity")
+
+// SpoolEntry identifies one buffered snapshot on disk.
+type SpoolEntry struct {
+	SnapshotID string
+	WireFormat string // config.WireFormatJSON or config.WireFormatProtobuf
+	Path       string // the payload file, or the part directory of a chunked snapshot
+	SizeBytes  int64
+	SpooledAt  time.Time
+	Parts      []SpoolPart // set for a chunked snapshot, in index order
+}
+
+// SpoolPart is one part of a buffered chunked snapshot.
+type SpoolPart struct {
+	Index     int
+	Checksum  string // hex sha256 of the part body
+	Path      string
+	SizeBytes int64
+}
+
+// Spool is a bounded, crash-safe FIFO of compressed snapshot payloads on
+// local disk (emptyDir or PVC). Entries are written to a temp file, fsynced,
+// then renamed, so a crash never leaves a truncated entry behind. When the
+// total size would exceed maxBytes the oldest entries are evicted.
+type Spool struct {
+	dir            string
+	maxBytes       int64
+	metrics        *observability.Metrics
+	errorCollector *agenterrors.ErrorCollector
+
+	mu        sync.Mutex
+	entries   []SpoolEntry // oldest first
+	sizeBytes int64
+}
+
+// NewSpool opens (or creates) a spool directory and loads any entries left
+// over from a previous run.
+func NewSpool(dir string, maxBytes int64, metrics *observability.Metrics, errCollector *agenterrors.ErrorCollector) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("transport: create spool dir %s: %w", dir, err)
+	}
+
+	s := &Spool{
+		dir:            dir,
+		maxBytes:       maxBytes,
+		metrics:        metrics,
+		errorCollector: errCollector,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	// The cap may have shrunk since the previous run.
+	s.mu.Lock()
+	s.evictLocked(0)
+	s.updateGaugeLocked()
+	s.mu.Unlock()
+
+	if len(s.entries) > 0 {
+		slog.Info("loaded buffered snapshots from spool",
+			"dir", dir,
+			"entries", len(s.entries),
+			"bytes", s.sizeBytes,
+		)
+	}
+	return s, nil
+}
+
+// load scans the spool directory, removing partial writes and indexing
+// complete entries in filename (= spool time) order.
+func (s *Spool) load() error {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("transport: read spool dir %s: %w", s.dir, err)
+	}
+
+	for _, de := range dirEntries {
+		name := de.Name()
+		path := filepath.Join(s.dir, name)
+
+		if de.IsDir() {
+			if strings.HasSuffix(name, spoolTempExt) {
+				_ = os.RemoveAll(path)
+				continue
+			}
+			if entry, ok := loadPartSet(path, name); ok {
+				s.entries = append(s.entries, entry)
+				s.sizeBytes += entry.SizeBytes
+			}
+			continue
+		}
+
+		if strings.HasSuffix(name, spoolTempExt) {
+			_ = os.Remove(path)
+			continue
+		}
+		if !strings.HasSuffix(name, spoolFileExt) {
+			continue
+		}
+
+		spooledAt, snapshotID, format, ok := parseSpoolName(name, spoolFileExt, spoolProtobufExt)
+		if !ok {
+			continue
+		}
+		fi, err := de.Info()
+		if err != nil {
+			continue
+		}
+		s.entries = append(s.entries, SpoolEntry{
+			SnapshotID: snapshotID,
+			WireFormat: format,
+			Path:       path,
+			SizeBytes:  fi.Size(),
+			SpooledAt:  spooledAt,
+		})
+		s.sizeBytes += fi.Size()
+	}
+
+	sort.Slice(s.entries, func(i, j int) bool {
+		return s.entries[i].Path < s.entries[j].Path
+	})
+	return nil
+}
+
+// loadPartSet indexes a part directory written by putParts. A directory
+// whose part files do not run from 0 without gaps is skipped.
+func loadPartSet(path, name string) (SpoolEntry, bool) {
+	spooledAt, snapshotID, format, ok := parseSpoolName(name, spoolPartsExt, spoolProtobufPartsExt)
+	if !ok {
+		return SpoolEntry{}, false
+	}
+	dirEntries, err := os.ReadDir(path)
+	if err != nil {
+		return SpoolEntry{}, false
+	}
+
+	entry := SpoolEntry{
+		SnapshotID: snapshotID,
+		WireFormat: format,
+		Path:       path,
+		SpooledAt:  spooledAt,
+	}
+	for _, de := range dirEntries {
+		index, checksum, ok := parsePartFileName(de.Name())
+		if !ok {
+			continue
+		}
+		fi, err := de.Info()
+		if err != nil {
+			return SpoolEntry{}, false
+		}
+		entry.Parts = append(entry.Parts, SpoolPart{
+			Index:     index,
+			Checksum:  checksum,
+			Path:      filepath.Join(path, de.Name()),
+			SizeBytes: fi.Size(),
+		})
+		entry.SizeBytes += fi.Size()
+	}
+
+	sort.Slice(entry.Parts, func(i, j int) bool {
+		return entry.Parts[i].Index < entry.Parts[j].Index
+	})
+	for i, p := range entry.Parts {
+		if p.Index != i {
+			return SpoolEntry{}, false
+		}
+	}
+	return entry, len(entry.Parts) > 0
+}
+
+// Put durably appends a compressed JSON payload. Oldest entries are evicted
+// to make room before it is written, so the spool never exceeds its cap on
+// disk; evictions are reported as BUFFER_FULL.
+func (s *Spool) Put(snapshotID string, payload []byte) error {
+	return s.PutReader(snapshotID, config.WireFormatJSON, bytes.NewReader(payload), int64(len(payload)))
+}
+
+// PutReader is Put for a payload of size bytes in the given wire format read
+// from r, such as a snapshot streamed to a temp file.
+func (s *Spool) PutReader(snapshotID, wireFormat string, r io.Reader, size int64) error {
+	if s.maxBytes > 0 && size > s.maxBytes {
+		return fmt.Errorf("%w (size=%d, limit=%d)", ErrSpoolEntryTooLarge, size, s.maxBytes)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateGaugeLocked()
+
+	s.evictLocked(size)
+	now := time.Now()
+	name := spoolFileName(now, snapshotID, wireFormat)
+	path := filepath.Join(s.dir, name)
+	if err := writeFileSync(path, r); err != nil {
+		return err
+	}
+	syncDir(s.dir)
+
+	s.entries = append(s.entries, SpoolEntry{
+		SnapshotID: snapshotID,
+		WireFormat: wireFormat,
+		Path:       path,
+		SizeBytes:  size,
+		SpooledAt:  now,
+	})
+	s.sizeBytes += size
+	return nil
+}
+
+// putParts durably appends the parts of a chunked snapshot as one entry,
+// evicting like PutReader. The parts are written to a temp directory that is
+// renamed into place, so a crash never leaves a partial set behind, and the
+// set is evicted as one.
+func (s *Spool) putParts(snapshotID, wireFormat string, parts []encodedPart) error {
+	var size int64
+	for _, p := range parts {
+		size += p.body.Size()
+	}
+	if s.maxBytes > 0 && size > s.maxBytes {
+		return fmt.Errorf("%w (size=%d, limit=%d)", ErrSpoolEntryTooLarge, size, s.maxBytes)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.updateGaugeLocked()
+
+	s.evictLocked(size)
+	now := time.Now()
+	path := filepath.Join(s.dir, spoolPartsName(now, snapshotID, wireFormat))
+	tmp := path + spoolTempExt
+	if err := os.Mkdir(tmp, 0o750); err != nil {
+		return fmt.Errorf("transport: create spool part dir: %w", err)
+	}
+
+	entry := SpoolEntry{
+		SnapshotID: snapshotID,
+		WireFormat: wireFormat,
+		Path:       path,
+		SizeBytes:  size,
+		SpooledAt:  now,
+	}
+	for _, p := range parts {
+		name := partFileName(p.index, p.checksum)
+		if err := writeFileSync(filepath.Join(tmp, name), io.NewSectionReader(p.body, 0, p.body.Size())); err != nil {
+			_ = os.RemoveAll(tmp)
+			return err
+		}
+		entry.Parts = append(entry.Parts, SpoolPart{
+			Index:     p.index,
+			Checksum:  p.checksum,
+			Path:      filepath.Join(path, name),
+			SizeBytes: p.body.Size(),
+		})
+	}
+	syncDir(tmp)
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.RemoveAll(tmp)
+		return fmt.Errorf("transport: rename spool part dir: %w", err)
+	}
+	syncDir(s.dir)
+
+	s.entries = append(s.entries, entry)
+	s.sizeBytes += size
+	return nil
+}
+
+// Oldest returns the oldest entry and its payload, or ok=false when empty.
+// The payload of a chunked snapshot is nil; its parts are read one at a
+// time from entry.Parts.
+func (s *Spool) Oldest() (entry SpoolEntry, payload []byte, ok bool, err error) {
+	s.mu.Lock()
+	if len(s.entries) == 0 {
+		s.mu.Unlock()
+		return SpoolEntry{}, nil, false, nil
+	}
+	entry = s.entries[0]
+	s.mu.Unlock()
+
+	if entry.Parts != nil {
+		return entry, nil, true, nil
+	}
+
+	payload, err = os.ReadFile(entry.Path)
+	if err != nil {
+		return entry, nil, true, fmt.Errorf("transport: read spool entry %s: %w", entry.Path, err)
+	}
+	return entry, payload, true, nil
+}
+
+// Remove deletes an entry after it was delivered (or found unreadable).
+func (s *Spool) Remove(entry SpoolEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, e := range s.entries {
+		if e.Path != entry.Path {
+			continue
+		}
+		if err := os.RemoveAll(e.Path); err != nil {
+			slog.Warn("failed to remove spool entry", "path", e.Path, "error", err)
+		}
+		s.entries = append(s.entries[:i], s.entries[i+1:]...)
+		s.sizeBytes -= e.SizeBytes
+		break
+	}
+	s.updateGaugeLocked()
+}
+
+// Stats returns the number of buffered entries and their total size.
+func (s *Spool) Stats() (entries int, sizeBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries), s.sizeBytes
+}
+
+// evictLocked drops the oldest entries until sizeBytes+incoming fits the cap.
+// Caller must hold s.mu.
+func (s *Spool) evictLocked(incoming int64) {
+	if s.maxBytes <= 0 {
+		return
+	}
+
+	evicted := 0
+	for len(s.entries) > 0 && s.sizeBytes+incoming > s.maxBytes {
+		oldest := s.entries[0]
+		if err := os.RemoveAll(oldest.Path); err != nil {
+			slog.Warn("failed to evict spool entry", "path", oldest.Path, "error", err)
+		}
+		s.entries = s.entries[1:]
+		s.sizeBytes -= oldest.SizeBytes
+		evicted++
+	}
+	if evicted == 0 {
+		return
+	}
+
+	slog.Warn("snapshot spool full, evicted oldest entries",
+		"evicted", evicted,
+		"remaining", len(s.entries),
+		"max_bytes", s.maxBytes,
+	)
+	if s.metrics != nil {
+		s.metrics.TransportBufferEvictions.Add(float64(evicted))
+	}
+	if s.errorCollector != nil {
+		s.errorCollector.Report(agenterrors.AgentError{
+			Code:      agenterrors.ErrBufferFull,
+			Message:   fmt.Sprintf("snapshot spool full: evicted %d oldest entries (max %d bytes)", evicted, s.maxBytes),
+			Component: "transport",
+			Timestamp: time.Now().UnixMilli(),
+		})
+	}
+}
+
+// updateGaugeLocked publishes the current spool size. Caller must hold s.mu.
+func (s *Spool) updateGaugeLocked() {
+	if s.metrics != nil {
+		s.metrics.TransportBufferBytes.Set(float64(s.sizeBytes))
+	}
+}
+
+// spoolFileName encodes the spool time (zero-padded so lexical order is
+// chronological), the snapshot ID needed for replay headers and the wire
+// format.
+func spoolFileName(t time.Time, snapshotID, wireFormat string) string {
+	ext := spoolFileExt
+	if wireFormat == config.WireFormatProtobuf {
+		ext = spoolProtobufExt
+	}
+	return fmt.Sprintf("%020d-%s%s", t.UnixNano(), snapshotID, ext)
+}
+
+// spoolPartsName is spoolFileName for the part directory of a chunked
+// snapshot.
+func spoolPartsName(t time.Time, snapshotID, wireFormat string) string {
+	ext := spoolPartsExt
+	if wireFormat == config.WireFormatProtobuf {
+		ext = spoolProtobufPartsExt
+	}
+	return fmt.Sprintf("%020d-%s%s", t.UnixNano(), snapshotID, ext)
+}
+
+// parseSpoolName is the inverse of spoolFileName (with the spoolFileExt
+// extensions) and spoolPartsName (with the spoolPartsExt ones).
+func parseSpoolName(name, ext, protobufExt string) (time.Time, string, string, bool) {
+	if !strings.HasSuffix(name, ext) {
+		return time.Time{}, "", "", false
+	}
+	format := config.WireFormatJSON
+	base := strings.TrimSuffix(name, ext)
+	if strings.HasSuffix(name, protobufExt) {
+		format = config.WireFormatProtobuf
+		base = strings.TrimSuffix(name, protobufExt)
+	}
+	ts, id, found := strings.Cut(base, "-")
+	if !found || id == "" {
+		return time.Time{}, "", "", false
+	}
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, "", "", false
+	}
+	return time.Unix(0, nanos), id, format, true
+}
+
+// partFileName encodes a part's index and checksum, which replay needs for
+// the part headers.
+func partFileName(index int, checksum string) string {
+	return fmt.Sprintf("%d-%s%s", index, checksum, spoolFileExt)
+}
+
+// parsePartFileName is the inverse of partFileName.
+func parsePartFileName(name string) (int, string, bool) {
+	base, ok := strings.CutSuffix(name, spoolFileExt)
+	if !ok {
+		return 0, "", false
+	}
+	idx, checksum, found := strings.Cut(base, "-")
+	if !found || checksum == "" {
+		return 0, "", false
+	}
+	index, err := strconv.Atoi(idx)
+	if err != nil || index < 0 {
+		return 0, "", false
+	}
+	return index, checksum, true
+}
+
+// writeFileSync writes r to path via a fsynced temp file and rename so
+// readers never observe a partially written entry.
+func writeFileSync(path string, r io.Reader) error {
+	tmp := path + spoolTempExt
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("transport: create spool file: %w", err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("transport: write spool file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("transport: sync spool file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("transport: close spool file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("transport: rename spool file: %w", err)
+	}
+	return nil
+}
+
+// syncDir fsyncs a directory so a preceding rename survives a crash.
+// Best-effort: some filesystems do not support directory sync.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
@@ -0,0 +1,278 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	agenterrors "github.com/kubeadapt/kubeadapt-agent/internal/errors"
+	"github.com/kubeadapt/kubeadapt-agent/internal/observability"
+)
+
+func TestSpool_PutOldestRemove_FIFO(t *testing.T) {
+	s, err := NewSpool(t.TempDir(), 1024, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+
+	for _, id := range []string{"snap-a", "snap-b", "snap-c"} {
+		if err := s.Put(id, []byte("payload-"+id)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", id, err)
+		}
+	}
+
+	for _, want := range []string{"snap-a", "snap-b", "snap-c"} {
+		entry, payload, ok, err := s.Oldest()
+		if err != nil || !ok {
+			t.Fatalf("Oldest() = ok=%v err=%v, want entry %s", ok, err, want)
+		}
+		if entry.SnapshotID != want {
+			t.Fatalf("Oldest().SnapshotID = %q, want %q", entry.SnapshotID, want)
+		}
+		if !bytes.Equal(payload, []byte("payload-"+want)) {
+			t.Fatalf("payload mismatch for %s: %q", want, payload)
+		}
+		s.Remove(entry)
+	}
+
+	if _, _, ok, _ := s.Oldest(); ok {
+		t.Fatal("expected empty spool after removing all entries")
+	}
+	if n, size := s.Stats(); n != 0 || size != 0 {
+		t.Fatalf("Stats() = (%d, %d), want (0, 0)", n, size)
+	}
+}
+
+func TestSpool_EvictsOldestWhenFull(t *testing.T) {
+	metrics := observability.NewMetrics()
+	errCollector := agenterrors.NewErrorCollector(agenterrors.RealClock{})
+	s, err := NewSpool(t.TempDir(), 25, metrics, errCollector)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+
+	// Three 10-byte payloads against a 25-byte cap: the first must be evicted.
+	for _, id := range []string{"snap-1", "snap-2", "snap-3"} {
+		if err := s.Put(id, bytes.Repeat([]byte("x"), 10)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", id, err)
+		}
+	}
+
+	n, size := s.Stats()
+	if n != 2 || size != 20 {
+		t.Fatalf("Stats() = (%d, %d), want (2, 20)", n, size)
+	}
+	entry, _, _, _ := s.Oldest()
+	if entry.SnapshotID != "snap-2" {
+		t.Fatalf("oldest after eviction = %q, want snap-2", entry.SnapshotID)
+	}
+
+	pb := &dto.Metric{}
+	if err := metrics.TransportBufferEvictions.Write(pb); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	if got := pb.GetCounter().GetValue(); got != 1 {
+		t.Fatalf("evictions counter = %v, want 1", got)
+	}
+
+	codes := errCollector.GetActiveErrorCodes()
+	if len(codes) != 1 || codes[0] != string(agenterrors.ErrBufferFull) {
+		t.Fatalf("active error codes = %v, want [%s]", codes, agenterrors.ErrBufferFull)
+	}
+}
+
+// dirSizeReader records the total size of the files in dir when it is first
+// read, then reads from r.
+type dirSizeReader struct {
+	r       io.Reader
+	dir     string
+	dirSize int64
+	read    bool
+}
+
+func (d *dirSizeReader) Read(p []byte) (int, error) {
+	if !d.read {
+		d.read = true
+		entries, _ := os.ReadDir(d.dir)
+		for _, e := range entries {
+			if fi, err := e.Info(); err == nil {
+				d.dirSize += fi.Size()
+			}
+		}
+	}
+	return d.r.Read(p)
+}
+
+// TestSpool_EvictsBeforeWrite verifies room is made before a payload is
+// written, so the spool directory never holds more than the cap.
+func TestSpool_EvictsBeforeWrite(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewSpool(dir, 25, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	for _, id := range []string{"snap-1", "snap-2"} {
+		if err := s.Put(id, bytes.Repeat([]byte("x"), 10)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", id, err)
+		}
+	}
+
+	r := &dirSizeReader{r: bytes.NewReader(bytes.Repeat([]byte("y"), 10)), dir: dir}
+	if err := s.PutReader("snap-3", config.WireFormatJSON, r, 10); err != nil {
+		t.Fatalf("PutReader failed: %v", err)
+	}
+	if r.dirSize > 15 {
+		t.Fatalf("spool dir held %d bytes while writing a 10-byte payload, want at most 15", r.dirSize)
+	}
+	if n, size := s.Stats(); n != 2 || size != 20 {
+		t.Fatalf("Stats() = (%d, %d), want (2, 20)", n, size)
+	}
+}
+
+func TestSpool_RejectsEntryLargerThanCap(t *testing.T) {
+	s, err := NewSpool(t.TempDir(), 8, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	if err := s.Put("snap-big", bytes.Repeat([]byte("x"), 9)); err == nil {
+		t.Fatal("expected error for payload larger than spool cap")
+	}
+	if n, _ := s.Stats(); n != 0 {
+		t.Fatalf("expected no entries, got %d", n)
+	}
+}
+
+func TestSpool_ReloadsAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewSpool(dir, 1024, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	if err := s.Put("3f2b7c1e-uuid-with-dashes", []byte("first")); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if err := s.Put("snap-second", []byte("second")); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	// Simulate a crash mid-write: a temp file left behind must be discarded.
+	partial := filepath.Join(dir, spoolFileName(s.entries[1].SpooledAt.Add(1), "snap-partial", config.WireFormatJSON)+spoolTempExt)
+	if err := os.WriteFile(partial, []byte("trunc"), 0o600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	reopened, err := NewSpool(dir, 1024, nil, nil)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	if n, size := reopened.Stats(); n != 2 || size != int64(len("first")+len("second")) {
+		t.Fatalf("Stats() after reopen = (%d, %d), want (2, 11)", n, size)
+	}
+	entry, payload, ok, err := reopened.Oldest()
+	if err != nil || !ok {
+		t.Fatalf("Oldest() after reopen: ok=%v err=%v", ok, err)
+	}
+	if entry.SnapshotID != "3f2b7c1e-uuid-with-dashes" || string(payload) != "first" {
+		t.Fatalf("Oldest() = (%q, %q), want (3f2b7c1e-uuid-with-dashes, first)", entry.SnapshotID, payload)
+	}
+	if _, err := os.Stat(partial); !os.IsNotExist(err) {
+		t.Fatalf("expected partial temp file to be removed, stat err = %v", err)
+	}
+}
+
+func TestSpool_PartSetIsOneEntry(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewSpool(dir, 25, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	parts := []encodedPart{
+		{index: 0, count: 2, body: bytes.NewReader([]byte("part-0")), checksum: "aa"},
+		{index: 1, count: 2, body: bytes.NewReader([]byte("part-1")), checksum: "bb"},
+	}
+	if err := s.putParts("snap-chunked", config.WireFormatProtobuf, parts); err != nil {
+		t.Fatalf("putParts failed: %v", err)
+	}
+
+	// Simulate a crash while writing another part set.
+	partial := filepath.Join(dir, spoolPartsName(s.entries[0].SpooledAt.Add(1), "snap-partial", config.WireFormatJSON)+spoolTempExt)
+	if err := os.Mkdir(partial, 0o750); err != nil {
+		t.Fatalf("Mkdir failed: %v", err)
+	}
+
+	reopened, err := NewSpool(dir, 25, nil, nil)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	if n, size := reopened.Stats(); n != 1 || size != 12 {
+		t.Fatalf("Stats() after reopen = (%d, %d), want (1, 12)", n, size)
+	}
+	entry, payload, ok, err := reopened.Oldest()
+	if err != nil || !ok {
+		t.Fatalf("Oldest() after reopen: ok=%v err=%v", ok, err)
+	}
+	if entry.SnapshotID != "snap-chunked" || entry.WireFormat != config.WireFormatProtobuf || payload != nil {
+		t.Fatalf("Oldest() = (%+v, %q), want snap-chunked protobuf parts", entry, payload)
+	}
+	if len(entry.Parts) != 2 {
+		t.Fatalf("entry has %d parts, want 2", len(entry.Parts))
+	}
+	for i, p := range entry.Parts {
+		body, err := os.ReadFile(p.Path)
+		if err != nil {
+			t.Fatalf("read part %d: %v", i, err)
+		}
+		if want := fmt.Sprintf("part-%d", i); p.Index != i || p.Checksum != parts[i].checksum || string(body) != want {
+			t.Errorf("part %d = %+v (%q), want index %d, checksum %q, body %q", i, p, body, i, parts[i].checksum, want)
+		}
+	}
+	if _, err := os.Stat(partial); !os.IsNotExist(err) {
+		t.Fatalf("expected partial part dir to be removed, stat err = %v", err)
+	}
+
+	// The set is evicted as a whole.
+	if err := reopened.Put("snap-next", bytes.Repeat([]byte("x"), 20)); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if n, _ := reopened.Stats(); n != 1 {
+		t.Fatalf("expected the part set evicted, got %d entries", n)
+	}
+	if _, err := os.Stat(entry.Path); !os.IsNotExist(err) {
+		t.Fatalf("expected evicted part dir to be removed, stat err = %v", err)
+	}
+}
+
+func TestSpool_ReopenWithSmallerCapEvicts(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewSpool(dir, 1024, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	for _, id := range []string{"snap-1", "snap-2", "snap-3"} {
+		if err := s.Put(id, bytes.Repeat([]byte("x"), 10)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", id, err)
+		}
+	}
+
+	reopened, err := NewSpool(dir, 10, nil, nil)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	n, _ := reopened.Stats()
+	if n != 1 {
+		t.Fatalf("expected 1 entry after shrinking cap, got %d", n)
+	}
+	entry, _, _, _ := reopened.Oldest()
+	if entry.SnapshotID != "snap-3" {
+		t.Fatalf("expected newest entry to survive, got %q", entry.SnapshotID)
+	}
+}
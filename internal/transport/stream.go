@@ -0,0 +1,300 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/modelpb"
+)
+
+// payload is a compressed snapshot body. bytes.Reader and fileBody satisfy
+// it; each attempt reads it through a fresh io.SectionReader.
+type payload interface {
+	io.ReaderAt
+	Size() int64
+}
+
+// fileBody is a payload compressed into a temp file.
+type fileBody struct {
+	f    *os.File
+	size int64
+}
+
+func (b *fileBody) ReadAt(p []byte, off int64) (int, error) { return b.f.ReadAt(p, off) }
+func (b *fileBody) Size() int64                             { return b.size }
+
+// remove closes and deletes the temp file.
+func (b *fileBody) remove() {
+	_ = b.f.Close()
+	_ = os.Remove(b.f.Name())
+}
+
+// releaseBody deletes the temp file behind a payload, if it has one.
+func releaseBody(body payload) {
+	if fb, ok := body.(*fileBody); ok {
+		fb.remove()
+	}
+}
+
+// errBodyOverLimit stops an encode as soon as its compressed output passes
+// the limit, so an oversize snapshot is not encoded to the end.
+var errBodyOverLimit = errors.New("transport: compressed body over limit")
+
+// limitWriter fails with errBodyOverLimit once more than n bytes in total
+// would be written to w.
+type limitWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.n {
+		return 0, errBodyOverLimit
+	}
+	l.n -= int64(len(p))
+	return l.w.Write(p)
+}
+
+// limitBody wraps w in a limitWriter, or returns it as is when limit is 0.
+func limitBody(w io.Writer, limit int64) io.Writer {
+	if limit <= 0 {
+		return w
+	}
+	return &limitWriter{w: w, n: limit}
+}
+
+// elementBuffers pools the buffers snapshot elements are encoded into.
+var elementBuffers = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// snapshotField is a top-level ClusterSnapshot field with its JSON key.
+type snapshotField struct {
+	index     int
+	name      string // JSON name, also the modelpb field name
+	key       string // `"name":`
+	omitEmpty bool
+	slice     bool
+}
+
+// snapshotFields lists the ClusterSnapshot fields in declaration order, the
+// order encoding/json writes them in.
+var snapshotFields = func() []snapshotField {
+	t := reflect.TypeFor[model.ClusterSnapshot]()
+	var fields []snapshotField
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if !sf.IsExported() || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, snapshotField{
+			index:     i,
+			name:      name,
+			key:       `"` + name + `":`,
+			omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+			slice:     sf.Type.Kind() == reflect.Slice,
+		})
+	}
+	return fields
+}()
+
+// writeSnapshotJSON writes the snapshot as JSON one field, and for resource
+// slices one element, at a time, so only the largest element is ever held
+// encoded in memory. The output is identical to json.Encoder's.
+func writeSnapshotJSON(w io.Writer, snapshot *model.ClusterSnapshot) error {
+	buf := elementBuffers.Get().(*bytes.Buffer)
+	defer elementBuffers.Put(buf)
+	enc := json.NewEncoder(buf)
+
+	// encode writes v without the newline json.Encoder appends.
+	encode := func(v any) error {
+		buf.Reset()
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		_, err := w.Write(buf.Bytes()[:buf.Len()-1])
+		return err
+	}
+	write := func(s string) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+
+	v := reflect.ValueOf(snapshot).Elem()
+	sep := "{"
+	for _, f := range snapshotFields {
+		fv := v.Field(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if err := write(sep + f.key); err != nil {
+			return err
+		}
+		sep = ","
+
+		if !f.slice || fv.IsNil() {
+			if err := encode(fv.Addr().Interface()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := write("["); err != nil {
+			return err
+		}
+		for i := range fv.Len() {
+			if i > 0 {
+				if err := write(","); err != nil {
+					return err
+				}
+			}
+			if err := encode(fv.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+		if err := write("]"); err != nil {
+			return err
+		}
+	}
+	if sep == "{" {
+		return write("{}\n")
+	}
+	return write("}\n")
+}
+
+// isEmptyValue mirrors encoding/json's omitempty rule.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+// snapshotDescriptor describes the protobuf ClusterSnapshot message.
+var snapshotDescriptor = (&modelpb.ClusterSnapshot{}).ProtoReflect().Descriptor()
+
+// writeSnapshotProto writes the snapshot as a modelpb.ClusterSnapshot, one
+// resource at a time. A protobuf message is the concatenation of its
+// encoded fields, so the scalar and header fields are marshaled once and
+// each element of a repeated field is appended as a record of its own.
+func writeSnapshotProto(w io.Writer, snapshot *model.ClusterSnapshot) error {
+	header := *snapshot
+	hv := reflect.ValueOf(&header).Elem()
+	for _, f := range snapshotFields {
+		if f.slice {
+			hv.Field(f.index).SetZero()
+		}
+	}
+	buf, err := proto.Marshal(modelpb.FromSnapshot(&header))
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(snapshot).Elem()
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	for _, f := range snapshotFields {
+		if !f.slice {
+			continue
+		}
+		fd := snapshotDescriptor.Fields().ByName(protoreflect.Name(f.name))
+		if fd == nil {
+			return fmt.Errorf("no protobuf field %q; run make proto", f.name)
+		}
+		fv := v.Field(f.index)
+		for i := range fv.Len() {
+			msg := modelpb.FromModel(fv.Index(i).Addr().Interface())
+			if msg == nil {
+				return fmt.Errorf("no protobuf message for %s; run make proto", fv.Type().Elem())
+			}
+			buf = protowire.AppendTag(buf[:0], fd.Number(), protowire.BytesType)
+			buf = protowire.AppendVarint(buf, uint64(proto.Size(msg)))
+			if buf, err = opts.MarshalAppend(buf, msg); err != nil {
+				return err
+			}
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compressSnapshot streams the snapshot, encoded in wireFormat, through one
+// of the codec's pooled zstd encoders into w and returns the pre-compression
+// byte count.
+func (cd *codec) compressSnapshot(w io.Writer, snapshot *model.ClusterSnapshot, wireFormat string) (int64, error) {
+	zw := cd.encoders.Get().(*zstd.Encoder)
+	defer cd.encoders.Put(zw)
+	zw.Reset(w)
+
+	// Tee through CountingWriter to capture pre-compression byte count.
+	orig := NewCountingWriter(zw)
+	if wireFormat == config.WireFormatProtobuf {
+		if err := writeSnapshotProto(orig, snapshot); err != nil {
+			_ = zw.Close()
+			return 0, fmt.Errorf("transport: protobuf encode failed: %w", err)
+		}
+	} else if err := writeSnapshotJSON(orig, snapshot); err != nil {
+		_ = zw.Close()
+		return 0, fmt.Errorf("transport: JSON encode failed: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return 0, fmt.Errorf("transport: zstd close failed: %w", err)
+	}
+	return orig.Count(), nil
+}
+
+// encodeSnapshotFile compresses the snapshot into a temp file in dir (the
+// default temp directory if empty), so neither the JSON nor the compressed
+// body is held in memory. The file carries the spool temp extension, so the
+// spool removes leftovers from a crash on startup. The caller removes it.
+// With a limit, the encode fails with errBodyOverLimit once the file would
+// grow past it.
+func (cd *codec) encodeSnapshotFile(dir string, snapshot *model.ClusterSnapshot, wireFormat string, limit int64) (*fileBody, int64, error) {
+	f, err := os.CreateTemp(dir, "snapshot-*"+spoolTempExt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("transport: create snapshot file: %w", err)
+	}
+	body := &fileBody{f: f}
+
+	originalBytes, err := cd.compressSnapshot(limitBody(f, limit), snapshot, wireFormat)
+	if err != nil {
+		body.remove()
+		return nil, 0, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		body.remove()
+		return nil, 0, fmt.Errorf("transport: stat snapshot file: %w", err)
+	}
+	body.size = fi.Size()
+	return body, originalBytes, nil
+}
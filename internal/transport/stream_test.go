@@ -0,0 +1,215 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/modelpb"
+)
+
+func TestWriteSnapshotJSON_MatchesEncodingJSON(t *testing.T) {
+	full := benchSnapshot(3, 20)
+	full.SnapshotType = model.SnapshotTypeDelta
+	full.Delta = &model.SnapshotDelta{Deleted: map[string][]string{"pods": {"uid-1"}}}
+	full.Part = &model.SnapshotPart{Index: 1, Count: 3, Family: model.PartFamilyPods}
+	full.Events = []model.EventInfo{{Reason: "<html> & escaping"}}
+
+	for name, snap := range map[string]*model.ClusterSnapshot{
+		"empty":   {},
+		"minimal": testSnapshot(),
+		"full":    full,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var want bytes.Buffer
+			if err := json.NewEncoder(&want).Encode(snap); err != nil {
+				t.Fatal(err)
+			}
+			var got bytes.Buffer
+			if err := writeSnapshotJSON(&got, snap); err != nil {
+				t.Fatalf("writeSnapshotJSON failed: %v", err)
+			}
+			if got.String() != want.String() {
+				t.Errorf("streamed JSON differs from encoding/json\ngot:  %.300s\nwant: %.300s", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func TestWriteSnapshotProto_MatchesProtoMarshal(t *testing.T) {
+	full := benchSnapshot(3, 20)
+	full.SnapshotType = model.SnapshotTypeDelta
+	full.Delta = &model.SnapshotDelta{Deleted: map[string][]string{"pods": {"uid-1"}}}
+	full.Part = &model.SnapshotPart{Index: 1, Count: 3, Family: model.PartFamilyPods}
+
+	for name, snap := range map[string]*model.ClusterSnapshot{
+		"empty":   {},
+		"minimal": testSnapshot(),
+		"full":    full,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeSnapshotProto(&buf, snap); err != nil {
+				t.Fatalf("writeSnapshotProto failed: %v", err)
+			}
+			var got modelpb.ClusterSnapshot
+			if err := proto.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("streamed protobuf does not unmarshal: %v", err)
+			}
+			if want := modelpb.FromSnapshot(snap); !proto.Equal(&got, want) {
+				t.Errorf("streamed protobuf differs from proto.Marshal\ngot:  %.300v\nwant: %.300v", &got, want)
+			}
+		})
+	}
+}
+
+// TestClient_Send_StreamingEncode verifies a snapshot streamed to a temp file
+// is sent with Content-Length and the file is removed afterwards.
+func TestClient_Send_StreamingEncode(t *testing.T) {
+	var contentLength int64
+	var snap model.ClusterSnapshot
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		dec, err := zstd.NewReader(r.Body)
+		if err != nil {
+			t.Errorf("body is not valid zstd: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer dec.Close()
+		if err := json.NewDecoder(dec).Decode(&snap); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	cfg := testConfig(srv.URL)
+	cfg.StreamingEncodeEnabled = true
+	client := NewClient(cfg, nil, nil)
+	spool, err := NewSpool(dir, 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	if _, err := client.Send(context.Background(), benchSnapshot(10, 200)); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if stats := client.LastSendStats(); contentLength != stats.CompressedBytes || contentLength <= 0 {
+		t.Errorf("Content-Length = %d, want the compressed size %d", contentLength, stats.CompressedBytes)
+	}
+	if len(snap.Pods) != 200 {
+		t.Errorf("received %d pods, want 200", len(snap.Pods))
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("spool dir holds %d files after send, want the temp file removed", len(entries))
+	}
+}
+
+// TestClient_Send_StreamingEncodeSpools verifies a streamed snapshot that
+// fails to send is buffered from its temp file.
+func TestClient_Send_StreamingEncodeSpools(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	cfg := testConfig(srv.URL)
+	cfg.StreamingEncodeEnabled = true
+	client := NewClient(cfg, nil, nil)
+	spool, err := NewSpool(dir, 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	if _, err := client.Send(context.Background(), testSnapshot()); err == nil {
+		t.Fatal("expected send error")
+	}
+	if n, _ := client.SpoolStats(); n != 1 {
+		t.Fatalf("expected 1 buffered snapshot, got %d", n)
+	}
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), spoolTempExt) {
+			t.Errorf("temp file %s left behind", filepath.Join(dir, e.Name()))
+		}
+	}
+}
+
+// TestEncodeSnapshotLimit_StopsAtLimit verifies an oversize encode fails with
+// errBodyOverLimit instead of running to the end.
+func TestEncodeSnapshotLimit_StopsAtLimit(t *testing.T) {
+	cd := newCodec(zstd.SpeedDefault, nil)
+	snap := largeSnapshot(500)
+
+	if _, _, err := encodeSnapshotLimit(cd, snap, config.WireFormatJSON, 4096); !errors.Is(err, errBodyOverLimit) {
+		t.Fatalf("err = %v, want errBodyOverLimit", err)
+	}
+	body, _, err := encodeSnapshotLimit(cd, snap, config.WireFormatJSON, 1<<20)
+	if err != nil {
+		t.Fatalf("encode under the limit failed: %v", err)
+	}
+	full, _, _ := encodeSnapshot(cd, snap, config.WireFormatJSON)
+	if !bytes.Equal(body, full) {
+		t.Error("encode under the limit differs from the unlimited encode")
+	}
+}
+
+// TestClient_Send_ChunkedStreamingEncode verifies parts are streamed through
+// temp files that are removed after the send.
+func TestClient_Send_ChunkedStreamingEncode(t *testing.T) {
+	srv, received := partServer(t, nil)
+	defer srv.Close()
+
+	dir := t.TempDir()
+	cfg := testConfig(srv.URL)
+	cfg.MaxCompressedBodyBytes = 4096
+	cfg.ChunkedUploadEnabled = true
+	cfg.StreamingEncodeEnabled = true
+	client := NewClient(cfg, nil, nil)
+	spool, err := NewSpool(dir, 1<<20, nil, nil)
+	if err != nil {
+		t.Fatalf("NewSpool failed: %v", err)
+	}
+	client.SetSpool(spool)
+
+	snap := largeSnapshot(500)
+	if _, err := client.Send(context.Background(), snap); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	var pods int
+	for i, p := range received() {
+		if p.size > 4096 {
+			t.Errorf("part %d is %d bytes, over the 4096 byte cap", i, p.size)
+		}
+		pods += len(p.snap.Pods)
+	}
+	if pods != len(snap.Pods) {
+		t.Errorf("parts hold %d pods, want %d", pods, len(snap.Pods))
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Errorf("spool dir holds %d files after send, want the part files removed", len(entries))
+	}
+}
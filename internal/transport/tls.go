@@ -0,0 +1,129 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+)
+
+// ErrTLSHandshake wraps errors from the TLS handshake with the backend or a
+// TLS-inspecting proxy: an untrusted or mismatched server certificate, a
+// rejected client certificate, or no common TLS version. Retried like other
+// connection errors, since a rotated certificate can fix it.
+var ErrTLSHandshake = errors.New("transport: TLS handshake failed")
+
+// NewTLSConfig returns the TLS configuration for the backend connection. It
+// fails if the CA bundle or the client certificate cannot be loaded, so a
+// misconfiguration surfaces at startup rather than on the first send.
+func NewTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	tc := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.TLSServerName,
+	}
+	if cfg.TLSMinVersion == config.TLSVersion13 {
+		tc.MinVersion = tls.VersionTLS13
+	}
+
+	if cfg.TLSCAFile != "" {
+		bundle, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("transport: read CA bundle: %w", err)
+		}
+		// Trust the bundle in addition to the public roots, which the
+		// backend itself may still be verified against.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("transport: no PEM certificates in CA bundle %s", cfg.TLSCAFile)
+		}
+		tc.RootCAs = pool
+	}
+
+	if cfg.TLSCertFile != "" {
+		kp, err := newKeyPairReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tc.GetClientCertificate = kp.clientCertificate
+	}
+	return tc, nil
+}
+
+// keyPairReloader serves the client certificate from disk and reloads it
+// when either file changes, so a rotated certificate (e.g. a cert-manager
+// Secret) is presented from the next handshake on, without a restart.
+type keyPairReloader struct {
+	certFile, keyFile string
+
+	mu              sync.Mutex
+	cert            *tls.Certificate
+	certMod, keyMod time.Time
+}
+
+func newKeyPairReloader(certFile, keyFile string) (*keyPairReloader, error) {
+	kp := &keyPairReloader{certFile: certFile, keyFile: keyFile}
+	if err := kp.reload(); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
+
+// reload loads the key pair if either file was modified since the last load.
+func (kp *keyPairReloader) reload() error {
+	certInfo, err := os.Stat(kp.certFile)
+	if err != nil {
+		return fmt.Errorf("transport: client certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(kp.keyFile)
+	if err != nil {
+		return fmt.Errorf("transport: client key: %w", err)
+	}
+	if kp.cert != nil && certInfo.ModTime().Equal(kp.certMod) && keyInfo.ModTime().Equal(kp.keyMod) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(kp.certFile, kp.keyFile)
+	if err != nil {
+		return fmt.Errorf("transport: client certificate: %w", err)
+	}
+	kp.cert = &cert
+	kp.certMod, kp.keyMod = certInfo.ModTime(), keyInfo.ModTime()
+	return nil
+}
+
+// clientCertificate implements tls.Config.GetClientCertificate. A failed
+// reload, e.g. of a pair caught mid-rotation, keeps the previous certificate.
+func (kp *keyPairReloader) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if err := kp.reload(); err != nil {
+		slog.Warn("failed to reload TLS client certificate, keeping the previous one", "error", err)
+	}
+	return kp.cert, nil
+}
+
+// isTLSHandshakeError reports whether err comes from the TLS handshake:
+// a certificate the client rejected, a malformed record, or an alert from
+// either side.
+func isTLSHandshakeError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var recordErr tls.RecordHeaderError
+	var alertErr tls.AlertError
+	if errors.As(err, &verifyErr) || errors.As(err, &recordErr) || errors.As(err, &alertErr) {
+		return true
+	}
+	// Alerts sent by the peer, e.g. for a rejected client certificate, are
+	// of an unexported type, wrapped in a net.OpError with this Op.
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "remote error"
+}
@@ -0,0 +1,303 @@
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/kubeadapt/kubeadapt-agent/internal/config"
+	agenterrors "github.com/kubeadapt/kubeadapt-agent/internal/errors"
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// testCA issues certificates for TLS tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for cn, valid for the given DNS
+// names and 127.0.0.1.
+func (ca *testCA) issue(t *testing.T, cn string, usage x509.ExtKeyUsage, dnsNames ...string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newTLSServer starts an HTTPS ingest stub with a certificate from ca for
+// ingest.example.com. It records the client certificate's common name and
+// the SNI of every request.
+func newTLSServer(t *testing.T, ca *testCA, configure func(*tls.Config)) (srv *httptest.Server, clientCNs, serverNames *[]string) {
+	t.Helper()
+	certPEM, keyPEM := ca.issue(t, "ingest", x509.ExtKeyUsageServerAuth, "ingest.example.com")
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCNs, serverNames = new([]string), new([]string)
+	srv = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*serverNames = append(*serverNames, r.TLS.ServerName)
+		if len(r.TLS.PeerCertificates) > 0 {
+			*clientCNs = append(*clientCNs, r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	if configure != nil {
+		configure(srv.TLS)
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv, clientCNs, serverNames
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTLSClient returns a client for srv configured by cfg's TLS options.
+func newTLSClient(t *testing.T, cfg *config.Config) *Client {
+	t.Helper()
+	tc, err := NewTLSConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewTLSConfig failed: %v", err)
+	}
+	client := NewClient(cfg, nil, agenterrors.NewErrorCollector(agenterrors.RealClock{}))
+	client.SetTLSConfig(tc)
+	return client
+}
+
+func TestClient_Send_CustomCABundle(t *testing.T) {
+	ca := newTestCA(t)
+	srv, _, _ := newTLSServer(t, ca, nil)
+
+	// Without the bundle the server certificate is untrusted.
+	cfg := testConfig(srv.URL)
+	client := newTLSClient(t, cfg)
+	_, err := client.Send(context.Background(), testSnapshot())
+	if !errors.Is(err, ErrTLSHandshake) {
+		t.Fatalf("Send error = %v, want ErrTLSHandshake", err)
+	}
+	if codes := client.errorCollector.GetActiveErrorCodes(); !slices.Contains(codes, string(agenterrors.ErrTLSHandshakeFailed)) {
+		t.Errorf("error codes = %v, want %s", codes, agenterrors.ErrTLSHandshakeFailed)
+	}
+
+	cfg.TLSCAFile = filepath.Join(t.TempDir(), "ca.crt")
+	writeFile(t, cfg.TLSCAFile, ca.pem)
+	if _, err := newTLSClient(t, cfg).Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send with CA bundle failed: %v", err)
+	}
+}
+
+func TestClient_Send_ServerNameOverride(t *testing.T) {
+	ca := newTestCA(t)
+	srv, _, serverNames := newTLSServer(t, ca, nil)
+
+	cfg := testConfig(srv.URL)
+	cfg.TLSCAFile = filepath.Join(t.TempDir(), "ca.crt")
+	writeFile(t, cfg.TLSCAFile, ca.pem)
+	cfg.TLSServerName = "ingest.example.com"
+
+	if _, err := newTLSClient(t, cfg).Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if len(*serverNames) != 1 || (*serverNames)[0] != "ingest.example.com" {
+		t.Errorf("SNI = %v, want [ingest.example.com]", *serverNames)
+	}
+
+	cfg.TLSServerName = "other.example.com"
+	if _, err := newTLSClient(t, cfg).Send(context.Background(), testSnapshot()); !errors.Is(err, ErrTLSHandshake) {
+		t.Errorf("Send error = %v, want ErrTLSHandshake for a name the certificate does not cover", err)
+	}
+}
+
+func TestClient_Send_MinVersion(t *testing.T) {
+	ca := newTestCA(t)
+	srv, _, _ := newTLSServer(t, ca, func(tc *tls.Config) { tc.MaxVersion = tls.VersionTLS12 })
+
+	cfg := testConfig(srv.URL)
+	cfg.TLSCAFile = filepath.Join(t.TempDir(), "ca.crt")
+	writeFile(t, cfg.TLSCAFile, ca.pem)
+	cfg.TLSMinVersion = config.TLSVersion13
+
+	if _, err := newTLSClient(t, cfg).Send(context.Background(), testSnapshot()); !errors.Is(err, ErrTLSHandshake) {
+		t.Errorf("Send error = %v, want ErrTLSHandshake against a TLS 1.2 server", err)
+	}
+}
+
+// TestClient_Send_HTTP2 verifies the custom TLS config keeps HTTP/2.
+func TestClient_Send_HTTP2(t *testing.T) {
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "ingest", x509.ExtKeyUsageServerAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var proto string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proto = r.Proto
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(model.SnapshotResponse{Success: true})
+	}))
+	srv.EnableHTTP2 = true
+	srv.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	srv.StartTLS()
+	defer srv.Close()
+
+	cfg := testConfig(srv.URL)
+	cfg.TLSCAFile = filepath.Join(t.TempDir(), "ca.crt")
+	writeFile(t, cfg.TLSCAFile, ca.pem)
+
+	if _, err := newTLSClient(t, cfg).Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if proto != "HTTP/2.0" {
+		t.Errorf("protocol = %q, want HTTP/2.0", proto)
+	}
+}
+
+// TestClient_Send_MutualTLS verifies the client certificate is presented and
+// a rotated one is picked up on the next connection.
+func TestClient_Send_MutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	srv, clientCNs, _ := newTLSServer(t, ca, func(tc *tls.Config) {
+		tc.ClientAuth = tls.RequireAndVerifyClientCert
+		tc.ClientCAs = x509.NewCertPool()
+		tc.ClientCAs.AddCert(ca.cert)
+	})
+
+	dir := t.TempDir()
+	cfg := testConfig(srv.URL)
+	cfg.TLSCAFile = filepath.Join(dir, "ca.crt")
+	cfg.TLSCertFile = filepath.Join(dir, "tls.crt")
+	cfg.TLSKeyFile = filepath.Join(dir, "tls.key")
+	writeFile(t, cfg.TLSCAFile, ca.pem)
+
+	// Without a client certificate the server rejects the handshake.
+	noCert := *cfg
+	noCert.TLSCertFile, noCert.TLSKeyFile = "", ""
+	if _, err := newTLSClient(t, &noCert).Send(context.Background(), testSnapshot()); !errors.Is(err, ErrTLSHandshake) {
+		t.Fatalf("Send error = %v, want ErrTLSHandshake without a client certificate", err)
+	}
+
+	certPEM, keyPEM := ca.issue(t, "agent-1", x509.ExtKeyUsageClientAuth)
+	writeFile(t, cfg.TLSCertFile, certPEM)
+	writeFile(t, cfg.TLSKeyFile, keyPEM)
+	client := newTLSClient(t, cfg)
+	if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	// Rotate the pair, as a cert-manager Secret update would.
+	certPEM, keyPEM = ca.issue(t, "agent-2", x509.ExtKeyUsageClientAuth)
+	writeFile(t, cfg.TLSCertFile, certPEM)
+	writeFile(t, cfg.TLSKeyFile, keyPEM)
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{cfg.TLSCertFile, cfg.TLSKeyFile} {
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client.baseTransport.CloseIdleConnections()
+	if _, err := client.Send(context.Background(), testSnapshot()); err != nil {
+		t.Fatalf("Send after rotation failed: %v", err)
+	}
+
+	if want := []string{"agent-1", "agent-2"}; !slices.Equal(*clientCNs, want) {
+		t.Errorf("client certificates = %v, want %v", *clientCNs, want)
+	}
+}
+
+func TestNewTLSConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "not.pem")
+	writeFile(t, notPEM, []byte("not a certificate"))
+
+	for name, cfg := range map[string]*config.Config{
+		"missing CA bundle": {TLSCAFile: filepath.Join(dir, "missing.crt")},
+		"CA bundle not PEM": {TLSCAFile: notPEM},
+		"missing key pair":  {TLSCertFile: filepath.Join(dir, "tls.crt"), TLSKeyFile: filepath.Join(dir, "tls.key")},
+		"key pair not PEM":  {TLSCertFile: notPEM, TLSKeyFile: notPEM},
+	} {
+		if _, err := NewTLSConfig(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	tc, err := NewTLSConfig(&config.Config{})
+	if err != nil {
+		t.Fatalf("NewTLSConfig with defaults failed: %v", err)
+	}
+	if tc.MinVersion != tls.VersionTLS12 || tc.RootCAs != nil || tc.GetClientCertificate != nil {
+		t.Errorf("default TLS config = %+v, want TLS 1.2 minimum and system roots", tc)
+	}
+}
@@ -5,10 +5,17 @@ type CustomWorkloadInfo struct {
 	APIVersion    string `json:"api_version"`
 	Kind          string `json:"kind"`
 	Name          string `json:"name"`
+	UID           string `json:"uid"`
 	Namespace     string `json:"namespace"`
 	Replicas      *int32 `json:"replicas,omitempty"`
 	ReadyReplicas *int32 `json:"ready_replicas,omitempty"`
 
+	// Immediate owner, for CRDs that are themselves owned by another CRD.
+	OwnerKind       string `json:"owner_kind,omitempty"`
+	OwnerName       string `json:"owner_name,omitempty"`
+	OwnerUID        string `json:"owner_uid,omitempty"`
+	OwnerAPIVersion string `json:"owner_api_version,omitempty"`
+
 	Status map[string]interface{} `json:"status"`
 
 	PodCount           int      `json:"pod_count"`
@@ -17,9 +24,19 @@ type CustomWorkloadInfo struct {
 	TotalCPUUsage      *float64 `json:"total_cpu_usage,omitempty"`
 	TotalMemoryUsage   *int64   `json:"total_memory_usage,omitempty"`
 
+	// HourlyCost sums the hourly cost of the pods resolved to the workload.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
 	Labels            map[string]string `json:"labels"`
 	Annotations       map[string]string `json:"annotations"`
 	CreationTimestamp int64             `json:"creation_timestamp"`
+
+	// EventReasons counts Events about the workload, its pods, and HPAs
+	// targeting it, by reason over the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // NodePoolInfo represents a Karpenter NodePool.
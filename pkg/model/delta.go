@@ -0,0 +1,17 @@
+package model
+
+// Snapshot types carried in ClusterSnapshot.SnapshotType.
+const (
+	SnapshotTypeFull  = "full"
+	SnapshotTypeDelta = "delta"
+)
+
+// SnapshotDelta lists the entities that changed relative to BaseSnapshotID,
+// grouped by resource family (the ClusterSnapshot JSON field, e.g. "pods").
+// Keys are UIDs for kinds that carry one, otherwise "namespace/name".
+// Added and updated entities are also present in full in the resource slices.
+type SnapshotDelta struct {
+	Added   map[string][]string `json:"added,omitempty"`
+	Updated map[string][]string `json:"updated,omitempty"`
+	Deleted map[string][]string `json:"deleted,omitempty"`
+}
@@ -0,0 +1,20 @@
+package model
+
+// EventInfo represents Kubernetes Events about one involved object with the
+// same reason, deduplicated over a snapshot interval. Count is the number of
+// occurrences within the interval, not the event's lifetime count.
+type EventInfo struct {
+	Type                string `json:"type"`
+	Reason              string `json:"reason"`
+	Note                string `json:"note"`
+	ReportingController string `json:"reporting_controller,omitempty"`
+
+	InvolvedKind      string `json:"involved_kind"`
+	InvolvedNamespace string `json:"involved_namespace,omitempty"`
+	InvolvedName      string `json:"involved_name"`
+	InvolvedUID       string `json:"involved_uid,omitempty"`
+
+	Count          int32 `json:"count"`
+	FirstTimestamp int64 `json:"first_timestamp"`
+	LastTimestamp  int64 `json:"last_timestamp"`
+}
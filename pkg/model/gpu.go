@@ -12,6 +12,63 @@ type GPUDeviceInfo struct {
 	MemoryTotalBytes    *int64   `json:"memory_total_bytes,omitempty"`
 	TemperatureCelsius  *float64 `json:"temperature_celsius,omitempty"`
 	PowerWatts          *float64 `json:"power_watts,omitempty"`
+	SMClockMHz          *float64 `json:"sm_clock_mhz,omitempty"`
 	MIGProfile          string   `json:"mig_profile,omitempty"`
 	MIGInstanceID       string   `json:"mig_instance_id,omitempty"`
+	// Allocated is set when the exporter attributes the device to a container.
+	Allocated bool `json:"allocated,omitempty"`
+
+	// Error and health signals (dcgm-exporter only).
+	XIDError           *int     `json:"xid_error,omitempty"`
+	ECCSingleBitErrors *int64   `json:"ecc_sbe_volatile_total,omitempty"`
+	ECCDoubleBitErrors *int64   `json:"ecc_dbe_volatile_total,omitempty"`
+	ThrottleReasons    []string `json:"throttle_reasons,omitempty"`
+	Health             string   `json:"health,omitempty"`
+	HealthReasons      []string `json:"health_reasons,omitempty"`
+
+	NVLinkBandwidthTotal *int64   `json:"nvlink_bandwidth_total,omitempty"`
+	NVLinkTxBytesPerSec  *float64 `json:"nvlink_tx_bytes_per_sec,omitempty"`
+	NVLinkRxBytesPerSec  *float64 `json:"nvlink_rx_bytes_per_sec,omitempty"`
+
+	// GPUIdle is set on MIG slices allocated but idle over the idle window.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+}
+
+// GPU health values reported in GPUDeviceInfo.Health and NodeInfo.GPUHealth.
+const (
+	GPUHealthHealthy   = "healthy"
+	GPUHealthDegraded  = "degraded"
+	GPUHealthUnhealthy = "unhealthy"
+)
+
+// GPU vendors reported in NodeInfo.GPUVendor.
+const (
+	GPUVendorNVIDIA = "nvidia"
+	GPUVendorAMD    = "amd"
+	GPUVendorIntel  = "intel"
+	GPUVendorHabana = "habana"
+)
+
+// GPUIdleInfo flags GPUs held but barely used, or left unallocated, for the
+// whole idle detection window. It is omitted when nothing is idle or idle
+// detection is disabled.
+type GPUIdleInfo struct {
+	Reason string `json:"reason"`
+	// GPUs is the number of idle GPUs; a MIG slice counts as its share of
+	// the GPU's compute slices.
+	GPUs                   float64  `json:"gpus"`
+	MaxUtilizationPercent  *float64 `json:"max_utilization_percent,omitempty"`
+	MaxTensorActivePercent *float64 `json:"max_tensor_active_percent,omitempty"`
+	WindowHours            float64  `json:"window_hours"`
+	// WastedGPUHours estimates the GPU time lost over the window: the idle
+	// GPUs times the window, scaled by the average unused utilization (or
+	// tensor activity) for held GPUs.
+	WastedGPUHours float64 `json:"wasted_gpu_hours"`
 }
+
+// Reasons reported in GPUIdleInfo.Reason.
+const (
+	GPUIdleLowUtilization    = "low_utilization"
+	GPUIdleLowTensorActivity = "low_tensor_activity"
+	GPUIdleUnallocated       = "unallocated"
+)
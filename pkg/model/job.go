@@ -26,12 +26,22 @@ type JobInfo struct {
 	TotalCPUUsage      *float64 `json:"total_cpu_usage,omitempty"`
 	TotalMemoryUsage   *int64   `json:"total_memory_usage,omitempty"`
 
+	// HourlyCost sums the hourly cost of the Job's pods.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
 	ContainerSpecs []ContainerSpecInfo `json:"container_specs"`
 
 	Labels            map[string]string  `json:"labels"`
 	Annotations       map[string]string  `json:"annotations"`
 	CreationTimestamp int64              `json:"creation_timestamp"`
 	Conditions        []JobConditionInfo `json:"conditions"`
+
+	// EventReasons counts Events about the Job and its pods by reason over
+	// the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // CronJobInfo represents a Kubernetes CronJob.
@@ -47,11 +57,21 @@ type CronJobInfo struct {
 
 	ActiveJobs []string `json:"active_jobs"`
 
+	// HourlyCost sums the hourly cost of the pods of the CronJob's Jobs.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
 	ContainerSpecs []ContainerSpecInfo `json:"container_specs"`
 
 	Labels            map[string]string `json:"labels"`
 	Annotations       map[string]string `json:"annotations"`
 	CreationTimestamp int64             `json:"creation_timestamp"`
+
+	// EventReasons counts Events about the CronJob, its Jobs, and their pods
+	// by reason over the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // JobConditionInfo represents a job condition (Complete, Failed, etc.).
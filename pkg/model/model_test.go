@@ -821,6 +821,7 @@ func TestNodePoolInfo_RoundTrip(t *testing.T) {
 
 func TestSnapshotResponse_RoundTrip(t *testing.T) {
 	retryAfter := 120
+	collectVPAs, collectKarpenter := true, false
 	gracePeriodEnds := int64(1700086400000)
 
 	orig := SnapshotResponse{
@@ -842,8 +843,8 @@ func TestSnapshotResponse_RoundTrip(t *testing.T) {
 		Directives: Directives{
 			NextSnapshotInSeconds: 60,
 			RetryAfterSeconds:     &retryAfter,
-			CollectVPAs:           true,
-			CollectKarpenter:      false,
+			CollectVPAs:           &collectVPAs,
+			CollectKarpenter:      &collectKarpenter,
 		},
 		Stats: IngestStats{
 			NodesProcessed:     5,
@@ -37,6 +37,7 @@ type NodeInfo struct {
 	PodAllocatable    int     `json:"pod_allocatable"`
 	GPUAllocatable    int     `json:"gpu_allocatable"`
 
+	GPUVendor              string          `json:"gpu_vendor,omitempty"`
 	GPUModel               string          `json:"gpu_model,omitempty"`
 	GPUDriverVersion       string          `json:"gpu_driver_version,omitempty"`
 	MIGEnabled             bool            `json:"mig_enabled,omitempty"`
@@ -49,15 +50,38 @@ type NodeInfo struct {
 	GPUTemperatureCelsius  *float64        `json:"gpu_temperature_celsius,omitempty"`
 	GPUPowerWatts          *float64        `json:"gpu_power_watts,omitempty"`
 	GPUDevices             []GPUDeviceInfo `json:"gpu_devices,omitempty"`
+	// GPUHealth is the worst health of the node's GPU devices, empty when
+	// the exporter reports no health signals.
+	GPUHealth           string `json:"gpu_health,omitempty"`
+	GPUUnhealthyDevices int    `json:"gpu_unhealthy_devices,omitempty"`
+	// GPUIdle is set when GPUs stayed unallocated for the idle window.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
 
 	CPUUsageCores    *float64 `json:"cpu_usage_cores,omitempty"`
 	MemoryUsageBytes *int64   `json:"memory_usage_bytes,omitempty"`
 
+	// Kubelet Summary API usage. Network counters are cumulative.
+	EphemeralStorageUsedBytes *int64 `json:"ephemeral_storage_used_bytes,omitempty"`
+	ImageFSUsedBytes          *int64 `json:"imagefs_used_bytes,omitempty"`
+	NetworkRxBytes            *int64 `json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes            *int64 `json:"network_tx_bytes,omitempty"`
+
+	// Hourly cost from the pricing catalog (omitted when cost attribution
+	// is disabled or the node has no price). IdleHourlyCost is the part not
+	// attributed to pod requests.
+	HourlyCost     *float64 `json:"hourly_cost,omitempty"`
+	IdleHourlyCost *float64 `json:"idle_hourly_cost,omitempty"`
+	PricingSource  string   `json:"pricing_source,omitempty"`
+
 	Ready         bool                `json:"ready"`
 	Unschedulable bool                `json:"unschedulable"`
 	Taints        []TaintInfo         `json:"taints"`
 	Conditions    []NodeConditionInfo `json:"conditions"`
 
+	// EventReasons counts Events about the node by reason over the
+	// snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
+
 	Labels            map[string]string `json:"labels"`
 	Annotations       map[string]string `json:"annotations"`
 	CreationTimestamp int64             `json:"creation_timestamp"`
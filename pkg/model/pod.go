@@ -10,9 +10,10 @@ type PodInfo struct {
 	Reason    string `json:"reason"`
 	QoSClass  string `json:"qos_class"`
 
-	OwnerKind string `json:"owner_kind"`
-	OwnerName string `json:"owner_name"`
-	OwnerUID  string `json:"owner_uid"`
+	OwnerKind       string `json:"owner_kind"`
+	OwnerName       string `json:"owner_name"`
+	OwnerUID        string `json:"owner_uid"`
+	OwnerAPIVersion string `json:"owner_api_version,omitempty"`
 
 	Containers     []ContainerInfo `json:"containers"`
 	InitContainers []ContainerInfo `json:"init_containers"`
@@ -32,7 +33,62 @@ type PodInfo struct {
 	HasHostPath bool   `json:"has_hostpath"`
 	HasEmptyDir bool   `json:"has_emptydir"`
 
+	Volumes []PodVolumeInfo `json:"volumes,omitempty"`
+
+	// Kubelet Summary API usage. Network counters are cumulative.
+	EphemeralStorageUsedBytes *int64 `json:"ephemeral_storage_used_bytes,omitempty"`
+	NetworkRxBytes            *int64 `json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes            *int64 `json:"network_tx_bytes,omitempty"`
+
+	// HourlyCost is the pod's share of its node's hourly cost by requests.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
 	Conditions []PodConditionInfo `json:"conditions"`
+
+	// EventReasons counts Events about the pod by reason over the
+	// snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
+}
+
+// Pod volume types reported in PodVolumeInfo.Type.
+const (
+	VolumeTypePVC       = "persistentVolumeClaim"
+	VolumeTypeConfigMap = "configMap"
+	VolumeTypeSecret    = "secret"
+	VolumeTypeProjected = "projected"
+	VolumeTypeEphemeral = "ephemeral"
+	VolumeTypeCSI       = "csi"
+	VolumeTypeEmptyDir  = "emptyDir"
+	VolumeTypeHostPath  = "hostPath"
+	VolumeTypeOther     = "other"
+)
+
+// PodVolumeInfo represents a volume declared in a pod spec. Only the
+// reference fields for the volume's Type are set.
+type PodVolumeInfo struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	ReadOnly bool   `json:"read_only,omitempty"`
+
+	// ClaimName is the PVC backing the volume: spec.persistentVolumeClaim.claimName,
+	// or the "<pod>-<volume>" PVC created for a generic ephemeral volume.
+	ClaimName string `json:"claim_name,omitempty"`
+
+	ConfigMapName string `json:"configmap_name,omitempty"`
+	SecretName    string `json:"secret_name,omitempty"`
+
+	// ProjectedSources lists the source types of a projected volume
+	// (configMap, secret, downwardAPI, serviceAccountToken, clusterTrustBundle).
+	ProjectedSources []string `json:"projected_sources,omitempty"`
+
+	// Ephemeral volume claim template.
+	StorageClassName string `json:"storage_class_name,omitempty"`
+	RequestedBytes   int64  `json:"requested_bytes,omitempty"`
+
+	// CSIDriver is set for CSI inline volumes.
+	CSIDriver string `json:"csi_driver,omitempty"`
+
+	SizeLimitBytes int64 `json:"size_limit_bytes,omitempty"`
 }
 
 // ContainerInfo represents a container within a pod including spec, status, and metrics.
@@ -53,8 +109,24 @@ type ContainerInfo struct {
 	CPUUsageCores    *float64 `json:"cpu_usage_cores,omitempty"`
 	MemoryUsageBytes *int64   `json:"memory_usage_bytes,omitempty"`
 
-	GPUUtilizationPercent *float64 `json:"gpu_utilization_percent,omitempty"`
-	GPUMemoryUsedBytes    *int64   `json:"gpu_memory_used_bytes,omitempty"`
+	// Usage distribution over the metrics interval, from sub-interval samples.
+	CPUUsageStats    *CPUUsageStats    `json:"cpu_usage_stats,omitempty"`
+	MemoryUsageStats *MemoryUsageStats `json:"memory_usage_stats,omitempty"`
+
+	GPUUtilizationPercent  *float64 `json:"gpu_utilization_percent,omitempty"`
+	GPUTensorActivePercent *float64 `json:"gpu_tensor_active_percent,omitempty"`
+	GPUMemoryUsedBytes     *int64   `json:"gpu_memory_used_bytes,omitempty"`
+	// GPUIdle is set when the container's GPUs stayed idle for the idle window.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
+	// Kubelet Summary API filesystem usage (writable layer and container logs).
+	RootfsUsedBytes *int64 `json:"rootfs_used_bytes,omitempty"`
+	LogsUsedBytes   *int64 `json:"logs_used_bytes,omitempty"`
+
+	// cAdvisor signals over the last metrics interval: fraction of CFS
+	// periods that were throttled (0-1), and OOM events.
+	CPUThrottledRatio *float64 `json:"cpu_throttled_ratio,omitempty"`
+	OOMEvents         *int64   `json:"oom_events,omitempty"`
 
 	Ready                 bool   `json:"ready"`
 	Started               *bool  `json:"started,omitempty"`
@@ -97,3 +169,27 @@ type ContainerMetrics struct {
 	CPUUsageCores    float64 `json:"cpu_usage_cores"`
 	MemoryUsageBytes int64   `json:"memory_usage_bytes"`
 }
+
+// CPUUsageStats summarizes a container's CPU usage samples over a window.
+type CPUUsageStats struct {
+	MinCores float64 `json:"min_cores"`
+	AvgCores float64 `json:"avg_cores"`
+	MaxCores float64 `json:"max_cores"`
+	P95Cores float64 `json:"p95_cores"`
+	Samples  int     `json:"samples"`
+}
+
+// MemoryUsageStats summarizes a container's memory usage samples over a window.
+type MemoryUsageStats struct {
+	MinBytes int64 `json:"min_bytes"`
+	AvgBytes int64 `json:"avg_bytes"`
+	MaxBytes int64 `json:"max_bytes"`
+	P95Bytes int64 `json:"p95_bytes"`
+	Samples  int   `json:"samples"`
+}
+
+// ContainerUsageStats holds the CPU and memory usage summary of a container.
+type ContainerUsageStats struct {
+	CPU    CPUUsageStats    `json:"cpu"`
+	Memory MemoryUsageStats `json:"memory"`
+}
@@ -38,11 +38,24 @@ type QuotaStatus struct {
 }
 
 // Directives tell the agent what to do next.
+// Zero/nil fields mean "no change": NextSnapshotInSeconds=0 keeps the current
+// cadence and a nil Collect* flag leaves that collector as it is. The agent
+// acts on a Collect* flag only when it differs from the previous response's,
+// since older backends always send false.
 type Directives struct {
-	NextSnapshotInSeconds int  `json:"next_snapshot_in_seconds"`
-	RetryAfterSeconds     *int `json:"retry_after_seconds,omitempty"`
-	CollectVPAs           bool `json:"collect_vpas"`
-	CollectKarpenter      bool `json:"collect_karpenter"`
+	NextSnapshotInSeconds int   `json:"next_snapshot_in_seconds"`
+	RetryAfterSeconds     *int  `json:"retry_after_seconds,omitempty"`
+	CollectVPAs           *bool `json:"collect_vpas,omitempty"`
+	CollectKarpenter      *bool `json:"collect_karpenter,omitempty"`
+
+	// FullSnapshotRequired is set when the backend detected a gap in the
+	// delta sequence; the agent sends a full keyframe next.
+	FullSnapshotRequired bool `json:"full_snapshot_required,omitempty"`
+
+	// CompressionDictionaryID names the zstd dictionary the backend wants
+	// snapshots compressed with; the agent fetches it by ID. Zero means no
+	// change.
+	CompressionDictionaryID uint32 `json:"compression_dictionary_id,omitempty"`
 }
 
 // IngestStats returned after successful processing.
@@ -8,6 +8,17 @@ type ClusterSnapshot struct {
 	Timestamp    int64  `json:"timestamp"`
 	AgentVersion string `json:"agent_version"`
 
+	// Delta encoding (omitted when delta snapshots are disabled).
+	// A "delta" snapshot carries only added/updated entities in the resource
+	// slices below; removals are listed in Delta.Deleted.
+	SnapshotType   string         `json:"snapshot_type,omitempty"`
+	Sequence       uint64         `json:"sequence,omitempty"`
+	BaseSnapshotID string         `json:"base_snapshot_id,omitempty"`
+	Delta          *SnapshotDelta `json:"delta,omitempty"`
+
+	// Chunked upload (omitted unless the snapshot was split into parts).
+	Part *SnapshotPart `json:"part,omitempty"`
+
 	// Provider
 	Provider          string `json:"provider"`
 	Region            string `json:"region"`
@@ -47,6 +58,9 @@ type ClusterSnapshot struct {
 	// Karpenter (omitted if not present)
 	NodePools []NodePoolInfo `json:"node_pools,omitempty"`
 
+	// Events deduplicated over the interval (omitted unless raw events are enabled)
+	Events []EventInfo `json:"events,omitempty"`
+
 	// Computed
 	Summary ClusterSummary `json:"summary"`
 
@@ -54,6 +68,23 @@ type ClusterSnapshot struct {
 	Health AgentHealth `json:"health"`
 }
 
+// SnapshotPart identifies one part of a snapshot split for upload. All parts
+// share the snapshot's identity; the backend reassembles them once all Count
+// parts have arrived. Summary, health and delta travel in part 0.
+type SnapshotPart struct {
+	Index  int    `json:"index"`
+	Count  int    `json:"count"`
+	Family string `json:"family"`
+}
+
+// Resource families of snapshot parts. Pods may span several parts.
+const (
+	PartFamilyNodes          = "nodes"
+	PartFamilyPods           = "pods"
+	PartFamilyWorkloads      = "workloads"
+	PartFamilyNetworkStorage = "network_storage"
+)
+
 // ClusterSummary holds computed counts and resource totals.
 type ClusterSummary struct {
 	NodeCount           int `json:"node_count"`
@@ -100,6 +131,12 @@ type ClusterSummary struct {
 	TotalStorageRequested int64 `json:"total_storage_requested"`
 
 	MetricsAvailable bool `json:"metrics_available"`
+
+	// Cost totals over priced nodes (omitted when no node has a price).
+	// IdleHourlyCost is the part of TotalHourlyCost not requested by pods.
+	TotalHourlyCost *float64 `json:"total_hourly_cost,omitempty"`
+	IdleHourlyCost  *float64 `json:"idle_hourly_cost,omitempty"`
+	CostCurrency    string   `json:"cost_currency,omitempty"`
 }
 
 // AgentHealth is sent with every snapshot for ClickHouse storage.
@@ -117,6 +154,13 @@ type AgentHealth struct {
 	State       string `json:"state"`
 	StateReason string `json:"state_reason,omitempty"`
 
+	// Leader election (multi-replica HA). IsLeader and LeaderIdentity are
+	// only meaningful when LeaderElectionEnabled is true.
+	LeaderElectionEnabled bool   `json:"leader_election_enabled"`
+	IsLeader              bool   `json:"is_leader"`
+	LeaderIdentity        string `json:"leader_identity,omitempty"`
+	LeadershipTransitions uint64 `json:"leadership_transitions"`
+
 	// Snapshot build performance
 	LastBuildDurationMs          int64 `json:"last_build_duration_ms"`
 	LastMetricsCollectDurationMs int64 `json:"last_metrics_collect_duration_ms"`
@@ -128,6 +172,10 @@ type AgentHealth struct {
 	CompressedSizeBytes int64   `json:"compressed_size_bytes"`
 	CompressionFactor   float64 `json:"compression_factor"`
 
+	// Durable buffer (on-disk spool of unsent snapshots)
+	BufferedSnapshots int   `json:"buffered_snapshots"`
+	BufferedBytes     int64 `json:"buffered_bytes"`
+
 	// Entity counts
 	NodeCount      int `json:"node_count"`
 	PodCount       int `json:"pod_count"`
@@ -166,6 +214,10 @@ type AgentHealth struct {
 
 	CollectedAt int64 `json:"collected_at"`
 
+	// Redaction policy applied to labels, annotations and names (nil when
+	// nothing is redacted).
+	Redaction *RedactionInfo `json:"redaction,omitempty"`
+
 	// Kubernetes pod metadata
 	ChartVersion    string `json:"chart_version,omitempty"`
 	HelmReleaseName string `json:"helm_release_name,omitempty"`
@@ -173,3 +225,16 @@ type AgentHealth struct {
 	PodNamespace    string `json:"pod_namespace,omitempty"`
 	NodeName        string `json:"node_name,omitempty"`
 }
+
+// RedactionInfo describes the redaction policy the agent applied to the
+// snapshot. Value patterns are only counted; SaltFingerprint changes when the
+// name hashing salt does, which breaks joins with earlier snapshots.
+type RedactionInfo struct {
+	LabelAllow      []string `json:"label_allow,omitempty"`
+	LabelDeny       []string `json:"label_deny,omitempty"`
+	AnnotationAllow []string `json:"annotation_allow,omitempty"`
+	AnnotationDeny  []string `json:"annotation_deny,omitempty"`
+	ValuePatterns   int      `json:"value_patterns"`
+	NamesHashed     bool     `json:"names_hashed"`
+	SaltFingerprint string   `json:"salt_fingerprint,omitempty"`
+}
@@ -0,0 +1,70 @@
+package model
+
+// NodeStats represents kubelet Summary API (/stats/summary) data for a node.
+// Network counters are cumulative since the interface came up.
+type NodeStats struct {
+	Name string `json:"name"`
+
+	FSUsedBytes      int64 `json:"fs_used_bytes"`
+	FSCapacityBytes  int64 `json:"fs_capacity_bytes"`
+	FSAvailableBytes int64 `json:"fs_available_bytes"`
+
+	ImageFSUsedBytes     int64 `json:"imagefs_used_bytes"`
+	ImageFSCapacityBytes int64 `json:"imagefs_capacity_bytes"`
+
+	NetworkRxBytes int64 `json:"network_rx_bytes"`
+	NetworkTxBytes int64 `json:"network_tx_bytes"`
+
+	Timestamp int64 `json:"timestamp"`
+}
+
+// PodStats represents kubelet Summary API data for a pod.
+type PodStats struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	EphemeralStorageUsedBytes int64 `json:"ephemeral_storage_used_bytes"`
+	NetworkRxBytes            int64 `json:"network_rx_bytes"`
+	NetworkTxBytes            int64 `json:"network_tx_bytes"`
+
+	Containers []ContainerStats `json:"containers"`
+	Timestamp  int64            `json:"timestamp"`
+}
+
+// ContainerStats represents kubelet Summary API filesystem usage for a container.
+type ContainerStats struct {
+	Name            string `json:"name"`
+	RootfsUsedBytes int64  `json:"rootfs_used_bytes"`
+	LogsUsedBytes   int64  `json:"logs_used_bytes"`
+}
+
+// VolumeStats represents kubelet Summary API usage for a PVC-backed volume,
+// as reported by the node the mounting pod runs on.
+type VolumeStats struct {
+	PVCName   string `json:"pvc_name"`
+	Namespace string `json:"namespace"`
+
+	UsedBytes      int64 `json:"used_bytes"`
+	CapacityBytes  int64 `json:"capacity_bytes"`
+	AvailableBytes int64 `json:"available_bytes"`
+	InodesUsed     int64 `json:"inodes_used"`
+
+	Timestamp int64 `json:"timestamp"`
+}
+
+// ContainerSignals represents per-interval CPU throttling and OOM activity
+// for a container, derived from kubelet cAdvisor counters
+// (/metrics/cadvisor) between two consecutive scrapes.
+type ContainerSignals struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+
+	// CFS periods in the interval, and how many of them were throttled.
+	CPUPeriods          int64 `json:"cpu_periods"`
+	CPUThrottledPeriods int64 `json:"cpu_throttled_periods"`
+	// OOM events in the interval.
+	OOMEvents int64 `json:"oom_events"`
+
+	Timestamp int64 `json:"timestamp"`
+}
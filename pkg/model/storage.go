@@ -52,6 +52,11 @@ type PVCInfo struct {
 	RequestedBytes int64 `json:"requested_bytes"`
 	CapacityBytes  int64 `json:"capacity_bytes"`
 
+	// Filesystem usage from the kubelet Summary API; nil while no pod mounts
+	// the claim or the volume plugin does not report stats.
+	UsedBytes      *int64 `json:"used_bytes,omitempty"`
+	AvailableBytes *int64 `json:"available_bytes,omitempty"`
+
 	MountedByPods []string `json:"mounted_by_pods"`
 
 	Labels            map[string]string `json:"labels"`
@@ -21,6 +21,20 @@ type DeploymentInfo struct {
 	TotalCPUUsage      *float64 `json:"total_cpu_usage,omitempty"`
 	TotalMemoryUsage   *int64   `json:"total_memory_usage,omitempty"`
 
+	// HourlyCost sums the hourly cost of the pods of the Deployment's
+	// ReplicaSets.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
+	// Recommendations has one entry per container; see ResourceRecommendation.
+	Recommendations []ResourceRecommendation `json:"recommendations,omitempty"`
+
+	// TotalPVCCapacityBytes sums the capacity of the distinct PVCs mounted
+	// by the workload's pods (requested size for unbound claims).
+	TotalPVCCapacityBytes int64 `json:"total_pvc_capacity_bytes"`
+
 	ContainerSpecs []ContainerSpecInfo `json:"container_specs"`
 
 	Selector          map[string]string `json:"selector"`
@@ -30,6 +44,10 @@ type DeploymentInfo struct {
 
 	Conditions []WorkloadConditionInfo `json:"conditions"`
 	Paused     bool                    `json:"paused"`
+
+	// EventReasons counts Events about the Deployment, its ReplicaSets and
+	// pods, and HPAs targeting it, by reason over the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // StatefulSetInfo represents a Kubernetes StatefulSet.
@@ -53,6 +71,19 @@ type StatefulSetInfo struct {
 	TotalCPUUsage      *float64 `json:"total_cpu_usage,omitempty"`
 	TotalMemoryUsage   *int64   `json:"total_memory_usage,omitempty"`
 
+	// HourlyCost sums the hourly cost of the StatefulSet's pods.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
+	// Recommendations has one entry per container; see ResourceRecommendation.
+	Recommendations []ResourceRecommendation `json:"recommendations,omitempty"`
+
+	// TotalPVCCapacityBytes sums the capacity of the distinct PVCs mounted
+	// by the workload's pods (requested size for unbound claims).
+	TotalPVCCapacityBytes int64 `json:"total_pvc_capacity_bytes"`
+
 	ContainerSpecs []ContainerSpecInfo `json:"container_specs"`
 
 	Selector          map[string]string `json:"selector"`
@@ -63,6 +94,10 @@ type StatefulSetInfo struct {
 	Partition *int32 `json:"partition,omitempty"`
 
 	Conditions []WorkloadConditionInfo `json:"conditions"`
+
+	// EventReasons counts Events about the StatefulSet, its pods, and HPAs
+	// targeting it, by reason over the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // DaemonSetInfo represents a Kubernetes DaemonSet.
@@ -86,6 +121,15 @@ type DaemonSetInfo struct {
 	TotalCPUUsage      *float64 `json:"total_cpu_usage,omitempty"`
 	TotalMemoryUsage   *int64   `json:"total_memory_usage,omitempty"`
 
+	// HourlyCost sums the hourly cost of the DaemonSet's pods.
+	HourlyCost *float64 `json:"hourly_cost,omitempty"`
+
+	// GPUIdle sums the idle GPUs of the workload's containers.
+	GPUIdle *GPUIdleInfo `json:"gpu_idle,omitempty"`
+
+	// Recommendations has one entry per container; see ResourceRecommendation.
+	Recommendations []ResourceRecommendation `json:"recommendations,omitempty"`
+
 	ContainerSpecs []ContainerSpecInfo `json:"container_specs"`
 
 	Selector          map[string]string `json:"selector"`
@@ -94,9 +138,15 @@ type DaemonSetInfo struct {
 	CreationTimestamp int64             `json:"creation_timestamp"`
 
 	Conditions []WorkloadConditionInfo `json:"conditions"`
+
+	// EventReasons counts Events about the DaemonSet and its pods by reason
+	// over the snapshot interval.
+	EventReasons map[string]int `json:"event_reasons,omitempty"`
 }
 
 // ReplicaSetInfo represents a Kubernetes ReplicaSet (used internally for ownership resolution).
+// The agent watches ReplicaSets metadata-only, so Replicas, ReadyReplicas and
+// Selector are not populated.
 type ReplicaSetInfo struct {
 	Name              string            `json:"name"`
 	Namespace         string            `json:"namespace"`
@@ -105,6 +155,7 @@ type ReplicaSetInfo struct {
 	OwnerKind         string            `json:"owner_kind"`
 	OwnerName         string            `json:"owner_name"`
 	OwnerUID          string            `json:"owner_uid"`
+	OwnerAPIVersion   string            `json:"owner_api_version"`
 	Selector          map[string]string `json:"selector"`
 	Labels            map[string]string `json:"labels"`
 	CreationTimestamp int64             `json:"creation_timestamp"`
@@ -122,6 +173,30 @@ type ContainerSpecInfo struct {
 	GPULimit           int     `json:"gpu_limit"`
 }
 
+// ResourceRecommendation is a recommended request for one container of a
+// workload, computed in-agent from the container's usage history. A resource
+// is left out when an HPA scales the workload on it or there is no usage
+// history for it. Workloads carry no recommendations when rightsizing is
+// disabled.
+type ResourceRecommendation struct {
+	ContainerName      string   `json:"container_name"`
+	CPURequestCores    *float64 `json:"cpu_request_cores,omitempty"`
+	MemoryRequestBytes *int64   `json:"memory_request_bytes,omitempty"`
+	Confidence         string   `json:"confidence"`
+	HistoryHours       int      `json:"history_hours"`
+
+	// HPAControlled lists the resources ("cpu", "memory") an HPA scales on.
+	HPAControlled []string `json:"hpa_controlled,omitempty"`
+}
+
+// Recommendation confidence levels, by how much of the history window has
+// usage data.
+const (
+	ConfidenceLow    = "low"
+	ConfidenceMedium = "medium"
+	ConfidenceHigh   = "high"
+)
+
 // WorkloadConditionInfo represents a workload condition (Available, Progressing, etc.).
 type WorkloadConditionInfo struct {
 	Type    string `json:"type"`
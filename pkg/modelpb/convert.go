@@ -0,0 +1,2805 @@
+// Code generated by protogen from pkg/model. DO NOT EDIT.
+
+package modelpb
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// FromModel converts a pointer to a pkg/model type mirrored by the schema to
+// its message. It returns nil for other types.
+func FromModel(v any) proto.Message {
+	switch m := v.(type) {
+	case *model.ClusterSnapshot:
+		return clusterSnapshotFromModel(m)
+	case *model.SnapshotDelta:
+		return snapshotDeltaFromModel(m)
+	case *model.SnapshotPart:
+		return snapshotPartFromModel(m)
+	case *model.NodeInfo:
+		return nodeInfoFromModel(m)
+	case *model.PodInfo:
+		return podInfoFromModel(m)
+	case *model.NamespaceInfo:
+		return namespaceInfoFromModel(m)
+	case *model.DeploymentInfo:
+		return deploymentInfoFromModel(m)
+	case *model.StatefulSetInfo:
+		return statefulSetInfoFromModel(m)
+	case *model.DaemonSetInfo:
+		return daemonSetInfoFromModel(m)
+	case *model.JobInfo:
+		return jobInfoFromModel(m)
+	case *model.CronJobInfo:
+		return cronJobInfoFromModel(m)
+	case *model.CustomWorkloadInfo:
+		return customWorkloadInfoFromModel(m)
+	case *model.HPAInfo:
+		return hPAInfoFromModel(m)
+	case *model.VPAInfo:
+		return vPAInfoFromModel(m)
+	case *model.PDBInfo:
+		return pDBInfoFromModel(m)
+	case *model.ServiceInfo:
+		return serviceInfoFromModel(m)
+	case *model.IngressInfo:
+		return ingressInfoFromModel(m)
+	case *model.PVInfo:
+		return pVInfoFromModel(m)
+	case *model.PVCInfo:
+		return pVCInfoFromModel(m)
+	case *model.StorageClassInfo:
+		return storageClassInfoFromModel(m)
+	case *model.PriorityClassInfo:
+		return priorityClassInfoFromModel(m)
+	case *model.LimitRangeInfo:
+		return limitRangeInfoFromModel(m)
+	case *model.ResourceQuotaInfo:
+		return resourceQuotaInfoFromModel(m)
+	case *model.NodePoolInfo:
+		return nodePoolInfoFromModel(m)
+	case *model.EventInfo:
+		return eventInfoFromModel(m)
+	case *model.ClusterSummary:
+		return clusterSummaryFromModel(m)
+	case *model.AgentHealth:
+		return agentHealthFromModel(m)
+	case *model.GPUDeviceInfo:
+		return gPUDeviceInfoFromModel(m)
+	case *model.GPUIdleInfo:
+		return gPUIdleInfoFromModel(m)
+	case *model.TaintInfo:
+		return taintInfoFromModel(m)
+	case *model.NodeConditionInfo:
+		return nodeConditionInfoFromModel(m)
+	case *model.ContainerInfo:
+		return containerInfoFromModel(m)
+	case *model.PodVolumeInfo:
+		return podVolumeInfoFromModel(m)
+	case *model.PodConditionInfo:
+		return podConditionInfoFromModel(m)
+	case *model.ResourceRecommendation:
+		return resourceRecommendationFromModel(m)
+	case *model.ContainerSpecInfo:
+		return containerSpecInfoFromModel(m)
+	case *model.WorkloadConditionInfo:
+		return workloadConditionInfoFromModel(m)
+	case *model.JobConditionInfo:
+		return jobConditionInfoFromModel(m)
+	case *model.HPAMetricInfo:
+		return hPAMetricInfoFromModel(m)
+	case *model.HPACurrentMetricInfo:
+		return hPACurrentMetricInfoFromModel(m)
+	case *model.HPAScalingBehavior:
+		return hPAScalingBehaviorFromModel(m)
+	case *model.HPAConditionInfo:
+		return hPAConditionInfoFromModel(m)
+	case *model.VPAContainerRecommendation:
+		return vPAContainerRecommendationFromModel(m)
+	case *model.VPAConditionInfo:
+		return vPAConditionInfoFromModel(m)
+	case *model.LabelSelectorRequirement:
+		return labelSelectorRequirementFromModel(m)
+	case *model.WorkloadReference:
+		return workloadReferenceFromModel(m)
+	case *model.PDBConditionInfo:
+		return pDBConditionInfoFromModel(m)
+	case *model.LoadBalancerInfo:
+		return loadBalancerInfoFromModel(m)
+	case *model.ServicePortInfo:
+		return servicePortInfoFromModel(m)
+	case *model.IngressRuleInfo:
+		return ingressRuleInfoFromModel(m)
+	case *model.IngressTLSInfo:
+		return ingressTLSInfoFromModel(m)
+	case *model.IngressBackendInfo:
+		return ingressBackendInfoFromModel(m)
+	case *model.PVSourceInfo:
+		return pVSourceInfoFromModel(m)
+	case *model.PVClaimRefInfo:
+		return pVClaimRefInfoFromModel(m)
+	case *model.PVCConditionInfo:
+		return pVCConditionInfoFromModel(m)
+	case *model.LimitRangeItemInfo:
+		return limitRangeItemInfoFromModel(m)
+	case *model.NodeSelectorRequirement:
+		return nodeSelectorRequirementFromModel(m)
+	case *model.RedactionInfo:
+		return redactionInfoFromModel(m)
+	case *model.CPUUsageStats:
+		return cPUUsageStatsFromModel(m)
+	case *model.MemoryUsageStats:
+		return memoryUsageStatsFromModel(m)
+	case *model.ContainerPortInfo:
+		return containerPortInfoFromModel(m)
+	case *model.HPAScalingPolicy:
+		return hPAScalingPolicyFromModel(m)
+	case *model.ResourceValues:
+		return resourceValuesFromModel(m)
+	case *model.LoadBalancerIngress:
+		return loadBalancerIngressFromModel(m)
+	case *model.IngressPathInfo:
+		return ingressPathInfoFromModel(m)
+	}
+	return nil
+}
+
+// ToModel converts a message to a pointer to its pkg/model type. It returns
+// nil for messages not generated from pkg/model.
+func ToModel(msg proto.Message) any {
+	switch p := msg.(type) {
+	case *ClusterSnapshot:
+		return clusterSnapshotToModel(p)
+	case *SnapshotDelta:
+		return snapshotDeltaToModel(p)
+	case *SnapshotPart:
+		return snapshotPartToModel(p)
+	case *NodeInfo:
+		return nodeInfoToModel(p)
+	case *PodInfo:
+		return podInfoToModel(p)
+	case *NamespaceInfo:
+		return namespaceInfoToModel(p)
+	case *DeploymentInfo:
+		return deploymentInfoToModel(p)
+	case *StatefulSetInfo:
+		return statefulSetInfoToModel(p)
+	case *DaemonSetInfo:
+		return daemonSetInfoToModel(p)
+	case *JobInfo:
+		return jobInfoToModel(p)
+	case *CronJobInfo:
+		return cronJobInfoToModel(p)
+	case *CustomWorkloadInfo:
+		return customWorkloadInfoToModel(p)
+	case *HPAInfo:
+		return hPAInfoToModel(p)
+	case *VPAInfo:
+		return vPAInfoToModel(p)
+	case *PDBInfo:
+		return pDBInfoToModel(p)
+	case *ServiceInfo:
+		return serviceInfoToModel(p)
+	case *IngressInfo:
+		return ingressInfoToModel(p)
+	case *PVInfo:
+		return pVInfoToModel(p)
+	case *PVCInfo:
+		return pVCInfoToModel(p)
+	case *StorageClassInfo:
+		return storageClassInfoToModel(p)
+	case *PriorityClassInfo:
+		return priorityClassInfoToModel(p)
+	case *LimitRangeInfo:
+		return limitRangeInfoToModel(p)
+	case *ResourceQuotaInfo:
+		return resourceQuotaInfoToModel(p)
+	case *NodePoolInfo:
+		return nodePoolInfoToModel(p)
+	case *EventInfo:
+		return eventInfoToModel(p)
+	case *ClusterSummary:
+		return clusterSummaryToModel(p)
+	case *AgentHealth:
+		return agentHealthToModel(p)
+	case *GPUDeviceInfo:
+		return gPUDeviceInfoToModel(p)
+	case *GPUIdleInfo:
+		return gPUIdleInfoToModel(p)
+	case *TaintInfo:
+		return taintInfoToModel(p)
+	case *NodeConditionInfo:
+		return nodeConditionInfoToModel(p)
+	case *ContainerInfo:
+		return containerInfoToModel(p)
+	case *PodVolumeInfo:
+		return podVolumeInfoToModel(p)
+	case *PodConditionInfo:
+		return podConditionInfoToModel(p)
+	case *ResourceRecommendation:
+		return resourceRecommendationToModel(p)
+	case *ContainerSpecInfo:
+		return containerSpecInfoToModel(p)
+	case *WorkloadConditionInfo:
+		return workloadConditionInfoToModel(p)
+	case *JobConditionInfo:
+		return jobConditionInfoToModel(p)
+	case *HPAMetricInfo:
+		return hPAMetricInfoToModel(p)
+	case *HPACurrentMetricInfo:
+		return hPACurrentMetricInfoToModel(p)
+	case *HPAScalingBehavior:
+		return hPAScalingBehaviorToModel(p)
+	case *HPAConditionInfo:
+		return hPAConditionInfoToModel(p)
+	case *VPAContainerRecommendation:
+		return vPAContainerRecommendationToModel(p)
+	case *VPAConditionInfo:
+		return vPAConditionInfoToModel(p)
+	case *LabelSelectorRequirement:
+		return labelSelectorRequirementToModel(p)
+	case *WorkloadReference:
+		return workloadReferenceToModel(p)
+	case *PDBConditionInfo:
+		return pDBConditionInfoToModel(p)
+	case *LoadBalancerInfo:
+		return loadBalancerInfoToModel(p)
+	case *ServicePortInfo:
+		return servicePortInfoToModel(p)
+	case *IngressRuleInfo:
+		return ingressRuleInfoToModel(p)
+	case *IngressTLSInfo:
+		return ingressTLSInfoToModel(p)
+	case *IngressBackendInfo:
+		return ingressBackendInfoToModel(p)
+	case *PVSourceInfo:
+		return pVSourceInfoToModel(p)
+	case *PVClaimRefInfo:
+		return pVClaimRefInfoToModel(p)
+	case *PVCConditionInfo:
+		return pVCConditionInfoToModel(p)
+	case *LimitRangeItemInfo:
+		return limitRangeItemInfoToModel(p)
+	case *NodeSelectorRequirement:
+		return nodeSelectorRequirementToModel(p)
+	case *RedactionInfo:
+		return redactionInfoToModel(p)
+	case *CPUUsageStats:
+		return cPUUsageStatsToModel(p)
+	case *MemoryUsageStats:
+		return memoryUsageStatsToModel(p)
+	case *ContainerPortInfo:
+		return containerPortInfoToModel(p)
+	case *HPAScalingPolicy:
+		return hPAScalingPolicyToModel(p)
+	case *ResourceValues:
+		return resourceValuesToModel(p)
+	case *LoadBalancerIngress:
+		return loadBalancerIngressToModel(p)
+	case *IngressPathInfo:
+		return ingressPathInfoToModel(p)
+	}
+	return nil
+}
+
+func clusterSnapshotFromModel(m *model.ClusterSnapshot) *ClusterSnapshot {
+	if m == nil {
+		return nil
+	}
+	return &ClusterSnapshot{
+		SnapshotId:        m.SnapshotID,
+		ClusterId:         m.ClusterID,
+		Timestamp:         m.Timestamp,
+		AgentVersion:      m.AgentVersion,
+		SnapshotType:      m.SnapshotType,
+		Sequence:          m.Sequence,
+		BaseSnapshotId:    m.BaseSnapshotID,
+		Delta:             snapshotDeltaFromModel(m.Delta),
+		Part:              snapshotPartFromModel(m.Part),
+		Provider:          m.Provider,
+		Region:            m.Region,
+		CloudAccountId:    m.CloudAccountID,
+		KubernetesVersion: m.KubernetesVersion,
+		Nodes:             fromModelSlice(m.Nodes, nodeInfoFromModel),
+		Pods:              fromModelSlice(m.Pods, podInfoFromModel),
+		Namespaces:        fromModelSlice(m.Namespaces, namespaceInfoFromModel),
+		Deployments:       fromModelSlice(m.Deployments, deploymentInfoFromModel),
+		Statefulsets:      fromModelSlice(m.StatefulSets, statefulSetInfoFromModel),
+		Daemonsets:        fromModelSlice(m.DaemonSets, daemonSetInfoFromModel),
+		Jobs:              fromModelSlice(m.Jobs, jobInfoFromModel),
+		Cronjobs:          fromModelSlice(m.CronJobs, cronJobInfoFromModel),
+		CustomWorkloads:   fromModelSlice(m.CustomWorkloads, customWorkloadInfoFromModel),
+		Hpas:              fromModelSlice(m.HPAs, hPAInfoFromModel),
+		Vpas:              fromModelSlice(m.VPAs, vPAInfoFromModel),
+		Pdbs:              fromModelSlice(m.PDBs, pDBInfoFromModel),
+		Services:          fromModelSlice(m.Services, serviceInfoFromModel),
+		Ingresses:         fromModelSlice(m.Ingresses, ingressInfoFromModel),
+		Pvs:               fromModelSlice(m.PVs, pVInfoFromModel),
+		Pvcs:              fromModelSlice(m.PVCs, pVCInfoFromModel),
+		StorageClasses:    fromModelSlice(m.StorageClasses, storageClassInfoFromModel),
+		PriorityClasses:   fromModelSlice(m.PriorityClasses, priorityClassInfoFromModel),
+		LimitRanges:       fromModelSlice(m.LimitRanges, limitRangeInfoFromModel),
+		ResourceQuotas:    fromModelSlice(m.ResourceQuotas, resourceQuotaInfoFromModel),
+		NodePools:         fromModelSlice(m.NodePools, nodePoolInfoFromModel),
+		Events:            fromModelSlice(m.Events, eventInfoFromModel),
+		Summary:           clusterSummaryFromModel(&m.Summary),
+		Health:            agentHealthFromModel(&m.Health),
+	}
+}
+
+func clusterSnapshotToModel(p *ClusterSnapshot) *model.ClusterSnapshot {
+	if p == nil {
+		return nil
+	}
+	return &model.ClusterSnapshot{
+		SnapshotID:        p.SnapshotId,
+		ClusterID:         p.ClusterId,
+		Timestamp:         p.Timestamp,
+		AgentVersion:      p.AgentVersion,
+		SnapshotType:      p.SnapshotType,
+		Sequence:          p.Sequence,
+		BaseSnapshotID:    p.BaseSnapshotId,
+		Delta:             snapshotDeltaToModel(p.Delta),
+		Part:              snapshotPartToModel(p.Part),
+		Provider:          p.Provider,
+		Region:            p.Region,
+		CloudAccountID:    p.CloudAccountId,
+		KubernetesVersion: p.KubernetesVersion,
+		Nodes:             toModelSlice(p.Nodes, nodeInfoToModel),
+		Pods:              toModelSlice(p.Pods, podInfoToModel),
+		Namespaces:        toModelSlice(p.Namespaces, namespaceInfoToModel),
+		Deployments:       toModelSlice(p.Deployments, deploymentInfoToModel),
+		StatefulSets:      toModelSlice(p.Statefulsets, statefulSetInfoToModel),
+		DaemonSets:        toModelSlice(p.Daemonsets, daemonSetInfoToModel),
+		Jobs:              toModelSlice(p.Jobs, jobInfoToModel),
+		CronJobs:          toModelSlice(p.Cronjobs, cronJobInfoToModel),
+		CustomWorkloads:   toModelSlice(p.CustomWorkloads, customWorkloadInfoToModel),
+		HPAs:              toModelSlice(p.Hpas, hPAInfoToModel),
+		VPAs:              toModelSlice(p.Vpas, vPAInfoToModel),
+		PDBs:              toModelSlice(p.Pdbs, pDBInfoToModel),
+		Services:          toModelSlice(p.Services, serviceInfoToModel),
+		Ingresses:         toModelSlice(p.Ingresses, ingressInfoToModel),
+		PVs:               toModelSlice(p.Pvs, pVInfoToModel),
+		PVCs:              toModelSlice(p.Pvcs, pVCInfoToModel),
+		StorageClasses:    toModelSlice(p.StorageClasses, storageClassInfoToModel),
+		PriorityClasses:   toModelSlice(p.PriorityClasses, priorityClassInfoToModel),
+		LimitRanges:       toModelSlice(p.LimitRanges, limitRangeInfoToModel),
+		ResourceQuotas:    toModelSlice(p.ResourceQuotas, resourceQuotaInfoToModel),
+		NodePools:         toModelSlice(p.NodePools, nodePoolInfoToModel),
+		Events:            toModelSlice(p.Events, eventInfoToModel),
+		Summary:           deref(clusterSummaryToModel(p.Summary)),
+		Health:            deref(agentHealthToModel(p.Health)),
+	}
+}
+
+func snapshotDeltaFromModel(m *model.SnapshotDelta) *SnapshotDelta {
+	if m == nil {
+		return nil
+	}
+	return &SnapshotDelta{
+		Added:   stringListMap(m.Added),
+		Updated: stringListMap(m.Updated),
+		Deleted: stringListMap(m.Deleted),
+	}
+}
+
+func snapshotDeltaToModel(p *SnapshotDelta) *model.SnapshotDelta {
+	if p == nil {
+		return nil
+	}
+	return &model.SnapshotDelta{
+		Added:   stringSliceMap(p.Added),
+		Updated: stringSliceMap(p.Updated),
+		Deleted: stringSliceMap(p.Deleted),
+	}
+}
+
+func snapshotPartFromModel(m *model.SnapshotPart) *SnapshotPart {
+	if m == nil {
+		return nil
+	}
+	return &SnapshotPart{
+		Index:  int64(m.Index),
+		Count:  int64(m.Count),
+		Family: m.Family,
+	}
+}
+
+func snapshotPartToModel(p *SnapshotPart) *model.SnapshotPart {
+	if p == nil {
+		return nil
+	}
+	return &model.SnapshotPart{
+		Index:  int(p.Index),
+		Count:  int(p.Count),
+		Family: p.Family,
+	}
+}
+
+func nodeInfoFromModel(m *model.NodeInfo) *NodeInfo {
+	if m == nil {
+		return nil
+	}
+	return &NodeInfo{
+		Name:                        m.Name,
+		Uid:                         m.UID,
+		ProviderId:                  m.ProviderID,
+		InstanceId:                  m.InstanceID,
+		InstanceType:                m.InstanceType,
+		Region:                      m.Region,
+		Zone:                        m.Zone,
+		CapacityType:                m.CapacityType,
+		NodeGroup:                   m.NodeGroup,
+		Architecture:                m.Architecture,
+		Os:                          m.OS,
+		KubeletVersion:              m.KubeletVersion,
+		ContainerRuntime:            m.ContainerRuntime,
+		PodCidr:                     m.PodCIDR,
+		PodCidrs:                    m.PodCIDRs,
+		OsImage:                     m.OSImage,
+		KernelVersion:               m.KernelVersion,
+		KubeProxyVersion:            m.KubeProxyVersion,
+		CpuCapacityCores:            m.CPUCapacityCores,
+		MemoryCapacityBytes:         m.MemoryCapacityBytes,
+		EphemeralStorageBytes:       m.EphemeralStorageBytes,
+		EphemeralStorageAllocatable: m.EphemeralStorageAllocatable,
+		PodCapacity:                 int64(m.PodCapacity),
+		GpuCapacity:                 int64(m.GPUCapacity),
+		CpuAllocatable:              m.CPUAllocatable,
+		MemoryAllocatable:           m.MemoryAllocatable,
+		PodAllocatable:              int64(m.PodAllocatable),
+		GpuAllocatable:              int64(m.GPUAllocatable),
+		GpuVendor:                   m.GPUVendor,
+		GpuModel:                    m.GPUModel,
+		GpuDriverVersion:            m.GPUDriverVersion,
+		MigEnabled:                  m.MIGEnabled,
+		MigDevices:                  int64Map(m.MIGDevices),
+		GpuUtilizationPercent:       m.GPUUtilizationPercent,
+		GpuTensorActivePercent:      m.GPUTensorActivePercent,
+		GpuMemoryUtilPercent:        m.GPUMemoryUtilPercent,
+		GpuMemoryUsedBytes:          m.GPUMemoryUsedBytes,
+		GpuMemoryTotalBytes:         m.GPUMemoryTotalBytes,
+		GpuTemperatureCelsius:       m.GPUTemperatureCelsius,
+		GpuPowerWatts:               m.GPUPowerWatts,
+		GpuDevices:                  fromModelSlice(m.GPUDevices, gPUDeviceInfoFromModel),
+		GpuHealth:                   m.GPUHealth,
+		GpuUnhealthyDevices:         int64(m.GPUUnhealthyDevices),
+		GpuIdle:                     gPUIdleInfoFromModel(m.GPUIdle),
+		CpuUsageCores:               m.CPUUsageCores,
+		MemoryUsageBytes:            m.MemoryUsageBytes,
+		EphemeralStorageUsedBytes:   m.EphemeralStorageUsedBytes,
+		ImagefsUsedBytes:            m.ImageFSUsedBytes,
+		NetworkRxBytes:              m.NetworkRxBytes,
+		NetworkTxBytes:              m.NetworkTxBytes,
+		HourlyCost:                  m.HourlyCost,
+		IdleHourlyCost:              m.IdleHourlyCost,
+		PricingSource:               m.PricingSource,
+		Ready:                       m.Ready,
+		Unschedulable:               m.Unschedulable,
+		Taints:                      fromModelSlice(m.Taints, taintInfoFromModel),
+		Conditions:                  fromModelSlice(m.Conditions, nodeConditionInfoFromModel),
+		EventReasons:                int64Map(m.EventReasons),
+		Labels:                      m.Labels,
+		Annotations:                 m.Annotations,
+		CreationTimestamp:           m.CreationTimestamp,
+	}
+}
+
+func nodeInfoToModel(p *NodeInfo) *model.NodeInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.NodeInfo{
+		Name:                        p.Name,
+		UID:                         p.Uid,
+		ProviderID:                  p.ProviderId,
+		InstanceID:                  p.InstanceId,
+		InstanceType:                p.InstanceType,
+		Region:                      p.Region,
+		Zone:                        p.Zone,
+		CapacityType:                p.CapacityType,
+		NodeGroup:                   p.NodeGroup,
+		Architecture:                p.Architecture,
+		OS:                          p.Os,
+		KubeletVersion:              p.KubeletVersion,
+		ContainerRuntime:            p.ContainerRuntime,
+		PodCIDR:                     p.PodCidr,
+		PodCIDRs:                    p.PodCidrs,
+		OSImage:                     p.OsImage,
+		KernelVersion:               p.KernelVersion,
+		KubeProxyVersion:            p.KubeProxyVersion,
+		CPUCapacityCores:            p.CpuCapacityCores,
+		MemoryCapacityBytes:         p.MemoryCapacityBytes,
+		EphemeralStorageBytes:       p.EphemeralStorageBytes,
+		EphemeralStorageAllocatable: p.EphemeralStorageAllocatable,
+		PodCapacity:                 int(p.PodCapacity),
+		GPUCapacity:                 int(p.GpuCapacity),
+		CPUAllocatable:              p.CpuAllocatable,
+		MemoryAllocatable:           p.MemoryAllocatable,
+		PodAllocatable:              int(p.PodAllocatable),
+		GPUAllocatable:              int(p.GpuAllocatable),
+		GPUVendor:                   p.GpuVendor,
+		GPUModel:                    p.GpuModel,
+		GPUDriverVersion:            p.GpuDriverVersion,
+		MIGEnabled:                  p.MigEnabled,
+		MIGDevices:                  intMap(p.MigDevices),
+		GPUUtilizationPercent:       p.GpuUtilizationPercent,
+		GPUTensorActivePercent:      p.GpuTensorActivePercent,
+		GPUMemoryUtilPercent:        p.GpuMemoryUtilPercent,
+		GPUMemoryUsedBytes:          p.GpuMemoryUsedBytes,
+		GPUMemoryTotalBytes:         p.GpuMemoryTotalBytes,
+		GPUTemperatureCelsius:       p.GpuTemperatureCelsius,
+		GPUPowerWatts:               p.GpuPowerWatts,
+		GPUDevices:                  toModelSlice(p.GpuDevices, gPUDeviceInfoToModel),
+		GPUHealth:                   p.GpuHealth,
+		GPUUnhealthyDevices:         int(p.GpuUnhealthyDevices),
+		GPUIdle:                     gPUIdleInfoToModel(p.GpuIdle),
+		CPUUsageCores:               p.CpuUsageCores,
+		MemoryUsageBytes:            p.MemoryUsageBytes,
+		EphemeralStorageUsedBytes:   p.EphemeralStorageUsedBytes,
+		ImageFSUsedBytes:            p.ImagefsUsedBytes,
+		NetworkRxBytes:              p.NetworkRxBytes,
+		NetworkTxBytes:              p.NetworkTxBytes,
+		HourlyCost:                  p.HourlyCost,
+		IdleHourlyCost:              p.IdleHourlyCost,
+		PricingSource:               p.PricingSource,
+		Ready:                       p.Ready,
+		Unschedulable:               p.Unschedulable,
+		Taints:                      toModelSlice(p.Taints, taintInfoToModel),
+		Conditions:                  toModelSlice(p.Conditions, nodeConditionInfoToModel),
+		EventReasons:                intMap(p.EventReasons),
+		Labels:                      p.Labels,
+		Annotations:                 p.Annotations,
+		CreationTimestamp:           p.CreationTimestamp,
+	}
+}
+
+func podInfoFromModel(m *model.PodInfo) *PodInfo {
+	if m == nil {
+		return nil
+	}
+	return &PodInfo{
+		Name:                      m.Name,
+		Uid:                       m.UID,
+		Namespace:                 m.Namespace,
+		NodeName:                  m.NodeName,
+		Phase:                     m.Phase,
+		Reason:                    m.Reason,
+		QosClass:                  m.QoSClass,
+		OwnerKind:                 m.OwnerKind,
+		OwnerName:                 m.OwnerName,
+		OwnerUid:                  m.OwnerUID,
+		OwnerApiVersion:           m.OwnerAPIVersion,
+		Containers:                fromModelSlice(m.Containers, containerInfoFromModel),
+		InitContainers:            fromModelSlice(m.InitContainers, containerInfoFromModel),
+		Labels:                    m.Labels,
+		Annotations:               m.Annotations,
+		CreationTimestamp:         m.CreationTimestamp,
+		PriorityClassName:         m.PriorityClassName,
+		Priority:                  m.Priority,
+		SchedulerName:             m.SchedulerName,
+		ServiceAccountName:        m.ServiceAccountName,
+		PodIp:                     m.PodIP,
+		HostIp:                    m.HostIP,
+		HostNetwork:               m.HostNetwork,
+		HasHostpath:               m.HasHostPath,
+		HasEmptydir:               m.HasEmptyDir,
+		Volumes:                   fromModelSlice(m.Volumes, podVolumeInfoFromModel),
+		EphemeralStorageUsedBytes: m.EphemeralStorageUsedBytes,
+		NetworkRxBytes:            m.NetworkRxBytes,
+		NetworkTxBytes:            m.NetworkTxBytes,
+		HourlyCost:                m.HourlyCost,
+		Conditions:                fromModelSlice(m.Conditions, podConditionInfoFromModel),
+		EventReasons:              int64Map(m.EventReasons),
+	}
+}
+
+func podInfoToModel(p *PodInfo) *model.PodInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PodInfo{
+		Name:                      p.Name,
+		UID:                       p.Uid,
+		Namespace:                 p.Namespace,
+		NodeName:                  p.NodeName,
+		Phase:                     p.Phase,
+		Reason:                    p.Reason,
+		QoSClass:                  p.QosClass,
+		OwnerKind:                 p.OwnerKind,
+		OwnerName:                 p.OwnerName,
+		OwnerUID:                  p.OwnerUid,
+		OwnerAPIVersion:           p.OwnerApiVersion,
+		Containers:                toModelSlice(p.Containers, containerInfoToModel),
+		InitContainers:            toModelSlice(p.InitContainers, containerInfoToModel),
+		Labels:                    p.Labels,
+		Annotations:               p.Annotations,
+		CreationTimestamp:         p.CreationTimestamp,
+		PriorityClassName:         p.PriorityClassName,
+		Priority:                  p.Priority,
+		SchedulerName:             p.SchedulerName,
+		ServiceAccountName:        p.ServiceAccountName,
+		PodIP:                     p.PodIp,
+		HostIP:                    p.HostIp,
+		HostNetwork:               p.HostNetwork,
+		HasHostPath:               p.HasHostpath,
+		HasEmptyDir:               p.HasEmptydir,
+		Volumes:                   toModelSlice(p.Volumes, podVolumeInfoToModel),
+		EphemeralStorageUsedBytes: p.EphemeralStorageUsedBytes,
+		NetworkRxBytes:            p.NetworkRxBytes,
+		NetworkTxBytes:            p.NetworkTxBytes,
+		HourlyCost:                p.HourlyCost,
+		Conditions:                toModelSlice(p.Conditions, podConditionInfoToModel),
+		EventReasons:              intMap(p.EventReasons),
+	}
+}
+
+func namespaceInfoFromModel(m *model.NamespaceInfo) *NamespaceInfo {
+	if m == nil {
+		return nil
+	}
+	return &NamespaceInfo{
+		Name:              m.Name,
+		Phase:             m.Phase,
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		CreationTimestamp: m.CreationTimestamp,
+	}
+}
+
+func namespaceInfoToModel(p *NamespaceInfo) *model.NamespaceInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.NamespaceInfo{
+		Name:              p.Name,
+		Phase:             p.Phase,
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		CreationTimestamp: p.CreationTimestamp,
+	}
+}
+
+func deploymentInfoFromModel(m *model.DeploymentInfo) *DeploymentInfo {
+	if m == nil {
+		return nil
+	}
+	return &DeploymentInfo{
+		Name:                  m.Name,
+		Uid:                   m.UID,
+		Namespace:             m.Namespace,
+		Replicas:              m.Replicas,
+		ReadyReplicas:         m.ReadyReplicas,
+		AvailableReplicas:     m.AvailableReplicas,
+		UnavailableReplicas:   m.UnavailableReplicas,
+		UpdatedReplicas:       m.UpdatedReplicas,
+		Strategy:              m.Strategy,
+		MaxSurge:              m.MaxSurge,
+		MaxUnavailable:        m.MaxUnavailable,
+		TotalCpuRequest:       m.TotalCPURequest,
+		TotalMemoryRequest:    m.TotalMemoryRequest,
+		TotalCpuLimit:         m.TotalCPULimit,
+		TotalMemoryLimit:      m.TotalMemoryLimit,
+		TotalCpuUsage:         m.TotalCPUUsage,
+		TotalMemoryUsage:      m.TotalMemoryUsage,
+		HourlyCost:            m.HourlyCost,
+		GpuIdle:               gPUIdleInfoFromModel(m.GPUIdle),
+		Recommendations:       fromModelSlice(m.Recommendations, resourceRecommendationFromModel),
+		TotalPvcCapacityBytes: m.TotalPVCCapacityBytes,
+		ContainerSpecs:        fromModelSlice(m.ContainerSpecs, containerSpecInfoFromModel),
+		Selector:              m.Selector,
+		Labels:                m.Labels,
+		Annotations:           m.Annotations,
+		CreationTimestamp:     m.CreationTimestamp,
+		Conditions:            fromModelSlice(m.Conditions, workloadConditionInfoFromModel),
+		Paused:                m.Paused,
+		EventReasons:          int64Map(m.EventReasons),
+	}
+}
+
+func deploymentInfoToModel(p *DeploymentInfo) *model.DeploymentInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.DeploymentInfo{
+		Name:                  p.Name,
+		UID:                   p.Uid,
+		Namespace:             p.Namespace,
+		Replicas:              p.Replicas,
+		ReadyReplicas:         p.ReadyReplicas,
+		AvailableReplicas:     p.AvailableReplicas,
+		UnavailableReplicas:   p.UnavailableReplicas,
+		UpdatedReplicas:       p.UpdatedReplicas,
+		Strategy:              p.Strategy,
+		MaxSurge:              p.MaxSurge,
+		MaxUnavailable:        p.MaxUnavailable,
+		TotalCPURequest:       p.TotalCpuRequest,
+		TotalMemoryRequest:    p.TotalMemoryRequest,
+		TotalCPULimit:         p.TotalCpuLimit,
+		TotalMemoryLimit:      p.TotalMemoryLimit,
+		TotalCPUUsage:         p.TotalCpuUsage,
+		TotalMemoryUsage:      p.TotalMemoryUsage,
+		HourlyCost:            p.HourlyCost,
+		GPUIdle:               gPUIdleInfoToModel(p.GpuIdle),
+		Recommendations:       toModelSlice(p.Recommendations, resourceRecommendationToModel),
+		TotalPVCCapacityBytes: p.TotalPvcCapacityBytes,
+		ContainerSpecs:        toModelSlice(p.ContainerSpecs, containerSpecInfoToModel),
+		Selector:              p.Selector,
+		Labels:                p.Labels,
+		Annotations:           p.Annotations,
+		CreationTimestamp:     p.CreationTimestamp,
+		Conditions:            toModelSlice(p.Conditions, workloadConditionInfoToModel),
+		Paused:                p.Paused,
+		EventReasons:          intMap(p.EventReasons),
+	}
+}
+
+func statefulSetInfoFromModel(m *model.StatefulSetInfo) *StatefulSetInfo {
+	if m == nil {
+		return nil
+	}
+	return &StatefulSetInfo{
+		Name:                  m.Name,
+		Uid:                   m.UID,
+		Namespace:             m.Namespace,
+		Replicas:              m.Replicas,
+		ReadyReplicas:         m.ReadyReplicas,
+		AvailableReplicas:     m.AvailableReplicas,
+		UpdatedReplicas:       m.UpdatedReplicas,
+		Strategy:              m.Strategy,
+		ServiceName:           m.ServiceName,
+		PodManagementPolicy:   m.PodManagementPolicy,
+		VolumeClaimTemplates:  m.VolumeClaimTemplates,
+		TotalCpuRequest:       m.TotalCPURequest,
+		TotalMemoryRequest:    m.TotalMemoryRequest,
+		TotalCpuLimit:         m.TotalCPULimit,
+		TotalMemoryLimit:      m.TotalMemoryLimit,
+		TotalCpuUsage:         m.TotalCPUUsage,
+		TotalMemoryUsage:      m.TotalMemoryUsage,
+		HourlyCost:            m.HourlyCost,
+		GpuIdle:               gPUIdleInfoFromModel(m.GPUIdle),
+		Recommendations:       fromModelSlice(m.Recommendations, resourceRecommendationFromModel),
+		TotalPvcCapacityBytes: m.TotalPVCCapacityBytes,
+		ContainerSpecs:        fromModelSlice(m.ContainerSpecs, containerSpecInfoFromModel),
+		Selector:              m.Selector,
+		Labels:                m.Labels,
+		Annotations:           m.Annotations,
+		CreationTimestamp:     m.CreationTimestamp,
+		Partition:             m.Partition,
+		Conditions:            fromModelSlice(m.Conditions, workloadConditionInfoFromModel),
+		EventReasons:          int64Map(m.EventReasons),
+	}
+}
+
+func statefulSetInfoToModel(p *StatefulSetInfo) *model.StatefulSetInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.StatefulSetInfo{
+		Name:                  p.Name,
+		UID:                   p.Uid,
+		Namespace:             p.Namespace,
+		Replicas:              p.Replicas,
+		ReadyReplicas:         p.ReadyReplicas,
+		AvailableReplicas:     p.AvailableReplicas,
+		UpdatedReplicas:       p.UpdatedReplicas,
+		Strategy:              p.Strategy,
+		ServiceName:           p.ServiceName,
+		PodManagementPolicy:   p.PodManagementPolicy,
+		VolumeClaimTemplates:  p.VolumeClaimTemplates,
+		TotalCPURequest:       p.TotalCpuRequest,
+		TotalMemoryRequest:    p.TotalMemoryRequest,
+		TotalCPULimit:         p.TotalCpuLimit,
+		TotalMemoryLimit:      p.TotalMemoryLimit,
+		TotalCPUUsage:         p.TotalCpuUsage,
+		TotalMemoryUsage:      p.TotalMemoryUsage,
+		HourlyCost:            p.HourlyCost,
+		GPUIdle:               gPUIdleInfoToModel(p.GpuIdle),
+		Recommendations:       toModelSlice(p.Recommendations, resourceRecommendationToModel),
+		TotalPVCCapacityBytes: p.TotalPvcCapacityBytes,
+		ContainerSpecs:        toModelSlice(p.ContainerSpecs, containerSpecInfoToModel),
+		Selector:              p.Selector,
+		Labels:                p.Labels,
+		Annotations:           p.Annotations,
+		CreationTimestamp:     p.CreationTimestamp,
+		Partition:             p.Partition,
+		Conditions:            toModelSlice(p.Conditions, workloadConditionInfoToModel),
+		EventReasons:          intMap(p.EventReasons),
+	}
+}
+
+func daemonSetInfoFromModel(m *model.DaemonSetInfo) *DaemonSetInfo {
+	if m == nil {
+		return nil
+	}
+	return &DaemonSetInfo{
+		Name:                   m.Name,
+		Uid:                    m.UID,
+		Namespace:              m.Namespace,
+		DesiredNumberScheduled: m.DesiredNumberScheduled,
+		CurrentNumberScheduled: m.CurrentNumberScheduled,
+		NumberReady:            m.NumberReady,
+		NumberMisscheduled:     m.NumberMisscheduled,
+		UpdatedNumberScheduled: m.UpdatedNumberScheduled,
+		NumberUnavailable:      m.NumberUnavailable,
+		NumberAvailable:        m.NumberAvailable,
+		Strategy:               m.Strategy,
+		TotalCpuRequest:        m.TotalCPURequest,
+		TotalMemoryRequest:     m.TotalMemoryRequest,
+		TotalCpuLimit:          m.TotalCPULimit,
+		TotalMemoryLimit:       m.TotalMemoryLimit,
+		TotalCpuUsage:          m.TotalCPUUsage,
+		TotalMemoryUsage:       m.TotalMemoryUsage,
+		HourlyCost:             m.HourlyCost,
+		GpuIdle:                gPUIdleInfoFromModel(m.GPUIdle),
+		Recommendations:        fromModelSlice(m.Recommendations, resourceRecommendationFromModel),
+		ContainerSpecs:         fromModelSlice(m.ContainerSpecs, containerSpecInfoFromModel),
+		Selector:               m.Selector,
+		Labels:                 m.Labels,
+		Annotations:            m.Annotations,
+		CreationTimestamp:      m.CreationTimestamp,
+		Conditions:             fromModelSlice(m.Conditions, workloadConditionInfoFromModel),
+		EventReasons:           int64Map(m.EventReasons),
+	}
+}
+
+func daemonSetInfoToModel(p *DaemonSetInfo) *model.DaemonSetInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.DaemonSetInfo{
+		Name:                   p.Name,
+		UID:                    p.Uid,
+		Namespace:              p.Namespace,
+		DesiredNumberScheduled: p.DesiredNumberScheduled,
+		CurrentNumberScheduled: p.CurrentNumberScheduled,
+		NumberReady:            p.NumberReady,
+		NumberMisscheduled:     p.NumberMisscheduled,
+		UpdatedNumberScheduled: p.UpdatedNumberScheduled,
+		NumberUnavailable:      p.NumberUnavailable,
+		NumberAvailable:        p.NumberAvailable,
+		Strategy:               p.Strategy,
+		TotalCPURequest:        p.TotalCpuRequest,
+		TotalMemoryRequest:     p.TotalMemoryRequest,
+		TotalCPULimit:          p.TotalCpuLimit,
+		TotalMemoryLimit:       p.TotalMemoryLimit,
+		TotalCPUUsage:          p.TotalCpuUsage,
+		TotalMemoryUsage:       p.TotalMemoryUsage,
+		HourlyCost:             p.HourlyCost,
+		GPUIdle:                gPUIdleInfoToModel(p.GpuIdle),
+		Recommendations:        toModelSlice(p.Recommendations, resourceRecommendationToModel),
+		ContainerSpecs:         toModelSlice(p.ContainerSpecs, containerSpecInfoToModel),
+		Selector:               p.Selector,
+		Labels:                 p.Labels,
+		Annotations:            p.Annotations,
+		CreationTimestamp:      p.CreationTimestamp,
+		Conditions:             toModelSlice(p.Conditions, workloadConditionInfoToModel),
+		EventReasons:           intMap(p.EventReasons),
+	}
+}
+
+func jobInfoFromModel(m *model.JobInfo) *JobInfo {
+	if m == nil {
+		return nil
+	}
+	return &JobInfo{
+		Name:                    m.Name,
+		Uid:                     m.UID,
+		Namespace:               m.Namespace,
+		OwnerCronjob:            m.OwnerCronJob,
+		Completions:             m.Completions,
+		Parallelism:             m.Parallelism,
+		BackoffLimit:            m.BackoffLimit,
+		ActiveDeadlineSeconds:   m.ActiveDeadlineSeconds,
+		TtlSecondsAfterFinished: m.TTLSecondsAfterFinished,
+		Active:                  m.Active,
+		Succeeded:               m.Succeeded,
+		Failed:                  m.Failed,
+		StartTime:               m.StartTime,
+		CompletionTime:          m.CompletionTime,
+		DurationSeconds:         m.DurationSeconds,
+		TotalCpuRequest:         m.TotalCPURequest,
+		TotalMemoryRequest:      m.TotalMemoryRequest,
+		TotalCpuUsage:           m.TotalCPUUsage,
+		TotalMemoryUsage:        m.TotalMemoryUsage,
+		HourlyCost:              m.HourlyCost,
+		GpuIdle:                 gPUIdleInfoFromModel(m.GPUIdle),
+		ContainerSpecs:          fromModelSlice(m.ContainerSpecs, containerSpecInfoFromModel),
+		Labels:                  m.Labels,
+		Annotations:             m.Annotations,
+		CreationTimestamp:       m.CreationTimestamp,
+		Conditions:              fromModelSlice(m.Conditions, jobConditionInfoFromModel),
+		EventReasons:            int64Map(m.EventReasons),
+	}
+}
+
+func jobInfoToModel(p *JobInfo) *model.JobInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.JobInfo{
+		Name:                    p.Name,
+		UID:                     p.Uid,
+		Namespace:               p.Namespace,
+		OwnerCronJob:            p.OwnerCronjob,
+		Completions:             p.Completions,
+		Parallelism:             p.Parallelism,
+		BackoffLimit:            p.BackoffLimit,
+		ActiveDeadlineSeconds:   p.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: p.TtlSecondsAfterFinished,
+		Active:                  p.Active,
+		Succeeded:               p.Succeeded,
+		Failed:                  p.Failed,
+		StartTime:               p.StartTime,
+		CompletionTime:          p.CompletionTime,
+		DurationSeconds:         p.DurationSeconds,
+		TotalCPURequest:         p.TotalCpuRequest,
+		TotalMemoryRequest:      p.TotalMemoryRequest,
+		TotalCPUUsage:           p.TotalCpuUsage,
+		TotalMemoryUsage:        p.TotalMemoryUsage,
+		HourlyCost:              p.HourlyCost,
+		GPUIdle:                 gPUIdleInfoToModel(p.GpuIdle),
+		ContainerSpecs:          toModelSlice(p.ContainerSpecs, containerSpecInfoToModel),
+		Labels:                  p.Labels,
+		Annotations:             p.Annotations,
+		CreationTimestamp:       p.CreationTimestamp,
+		Conditions:              toModelSlice(p.Conditions, jobConditionInfoToModel),
+		EventReasons:            intMap(p.EventReasons),
+	}
+}
+
+func cronJobInfoFromModel(m *model.CronJobInfo) *CronJobInfo {
+	if m == nil {
+		return nil
+	}
+	return &CronJobInfo{
+		Name:               m.Name,
+		Uid:                m.UID,
+		Namespace:          m.Namespace,
+		Schedule:           m.Schedule,
+		Suspend:            m.Suspend,
+		ConcurrencyPolicy:  m.ConcurrencyPolicy,
+		LastScheduleTime:   m.LastScheduleTime,
+		LastSuccessfulTime: m.LastSuccessfulTime,
+		ActiveJobs:         m.ActiveJobs,
+		HourlyCost:         m.HourlyCost,
+		GpuIdle:            gPUIdleInfoFromModel(m.GPUIdle),
+		ContainerSpecs:     fromModelSlice(m.ContainerSpecs, containerSpecInfoFromModel),
+		Labels:             m.Labels,
+		Annotations:        m.Annotations,
+		CreationTimestamp:  m.CreationTimestamp,
+		EventReasons:       int64Map(m.EventReasons),
+	}
+}
+
+func cronJobInfoToModel(p *CronJobInfo) *model.CronJobInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.CronJobInfo{
+		Name:               p.Name,
+		UID:                p.Uid,
+		Namespace:          p.Namespace,
+		Schedule:           p.Schedule,
+		Suspend:            p.Suspend,
+		ConcurrencyPolicy:  p.ConcurrencyPolicy,
+		LastScheduleTime:   p.LastScheduleTime,
+		LastSuccessfulTime: p.LastSuccessfulTime,
+		ActiveJobs:         p.ActiveJobs,
+		HourlyCost:         p.HourlyCost,
+		GPUIdle:            gPUIdleInfoToModel(p.GpuIdle),
+		ContainerSpecs:     toModelSlice(p.ContainerSpecs, containerSpecInfoToModel),
+		Labels:             p.Labels,
+		Annotations:        p.Annotations,
+		CreationTimestamp:  p.CreationTimestamp,
+		EventReasons:       intMap(p.EventReasons),
+	}
+}
+
+func customWorkloadInfoFromModel(m *model.CustomWorkloadInfo) *CustomWorkloadInfo {
+	if m == nil {
+		return nil
+	}
+	return &CustomWorkloadInfo{
+		ApiVersion:         m.APIVersion,
+		Kind:               m.Kind,
+		Name:               m.Name,
+		Uid:                m.UID,
+		Namespace:          m.Namespace,
+		Replicas:           m.Replicas,
+		ReadyReplicas:      m.ReadyReplicas,
+		OwnerKind:          m.OwnerKind,
+		OwnerName:          m.OwnerName,
+		OwnerUid:           m.OwnerUID,
+		OwnerApiVersion:    m.OwnerAPIVersion,
+		Status:             newStruct(m.Status),
+		PodCount:           int64(m.PodCount),
+		TotalCpuRequest:    m.TotalCPURequest,
+		TotalMemoryRequest: m.TotalMemoryRequest,
+		TotalCpuUsage:      m.TotalCPUUsage,
+		TotalMemoryUsage:   m.TotalMemoryUsage,
+		HourlyCost:         m.HourlyCost,
+		GpuIdle:            gPUIdleInfoFromModel(m.GPUIdle),
+		Labels:             m.Labels,
+		Annotations:        m.Annotations,
+		CreationTimestamp:  m.CreationTimestamp,
+		EventReasons:       int64Map(m.EventReasons),
+	}
+}
+
+func customWorkloadInfoToModel(p *CustomWorkloadInfo) *model.CustomWorkloadInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.CustomWorkloadInfo{
+		APIVersion:         p.ApiVersion,
+		Kind:               p.Kind,
+		Name:               p.Name,
+		UID:                p.Uid,
+		Namespace:          p.Namespace,
+		Replicas:           p.Replicas,
+		ReadyReplicas:      p.ReadyReplicas,
+		OwnerKind:          p.OwnerKind,
+		OwnerName:          p.OwnerName,
+		OwnerUID:           p.OwnerUid,
+		OwnerAPIVersion:    p.OwnerApiVersion,
+		Status:             structMap(p.Status),
+		PodCount:           int(p.PodCount),
+		TotalCPURequest:    p.TotalCpuRequest,
+		TotalMemoryRequest: p.TotalMemoryRequest,
+		TotalCPUUsage:      p.TotalCpuUsage,
+		TotalMemoryUsage:   p.TotalMemoryUsage,
+		HourlyCost:         p.HourlyCost,
+		GPUIdle:            gPUIdleInfoToModel(p.GpuIdle),
+		Labels:             p.Labels,
+		Annotations:        p.Annotations,
+		CreationTimestamp:  p.CreationTimestamp,
+		EventReasons:       intMap(p.EventReasons),
+	}
+}
+
+func hPAInfoFromModel(m *model.HPAInfo) *HPAInfo {
+	if m == nil {
+		return nil
+	}
+	return &HPAInfo{
+		Name:              m.Name,
+		Uid:               m.UID,
+		Namespace:         m.Namespace,
+		TargetKind:        m.TargetKind,
+		TargetName:        m.TargetName,
+		TargetApiVersion:  m.TargetAPIVersion,
+		MinReplicas:       m.MinReplicas,
+		MaxReplicas:       m.MaxReplicas,
+		CurrentReplicas:   m.CurrentReplicas,
+		DesiredReplicas:   m.DesiredReplicas,
+		Metrics:           fromModelSlice(m.Metrics, hPAMetricInfoFromModel),
+		CurrentMetrics:    fromModelSlice(m.CurrentMetrics, hPACurrentMetricInfoFromModel),
+		ScaleUpBehavior:   hPAScalingBehaviorFromModel(m.ScaleUpBehavior),
+		ScaleDownBehavior: hPAScalingBehaviorFromModel(m.ScaleDownBehavior),
+		Conditions:        fromModelSlice(m.Conditions, hPAConditionInfoFromModel),
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		CreationTimestamp: m.CreationTimestamp,
+		LastScaleTime:     m.LastScaleTime,
+	}
+}
+
+func hPAInfoToModel(p *HPAInfo) *model.HPAInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.HPAInfo{
+		Name:              p.Name,
+		UID:               p.Uid,
+		Namespace:         p.Namespace,
+		TargetKind:        p.TargetKind,
+		TargetName:        p.TargetName,
+		TargetAPIVersion:  p.TargetApiVersion,
+		MinReplicas:       p.MinReplicas,
+		MaxReplicas:       p.MaxReplicas,
+		CurrentReplicas:   p.CurrentReplicas,
+		DesiredReplicas:   p.DesiredReplicas,
+		Metrics:           toModelSlice(p.Metrics, hPAMetricInfoToModel),
+		CurrentMetrics:    toModelSlice(p.CurrentMetrics, hPACurrentMetricInfoToModel),
+		ScaleUpBehavior:   hPAScalingBehaviorToModel(p.ScaleUpBehavior),
+		ScaleDownBehavior: hPAScalingBehaviorToModel(p.ScaleDownBehavior),
+		Conditions:        toModelSlice(p.Conditions, hPAConditionInfoToModel),
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		CreationTimestamp: p.CreationTimestamp,
+		LastScaleTime:     p.LastScaleTime,
+	}
+}
+
+func vPAInfoFromModel(m *model.VPAInfo) *VPAInfo {
+	if m == nil {
+		return nil
+	}
+	return &VPAInfo{
+		Name:                     m.Name,
+		Namespace:                m.Namespace,
+		TargetKind:               m.TargetKind,
+		TargetName:               m.TargetName,
+		TargetApiVersion:         m.TargetAPIVersion,
+		UpdateMode:               m.UpdateMode,
+		ContainerRecommendations: fromModelSlice(m.ContainerRecommendations, vPAContainerRecommendationFromModel),
+		Conditions:               fromModelSlice(m.Conditions, vPAConditionInfoFromModel),
+		Labels:                   m.Labels,
+		CreationTimestamp:        m.CreationTimestamp,
+	}
+}
+
+func vPAInfoToModel(p *VPAInfo) *model.VPAInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.VPAInfo{
+		Name:                     p.Name,
+		Namespace:                p.Namespace,
+		TargetKind:               p.TargetKind,
+		TargetName:               p.TargetName,
+		TargetAPIVersion:         p.TargetApiVersion,
+		UpdateMode:               p.UpdateMode,
+		ContainerRecommendations: toModelSlice(p.ContainerRecommendations, vPAContainerRecommendationToModel),
+		Conditions:               toModelSlice(p.Conditions, vPAConditionInfoToModel),
+		Labels:                   p.Labels,
+		CreationTimestamp:        p.CreationTimestamp,
+	}
+}
+
+func pDBInfoFromModel(m *model.PDBInfo) *PDBInfo {
+	if m == nil {
+		return nil
+	}
+	return &PDBInfo{
+		Name:               m.Name,
+		Uid:                m.UID,
+		Namespace:          m.Namespace,
+		MatchLabels:        m.MatchLabels,
+		MatchExpressions:   fromModelSlice(m.MatchExpressions, labelSelectorRequirementFromModel),
+		TargetWorkloads:    fromModelSlice(m.TargetWorkloads, workloadReferenceFromModel),
+		MinAvailable:       m.MinAvailable,
+		MaxUnavailable:     m.MaxUnavailable,
+		CurrentHealthy:     m.CurrentHealthy,
+		DesiredHealthy:     m.DesiredHealthy,
+		DisruptionsAllowed: m.DisruptionsAllowed,
+		ExpectedPods:       m.ExpectedPods,
+		Conditions:         fromModelSlice(m.Conditions, pDBConditionInfoFromModel),
+		Labels:             m.Labels,
+		Annotations:        m.Annotations,
+		CreationTimestamp:  m.CreationTimestamp,
+	}
+}
+
+func pDBInfoToModel(p *PDBInfo) *model.PDBInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PDBInfo{
+		Name:               p.Name,
+		UID:                p.Uid,
+		Namespace:          p.Namespace,
+		MatchLabels:        p.MatchLabels,
+		MatchExpressions:   toModelSlice(p.MatchExpressions, labelSelectorRequirementToModel),
+		TargetWorkloads:    toModelSlice(p.TargetWorkloads, workloadReferenceToModel),
+		MinAvailable:       p.MinAvailable,
+		MaxUnavailable:     p.MaxUnavailable,
+		CurrentHealthy:     p.CurrentHealthy,
+		DesiredHealthy:     p.DesiredHealthy,
+		DisruptionsAllowed: p.DisruptionsAllowed,
+		ExpectedPods:       p.ExpectedPods,
+		Conditions:         toModelSlice(p.Conditions, pDBConditionInfoToModel),
+		Labels:             p.Labels,
+		Annotations:        p.Annotations,
+		CreationTimestamp:  p.CreationTimestamp,
+	}
+}
+
+func serviceInfoFromModel(m *model.ServiceInfo) *ServiceInfo {
+	if m == nil {
+		return nil
+	}
+	return &ServiceInfo{
+		Name:              m.Name,
+		Namespace:         m.Namespace,
+		Type:              m.Type,
+		ClusterIp:         m.ClusterIP,
+		ClusterIps:        m.ClusterIPs,
+		ExternalIps:       m.ExternalIPs,
+		LoadBalancer:      loadBalancerInfoFromModel(m.LoadBalancer),
+		Ports:             fromModelSlice(m.Ports, servicePortInfoFromModel),
+		Selector:          m.Selector,
+		TargetWorkloads:   fromModelSlice(m.TargetWorkloads, workloadReferenceFromModel),
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		CreationTimestamp: m.CreationTimestamp,
+		SessionAffinity:   m.SessionAffinity,
+	}
+}
+
+func serviceInfoToModel(p *ServiceInfo) *model.ServiceInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ServiceInfo{
+		Name:              p.Name,
+		Namespace:         p.Namespace,
+		Type:              p.Type,
+		ClusterIP:         p.ClusterIp,
+		ClusterIPs:        p.ClusterIps,
+		ExternalIPs:       p.ExternalIps,
+		LoadBalancer:      loadBalancerInfoToModel(p.LoadBalancer),
+		Ports:             toModelSlice(p.Ports, servicePortInfoToModel),
+		Selector:          p.Selector,
+		TargetWorkloads:   toModelSlice(p.TargetWorkloads, workloadReferenceToModel),
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		CreationTimestamp: p.CreationTimestamp,
+		SessionAffinity:   p.SessionAffinity,
+	}
+}
+
+func ingressInfoFromModel(m *model.IngressInfo) *IngressInfo {
+	if m == nil {
+		return nil
+	}
+	return &IngressInfo{
+		Name:                  m.Name,
+		Namespace:             m.Namespace,
+		IngressClassName:      m.IngressClassName,
+		Rules:                 fromModelSlice(m.Rules, ingressRuleInfoFromModel),
+		Tls:                   fromModelSlice(m.TLS, ingressTLSInfoFromModel),
+		DefaultBackend:        ingressBackendInfoFromModel(m.DefaultBackend),
+		Labels:                m.Labels,
+		Annotations:           m.Annotations,
+		CreationTimestamp:     m.CreationTimestamp,
+		LoadBalancerHostnames: m.LoadBalancerHostnames,
+	}
+}
+
+func ingressInfoToModel(p *IngressInfo) *model.IngressInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.IngressInfo{
+		Name:                  p.Name,
+		Namespace:             p.Namespace,
+		IngressClassName:      p.IngressClassName,
+		Rules:                 toModelSlice(p.Rules, ingressRuleInfoToModel),
+		TLS:                   toModelSlice(p.Tls, ingressTLSInfoToModel),
+		DefaultBackend:        ingressBackendInfoToModel(p.DefaultBackend),
+		Labels:                p.Labels,
+		Annotations:           p.Annotations,
+		CreationTimestamp:     p.CreationTimestamp,
+		LoadBalancerHostnames: p.LoadBalancerHostnames,
+	}
+}
+
+func pVInfoFromModel(m *model.PVInfo) *PVInfo {
+	if m == nil {
+		return nil
+	}
+	return &PVInfo{
+		Name:              m.Name,
+		Capacity:          m.Capacity,
+		AccessModes:       m.AccessModes,
+		ReclaimPolicy:     m.ReclaimPolicy,
+		StorageClassName:  m.StorageClassName,
+		VolumeMode:        m.VolumeMode,
+		Phase:             m.Phase,
+		MountOptions:      m.MountOptions,
+		Source:            pVSourceInfoFromModel(&m.Source),
+		ClaimRef:          pVClaimRefInfoFromModel(m.ClaimRef),
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		CreationTimestamp: m.CreationTimestamp,
+	}
+}
+
+func pVInfoToModel(p *PVInfo) *model.PVInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PVInfo{
+		Name:              p.Name,
+		Capacity:          p.Capacity,
+		AccessModes:       p.AccessModes,
+		ReclaimPolicy:     p.ReclaimPolicy,
+		StorageClassName:  p.StorageClassName,
+		VolumeMode:        p.VolumeMode,
+		Phase:             p.Phase,
+		MountOptions:      p.MountOptions,
+		Source:            deref(pVSourceInfoToModel(p.Source)),
+		ClaimRef:          pVClaimRefInfoToModel(p.ClaimRef),
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		CreationTimestamp: p.CreationTimestamp,
+	}
+}
+
+func pVCInfoFromModel(m *model.PVCInfo) *PVCInfo {
+	if m == nil {
+		return nil
+	}
+	return &PVCInfo{
+		Name:              m.Name,
+		Namespace:         m.Namespace,
+		Phase:             m.Phase,
+		AccessModes:       m.AccessModes,
+		StorageClassName:  m.StorageClassName,
+		VolumeMode:        m.VolumeMode,
+		VolumeName:        m.VolumeName,
+		RequestedBytes:    m.RequestedBytes,
+		CapacityBytes:     m.CapacityBytes,
+		UsedBytes:         m.UsedBytes,
+		AvailableBytes:    m.AvailableBytes,
+		MountedByPods:     m.MountedByPods,
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		CreationTimestamp: m.CreationTimestamp,
+		Conditions:        fromModelSlice(m.Conditions, pVCConditionInfoFromModel),
+	}
+}
+
+func pVCInfoToModel(p *PVCInfo) *model.PVCInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PVCInfo{
+		Name:              p.Name,
+		Namespace:         p.Namespace,
+		Phase:             p.Phase,
+		AccessModes:       p.AccessModes,
+		StorageClassName:  p.StorageClassName,
+		VolumeMode:        p.VolumeMode,
+		VolumeName:        p.VolumeName,
+		RequestedBytes:    p.RequestedBytes,
+		CapacityBytes:     p.CapacityBytes,
+		UsedBytes:         p.UsedBytes,
+		AvailableBytes:    p.AvailableBytes,
+		MountedByPods:     p.MountedByPods,
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		CreationTimestamp: p.CreationTimestamp,
+		Conditions:        toModelSlice(p.Conditions, pVCConditionInfoToModel),
+	}
+}
+
+func storageClassInfoFromModel(m *model.StorageClassInfo) *StorageClassInfo {
+	if m == nil {
+		return nil
+	}
+	return &StorageClassInfo{
+		Name:                 m.Name,
+		Provisioner:          m.Provisioner,
+		ReclaimPolicy:        m.ReclaimPolicy,
+		VolumeBindingMode:    m.VolumeBindingMode,
+		AllowVolumeExpansion: m.AllowVolumeExpansion,
+		Parameters:           m.Parameters,
+		MountOptions:         m.MountOptions,
+		Labels:               m.Labels,
+		Annotations:          m.Annotations,
+		IsDefault:            m.IsDefault,
+	}
+}
+
+func storageClassInfoToModel(p *StorageClassInfo) *model.StorageClassInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.StorageClassInfo{
+		Name:                 p.Name,
+		Provisioner:          p.Provisioner,
+		ReclaimPolicy:        p.ReclaimPolicy,
+		VolumeBindingMode:    p.VolumeBindingMode,
+		AllowVolumeExpansion: p.AllowVolumeExpansion,
+		Parameters:           p.Parameters,
+		MountOptions:         p.MountOptions,
+		Labels:               p.Labels,
+		Annotations:          p.Annotations,
+		IsDefault:            p.IsDefault,
+	}
+}
+
+func priorityClassInfoFromModel(m *model.PriorityClassInfo) *PriorityClassInfo {
+	if m == nil {
+		return nil
+	}
+	return &PriorityClassInfo{
+		Name:             m.Name,
+		Value:            m.Value,
+		GlobalDefault:    m.GlobalDefault,
+		PreemptionPolicy: m.PreemptionPolicy,
+		Description:      m.Description,
+	}
+}
+
+func priorityClassInfoToModel(p *PriorityClassInfo) *model.PriorityClassInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PriorityClassInfo{
+		Name:             p.Name,
+		Value:            p.Value,
+		GlobalDefault:    p.GlobalDefault,
+		PreemptionPolicy: p.PreemptionPolicy,
+		Description:      p.Description,
+	}
+}
+
+func limitRangeInfoFromModel(m *model.LimitRangeInfo) *LimitRangeInfo {
+	if m == nil {
+		return nil
+	}
+	return &LimitRangeInfo{
+		Name:      m.Name,
+		Namespace: m.Namespace,
+		Limits:    fromModelSlice(m.Limits, limitRangeItemInfoFromModel),
+	}
+}
+
+func limitRangeInfoToModel(p *LimitRangeInfo) *model.LimitRangeInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.LimitRangeInfo{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Limits:    toModelSlice(p.Limits, limitRangeItemInfoToModel),
+	}
+}
+
+func resourceQuotaInfoFromModel(m *model.ResourceQuotaInfo) *ResourceQuotaInfo {
+	if m == nil {
+		return nil
+	}
+	return &ResourceQuotaInfo{
+		Name:      m.Name,
+		Namespace: m.Namespace,
+		Hard:      m.Hard,
+		Used:      m.Used,
+		Labels:    m.Labels,
+	}
+}
+
+func resourceQuotaInfoToModel(p *ResourceQuotaInfo) *model.ResourceQuotaInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ResourceQuotaInfo{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Hard:      p.Hard,
+		Used:      p.Used,
+		Labels:    p.Labels,
+	}
+}
+
+func nodePoolInfoFromModel(m *model.NodePoolInfo) *NodePoolInfo {
+	if m == nil {
+		return nil
+	}
+	return &NodePoolInfo{
+		Name:              m.Name,
+		Uid:               m.UID,
+		MinReplicas:       int64Ptr(m.MinReplicas),
+		MaxReplicas:       int64Ptr(m.MaxReplicas),
+		NodeClassName:     m.NodeClassName,
+		Labels:            m.Labels,
+		Annotations:       m.Annotations,
+		Taints:            fromModelSlice(m.Taints, taintInfoFromModel),
+		Requirements:      fromModelSlice(m.Requirements, nodeSelectorRequirementFromModel),
+		CreationTimestamp: m.CreationTimestamp,
+	}
+}
+
+func nodePoolInfoToModel(p *NodePoolInfo) *model.NodePoolInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.NodePoolInfo{
+		Name:              p.Name,
+		UID:               p.Uid,
+		MinReplicas:       intPtr(p.MinReplicas),
+		MaxReplicas:       intPtr(p.MaxReplicas),
+		NodeClassName:     p.NodeClassName,
+		Labels:            p.Labels,
+		Annotations:       p.Annotations,
+		Taints:            toModelSlice(p.Taints, taintInfoToModel),
+		Requirements:      toModelSlice(p.Requirements, nodeSelectorRequirementToModel),
+		CreationTimestamp: p.CreationTimestamp,
+	}
+}
+
+func eventInfoFromModel(m *model.EventInfo) *EventInfo {
+	if m == nil {
+		return nil
+	}
+	return &EventInfo{
+		Type:                m.Type,
+		Reason:              m.Reason,
+		Note:                m.Note,
+		ReportingController: m.ReportingController,
+		InvolvedKind:        m.InvolvedKind,
+		InvolvedNamespace:   m.InvolvedNamespace,
+		InvolvedName:        m.InvolvedName,
+		InvolvedUid:         m.InvolvedUID,
+		Count:               m.Count,
+		FirstTimestamp:      m.FirstTimestamp,
+		LastTimestamp:       m.LastTimestamp,
+	}
+}
+
+func eventInfoToModel(p *EventInfo) *model.EventInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.EventInfo{
+		Type:                p.Type,
+		Reason:              p.Reason,
+		Note:                p.Note,
+		ReportingController: p.ReportingController,
+		InvolvedKind:        p.InvolvedKind,
+		InvolvedNamespace:   p.InvolvedNamespace,
+		InvolvedName:        p.InvolvedName,
+		InvolvedUID:         p.InvolvedUid,
+		Count:               p.Count,
+		FirstTimestamp:      p.FirstTimestamp,
+		LastTimestamp:       p.LastTimestamp,
+	}
+}
+
+func clusterSummaryFromModel(m *model.ClusterSummary) *ClusterSummary {
+	if m == nil {
+		return nil
+	}
+	return &ClusterSummary{
+		NodeCount:              int64(m.NodeCount),
+		PodCount:               int64(m.PodCount),
+		RunningPodCount:        int64(m.RunningPodCount),
+		PendingPodCount:        int64(m.PendingPodCount),
+		FailedPodCount:         int64(m.FailedPodCount),
+		SucceededPodCount:      int64(m.SucceededPodCount),
+		NamespaceCount:         int64(m.NamespaceCount),
+		DeploymentCount:        int64(m.DeploymentCount),
+		StatefulsetCount:       int64(m.StatefulSetCount),
+		DaemonsetCount:         int64(m.DaemonSetCount),
+		JobCount:               int64(m.JobCount),
+		CronjobCount:           int64(m.CronJobCount),
+		CustomWorkloadCount:    int64(m.CustomWorkloadCount),
+		HpaCount:               int64(m.HPACount),
+		ServiceCount:           int64(m.ServiceCount),
+		IngressCount:           int64(m.IngressCount),
+		PvCount:                int64(m.PVCount),
+		PvcCount:               int64(m.PVCCount),
+		ContainerCount:         int64(m.ContainerCount),
+		TotalCpuCapacity:       m.TotalCPUCapacity,
+		TotalCpuAllocatable:    m.TotalCPUAllocatable,
+		TotalCpuRequested:      m.TotalCPURequested,
+		TotalCpuUsage:          m.TotalCPUUsage,
+		TotalMemoryCapacity:    m.TotalMemoryCapacity,
+		TotalMemoryAllocatable: m.TotalMemoryAllocatable,
+		TotalMemoryRequested:   m.TotalMemoryRequested,
+		TotalMemoryUsage:       m.TotalMemoryUsage,
+		TotalGpuCapacity:       int64(m.TotalGPUCapacity),
+		TotalGpuRequested:      int64(m.TotalGPURequested),
+		TotalGpuUsage:          m.TotalGPUUsage,
+		TotalGpuTensorActive:   m.TotalGPUTensorActive,
+		TotalGpuMemoryUtil:     m.TotalGPUMemoryUtil,
+		TotalGpuMemoryUsed:     m.TotalGPUMemoryUsed,
+		TotalGpuMemoryTotal:    m.TotalGPUMemoryTotal,
+		GpuMetricsAvailable:    m.GPUMetricsAvailable,
+		TotalStorageCapacity:   m.TotalStorageCapacity,
+		TotalStorageRequested:  m.TotalStorageRequested,
+		MetricsAvailable:       m.MetricsAvailable,
+		TotalHourlyCost:        m.TotalHourlyCost,
+		IdleHourlyCost:         m.IdleHourlyCost,
+		CostCurrency:           m.CostCurrency,
+	}
+}
+
+func clusterSummaryToModel(p *ClusterSummary) *model.ClusterSummary {
+	if p == nil {
+		return nil
+	}
+	return &model.ClusterSummary{
+		NodeCount:              int(p.NodeCount),
+		PodCount:               int(p.PodCount),
+		RunningPodCount:        int(p.RunningPodCount),
+		PendingPodCount:        int(p.PendingPodCount),
+		FailedPodCount:         int(p.FailedPodCount),
+		SucceededPodCount:      int(p.SucceededPodCount),
+		NamespaceCount:         int(p.NamespaceCount),
+		DeploymentCount:        int(p.DeploymentCount),
+		StatefulSetCount:       int(p.StatefulsetCount),
+		DaemonSetCount:         int(p.DaemonsetCount),
+		JobCount:               int(p.JobCount),
+		CronJobCount:           int(p.CronjobCount),
+		CustomWorkloadCount:    int(p.CustomWorkloadCount),
+		HPACount:               int(p.HpaCount),
+		ServiceCount:           int(p.ServiceCount),
+		IngressCount:           int(p.IngressCount),
+		PVCount:                int(p.PvCount),
+		PVCCount:               int(p.PvcCount),
+		ContainerCount:         int(p.ContainerCount),
+		TotalCPUCapacity:       p.TotalCpuCapacity,
+		TotalCPUAllocatable:    p.TotalCpuAllocatable,
+		TotalCPURequested:      p.TotalCpuRequested,
+		TotalCPUUsage:          p.TotalCpuUsage,
+		TotalMemoryCapacity:    p.TotalMemoryCapacity,
+		TotalMemoryAllocatable: p.TotalMemoryAllocatable,
+		TotalMemoryRequested:   p.TotalMemoryRequested,
+		TotalMemoryUsage:       p.TotalMemoryUsage,
+		TotalGPUCapacity:       int(p.TotalGpuCapacity),
+		TotalGPURequested:      int(p.TotalGpuRequested),
+		TotalGPUUsage:          p.TotalGpuUsage,
+		TotalGPUTensorActive:   p.TotalGpuTensorActive,
+		TotalGPUMemoryUtil:     p.TotalGpuMemoryUtil,
+		TotalGPUMemoryUsed:     p.TotalGpuMemoryUsed,
+		TotalGPUMemoryTotal:    p.TotalGpuMemoryTotal,
+		GPUMetricsAvailable:    p.GpuMetricsAvailable,
+		TotalStorageCapacity:   p.TotalStorageCapacity,
+		TotalStorageRequested:  p.TotalStorageRequested,
+		MetricsAvailable:       p.MetricsAvailable,
+		TotalHourlyCost:        p.TotalHourlyCost,
+		IdleHourlyCost:         p.IdleHourlyCost,
+		CostCurrency:           p.CostCurrency,
+	}
+}
+
+func agentHealthFromModel(m *model.AgentHealth) *AgentHealth {
+	if m == nil {
+		return nil
+	}
+	return &AgentHealth{
+		SnapshotsSentTotal:           m.SnapshotsSentTotal,
+		SnapshotsFailedTotal:         m.SnapshotsFailedTotal,
+		SnapshotsTotal:               m.SnapshotsTotalCount,
+		SnapshotsSentDelta:           m.SnapshotsSentDelta,
+		SnapshotsFailedDelta:         m.SnapshotsFailedDelta,
+		State:                        m.State,
+		StateReason:                  m.StateReason,
+		LeaderElectionEnabled:        m.LeaderElectionEnabled,
+		IsLeader:                     m.IsLeader,
+		LeaderIdentity:               m.LeaderIdentity,
+		LeadershipTransitions:        m.LeadershipTransitions,
+		LastBuildDurationMs:          m.LastBuildDurationMs,
+		LastMetricsCollectDurationMs: m.LastMetricsCollectDurationMs,
+		LastSendDurationMs:           m.LastSendDurationMs,
+		EncodeDurationMs:             m.EncodeDurationMs,
+		OriginalSizeBytes:            m.OriginalSizeBytes,
+		CompressedSizeBytes:          m.CompressedSizeBytes,
+		CompressionFactor:            m.CompressionFactor,
+		BufferedSnapshots:            int64(m.BufferedSnapshots),
+		BufferedBytes:                m.BufferedBytes,
+		NodeCount:                    int64(m.NodeCount),
+		PodCount:                     int64(m.PodCount),
+		ContainerCount:               int64(m.ContainerCount),
+		WorkloadCount:                int64(m.WorkloadCount),
+		ServiceCount:                 int64(m.ServiceCount),
+		HpaCount:                     int64(m.HPACount),
+		PdbCount:                     int64(m.PDBCount),
+		PvCount:                      int64(m.PVCount),
+		MetricsServerAvailable:       m.MetricsServerAvailable,
+		VpaAvailable:                 m.VPAAvailable,
+		KarpenterAvailable:           m.KarpenterAvailable,
+		GpuMetricsAvailable:          m.GPUMetricsAvailable,
+		DcgmExporterTargets:          int64(m.DCGMExporterTargets),
+		DcgmExporterUpTargets:        int64(m.DCGMExporterUpTargets),
+		InformersSynced:              m.InformersSynced,
+		InformersHealthy:             int64(m.InformersHealthy),
+		InformersTotal:               int64(m.InformersTotal),
+		StaleResources:               m.StaleResources,
+		ApiCallsTotal:                int64(m.APICallsTotal),
+		ApiCallsFailed:               int64(m.APICallsFailedCount),
+		ActiveErrorsCount:            int64(m.ActiveErrorsCount),
+		ErrorCodes:                   m.ErrorCodes,
+		UptimeSeconds:                m.UptimeSeconds,
+		StartedAt:                    m.StartedAt,
+		CollectedAt:                  m.CollectedAt,
+		Redaction:                    redactionInfoFromModel(m.Redaction),
+		ChartVersion:                 m.ChartVersion,
+		HelmReleaseName:              m.HelmReleaseName,
+		PodName:                      m.PodName,
+		PodNamespace:                 m.PodNamespace,
+		NodeName:                     m.NodeName,
+	}
+}
+
+func agentHealthToModel(p *AgentHealth) *model.AgentHealth {
+	if p == nil {
+		return nil
+	}
+	return &model.AgentHealth{
+		SnapshotsSentTotal:           p.SnapshotsSentTotal,
+		SnapshotsFailedTotal:         p.SnapshotsFailedTotal,
+		SnapshotsTotalCount:          p.SnapshotsTotal,
+		SnapshotsSentDelta:           p.SnapshotsSentDelta,
+		SnapshotsFailedDelta:         p.SnapshotsFailedDelta,
+		State:                        p.State,
+		StateReason:                  p.StateReason,
+		LeaderElectionEnabled:        p.LeaderElectionEnabled,
+		IsLeader:                     p.IsLeader,
+		LeaderIdentity:               p.LeaderIdentity,
+		LeadershipTransitions:        p.LeadershipTransitions,
+		LastBuildDurationMs:          p.LastBuildDurationMs,
+		LastMetricsCollectDurationMs: p.LastMetricsCollectDurationMs,
+		LastSendDurationMs:           p.LastSendDurationMs,
+		EncodeDurationMs:             p.EncodeDurationMs,
+		OriginalSizeBytes:            p.OriginalSizeBytes,
+		CompressedSizeBytes:          p.CompressedSizeBytes,
+		CompressionFactor:            p.CompressionFactor,
+		BufferedSnapshots:            int(p.BufferedSnapshots),
+		BufferedBytes:                p.BufferedBytes,
+		NodeCount:                    int(p.NodeCount),
+		PodCount:                     int(p.PodCount),
+		ContainerCount:               int(p.ContainerCount),
+		WorkloadCount:                int(p.WorkloadCount),
+		ServiceCount:                 int(p.ServiceCount),
+		HPACount:                     int(p.HpaCount),
+		PDBCount:                     int(p.PdbCount),
+		PVCount:                      int(p.PvCount),
+		MetricsServerAvailable:       p.MetricsServerAvailable,
+		VPAAvailable:                 p.VpaAvailable,
+		KarpenterAvailable:           p.KarpenterAvailable,
+		GPUMetricsAvailable:          p.GpuMetricsAvailable,
+		DCGMExporterTargets:          int(p.DcgmExporterTargets),
+		DCGMExporterUpTargets:        int(p.DcgmExporterUpTargets),
+		InformersSynced:              p.InformersSynced,
+		InformersHealthy:             int(p.InformersHealthy),
+		InformersTotal:               int(p.InformersTotal),
+		StaleResources:               p.StaleResources,
+		APICallsTotal:                int(p.ApiCallsTotal),
+		APICallsFailedCount:          int(p.ApiCallsFailed),
+		ActiveErrorsCount:            int(p.ActiveErrorsCount),
+		ErrorCodes:                   p.ErrorCodes,
+		UptimeSeconds:                p.UptimeSeconds,
+		StartedAt:                    p.StartedAt,
+		CollectedAt:                  p.CollectedAt,
+		Redaction:                    redactionInfoToModel(p.Redaction),
+		ChartVersion:                 p.ChartVersion,
+		HelmReleaseName:              p.HelmReleaseName,
+		PodName:                      p.PodName,
+		PodNamespace:                 p.PodNamespace,
+		NodeName:                     p.NodeName,
+	}
+}
+
+func gPUDeviceInfoFromModel(m *model.GPUDeviceInfo) *GPUDeviceInfo {
+	if m == nil {
+		return nil
+	}
+	return &GPUDeviceInfo{
+		Uuid:                 m.UUID,
+		DeviceIndex:          m.DeviceIndex,
+		ModelName:            m.ModelName,
+		UtilizationPercent:   m.UtilizationPercent,
+		TensorActivePercent:  m.TensorActivePercent,
+		MemoryUtilPercent:    m.MemoryUtilPercent,
+		MemoryUsedBytes:      m.MemoryUsedBytes,
+		MemoryTotalBytes:     m.MemoryTotalBytes,
+		TemperatureCelsius:   m.TemperatureCelsius,
+		PowerWatts:           m.PowerWatts,
+		SmClockMhz:           m.SMClockMHz,
+		MigProfile:           m.MIGProfile,
+		MigInstanceId:        m.MIGInstanceID,
+		Allocated:            m.Allocated,
+		XidError:             int64Ptr(m.XIDError),
+		EccSbeVolatileTotal:  m.ECCSingleBitErrors,
+		EccDbeVolatileTotal:  m.ECCDoubleBitErrors,
+		ThrottleReasons:      m.ThrottleReasons,
+		Health:               m.Health,
+		HealthReasons:        m.HealthReasons,
+		NvlinkBandwidthTotal: m.NVLinkBandwidthTotal,
+		NvlinkTxBytesPerSec:  m.NVLinkTxBytesPerSec,
+		NvlinkRxBytesPerSec:  m.NVLinkRxBytesPerSec,
+		GpuIdle:              gPUIdleInfoFromModel(m.GPUIdle),
+	}
+}
+
+func gPUDeviceInfoToModel(p *GPUDeviceInfo) *model.GPUDeviceInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.GPUDeviceInfo{
+		UUID:                 p.Uuid,
+		DeviceIndex:          p.DeviceIndex,
+		ModelName:            p.ModelName,
+		UtilizationPercent:   p.UtilizationPercent,
+		TensorActivePercent:  p.TensorActivePercent,
+		MemoryUtilPercent:    p.MemoryUtilPercent,
+		MemoryUsedBytes:      p.MemoryUsedBytes,
+		MemoryTotalBytes:     p.MemoryTotalBytes,
+		TemperatureCelsius:   p.TemperatureCelsius,
+		PowerWatts:           p.PowerWatts,
+		SMClockMHz:           p.SmClockMhz,
+		MIGProfile:           p.MigProfile,
+		MIGInstanceID:        p.MigInstanceId,
+		Allocated:            p.Allocated,
+		XIDError:             intPtr(p.XidError),
+		ECCSingleBitErrors:   p.EccSbeVolatileTotal,
+		ECCDoubleBitErrors:   p.EccDbeVolatileTotal,
+		ThrottleReasons:      p.ThrottleReasons,
+		Health:               p.Health,
+		HealthReasons:        p.HealthReasons,
+		NVLinkBandwidthTotal: p.NvlinkBandwidthTotal,
+		NVLinkTxBytesPerSec:  p.NvlinkTxBytesPerSec,
+		NVLinkRxBytesPerSec:  p.NvlinkRxBytesPerSec,
+		GPUIdle:              gPUIdleInfoToModel(p.GpuIdle),
+	}
+}
+
+func gPUIdleInfoFromModel(m *model.GPUIdleInfo) *GPUIdleInfo {
+	if m == nil {
+		return nil
+	}
+	return &GPUIdleInfo{
+		Reason:                 m.Reason,
+		Gpus:                   m.GPUs,
+		MaxUtilizationPercent:  m.MaxUtilizationPercent,
+		MaxTensorActivePercent: m.MaxTensorActivePercent,
+		WindowHours:            m.WindowHours,
+		WastedGpuHours:         m.WastedGPUHours,
+	}
+}
+
+func gPUIdleInfoToModel(p *GPUIdleInfo) *model.GPUIdleInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.GPUIdleInfo{
+		Reason:                 p.Reason,
+		GPUs:                   p.Gpus,
+		MaxUtilizationPercent:  p.MaxUtilizationPercent,
+		MaxTensorActivePercent: p.MaxTensorActivePercent,
+		WindowHours:            p.WindowHours,
+		WastedGPUHours:         p.WastedGpuHours,
+	}
+}
+
+func taintInfoFromModel(m *model.TaintInfo) *TaintInfo {
+	if m == nil {
+		return nil
+	}
+	return &TaintInfo{
+		Key:    m.Key,
+		Value:  m.Value,
+		Effect: m.Effect,
+	}
+}
+
+func taintInfoToModel(p *TaintInfo) *model.TaintInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.TaintInfo{
+		Key:    p.Key,
+		Value:  p.Value,
+		Effect: p.Effect,
+	}
+}
+
+func nodeConditionInfoFromModel(m *model.NodeConditionInfo) *NodeConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &NodeConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func nodeConditionInfoToModel(p *NodeConditionInfo) *model.NodeConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.NodeConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func containerInfoFromModel(m *model.ContainerInfo) *ContainerInfo {
+	if m == nil {
+		return nil
+	}
+	return &ContainerInfo{
+		Name:                    m.Name,
+		Image:                   m.Image,
+		ImageId:                 m.ImageID,
+		CpuRequestCores:         m.CPURequestCores,
+		MemoryRequestBytes:      m.MemoryRequestBytes,
+		CpuLimitCores:           m.CPULimitCores,
+		MemoryLimitBytes:        m.MemoryLimitBytes,
+		EphemeralStorageRequest: m.EphemeralStorageRequest,
+		EphemeralStorageLimit:   m.EphemeralStorageLimit,
+		GpuRequest:              int64(m.GPURequest),
+		GpuLimit:                int64(m.GPULimit),
+		CpuUsageCores:           m.CPUUsageCores,
+		MemoryUsageBytes:        m.MemoryUsageBytes,
+		CpuUsageStats:           cPUUsageStatsFromModel(m.CPUUsageStats),
+		MemoryUsageStats:        memoryUsageStatsFromModel(m.MemoryUsageStats),
+		GpuUtilizationPercent:   m.GPUUtilizationPercent,
+		GpuTensorActivePercent:  m.GPUTensorActivePercent,
+		GpuMemoryUsedBytes:      m.GPUMemoryUsedBytes,
+		GpuIdle:                 gPUIdleInfoFromModel(m.GPUIdle),
+		RootfsUsedBytes:         m.RootfsUsedBytes,
+		LogsUsedBytes:           m.LogsUsedBytes,
+		CpuThrottledRatio:       m.CPUThrottledRatio,
+		OomEvents:               m.OOMEvents,
+		Ready:                   m.Ready,
+		Started:                 m.Started,
+		RestartCount:            m.RestartCount,
+		State:                   m.State,
+		StateReason:             m.StateReason,
+		StateMessage:            m.StateMessage,
+		LastTerminationReason:   m.LastTerminationReason,
+		ExitCode:                m.ExitCode,
+		Ports:                   fromModelSlice(m.Ports, containerPortInfoFromModel),
+	}
+}
+
+func containerInfoToModel(p *ContainerInfo) *model.ContainerInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ContainerInfo{
+		Name:                    p.Name,
+		Image:                   p.Image,
+		ImageID:                 p.ImageId,
+		CPURequestCores:         p.CpuRequestCores,
+		MemoryRequestBytes:      p.MemoryRequestBytes,
+		CPULimitCores:           p.CpuLimitCores,
+		MemoryLimitBytes:        p.MemoryLimitBytes,
+		EphemeralStorageRequest: p.EphemeralStorageRequest,
+		EphemeralStorageLimit:   p.EphemeralStorageLimit,
+		GPURequest:              int(p.GpuRequest),
+		GPULimit:                int(p.GpuLimit),
+		CPUUsageCores:           p.CpuUsageCores,
+		MemoryUsageBytes:        p.MemoryUsageBytes,
+		CPUUsageStats:           cPUUsageStatsToModel(p.CpuUsageStats),
+		MemoryUsageStats:        memoryUsageStatsToModel(p.MemoryUsageStats),
+		GPUUtilizationPercent:   p.GpuUtilizationPercent,
+		GPUTensorActivePercent:  p.GpuTensorActivePercent,
+		GPUMemoryUsedBytes:      p.GpuMemoryUsedBytes,
+		GPUIdle:                 gPUIdleInfoToModel(p.GpuIdle),
+		RootfsUsedBytes:         p.RootfsUsedBytes,
+		LogsUsedBytes:           p.LogsUsedBytes,
+		CPUThrottledRatio:       p.CpuThrottledRatio,
+		OOMEvents:               p.OomEvents,
+		Ready:                   p.Ready,
+		Started:                 p.Started,
+		RestartCount:            p.RestartCount,
+		State:                   p.State,
+		StateReason:             p.StateReason,
+		StateMessage:            p.StateMessage,
+		LastTerminationReason:   p.LastTerminationReason,
+		ExitCode:                p.ExitCode,
+		Ports:                   toModelSlice(p.Ports, containerPortInfoToModel),
+	}
+}
+
+func podVolumeInfoFromModel(m *model.PodVolumeInfo) *PodVolumeInfo {
+	if m == nil {
+		return nil
+	}
+	return &PodVolumeInfo{
+		Name:             m.Name,
+		Type:             m.Type,
+		ReadOnly:         m.ReadOnly,
+		ClaimName:        m.ClaimName,
+		ConfigmapName:    m.ConfigMapName,
+		SecretName:       m.SecretName,
+		ProjectedSources: m.ProjectedSources,
+		StorageClassName: m.StorageClassName,
+		RequestedBytes:   m.RequestedBytes,
+		CsiDriver:        m.CSIDriver,
+		SizeLimitBytes:   m.SizeLimitBytes,
+	}
+}
+
+func podVolumeInfoToModel(p *PodVolumeInfo) *model.PodVolumeInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PodVolumeInfo{
+		Name:             p.Name,
+		Type:             p.Type,
+		ReadOnly:         p.ReadOnly,
+		ClaimName:        p.ClaimName,
+		ConfigMapName:    p.ConfigmapName,
+		SecretName:       p.SecretName,
+		ProjectedSources: p.ProjectedSources,
+		StorageClassName: p.StorageClassName,
+		RequestedBytes:   p.RequestedBytes,
+		CSIDriver:        p.CsiDriver,
+		SizeLimitBytes:   p.SizeLimitBytes,
+	}
+}
+
+func podConditionInfoFromModel(m *model.PodConditionInfo) *PodConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &PodConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func podConditionInfoToModel(p *PodConditionInfo) *model.PodConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PodConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func resourceRecommendationFromModel(m *model.ResourceRecommendation) *ResourceRecommendation {
+	if m == nil {
+		return nil
+	}
+	return &ResourceRecommendation{
+		ContainerName:      m.ContainerName,
+		CpuRequestCores:    m.CPURequestCores,
+		MemoryRequestBytes: m.MemoryRequestBytes,
+		Confidence:         m.Confidence,
+		HistoryHours:       int64(m.HistoryHours),
+		HpaControlled:      m.HPAControlled,
+	}
+}
+
+func resourceRecommendationToModel(p *ResourceRecommendation) *model.ResourceRecommendation {
+	if p == nil {
+		return nil
+	}
+	return &model.ResourceRecommendation{
+		ContainerName:      p.ContainerName,
+		CPURequestCores:    p.CpuRequestCores,
+		MemoryRequestBytes: p.MemoryRequestBytes,
+		Confidence:         p.Confidence,
+		HistoryHours:       int(p.HistoryHours),
+		HPAControlled:      p.HpaControlled,
+	}
+}
+
+func containerSpecInfoFromModel(m *model.ContainerSpecInfo) *ContainerSpecInfo {
+	if m == nil {
+		return nil
+	}
+	return &ContainerSpecInfo{
+		Name:               m.Name,
+		Image:              m.Image,
+		CpuRequestCores:    m.CPURequestCores,
+		MemoryRequestBytes: m.MemoryRequestBytes,
+		CpuLimitCores:      m.CPULimitCores,
+		MemoryLimitBytes:   m.MemoryLimitBytes,
+		GpuRequest:         int64(m.GPURequest),
+		GpuLimit:           int64(m.GPULimit),
+	}
+}
+
+func containerSpecInfoToModel(p *ContainerSpecInfo) *model.ContainerSpecInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ContainerSpecInfo{
+		Name:               p.Name,
+		Image:              p.Image,
+		CPURequestCores:    p.CpuRequestCores,
+		MemoryRequestBytes: p.MemoryRequestBytes,
+		CPULimitCores:      p.CpuLimitCores,
+		MemoryLimitBytes:   p.MemoryLimitBytes,
+		GPURequest:         int(p.GpuRequest),
+		GPULimit:           int(p.GpuLimit),
+	}
+}
+
+func workloadConditionInfoFromModel(m *model.WorkloadConditionInfo) *WorkloadConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &WorkloadConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func workloadConditionInfoToModel(p *WorkloadConditionInfo) *model.WorkloadConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.WorkloadConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func jobConditionInfoFromModel(m *model.JobConditionInfo) *JobConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &JobConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func jobConditionInfoToModel(p *JobConditionInfo) *model.JobConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.JobConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func hPAMetricInfoFromModel(m *model.HPAMetricInfo) *HPAMetricInfo {
+	if m == nil {
+		return nil
+	}
+	return &HPAMetricInfo{
+		Type:          m.Type,
+		ResourceName:  m.ResourceName,
+		ContainerName: m.ContainerName,
+		MetricName:    m.MetricName,
+		TargetType:    m.TargetType,
+		TargetValue:   m.TargetValue,
+	}
+}
+
+func hPAMetricInfoToModel(p *HPAMetricInfo) *model.HPAMetricInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.HPAMetricInfo{
+		Type:          p.Type,
+		ResourceName:  p.ResourceName,
+		ContainerName: p.ContainerName,
+		MetricName:    p.MetricName,
+		TargetType:    p.TargetType,
+		TargetValue:   p.TargetValue,
+	}
+}
+
+func hPACurrentMetricInfoFromModel(m *model.HPACurrentMetricInfo) *HPACurrentMetricInfo {
+	if m == nil {
+		return nil
+	}
+	return &HPACurrentMetricInfo{
+		Type:                m.Type,
+		ResourceName:        m.ResourceName,
+		CurrentValue:        m.CurrentValue,
+		CurrentAverageValue: m.CurrentAverageValue,
+		CurrentUtilization:  m.CurrentUtilization,
+	}
+}
+
+func hPACurrentMetricInfoToModel(p *HPACurrentMetricInfo) *model.HPACurrentMetricInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.HPACurrentMetricInfo{
+		Type:                p.Type,
+		ResourceName:        p.ResourceName,
+		CurrentValue:        p.CurrentValue,
+		CurrentAverageValue: p.CurrentAverageValue,
+		CurrentUtilization:  p.CurrentUtilization,
+	}
+}
+
+func hPAScalingBehaviorFromModel(m *model.HPAScalingBehavior) *HPAScalingBehavior {
+	if m == nil {
+		return nil
+	}
+	return &HPAScalingBehavior{
+		StabilizationWindowSeconds: m.StabilizationWindowSeconds,
+		Policies:                   fromModelSlice(m.Policies, hPAScalingPolicyFromModel),
+		SelectPolicy:               m.SelectPolicy,
+	}
+}
+
+func hPAScalingBehaviorToModel(p *HPAScalingBehavior) *model.HPAScalingBehavior {
+	if p == nil {
+		return nil
+	}
+	return &model.HPAScalingBehavior{
+		StabilizationWindowSeconds: p.StabilizationWindowSeconds,
+		Policies:                   toModelSlice(p.Policies, hPAScalingPolicyToModel),
+		SelectPolicy:               p.SelectPolicy,
+	}
+}
+
+func hPAConditionInfoFromModel(m *model.HPAConditionInfo) *HPAConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &HPAConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func hPAConditionInfoToModel(p *HPAConditionInfo) *model.HPAConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.HPAConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func vPAContainerRecommendationFromModel(m *model.VPAContainerRecommendation) *VPAContainerRecommendation {
+	if m == nil {
+		return nil
+	}
+	return &VPAContainerRecommendation{
+		ContainerName:  m.ContainerName,
+		LowerBound:     resourceValuesFromModel(&m.LowerBound),
+		Target:         resourceValuesFromModel(&m.Target),
+		UncappedTarget: resourceValuesFromModel(&m.UncappedTarget),
+		UpperBound:     resourceValuesFromModel(&m.UpperBound),
+	}
+}
+
+func vPAContainerRecommendationToModel(p *VPAContainerRecommendation) *model.VPAContainerRecommendation {
+	if p == nil {
+		return nil
+	}
+	return &model.VPAContainerRecommendation{
+		ContainerName:  p.ContainerName,
+		LowerBound:     deref(resourceValuesToModel(p.LowerBound)),
+		Target:         deref(resourceValuesToModel(p.Target)),
+		UncappedTarget: deref(resourceValuesToModel(p.UncappedTarget)),
+		UpperBound:     deref(resourceValuesToModel(p.UpperBound)),
+	}
+}
+
+func vPAConditionInfoFromModel(m *model.VPAConditionInfo) *VPAConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &VPAConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func vPAConditionInfoToModel(p *VPAConditionInfo) *model.VPAConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.VPAConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func labelSelectorRequirementFromModel(m *model.LabelSelectorRequirement) *LabelSelectorRequirement {
+	if m == nil {
+		return nil
+	}
+	return &LabelSelectorRequirement{
+		Key:      m.Key,
+		Operator: m.Operator,
+		Values:   m.Values,
+	}
+}
+
+func labelSelectorRequirementToModel(p *LabelSelectorRequirement) *model.LabelSelectorRequirement {
+	if p == nil {
+		return nil
+	}
+	return &model.LabelSelectorRequirement{
+		Key:      p.Key,
+		Operator: p.Operator,
+		Values:   p.Values,
+	}
+}
+
+func workloadReferenceFromModel(m *model.WorkloadReference) *WorkloadReference {
+	if m == nil {
+		return nil
+	}
+	return &WorkloadReference{
+		Kind:      m.Kind,
+		Name:      m.Name,
+		Namespace: m.Namespace,
+	}
+}
+
+func workloadReferenceToModel(p *WorkloadReference) *model.WorkloadReference {
+	if p == nil {
+		return nil
+	}
+	return &model.WorkloadReference{
+		Kind:      p.Kind,
+		Name:      p.Name,
+		Namespace: p.Namespace,
+	}
+}
+
+func pDBConditionInfoFromModel(m *model.PDBConditionInfo) *PDBConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &PDBConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func pDBConditionInfoToModel(p *PDBConditionInfo) *model.PDBConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PDBConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func loadBalancerInfoFromModel(m *model.LoadBalancerInfo) *LoadBalancerInfo {
+	if m == nil {
+		return nil
+	}
+	return &LoadBalancerInfo{
+		Ingress:             fromModelSlice(m.Ingress, loadBalancerIngressFromModel),
+		Class:               m.Class,
+		AwsLoadBalancerType: m.AWSLoadBalancerType,
+		AwsScheme:           m.AWSScheme,
+		AwsArnAnnotation:    m.AWSARNAnnotation,
+	}
+}
+
+func loadBalancerInfoToModel(p *LoadBalancerInfo) *model.LoadBalancerInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.LoadBalancerInfo{
+		Ingress:             toModelSlice(p.Ingress, loadBalancerIngressToModel),
+		Class:               p.Class,
+		AWSLoadBalancerType: p.AwsLoadBalancerType,
+		AWSScheme:           p.AwsScheme,
+		AWSARNAnnotation:    p.AwsArnAnnotation,
+	}
+}
+
+func servicePortInfoFromModel(m *model.ServicePortInfo) *ServicePortInfo {
+	if m == nil {
+		return nil
+	}
+	return &ServicePortInfo{
+		Name:       m.Name,
+		Protocol:   m.Protocol,
+		Port:       m.Port,
+		TargetPort: m.TargetPort,
+		NodePort:   m.NodePort,
+	}
+}
+
+func servicePortInfoToModel(p *ServicePortInfo) *model.ServicePortInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ServicePortInfo{
+		Name:       p.Name,
+		Protocol:   p.Protocol,
+		Port:       p.Port,
+		TargetPort: p.TargetPort,
+		NodePort:   p.NodePort,
+	}
+}
+
+func ingressRuleInfoFromModel(m *model.IngressRuleInfo) *IngressRuleInfo {
+	if m == nil {
+		return nil
+	}
+	return &IngressRuleInfo{
+		Host:  m.Host,
+		Paths: fromModelSlice(m.Paths, ingressPathInfoFromModel),
+	}
+}
+
+func ingressRuleInfoToModel(p *IngressRuleInfo) *model.IngressRuleInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.IngressRuleInfo{
+		Host:  p.Host,
+		Paths: toModelSlice(p.Paths, ingressPathInfoToModel),
+	}
+}
+
+func ingressTLSInfoFromModel(m *model.IngressTLSInfo) *IngressTLSInfo {
+	if m == nil {
+		return nil
+	}
+	return &IngressTLSInfo{
+		Hosts:      m.Hosts,
+		SecretName: m.SecretName,
+	}
+}
+
+func ingressTLSInfoToModel(p *IngressTLSInfo) *model.IngressTLSInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.IngressTLSInfo{
+		Hosts:      p.Hosts,
+		SecretName: p.SecretName,
+	}
+}
+
+func ingressBackendInfoFromModel(m *model.IngressBackendInfo) *IngressBackendInfo {
+	if m == nil {
+		return nil
+	}
+	return &IngressBackendInfo{
+		ServiceName: m.ServiceName,
+		ServicePort: m.ServicePort,
+	}
+}
+
+func ingressBackendInfoToModel(p *IngressBackendInfo) *model.IngressBackendInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.IngressBackendInfo{
+		ServiceName: p.ServiceName,
+		ServicePort: p.ServicePort,
+	}
+}
+
+func pVSourceInfoFromModel(m *model.PVSourceInfo) *PVSourceInfo {
+	if m == nil {
+		return nil
+	}
+	return &PVSourceInfo{
+		Type:            m.Type,
+		CsiDriver:       m.CSIDriver,
+		CsiVolumeHandle: m.CSIVolumeHandle,
+		CsiFsType:       m.CSIFSType,
+		AwsVolumeId:     m.AWSVolumeID,
+		AwsPartition:    int64(m.AWSPartition),
+		NfsServer:       m.NFSServer,
+		NfsPath:         m.NFSPath,
+	}
+}
+
+func pVSourceInfoToModel(p *PVSourceInfo) *model.PVSourceInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PVSourceInfo{
+		Type:            p.Type,
+		CSIDriver:       p.CsiDriver,
+		CSIVolumeHandle: p.CsiVolumeHandle,
+		CSIFSType:       p.CsiFsType,
+		AWSVolumeID:     p.AwsVolumeId,
+		AWSPartition:    int(p.AwsPartition),
+		NFSServer:       p.NfsServer,
+		NFSPath:         p.NfsPath,
+	}
+}
+
+func pVClaimRefInfoFromModel(m *model.PVClaimRefInfo) *PVClaimRefInfo {
+	if m == nil {
+		return nil
+	}
+	return &PVClaimRefInfo{
+		Namespace: m.Namespace,
+		Name:      m.Name,
+		Uid:       m.UID,
+	}
+}
+
+func pVClaimRefInfoToModel(p *PVClaimRefInfo) *model.PVClaimRefInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PVClaimRefInfo{
+		Namespace: p.Namespace,
+		Name:      p.Name,
+		UID:       p.Uid,
+	}
+}
+
+func pVCConditionInfoFromModel(m *model.PVCConditionInfo) *PVCConditionInfo {
+	if m == nil {
+		return nil
+	}
+	return &PVCConditionInfo{
+		Type:    m.Type,
+		Status:  m.Status,
+		Reason:  m.Reason,
+		Message: m.Message,
+	}
+}
+
+func pVCConditionInfoToModel(p *PVCConditionInfo) *model.PVCConditionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.PVCConditionInfo{
+		Type:    p.Type,
+		Status:  p.Status,
+		Reason:  p.Reason,
+		Message: p.Message,
+	}
+}
+
+func limitRangeItemInfoFromModel(m *model.LimitRangeItemInfo) *LimitRangeItemInfo {
+	if m == nil {
+		return nil
+	}
+	return &LimitRangeItemInfo{
+		Type:                 m.Type,
+		Default:              m.Default,
+		DefaultRequest:       m.DefaultRequest,
+		Max:                  m.Max,
+		Min:                  m.Min,
+		MaxLimitRequestRatio: m.MaxLimitRequestRatio,
+	}
+}
+
+func limitRangeItemInfoToModel(p *LimitRangeItemInfo) *model.LimitRangeItemInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.LimitRangeItemInfo{
+		Type:                 p.Type,
+		Default:              p.Default,
+		DefaultRequest:       p.DefaultRequest,
+		Max:                  p.Max,
+		Min:                  p.Min,
+		MaxLimitRequestRatio: p.MaxLimitRequestRatio,
+	}
+}
+
+func nodeSelectorRequirementFromModel(m *model.NodeSelectorRequirement) *NodeSelectorRequirement {
+	if m == nil {
+		return nil
+	}
+	return &NodeSelectorRequirement{
+		Key:      m.Key,
+		Operator: m.Operator,
+		Values:   m.Values,
+	}
+}
+
+func nodeSelectorRequirementToModel(p *NodeSelectorRequirement) *model.NodeSelectorRequirement {
+	if p == nil {
+		return nil
+	}
+	return &model.NodeSelectorRequirement{
+		Key:      p.Key,
+		Operator: p.Operator,
+		Values:   p.Values,
+	}
+}
+
+func redactionInfoFromModel(m *model.RedactionInfo) *RedactionInfo {
+	if m == nil {
+		return nil
+	}
+	return &RedactionInfo{
+		LabelAllow:      m.LabelAllow,
+		LabelDeny:       m.LabelDeny,
+		AnnotationAllow: m.AnnotationAllow,
+		AnnotationDeny:  m.AnnotationDeny,
+		ValuePatterns:   int64(m.ValuePatterns),
+		NamesHashed:     m.NamesHashed,
+		SaltFingerprint: m.SaltFingerprint,
+	}
+}
+
+func redactionInfoToModel(p *RedactionInfo) *model.RedactionInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.RedactionInfo{
+		LabelAllow:      p.LabelAllow,
+		LabelDeny:       p.LabelDeny,
+		AnnotationAllow: p.AnnotationAllow,
+		AnnotationDeny:  p.AnnotationDeny,
+		ValuePatterns:   int(p.ValuePatterns),
+		NamesHashed:     p.NamesHashed,
+		SaltFingerprint: p.SaltFingerprint,
+	}
+}
+
+func cPUUsageStatsFromModel(m *model.CPUUsageStats) *CPUUsageStats {
+	if m == nil {
+		return nil
+	}
+	return &CPUUsageStats{
+		MinCores: m.MinCores,
+		AvgCores: m.AvgCores,
+		MaxCores: m.MaxCores,
+		P95Cores: m.P95Cores,
+		Samples:  int64(m.Samples),
+	}
+}
+
+func cPUUsageStatsToModel(p *CPUUsageStats) *model.CPUUsageStats {
+	if p == nil {
+		return nil
+	}
+	return &model.CPUUsageStats{
+		MinCores: p.MinCores,
+		AvgCores: p.AvgCores,
+		MaxCores: p.MaxCores,
+		P95Cores: p.P95Cores,
+		Samples:  int(p.Samples),
+	}
+}
+
+func memoryUsageStatsFromModel(m *model.MemoryUsageStats) *MemoryUsageStats {
+	if m == nil {
+		return nil
+	}
+	return &MemoryUsageStats{
+		MinBytes: m.MinBytes,
+		AvgBytes: m.AvgBytes,
+		MaxBytes: m.MaxBytes,
+		P95Bytes: m.P95Bytes,
+		Samples:  int64(m.Samples),
+	}
+}
+
+func memoryUsageStatsToModel(p *MemoryUsageStats) *model.MemoryUsageStats {
+	if p == nil {
+		return nil
+	}
+	return &model.MemoryUsageStats{
+		MinBytes: p.MinBytes,
+		AvgBytes: p.AvgBytes,
+		MaxBytes: p.MaxBytes,
+		P95Bytes: p.P95Bytes,
+		Samples:  int(p.Samples),
+	}
+}
+
+func containerPortInfoFromModel(m *model.ContainerPortInfo) *ContainerPortInfo {
+	if m == nil {
+		return nil
+	}
+	return &ContainerPortInfo{
+		Name:          m.Name,
+		ContainerPort: m.ContainerPort,
+		Protocol:      m.Protocol,
+	}
+}
+
+func containerPortInfoToModel(p *ContainerPortInfo) *model.ContainerPortInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.ContainerPortInfo{
+		Name:          p.Name,
+		ContainerPort: p.ContainerPort,
+		Protocol:      p.Protocol,
+	}
+}
+
+func hPAScalingPolicyFromModel(m *model.HPAScalingPolicy) *HPAScalingPolicy {
+	if m == nil {
+		return nil
+	}
+	return &HPAScalingPolicy{
+		Type:          m.Type,
+		Value:         m.Value,
+		PeriodSeconds: m.PeriodSeconds,
+	}
+}
+
+func hPAScalingPolicyToModel(p *HPAScalingPolicy) *model.HPAScalingPolicy {
+	if p == nil {
+		return nil
+	}
+	return &model.HPAScalingPolicy{
+		Type:          p.Type,
+		Value:         p.Value,
+		PeriodSeconds: p.PeriodSeconds,
+	}
+}
+
+func resourceValuesFromModel(m *model.ResourceValues) *ResourceValues {
+	if m == nil {
+		return nil
+	}
+	return &ResourceValues{
+		CpuCores:    m.CPUCores,
+		MemoryBytes: m.MemoryBytes,
+	}
+}
+
+func resourceValuesToModel(p *ResourceValues) *model.ResourceValues {
+	if p == nil {
+		return nil
+	}
+	return &model.ResourceValues{
+		CPUCores:    p.CpuCores,
+		MemoryBytes: p.MemoryBytes,
+	}
+}
+
+func loadBalancerIngressFromModel(m *model.LoadBalancerIngress) *LoadBalancerIngress {
+	if m == nil {
+		return nil
+	}
+	return &LoadBalancerIngress{
+		Ip:       m.IP,
+		Hostname: m.Hostname,
+	}
+}
+
+func loadBalancerIngressToModel(p *LoadBalancerIngress) *model.LoadBalancerIngress {
+	if p == nil {
+		return nil
+	}
+	return &model.LoadBalancerIngress{
+		IP:       p.Ip,
+		Hostname: p.Hostname,
+	}
+}
+
+func ingressPathInfoFromModel(m *model.IngressPathInfo) *IngressPathInfo {
+	if m == nil {
+		return nil
+	}
+	return &IngressPathInfo{
+		Path:           m.Path,
+		PathType:       m.PathType,
+		BackendService: m.BackendService,
+		BackendPort:    m.BackendPort,
+	}
+}
+
+func ingressPathInfoToModel(p *IngressPathInfo) *model.IngressPathInfo {
+	if p == nil {
+		return nil
+	}
+	return &model.IngressPathInfo{
+		Path:           p.Path,
+		PathType:       p.PathType,
+		BackendService: p.BackendService,
+		BackendPort:    p.BackendPort,
+	}
+}
@@ -0,0 +1,144 @@
+// Package modelpb holds the protobuf wire types of pkg/model and converters
+// between the two. snapshot.pb.go is generated by protoc-gen-go from
+// proto/kubeadapt/agent/v2/snapshot.proto, and convert.go by hack/protogen;
+// run `make proto` after changing pkg/model.
+package modelpb
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// FromSnapshot converts a snapshot to its wire message.
+func FromSnapshot(s *model.ClusterSnapshot) *ClusterSnapshot {
+	return clusterSnapshotFromModel(s)
+}
+
+// ToSnapshot converts a wire message back to a snapshot. Empty lists and
+// maps come back nil, as protobuf does not distinguish them.
+func ToSnapshot(p *ClusterSnapshot) *model.ClusterSnapshot {
+	return clusterSnapshotToModel(p)
+}
+
+func fromModelSlice[M, P any](in []M, conv func(*M) *P) []*P {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*P, len(in))
+	for i := range in {
+		out[i] = conv(&in[i])
+	}
+	return out
+}
+
+func toModelSlice[P, M any](in []*P, conv func(*P) *M) []M {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]M, len(in))
+	for i, p := range in {
+		out[i] = deref(conv(p))
+	}
+	return out
+}
+
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+func int64Ptr(p *int) *int64 {
+	if p == nil {
+		return nil
+	}
+	v := int64(*p)
+	return &v
+}
+
+func intPtr(p *int64) *int {
+	if p == nil {
+		return nil
+	}
+	v := int(*p)
+	return &v
+}
+
+func int64Map(in map[string]int) map[string]int64 {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]int64, len(in))
+	for k, v := range in {
+		out[k] = int64(v)
+	}
+	return out
+}
+
+func intMap(in map[string]int64) map[string]int {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]int, len(in))
+	for k, v := range in {
+		out[k] = int(v)
+	}
+	return out
+}
+
+func stringListMap(in map[string][]string) map[string]*StringList {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]*StringList, len(in))
+	for k, v := range in {
+		out[k] = &StringList{Values: v}
+	}
+	return out
+}
+
+func stringSliceMap(in map[string]*StringList) map[string][]string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string][]string, len(in))
+	for k, v := range in {
+		out[k] = v.GetValues()
+	}
+	return out
+}
+
+// newStruct converts unstructured data such as a custom resource's status.
+// Values structpb cannot represent directly are normalized through JSON;
+// data that does not marshal to JSON is dropped, as it would be by the JSON
+// wire format.
+func newStruct(in map[string]any) *structpb.Struct {
+	if in == nil {
+		return nil
+	}
+	if s, err := structpb.NewStruct(in); err == nil {
+		return s
+	}
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil
+	}
+	var normalized map[string]any
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil
+	}
+	s, _ := structpb.NewStruct(normalized)
+	return s
+}
+
+func structMap(s *structpb.Struct) map[string]any {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
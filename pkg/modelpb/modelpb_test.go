@@ -0,0 +1,111 @@
+package modelpb
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kubeadapt/kubeadapt-agent/pkg/model"
+)
+
+// fill sets every field reachable from v to a distinct non-zero value, with
+// one element in every slice and map, so a field the converters miss shows
+// up as a difference after a round trip.
+func fill(v reflect.Value, n *int) {
+	*n++
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("s" + strconv.Itoa(*n))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(*n))
+	case reflect.Uint64:
+		v.SetUint(uint64(*n))
+	case reflect.Float64:
+		v.SetFloat(float64(*n) + 0.5)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), n)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0), n)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		val := reflect.New(v.Type().Elem()).Elem()
+		if val.Kind() == reflect.Interface {
+			// Unstructured data; structpb returns strings as strings.
+			val.Set(reflect.ValueOf("s" + strconv.Itoa(*n)))
+		} else {
+			fill(val, n)
+		}
+		v.SetMapIndex(reflect.ValueOf("k"+strconv.Itoa(*n)), val)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), n)
+			}
+		}
+	default:
+		panic("fill: unsupported kind " + v.Kind().String())
+	}
+}
+
+func TestRoundTrip_EveryField(t *testing.T) {
+	var want model.ClusterSnapshot
+	var n int
+	fill(reflect.ValueOf(&want).Elem(), &n)
+
+	data, err := proto.Marshal(FromSnapshot(&want))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var msg ClusterSnapshot
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := ToSnapshot(&msg)
+	if !reflect.DeepEqual(got, &want) {
+		t.Errorf("round trip lost data:\ngot:  %+v\nwant: %+v", got, &want)
+	}
+}
+
+func TestFromSnapshot_Nil(t *testing.T) {
+	if FromSnapshot(nil) != nil {
+		t.Error("FromSnapshot(nil) should be nil")
+	}
+	if ToSnapshot(nil) != nil {
+		t.Error("ToSnapshot(nil) should be nil")
+	}
+}
+
+func TestFromModel_Elements(t *testing.T) {
+	pod := &model.PodInfo{Name: "web-0", Namespace: "default"}
+	msg, ok := FromModel(pod).(*PodInfo)
+	if !ok || msg.GetName() != "web-0" || msg.GetNamespace() != "default" {
+		t.Fatalf("FromModel(pod) = %v", msg)
+	}
+	back, ok := ToModel(msg).(*model.PodInfo)
+	if !ok || !reflect.DeepEqual(back, pod) {
+		t.Errorf("ToModel = %+v, want %+v", back, pod)
+	}
+	if FromModel("not a model type") != nil {
+		t.Error("FromModel should return nil for other types")
+	}
+}
+
+func TestNewStruct_NormalizesValues(t *testing.T) {
+	s := newStruct(map[string]any{"replicas": int32(3), "nested": map[string]string{"a": "b"}})
+	if s == nil {
+		t.Fatal("newStruct returned nil")
+	}
+	got := s.AsMap()
+	if got["replicas"] != float64(3) {
+		t.Errorf("replicas = %v, want 3", got["replicas"])
+	}
+	if nested, _ := got["nested"].(map[string]any); nested["a"] != "b" {
+		t.Errorf("nested = %v, want map[a:b]", got["nested"])
+	}
+}
@@ -0,0 +1,9891 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: kubeadapt/agent/v2/snapshot.proto
+
+package modelpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClusterSnapshot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId        string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	ClusterId         string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Timestamp         int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AgentVersion      string                 `protobuf:"bytes,4,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	SnapshotType      string                 `protobuf:"bytes,5,opt,name=snapshot_type,json=snapshotType,proto3" json:"snapshot_type,omitempty"`
+	Sequence          uint64                 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	BaseSnapshotId    string                 `protobuf:"bytes,7,opt,name=base_snapshot_id,json=baseSnapshotId,proto3" json:"base_snapshot_id,omitempty"`
+	Delta             *SnapshotDelta         `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
+	Part              *SnapshotPart          `protobuf:"bytes,9,opt,name=part,proto3" json:"part,omitempty"`
+	Provider          string                 `protobuf:"bytes,10,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region            string                 `protobuf:"bytes,11,opt,name=region,proto3" json:"region,omitempty"`
+	CloudAccountId    string                 `protobuf:"bytes,12,opt,name=cloud_account_id,json=cloudAccountId,proto3" json:"cloud_account_id,omitempty"`
+	KubernetesVersion string                 `protobuf:"bytes,13,opt,name=kubernetes_version,json=kubernetesVersion,proto3" json:"kubernetes_version,omitempty"`
+	Nodes             []*NodeInfo            `protobuf:"bytes,14,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Pods              []*PodInfo             `protobuf:"bytes,15,rep,name=pods,proto3" json:"pods,omitempty"`
+	Namespaces        []*NamespaceInfo       `protobuf:"bytes,16,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Deployments       []*DeploymentInfo      `protobuf:"bytes,17,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	Statefulsets      []*StatefulSetInfo     `protobuf:"bytes,18,rep,name=statefulsets,proto3" json:"statefulsets,omitempty"`
+	Daemonsets        []*DaemonSetInfo       `protobuf:"bytes,19,rep,name=daemonsets,proto3" json:"daemonsets,omitempty"`
+	Jobs              []*JobInfo             `protobuf:"bytes,20,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Cronjobs          []*CronJobInfo         `protobuf:"bytes,21,rep,name=cronjobs,proto3" json:"cronjobs,omitempty"`
+	CustomWorkloads   []*CustomWorkloadInfo  `protobuf:"bytes,22,rep,name=custom_workloads,json=customWorkloads,proto3" json:"custom_workloads,omitempty"`
+	Hpas              []*HPAInfo             `protobuf:"bytes,23,rep,name=hpas,proto3" json:"hpas,omitempty"`
+	Vpas              []*VPAInfo             `protobuf:"bytes,24,rep,name=vpas,proto3" json:"vpas,omitempty"`
+	Pdbs              []*PDBInfo             `protobuf:"bytes,25,rep,name=pdbs,proto3" json:"pdbs,omitempty"`
+	Services          []*ServiceInfo         `protobuf:"bytes,26,rep,name=services,proto3" json:"services,omitempty"`
+	Ingresses         []*IngressInfo         `protobuf:"bytes,27,rep,name=ingresses,proto3" json:"ingresses,omitempty"`
+	Pvs               []*PVInfo              `protobuf:"bytes,28,rep,name=pvs,proto3" json:"pvs,omitempty"`
+	Pvcs              []*PVCInfo             `protobuf:"bytes,29,rep,name=pvcs,proto3" json:"pvcs,omitempty"`
+	StorageClasses    []*StorageClassInfo    `protobuf:"bytes,30,rep,name=storage_classes,json=storageClasses,proto3" json:"storage_classes,omitempty"`
+	PriorityClasses   []*PriorityClassInfo   `protobuf:"bytes,31,rep,name=priority_classes,json=priorityClasses,proto3" json:"priority_classes,omitempty"`
+	LimitRanges       []*LimitRangeInfo      `protobuf:"bytes,32,rep,name=limit_ranges,json=limitRanges,proto3" json:"limit_ranges,omitempty"`
+	ResourceQuotas    []*ResourceQuotaInfo   `protobuf:"bytes,33,rep,name=resource_quotas,json=resourceQuotas,proto3" json:"resource_quotas,omitempty"`
+	NodePools         []*NodePoolInfo        `protobuf:"bytes,34,rep,name=node_pools,json=nodePools,proto3" json:"node_pools,omitempty"`
+	Events            []*EventInfo           `protobuf:"bytes,35,rep,name=events,proto3" json:"events,omitempty"`
+	Summary           *ClusterSummary        `protobuf:"bytes,36,opt,name=summary,proto3" json:"summary,omitempty"`
+	Health            *AgentHealth           `protobuf:"bytes,37,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClusterSnapshot) Reset() {
+	*x = ClusterSnapshot{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSnapshot) ProtoMessage() {}
+
+func (x *ClusterSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSnapshot.ProtoReflect.Descriptor instead.
+func (*ClusterSnapshot) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClusterSnapshot) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetAgentVersion() string {
+	if x != nil {
+		return x.AgentVersion
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetSnapshotType() string {
+	if x != nil {
+		return x.SnapshotType
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetBaseSnapshotId() string {
+	if x != nil {
+		return x.BaseSnapshotId
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetDelta() *SnapshotDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPart() *SnapshotPart {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetCloudAccountId() string {
+	if x != nil {
+		return x.CloudAccountId
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+func (x *ClusterSnapshot) GetNodes() []*NodeInfo {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPods() []*PodInfo {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetNamespaces() []*NamespaceInfo {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetDeployments() []*DeploymentInfo {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetStatefulsets() []*StatefulSetInfo {
+	if x != nil {
+		return x.Statefulsets
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetDaemonsets() []*DaemonSetInfo {
+	if x != nil {
+		return x.Daemonsets
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetJobs() []*JobInfo {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetCronjobs() []*CronJobInfo {
+	if x != nil {
+		return x.Cronjobs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetCustomWorkloads() []*CustomWorkloadInfo {
+	if x != nil {
+		return x.CustomWorkloads
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetHpas() []*HPAInfo {
+	if x != nil {
+		return x.Hpas
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetVpas() []*VPAInfo {
+	if x != nil {
+		return x.Vpas
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPdbs() []*PDBInfo {
+	if x != nil {
+		return x.Pdbs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetServices() []*ServiceInfo {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetIngresses() []*IngressInfo {
+	if x != nil {
+		return x.Ingresses
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPvs() []*PVInfo {
+	if x != nil {
+		return x.Pvs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPvcs() []*PVCInfo {
+	if x != nil {
+		return x.Pvcs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetStorageClasses() []*StorageClassInfo {
+	if x != nil {
+		return x.StorageClasses
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPriorityClasses() []*PriorityClassInfo {
+	if x != nil {
+		return x.PriorityClasses
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetLimitRanges() []*LimitRangeInfo {
+	if x != nil {
+		return x.LimitRanges
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetResourceQuotas() []*ResourceQuotaInfo {
+	if x != nil {
+		return x.ResourceQuotas
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetNodePools() []*NodePoolInfo {
+	if x != nil {
+		return x.NodePools
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetEvents() []*EventInfo {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetSummary() *ClusterSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetHealth() *AgentHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type SnapshotDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         map[string]*StringList `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Updated       map[string]*StringList `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Deleted       map[string]*StringList `protobuf:"bytes,3,rep,name=deleted,proto3" json:"deleted,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotDelta) Reset() {
+	*x = SnapshotDelta{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDelta) ProtoMessage() {}
+
+func (x *SnapshotDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDelta.ProtoReflect.Descriptor instead.
+func (*SnapshotDelta) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SnapshotDelta) GetAdded() map[string]*StringList {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *SnapshotDelta) GetUpdated() map[string]*StringList {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *SnapshotDelta) GetDeleted() map[string]*StringList {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+type SnapshotPart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int64                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Family        string                 `protobuf:"bytes,3,opt,name=family,proto3" json:"family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotPart) Reset() {
+	*x = SnapshotPart{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotPart) ProtoMessage() {}
+
+func (x *SnapshotPart) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotPart.ProtoReflect.Descriptor instead.
+func (*SnapshotPart) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SnapshotPart) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SnapshotPart) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SnapshotPart) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+type NodeInfo struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	Name                        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                         string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	ProviderId                  string                 `protobuf:"bytes,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	InstanceId                  string                 `protobuf:"bytes,4,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	InstanceType                string                 `protobuf:"bytes,5,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	Region                      string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Zone                        string                 `protobuf:"bytes,7,opt,name=zone,proto3" json:"zone,omitempty"`
+	CapacityType                string                 `protobuf:"bytes,8,opt,name=capacity_type,json=capacityType,proto3" json:"capacity_type,omitempty"`
+	NodeGroup                   string                 `protobuf:"bytes,9,opt,name=node_group,json=nodeGroup,proto3" json:"node_group,omitempty"`
+	Architecture                string                 `protobuf:"bytes,10,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Os                          string                 `protobuf:"bytes,11,opt,name=os,proto3" json:"os,omitempty"`
+	KubeletVersion              string                 `protobuf:"bytes,12,opt,name=kubelet_version,json=kubeletVersion,proto3" json:"kubelet_version,omitempty"`
+	ContainerRuntime            string                 `protobuf:"bytes,13,opt,name=container_runtime,json=containerRuntime,proto3" json:"container_runtime,omitempty"`
+	PodCidr                     string                 `protobuf:"bytes,14,opt,name=pod_cidr,json=podCidr,proto3" json:"pod_cidr,omitempty"`
+	PodCidrs                    []string               `protobuf:"bytes,15,rep,name=pod_cidrs,json=podCidrs,proto3" json:"pod_cidrs,omitempty"`
+	OsImage                     string                 `protobuf:"bytes,16,opt,name=os_image,json=osImage,proto3" json:"os_image,omitempty"`
+	KernelVersion               string                 `protobuf:"bytes,17,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	KubeProxyVersion            string                 `protobuf:"bytes,18,opt,name=kube_proxy_version,json=kubeProxyVersion,proto3" json:"kube_proxy_version,omitempty"`
+	CpuCapacityCores            float64                `protobuf:"fixed64,19,opt,name=cpu_capacity_cores,json=cpuCapacityCores,proto3" json:"cpu_capacity_cores,omitempty"`
+	MemoryCapacityBytes         int64                  `protobuf:"varint,20,opt,name=memory_capacity_bytes,json=memoryCapacityBytes,proto3" json:"memory_capacity_bytes,omitempty"`
+	EphemeralStorageBytes       int64                  `protobuf:"varint,21,opt,name=ephemeral_storage_bytes,json=ephemeralStorageBytes,proto3" json:"ephemeral_storage_bytes,omitempty"`
+	EphemeralStorageAllocatable int64                  `protobuf:"varint,22,opt,name=ephemeral_storage_allocatable,json=ephemeralStorageAllocatable,proto3" json:"ephemeral_storage_allocatable,omitempty"`
+	PodCapacity                 int64                  `protobuf:"varint,23,opt,name=pod_capacity,json=podCapacity,proto3" json:"pod_capacity,omitempty"`
+	GpuCapacity                 int64                  `protobuf:"varint,24,opt,name=gpu_capacity,json=gpuCapacity,proto3" json:"gpu_capacity,omitempty"`
+	CpuAllocatable              float64                `protobuf:"fixed64,25,opt,name=cpu_allocatable,json=cpuAllocatable,proto3" json:"cpu_allocatable,omitempty"`
+	MemoryAllocatable           int64                  `protobuf:"varint,26,opt,name=memory_allocatable,json=memoryAllocatable,proto3" json:"memory_allocatable,omitempty"`
+	PodAllocatable              int64                  `protobuf:"varint,27,opt,name=pod_allocatable,json=podAllocatable,proto3" json:"pod_allocatable,omitempty"`
+	GpuAllocatable              int64                  `protobuf:"varint,28,opt,name=gpu_allocatable,json=gpuAllocatable,proto3" json:"gpu_allocatable,omitempty"`
+	GpuVendor                   string                 `protobuf:"bytes,29,opt,name=gpu_vendor,json=gpuVendor,proto3" json:"gpu_vendor,omitempty"`
+	GpuModel                    string                 `protobuf:"bytes,30,opt,name=gpu_model,json=gpuModel,proto3" json:"gpu_model,omitempty"`
+	GpuDriverVersion            string                 `protobuf:"bytes,31,opt,name=gpu_driver_version,json=gpuDriverVersion,proto3" json:"gpu_driver_version,omitempty"`
+	MigEnabled                  bool                   `protobuf:"varint,32,opt,name=mig_enabled,json=migEnabled,proto3" json:"mig_enabled,omitempty"`
+	MigDevices                  map[string]int64       `protobuf:"bytes,33,rep,name=mig_devices,json=migDevices,proto3" json:"mig_devices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	GpuUtilizationPercent       *float64               `protobuf:"fixed64,34,opt,name=gpu_utilization_percent,json=gpuUtilizationPercent,proto3,oneof" json:"gpu_utilization_percent,omitempty"`
+	GpuTensorActivePercent      *float64               `protobuf:"fixed64,35,opt,name=gpu_tensor_active_percent,json=gpuTensorActivePercent,proto3,oneof" json:"gpu_tensor_active_percent,omitempty"`
+	GpuMemoryUtilPercent        *float64               `protobuf:"fixed64,36,opt,name=gpu_memory_util_percent,json=gpuMemoryUtilPercent,proto3,oneof" json:"gpu_memory_util_percent,omitempty"`
+	GpuMemoryUsedBytes          *int64                 `protobuf:"varint,37,opt,name=gpu_memory_used_bytes,json=gpuMemoryUsedBytes,proto3,oneof" json:"gpu_memory_used_bytes,omitempty"`
+	GpuMemoryTotalBytes         *int64                 `protobuf:"varint,38,opt,name=gpu_memory_total_bytes,json=gpuMemoryTotalBytes,proto3,oneof" json:"gpu_memory_total_bytes,omitempty"`
+	GpuTemperatureCelsius       *float64               `protobuf:"fixed64,39,opt,name=gpu_temperature_celsius,json=gpuTemperatureCelsius,proto3,oneof" json:"gpu_temperature_celsius,omitempty"`
+	GpuPowerWatts               *float64               `protobuf:"fixed64,40,opt,name=gpu_power_watts,json=gpuPowerWatts,proto3,oneof" json:"gpu_power_watts,omitempty"`
+	GpuDevices                  []*GPUDeviceInfo       `protobuf:"bytes,41,rep,name=gpu_devices,json=gpuDevices,proto3" json:"gpu_devices,omitempty"`
+	GpuHealth                   string                 `protobuf:"bytes,42,opt,name=gpu_health,json=gpuHealth,proto3" json:"gpu_health,omitempty"`
+	GpuUnhealthyDevices         int64                  `protobuf:"varint,43,opt,name=gpu_unhealthy_devices,json=gpuUnhealthyDevices,proto3" json:"gpu_unhealthy_devices,omitempty"`
+	GpuIdle                     *GPUIdleInfo           `protobuf:"bytes,44,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	CpuUsageCores               *float64               `protobuf:"fixed64,45,opt,name=cpu_usage_cores,json=cpuUsageCores,proto3,oneof" json:"cpu_usage_cores,omitempty"`
+	MemoryUsageBytes            *int64                 `protobuf:"varint,46,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3,oneof" json:"memory_usage_bytes,omitempty"`
+	EphemeralStorageUsedBytes   *int64                 `protobuf:"varint,47,opt,name=ephemeral_storage_used_bytes,json=ephemeralStorageUsedBytes,proto3,oneof" json:"ephemeral_storage_used_bytes,omitempty"`
+	ImagefsUsedBytes            *int64                 `protobuf:"varint,48,opt,name=imagefs_used_bytes,json=imagefsUsedBytes,proto3,oneof" json:"imagefs_used_bytes,omitempty"`
+	NetworkRxBytes              *int64                 `protobuf:"varint,49,opt,name=network_rx_bytes,json=networkRxBytes,proto3,oneof" json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes              *int64                 `protobuf:"varint,50,opt,name=network_tx_bytes,json=networkTxBytes,proto3,oneof" json:"network_tx_bytes,omitempty"`
+	HourlyCost                  *float64               `protobuf:"fixed64,51,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	IdleHourlyCost              *float64               `protobuf:"fixed64,52,opt,name=idle_hourly_cost,json=idleHourlyCost,proto3,oneof" json:"idle_hourly_cost,omitempty"`
+	PricingSource               string                 `protobuf:"bytes,53,opt,name=pricing_source,json=pricingSource,proto3" json:"pricing_source,omitempty"`
+	Ready                       bool                   `protobuf:"varint,54,opt,name=ready,proto3" json:"ready,omitempty"`
+	Unschedulable               bool                   `protobuf:"varint,55,opt,name=unschedulable,proto3" json:"unschedulable,omitempty"`
+	Taints                      []*TaintInfo           `protobuf:"bytes,56,rep,name=taints,proto3" json:"taints,omitempty"`
+	Conditions                  []*NodeConditionInfo   `protobuf:"bytes,57,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	EventReasons                map[string]int64       `protobuf:"bytes,58,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Labels                      map[string]string      `protobuf:"bytes,59,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations                 map[string]string      `protobuf:"bytes,60,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp           int64                  `protobuf:"varint,61,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetCapacityType() string {
+	if x != nil {
+		return x.CapacityType
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetNodeGroup() string {
+	if x != nil {
+		return x.NodeGroup
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetKubeletVersion() string {
+	if x != nil {
+		return x.KubeletVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetContainerRuntime() string {
+	if x != nil {
+		return x.ContainerRuntime
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetPodCidr() string {
+	if x != nil {
+		return x.PodCidr
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetPodCidrs() []string {
+	if x != nil {
+		return x.PodCidrs
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetOsImage() string {
+	if x != nil {
+		return x.OsImage
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetKubeProxyVersion() string {
+	if x != nil {
+		return x.KubeProxyVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetCpuCapacityCores() float64 {
+	if x != nil {
+		return x.CpuCapacityCores
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetMemoryCapacityBytes() int64 {
+	if x != nil {
+		return x.MemoryCapacityBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetEphemeralStorageBytes() int64 {
+	if x != nil {
+		return x.EphemeralStorageBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetEphemeralStorageAllocatable() int64 {
+	if x != nil {
+		return x.EphemeralStorageAllocatable
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetPodCapacity() int64 {
+	if x != nil {
+		return x.PodCapacity
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuCapacity() int64 {
+	if x != nil {
+		return x.GpuCapacity
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetCpuAllocatable() float64 {
+	if x != nil {
+		return x.CpuAllocatable
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetMemoryAllocatable() int64 {
+	if x != nil {
+		return x.MemoryAllocatable
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetPodAllocatable() int64 {
+	if x != nil {
+		return x.PodAllocatable
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuAllocatable() int64 {
+	if x != nil {
+		return x.GpuAllocatable
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuVendor() string {
+	if x != nil {
+		return x.GpuVendor
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetGpuModel() string {
+	if x != nil {
+		return x.GpuModel
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetGpuDriverVersion() string {
+	if x != nil {
+		return x.GpuDriverVersion
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetMigEnabled() bool {
+	if x != nil {
+		return x.MigEnabled
+	}
+	return false
+}
+
+func (x *NodeInfo) GetMigDevices() map[string]int64 {
+	if x != nil {
+		return x.MigDevices
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetGpuUtilizationPercent() float64 {
+	if x != nil && x.GpuUtilizationPercent != nil {
+		return *x.GpuUtilizationPercent
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuTensorActivePercent() float64 {
+	if x != nil && x.GpuTensorActivePercent != nil {
+		return *x.GpuTensorActivePercent
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuMemoryUtilPercent() float64 {
+	if x != nil && x.GpuMemoryUtilPercent != nil {
+		return *x.GpuMemoryUtilPercent
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuMemoryUsedBytes() int64 {
+	if x != nil && x.GpuMemoryUsedBytes != nil {
+		return *x.GpuMemoryUsedBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuMemoryTotalBytes() int64 {
+	if x != nil && x.GpuMemoryTotalBytes != nil {
+		return *x.GpuMemoryTotalBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuTemperatureCelsius() float64 {
+	if x != nil && x.GpuTemperatureCelsius != nil {
+		return *x.GpuTemperatureCelsius
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuPowerWatts() float64 {
+	if x != nil && x.GpuPowerWatts != nil {
+		return *x.GpuPowerWatts
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuDevices() []*GPUDeviceInfo {
+	if x != nil {
+		return x.GpuDevices
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetGpuHealth() string {
+	if x != nil {
+		return x.GpuHealth
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetGpuUnhealthyDevices() int64 {
+	if x != nil {
+		return x.GpuUnhealthyDevices
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetCpuUsageCores() float64 {
+	if x != nil && x.CpuUsageCores != nil {
+		return *x.CpuUsageCores
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetMemoryUsageBytes() int64 {
+	if x != nil && x.MemoryUsageBytes != nil {
+		return *x.MemoryUsageBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetEphemeralStorageUsedBytes() int64 {
+	if x != nil && x.EphemeralStorageUsedBytes != nil {
+		return *x.EphemeralStorageUsedBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetImagefsUsedBytes() int64 {
+	if x != nil && x.ImagefsUsedBytes != nil {
+		return *x.ImagefsUsedBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetNetworkRxBytes() int64 {
+	if x != nil && x.NetworkRxBytes != nil {
+		return *x.NetworkRxBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetNetworkTxBytes() int64 {
+	if x != nil && x.NetworkTxBytes != nil {
+		return *x.NetworkTxBytes
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetIdleHourlyCost() float64 {
+	if x != nil && x.IdleHourlyCost != nil {
+		return *x.IdleHourlyCost
+	}
+	return 0
+}
+
+func (x *NodeInfo) GetPricingSource() string {
+	if x != nil {
+		return x.PricingSource
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *NodeInfo) GetUnschedulable() bool {
+	if x != nil {
+		return x.Unschedulable
+	}
+	return false
+}
+
+func (x *NodeInfo) GetTaints() []*TaintInfo {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetConditions() []*NodeConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type PodInfo struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Name                      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                       string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace                 string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeName                  string                 `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Phase                     string                 `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Reason                    string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	QosClass                  string                 `protobuf:"bytes,7,opt,name=qos_class,json=qosClass,proto3" json:"qos_class,omitempty"`
+	OwnerKind                 string                 `protobuf:"bytes,8,opt,name=owner_kind,json=ownerKind,proto3" json:"owner_kind,omitempty"`
+	OwnerName                 string                 `protobuf:"bytes,9,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	OwnerUid                  string                 `protobuf:"bytes,10,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
+	OwnerApiVersion           string                 `protobuf:"bytes,11,opt,name=owner_api_version,json=ownerApiVersion,proto3" json:"owner_api_version,omitempty"`
+	Containers                []*ContainerInfo       `protobuf:"bytes,12,rep,name=containers,proto3" json:"containers,omitempty"`
+	InitContainers            []*ContainerInfo       `protobuf:"bytes,13,rep,name=init_containers,json=initContainers,proto3" json:"init_containers,omitempty"`
+	Labels                    map[string]string      `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations               map[string]string      `protobuf:"bytes,15,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp         int64                  `protobuf:"varint,16,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	PriorityClassName         string                 `protobuf:"bytes,17,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	Priority                  *int32                 `protobuf:"varint,18,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	SchedulerName             string                 `protobuf:"bytes,19,opt,name=scheduler_name,json=schedulerName,proto3" json:"scheduler_name,omitempty"`
+	ServiceAccountName        string                 `protobuf:"bytes,20,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+	PodIp                     string                 `protobuf:"bytes,21,opt,name=pod_ip,json=podIp,proto3" json:"pod_ip,omitempty"`
+	HostIp                    string                 `protobuf:"bytes,22,opt,name=host_ip,json=hostIp,proto3" json:"host_ip,omitempty"`
+	HostNetwork               bool                   `protobuf:"varint,23,opt,name=host_network,json=hostNetwork,proto3" json:"host_network,omitempty"`
+	HasHostpath               bool                   `protobuf:"varint,24,opt,name=has_hostpath,json=hasHostpath,proto3" json:"has_hostpath,omitempty"`
+	HasEmptydir               bool                   `protobuf:"varint,25,opt,name=has_emptydir,json=hasEmptydir,proto3" json:"has_emptydir,omitempty"`
+	Volumes                   []*PodVolumeInfo       `protobuf:"bytes,26,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	EphemeralStorageUsedBytes *int64                 `protobuf:"varint,27,opt,name=ephemeral_storage_used_bytes,json=ephemeralStorageUsedBytes,proto3,oneof" json:"ephemeral_storage_used_bytes,omitempty"`
+	NetworkRxBytes            *int64                 `protobuf:"varint,28,opt,name=network_rx_bytes,json=networkRxBytes,proto3,oneof" json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes            *int64                 `protobuf:"varint,29,opt,name=network_tx_bytes,json=networkTxBytes,proto3,oneof" json:"network_tx_bytes,omitempty"`
+	HourlyCost                *float64               `protobuf:"fixed64,30,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	Conditions                []*PodConditionInfo    `protobuf:"bytes,31,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	EventReasons              map[string]int64       `protobuf:"bytes,32,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PodInfo) Reset() {
+	*x = PodInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodInfo) ProtoMessage() {}
+
+func (x *PodInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodInfo.ProtoReflect.Descriptor instead.
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PodInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PodInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PodInfo) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PodInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PodInfo) GetQosClass() string {
+	if x != nil {
+		return x.QosClass
+	}
+	return ""
+}
+
+func (x *PodInfo) GetOwnerKind() string {
+	if x != nil {
+		return x.OwnerKind
+	}
+	return ""
+}
+
+func (x *PodInfo) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetOwnerUid() string {
+	if x != nil {
+		return x.OwnerUid
+	}
+	return ""
+}
+
+func (x *PodInfo) GetOwnerApiVersion() string {
+	if x != nil {
+		return x.OwnerApiVersion
+	}
+	return ""
+}
+
+func (x *PodInfo) GetContainers() []*ContainerInfo {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *PodInfo) GetInitContainers() []*ContainerInfo {
+	if x != nil {
+		return x.InitContainers
+	}
+	return nil
+}
+
+func (x *PodInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PodInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PodInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *PodInfo) GetPriorityClassName() string {
+	if x != nil {
+		return x.PriorityClassName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *PodInfo) GetSchedulerName() string {
+	if x != nil {
+		return x.SchedulerName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPodIp() string {
+	if x != nil {
+		return x.PodIp
+	}
+	return ""
+}
+
+func (x *PodInfo) GetHostIp() string {
+	if x != nil {
+		return x.HostIp
+	}
+	return ""
+}
+
+func (x *PodInfo) GetHostNetwork() bool {
+	if x != nil {
+		return x.HostNetwork
+	}
+	return false
+}
+
+func (x *PodInfo) GetHasHostpath() bool {
+	if x != nil {
+		return x.HasHostpath
+	}
+	return false
+}
+
+func (x *PodInfo) GetHasEmptydir() bool {
+	if x != nil {
+		return x.HasEmptydir
+	}
+	return false
+}
+
+func (x *PodInfo) GetVolumes() []*PodVolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *PodInfo) GetEphemeralStorageUsedBytes() int64 {
+	if x != nil && x.EphemeralStorageUsedBytes != nil {
+		return *x.EphemeralStorageUsedBytes
+	}
+	return 0
+}
+
+func (x *PodInfo) GetNetworkRxBytes() int64 {
+	if x != nil && x.NetworkRxBytes != nil {
+		return *x.NetworkRxBytes
+	}
+	return 0
+}
+
+func (x *PodInfo) GetNetworkTxBytes() int64 {
+	if x != nil && x.NetworkTxBytes != nil {
+		return *x.NetworkTxBytes
+	}
+	return 0
+}
+
+func (x *PodInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *PodInfo) GetConditions() []*PodConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *PodInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type NamespaceInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phase             string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp int64                  `protobuf:"varint,5,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NamespaceInfo) Reset() {
+	*x = NamespaceInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceInfo) ProtoMessage() {}
+
+func (x *NamespaceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceInfo.ProtoReflect.Descriptor instead.
+func (*NamespaceInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NamespaceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *NamespaceInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NamespaceInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *NamespaceInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type DeploymentInfo struct {
+	state                 protoimpl.MessageState    `protogen:"open.v1"`
+	Name                  string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                   string                    `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace             string                    `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replicas              int32                     `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	ReadyReplicas         int32                     `protobuf:"varint,5,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	AvailableReplicas     int32                     `protobuf:"varint,6,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	UnavailableReplicas   int32                     `protobuf:"varint,7,opt,name=unavailable_replicas,json=unavailableReplicas,proto3" json:"unavailable_replicas,omitempty"`
+	UpdatedReplicas       int32                     `protobuf:"varint,8,opt,name=updated_replicas,json=updatedReplicas,proto3" json:"updated_replicas,omitempty"`
+	Strategy              string                    `protobuf:"bytes,9,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	MaxSurge              string                    `protobuf:"bytes,10,opt,name=max_surge,json=maxSurge,proto3" json:"max_surge,omitempty"`
+	MaxUnavailable        string                    `protobuf:"bytes,11,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	TotalCpuRequest       float64                   `protobuf:"fixed64,12,opt,name=total_cpu_request,json=totalCpuRequest,proto3" json:"total_cpu_request,omitempty"`
+	TotalMemoryRequest    int64                     `protobuf:"varint,13,opt,name=total_memory_request,json=totalMemoryRequest,proto3" json:"total_memory_request,omitempty"`
+	TotalCpuLimit         float64                   `protobuf:"fixed64,14,opt,name=total_cpu_limit,json=totalCpuLimit,proto3" json:"total_cpu_limit,omitempty"`
+	TotalMemoryLimit      int64                     `protobuf:"varint,15,opt,name=total_memory_limit,json=totalMemoryLimit,proto3" json:"total_memory_limit,omitempty"`
+	TotalCpuUsage         *float64                  `protobuf:"fixed64,16,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryUsage      *int64                    `protobuf:"varint,17,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	HourlyCost            *float64                  `protobuf:"fixed64,18,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle               *GPUIdleInfo              `protobuf:"bytes,19,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	Recommendations       []*ResourceRecommendation `protobuf:"bytes,20,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	TotalPvcCapacityBytes int64                     `protobuf:"varint,21,opt,name=total_pvc_capacity_bytes,json=totalPvcCapacityBytes,proto3" json:"total_pvc_capacity_bytes,omitempty"`
+	ContainerSpecs        []*ContainerSpecInfo      `protobuf:"bytes,22,rep,name=container_specs,json=containerSpecs,proto3" json:"container_specs,omitempty"`
+	Selector              map[string]string         `protobuf:"bytes,23,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels                map[string]string         `protobuf:"bytes,24,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations           map[string]string         `protobuf:"bytes,25,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp     int64                     `protobuf:"varint,26,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Conditions            []*WorkloadConditionInfo  `protobuf:"bytes,27,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Paused                bool                      `protobuf:"varint,28,opt,name=paused,proto3" json:"paused,omitempty"`
+	EventReasons          map[string]int64          `protobuf:"bytes,29,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *DeploymentInfo) Reset() {
+	*x = DeploymentInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeploymentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeploymentInfo) ProtoMessage() {}
+
+func (x *DeploymentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeploymentInfo.ProtoReflect.Descriptor instead.
+func (*DeploymentInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeploymentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetUnavailableReplicas() int32 {
+	if x != nil {
+		return x.UnavailableReplicas
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetMaxSurge() string {
+	if x != nil {
+		return x.MaxSurge
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetMaxUnavailable() string {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return ""
+}
+
+func (x *DeploymentInfo) GetTotalCpuRequest() float64 {
+	if x != nil {
+		return x.TotalCpuRequest
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetTotalMemoryRequest() int64 {
+	if x != nil {
+		return x.TotalMemoryRequest
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetTotalCpuLimit() float64 {
+	if x != nil {
+		return x.TotalCpuLimit
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetTotalMemoryLimit() int64 {
+	if x != nil {
+		return x.TotalMemoryLimit
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetRecommendations() []*ResourceRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetTotalPvcCapacityBytes() int64 {
+	if x != nil {
+		return x.TotalPvcCapacityBytes
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetContainerSpecs() []*ContainerSpecInfo {
+	if x != nil {
+		return x.ContainerSpecs
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *DeploymentInfo) GetConditions() []*WorkloadConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DeploymentInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *DeploymentInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type StatefulSetInfo struct {
+	state                 protoimpl.MessageState    `protogen:"open.v1"`
+	Name                  string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                   string                    `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace             string                    `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replicas              int32                     `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	ReadyReplicas         int32                     `protobuf:"varint,5,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	AvailableReplicas     int32                     `protobuf:"varint,6,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	UpdatedReplicas       int32                     `protobuf:"varint,7,opt,name=updated_replicas,json=updatedReplicas,proto3" json:"updated_replicas,omitempty"`
+	Strategy              string                    `protobuf:"bytes,8,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	ServiceName           string                    `protobuf:"bytes,9,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	PodManagementPolicy   string                    `protobuf:"bytes,10,opt,name=pod_management_policy,json=podManagementPolicy,proto3" json:"pod_management_policy,omitempty"`
+	VolumeClaimTemplates  []string                  `protobuf:"bytes,11,rep,name=volume_claim_templates,json=volumeClaimTemplates,proto3" json:"volume_claim_templates,omitempty"`
+	TotalCpuRequest       float64                   `protobuf:"fixed64,12,opt,name=total_cpu_request,json=totalCpuRequest,proto3" json:"total_cpu_request,omitempty"`
+	TotalMemoryRequest    int64                     `protobuf:"varint,13,opt,name=total_memory_request,json=totalMemoryRequest,proto3" json:"total_memory_request,omitempty"`
+	TotalCpuLimit         float64                   `protobuf:"fixed64,14,opt,name=total_cpu_limit,json=totalCpuLimit,proto3" json:"total_cpu_limit,omitempty"`
+	TotalMemoryLimit      int64                     `protobuf:"varint,15,opt,name=total_memory_limit,json=totalMemoryLimit,proto3" json:"total_memory_limit,omitempty"`
+	TotalCpuUsage         *float64                  `protobuf:"fixed64,16,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryUsage      *int64                    `protobuf:"varint,17,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	HourlyCost            *float64                  `protobuf:"fixed64,18,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle               *GPUIdleInfo              `protobuf:"bytes,19,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	Recommendations       []*ResourceRecommendation `protobuf:"bytes,20,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	TotalPvcCapacityBytes int64                     `protobuf:"varint,21,opt,name=total_pvc_capacity_bytes,json=totalPvcCapacityBytes,proto3" json:"total_pvc_capacity_bytes,omitempty"`
+	ContainerSpecs        []*ContainerSpecInfo      `protobuf:"bytes,22,rep,name=container_specs,json=containerSpecs,proto3" json:"container_specs,omitempty"`
+	Selector              map[string]string         `protobuf:"bytes,23,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels                map[string]string         `protobuf:"bytes,24,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations           map[string]string         `protobuf:"bytes,25,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp     int64                     `protobuf:"varint,26,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Partition             *int32                    `protobuf:"varint,27,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+	Conditions            []*WorkloadConditionInfo  `protobuf:"bytes,28,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	EventReasons          map[string]int64          `protobuf:"bytes,29,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StatefulSetInfo) Reset() {
+	*x = StatefulSetInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatefulSetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatefulSetInfo) ProtoMessage() {}
+
+func (x *StatefulSetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatefulSetInfo.ProtoReflect.Descriptor instead.
+func (*StatefulSetInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StatefulSetInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetPodManagementPolicy() string {
+	if x != nil {
+		return x.PodManagementPolicy
+	}
+	return ""
+}
+
+func (x *StatefulSetInfo) GetVolumeClaimTemplates() []string {
+	if x != nil {
+		return x.VolumeClaimTemplates
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetTotalCpuRequest() float64 {
+	if x != nil {
+		return x.TotalCpuRequest
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetTotalMemoryRequest() int64 {
+	if x != nil {
+		return x.TotalMemoryRequest
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetTotalCpuLimit() float64 {
+	if x != nil {
+		return x.TotalCpuLimit
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetTotalMemoryLimit() int64 {
+	if x != nil {
+		return x.TotalMemoryLimit
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetRecommendations() []*ResourceRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetTotalPvcCapacityBytes() int64 {
+	if x != nil {
+		return x.TotalPvcCapacityBytes
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetContainerSpecs() []*ContainerSpecInfo {
+	if x != nil {
+		return x.ContainerSpecs
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetPartition() int32 {
+	if x != nil && x.Partition != nil {
+		return *x.Partition
+	}
+	return 0
+}
+
+func (x *StatefulSetInfo) GetConditions() []*WorkloadConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *StatefulSetInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type DaemonSetInfo struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	Name                   string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                    string                    `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace              string                    `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DesiredNumberScheduled int32                     `protobuf:"varint,4,opt,name=desired_number_scheduled,json=desiredNumberScheduled,proto3" json:"desired_number_scheduled,omitempty"`
+	CurrentNumberScheduled int32                     `protobuf:"varint,5,opt,name=current_number_scheduled,json=currentNumberScheduled,proto3" json:"current_number_scheduled,omitempty"`
+	NumberReady            int32                     `protobuf:"varint,6,opt,name=number_ready,json=numberReady,proto3" json:"number_ready,omitempty"`
+	NumberMisscheduled     int32                     `protobuf:"varint,7,opt,name=number_misscheduled,json=numberMisscheduled,proto3" json:"number_misscheduled,omitempty"`
+	UpdatedNumberScheduled int32                     `protobuf:"varint,8,opt,name=updated_number_scheduled,json=updatedNumberScheduled,proto3" json:"updated_number_scheduled,omitempty"`
+	NumberUnavailable      int32                     `protobuf:"varint,9,opt,name=number_unavailable,json=numberUnavailable,proto3" json:"number_unavailable,omitempty"`
+	NumberAvailable        int32                     `protobuf:"varint,10,opt,name=number_available,json=numberAvailable,proto3" json:"number_available,omitempty"`
+	Strategy               string                    `protobuf:"bytes,11,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	TotalCpuRequest        float64                   `protobuf:"fixed64,12,opt,name=total_cpu_request,json=totalCpuRequest,proto3" json:"total_cpu_request,omitempty"`
+	TotalMemoryRequest     int64                     `protobuf:"varint,13,opt,name=total_memory_request,json=totalMemoryRequest,proto3" json:"total_memory_request,omitempty"`
+	TotalCpuLimit          float64                   `protobuf:"fixed64,14,opt,name=total_cpu_limit,json=totalCpuLimit,proto3" json:"total_cpu_limit,omitempty"`
+	TotalMemoryLimit       int64                     `protobuf:"varint,15,opt,name=total_memory_limit,json=totalMemoryLimit,proto3" json:"total_memory_limit,omitempty"`
+	TotalCpuUsage          *float64                  `protobuf:"fixed64,16,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryUsage       *int64                    `protobuf:"varint,17,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	HourlyCost             *float64                  `protobuf:"fixed64,18,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle                *GPUIdleInfo              `protobuf:"bytes,19,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	Recommendations        []*ResourceRecommendation `protobuf:"bytes,20,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	ContainerSpecs         []*ContainerSpecInfo      `protobuf:"bytes,21,rep,name=container_specs,json=containerSpecs,proto3" json:"container_specs,omitempty"`
+	Selector               map[string]string         `protobuf:"bytes,22,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels                 map[string]string         `protobuf:"bytes,23,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations            map[string]string         `protobuf:"bytes,24,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp      int64                     `protobuf:"varint,25,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Conditions             []*WorkloadConditionInfo  `protobuf:"bytes,26,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	EventReasons           map[string]int64          `protobuf:"bytes,27,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DaemonSetInfo) Reset() {
+	*x = DaemonSetInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaemonSetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonSetInfo) ProtoMessage() {}
+
+func (x *DaemonSetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonSetInfo.ProtoReflect.Descriptor instead.
+func (*DaemonSetInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DaemonSetInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaemonSetInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DaemonSetInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DaemonSetInfo) GetDesiredNumberScheduled() int32 {
+	if x != nil {
+		return x.DesiredNumberScheduled
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetCurrentNumberScheduled() int32 {
+	if x != nil {
+		return x.CurrentNumberScheduled
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetNumberReady() int32 {
+	if x != nil {
+		return x.NumberReady
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetNumberMisscheduled() int32 {
+	if x != nil {
+		return x.NumberMisscheduled
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetUpdatedNumberScheduled() int32 {
+	if x != nil {
+		return x.UpdatedNumberScheduled
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetNumberUnavailable() int32 {
+	if x != nil {
+		return x.NumberUnavailable
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetNumberAvailable() int32 {
+	if x != nil {
+		return x.NumberAvailable
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *DaemonSetInfo) GetTotalCpuRequest() float64 {
+	if x != nil {
+		return x.TotalCpuRequest
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetTotalMemoryRequest() int64 {
+	if x != nil {
+		return x.TotalMemoryRequest
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetTotalCpuLimit() float64 {
+	if x != nil {
+		return x.TotalCpuLimit
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetTotalMemoryLimit() int64 {
+	if x != nil {
+		return x.TotalMemoryLimit
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetRecommendations() []*ResourceRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetContainerSpecs() []*ContainerSpecInfo {
+	if x != nil {
+		return x.ContainerSpecs
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *DaemonSetInfo) GetConditions() []*WorkloadConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DaemonSetInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type JobInfo struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                     string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace               string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	OwnerCronjob            string                 `protobuf:"bytes,4,opt,name=owner_cronjob,json=ownerCronjob,proto3" json:"owner_cronjob,omitempty"`
+	Completions             *int32                 `protobuf:"varint,5,opt,name=completions,proto3,oneof" json:"completions,omitempty"`
+	Parallelism             *int32                 `protobuf:"varint,6,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`
+	BackoffLimit            *int32                 `protobuf:"varint,7,opt,name=backoff_limit,json=backoffLimit,proto3,oneof" json:"backoff_limit,omitempty"`
+	ActiveDeadlineSeconds   *int64                 `protobuf:"varint,8,opt,name=active_deadline_seconds,json=activeDeadlineSeconds,proto3,oneof" json:"active_deadline_seconds,omitempty"`
+	TtlSecondsAfterFinished *int32                 `protobuf:"varint,9,opt,name=ttl_seconds_after_finished,json=ttlSecondsAfterFinished,proto3,oneof" json:"ttl_seconds_after_finished,omitempty"`
+	Active                  int32                  `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	Succeeded               int32                  `protobuf:"varint,11,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed                  int32                  `protobuf:"varint,12,opt,name=failed,proto3" json:"failed,omitempty"`
+	StartTime               *int64                 `protobuf:"varint,13,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	CompletionTime          *int64                 `protobuf:"varint,14,opt,name=completion_time,json=completionTime,proto3,oneof" json:"completion_time,omitempty"`
+	DurationSeconds         *float64               `protobuf:"fixed64,15,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	TotalCpuRequest         float64                `protobuf:"fixed64,16,opt,name=total_cpu_request,json=totalCpuRequest,proto3" json:"total_cpu_request,omitempty"`
+	TotalMemoryRequest      int64                  `protobuf:"varint,17,opt,name=total_memory_request,json=totalMemoryRequest,proto3" json:"total_memory_request,omitempty"`
+	TotalCpuUsage           *float64               `protobuf:"fixed64,18,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryUsage        *int64                 `protobuf:"varint,19,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	HourlyCost              *float64               `protobuf:"fixed64,20,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle                 *GPUIdleInfo           `protobuf:"bytes,21,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	ContainerSpecs          []*ContainerSpecInfo   `protobuf:"bytes,22,rep,name=container_specs,json=containerSpecs,proto3" json:"container_specs,omitempty"`
+	Labels                  map[string]string      `protobuf:"bytes,23,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations             map[string]string      `protobuf:"bytes,24,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp       int64                  `protobuf:"varint,25,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Conditions              []*JobConditionInfo    `protobuf:"bytes,26,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	EventReasons            map[string]int64       `protobuf:"bytes,27,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *JobInfo) Reset() {
+	*x = JobInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInfo) ProtoMessage() {}
+
+func (x *JobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInfo.ProtoReflect.Descriptor instead.
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JobInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *JobInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *JobInfo) GetOwnerCronjob() string {
+	if x != nil {
+		return x.OwnerCronjob
+	}
+	return ""
+}
+
+func (x *JobInfo) GetCompletions() int32 {
+	if x != nil && x.Completions != nil {
+		return *x.Completions
+	}
+	return 0
+}
+
+func (x *JobInfo) GetParallelism() int32 {
+	if x != nil && x.Parallelism != nil {
+		return *x.Parallelism
+	}
+	return 0
+}
+
+func (x *JobInfo) GetBackoffLimit() int32 {
+	if x != nil && x.BackoffLimit != nil {
+		return *x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *JobInfo) GetActiveDeadlineSeconds() int64 {
+	if x != nil && x.ActiveDeadlineSeconds != nil {
+		return *x.ActiveDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *JobInfo) GetTtlSecondsAfterFinished() int32 {
+	if x != nil && x.TtlSecondsAfterFinished != nil {
+		return *x.TtlSecondsAfterFinished
+	}
+	return 0
+}
+
+func (x *JobInfo) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *JobInfo) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *JobInfo) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobInfo) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *JobInfo) GetCompletionTime() int64 {
+	if x != nil && x.CompletionTime != nil {
+		return *x.CompletionTime
+	}
+	return 0
+}
+
+func (x *JobInfo) GetDurationSeconds() float64 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *JobInfo) GetTotalCpuRequest() float64 {
+	if x != nil {
+		return x.TotalCpuRequest
+	}
+	return 0
+}
+
+func (x *JobInfo) GetTotalMemoryRequest() int64 {
+	if x != nil {
+		return x.TotalMemoryRequest
+	}
+	return 0
+}
+
+func (x *JobInfo) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *JobInfo) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *JobInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *JobInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *JobInfo) GetContainerSpecs() []*ContainerSpecInfo {
+	if x != nil {
+		return x.ContainerSpecs
+	}
+	return nil
+}
+
+func (x *JobInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *JobInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *JobInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *JobInfo) GetConditions() []*JobConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *JobInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type CronJobInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace          string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Schedule           string                 `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Suspend            bool                   `protobuf:"varint,5,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	ConcurrencyPolicy  string                 `protobuf:"bytes,6,opt,name=concurrency_policy,json=concurrencyPolicy,proto3" json:"concurrency_policy,omitempty"`
+	LastScheduleTime   *int64                 `protobuf:"varint,7,opt,name=last_schedule_time,json=lastScheduleTime,proto3,oneof" json:"last_schedule_time,omitempty"`
+	LastSuccessfulTime *int64                 `protobuf:"varint,8,opt,name=last_successful_time,json=lastSuccessfulTime,proto3,oneof" json:"last_successful_time,omitempty"`
+	ActiveJobs         []string               `protobuf:"bytes,9,rep,name=active_jobs,json=activeJobs,proto3" json:"active_jobs,omitempty"`
+	HourlyCost         *float64               `protobuf:"fixed64,10,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle            *GPUIdleInfo           `protobuf:"bytes,11,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	ContainerSpecs     []*ContainerSpecInfo   `protobuf:"bytes,12,rep,name=container_specs,json=containerSpecs,proto3" json:"container_specs,omitempty"`
+	Labels             map[string]string      `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations        map[string]string      `protobuf:"bytes,14,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp  int64                  `protobuf:"varint,15,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	EventReasons       map[string]int64       `protobuf:"bytes,16,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CronJobInfo) Reset() {
+	*x = CronJobInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronJobInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJobInfo) ProtoMessage() {}
+
+func (x *CronJobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJobInfo.ProtoReflect.Descriptor instead.
+func (*CronJobInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CronJobInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CronJobInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CronJobInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CronJobInfo) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CronJobInfo) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *CronJobInfo) GetConcurrencyPolicy() string {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (x *CronJobInfo) GetLastScheduleTime() int64 {
+	if x != nil && x.LastScheduleTime != nil {
+		return *x.LastScheduleTime
+	}
+	return 0
+}
+
+func (x *CronJobInfo) GetLastSuccessfulTime() int64 {
+	if x != nil && x.LastSuccessfulTime != nil {
+		return *x.LastSuccessfulTime
+	}
+	return 0
+}
+
+func (x *CronJobInfo) GetActiveJobs() []string {
+	if x != nil {
+		return x.ActiveJobs
+	}
+	return nil
+}
+
+func (x *CronJobInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *CronJobInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *CronJobInfo) GetContainerSpecs() []*ContainerSpecInfo {
+	if x != nil {
+		return x.ContainerSpecs
+	}
+	return nil
+}
+
+func (x *CronJobInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CronJobInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CronJobInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *CronJobInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type CustomWorkloadInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion         string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind               string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                string                 `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace          string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Replicas           *int32                 `protobuf:"varint,6,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	ReadyReplicas      *int32                 `protobuf:"varint,7,opt,name=ready_replicas,json=readyReplicas,proto3,oneof" json:"ready_replicas,omitempty"`
+	OwnerKind          string                 `protobuf:"bytes,8,opt,name=owner_kind,json=ownerKind,proto3" json:"owner_kind,omitempty"`
+	OwnerName          string                 `protobuf:"bytes,9,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	OwnerUid           string                 `protobuf:"bytes,10,opt,name=owner_uid,json=ownerUid,proto3" json:"owner_uid,omitempty"`
+	OwnerApiVersion    string                 `protobuf:"bytes,11,opt,name=owner_api_version,json=ownerApiVersion,proto3" json:"owner_api_version,omitempty"`
+	Status             *structpb.Struct       `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	PodCount           int64                  `protobuf:"varint,13,opt,name=pod_count,json=podCount,proto3" json:"pod_count,omitempty"`
+	TotalCpuRequest    float64                `protobuf:"fixed64,14,opt,name=total_cpu_request,json=totalCpuRequest,proto3" json:"total_cpu_request,omitempty"`
+	TotalMemoryRequest int64                  `protobuf:"varint,15,opt,name=total_memory_request,json=totalMemoryRequest,proto3" json:"total_memory_request,omitempty"`
+	TotalCpuUsage      *float64               `protobuf:"fixed64,16,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryUsage   *int64                 `protobuf:"varint,17,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	HourlyCost         *float64               `protobuf:"fixed64,18,opt,name=hourly_cost,json=hourlyCost,proto3,oneof" json:"hourly_cost,omitempty"`
+	GpuIdle            *GPUIdleInfo           `protobuf:"bytes,19,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	Labels             map[string]string      `protobuf:"bytes,20,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations        map[string]string      `protobuf:"bytes,21,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp  int64                  `protobuf:"varint,22,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	EventReasons       map[string]int64       `protobuf:"bytes,23,rep,name=event_reasons,json=eventReasons,proto3" json:"event_reasons,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CustomWorkloadInfo) Reset() {
+	*x = CustomWorkloadInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomWorkloadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomWorkloadInfo) ProtoMessage() {}
+
+func (x *CustomWorkloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomWorkloadInfo.ProtoReflect.Descriptor instead.
+func (*CustomWorkloadInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CustomWorkloadInfo) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetReadyReplicas() int32 {
+	if x != nil && x.ReadyReplicas != nil {
+		return *x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetOwnerKind() string {
+	if x != nil {
+		return x.OwnerKind
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetOwnerUid() string {
+	if x != nil {
+		return x.OwnerUid
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetOwnerApiVersion() string {
+	if x != nil {
+		return x.OwnerApiVersion
+	}
+	return ""
+}
+
+func (x *CustomWorkloadInfo) GetStatus() *structpb.Struct {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CustomWorkloadInfo) GetPodCount() int64 {
+	if x != nil {
+		return x.PodCount
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetTotalCpuRequest() float64 {
+	if x != nil {
+		return x.TotalCpuRequest
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetTotalMemoryRequest() int64 {
+	if x != nil {
+		return x.TotalMemoryRequest
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetHourlyCost() float64 {
+	if x != nil && x.HourlyCost != nil {
+		return *x.HourlyCost
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *CustomWorkloadInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CustomWorkloadInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CustomWorkloadInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *CustomWorkloadInfo) GetEventReasons() map[string]int64 {
+	if x != nil {
+		return x.EventReasons
+	}
+	return nil
+}
+
+type HPAInfo struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Name              string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid               string                  `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace         string                  `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TargetKind        string                  `protobuf:"bytes,4,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	TargetName        string                  `protobuf:"bytes,5,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	TargetApiVersion  string                  `protobuf:"bytes,6,opt,name=target_api_version,json=targetApiVersion,proto3" json:"target_api_version,omitempty"`
+	MinReplicas       *int32                  `protobuf:"varint,7,opt,name=min_replicas,json=minReplicas,proto3,oneof" json:"min_replicas,omitempty"`
+	MaxReplicas       int32                   `protobuf:"varint,8,opt,name=max_replicas,json=maxReplicas,proto3" json:"max_replicas,omitempty"`
+	CurrentReplicas   int32                   `protobuf:"varint,9,opt,name=current_replicas,json=currentReplicas,proto3" json:"current_replicas,omitempty"`
+	DesiredReplicas   int32                   `protobuf:"varint,10,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	Metrics           []*HPAMetricInfo        `protobuf:"bytes,11,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	CurrentMetrics    []*HPACurrentMetricInfo `protobuf:"bytes,12,rep,name=current_metrics,json=currentMetrics,proto3" json:"current_metrics,omitempty"`
+	ScaleUpBehavior   *HPAScalingBehavior     `protobuf:"bytes,13,opt,name=scale_up_behavior,json=scaleUpBehavior,proto3" json:"scale_up_behavior,omitempty"`
+	ScaleDownBehavior *HPAScalingBehavior     `protobuf:"bytes,14,opt,name=scale_down_behavior,json=scaleDownBehavior,proto3" json:"scale_down_behavior,omitempty"`
+	Conditions        []*HPAConditionInfo     `protobuf:"bytes,15,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Labels            map[string]string       `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string       `protobuf:"bytes,17,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp int64                   `protobuf:"varint,18,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	LastScaleTime     *int64                  `protobuf:"varint,19,opt,name=last_scale_time,json=lastScaleTime,proto3,oneof" json:"last_scale_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HPAInfo) Reset() {
+	*x = HPAInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPAInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPAInfo) ProtoMessage() {}
+
+func (x *HPAInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPAInfo.ProtoReflect.Descriptor instead.
+func (*HPAInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HPAInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetTargetKind() string {
+	if x != nil {
+		return x.TargetKind
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetTargetApiVersion() string {
+	if x != nil {
+		return x.TargetApiVersion
+	}
+	return ""
+}
+
+func (x *HPAInfo) GetMinReplicas() int32 {
+	if x != nil && x.MinReplicas != nil {
+		return *x.MinReplicas
+	}
+	return 0
+}
+
+func (x *HPAInfo) GetMaxReplicas() int32 {
+	if x != nil {
+		return x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *HPAInfo) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+func (x *HPAInfo) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *HPAInfo) GetMetrics() []*HPAMetricInfo {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetCurrentMetrics() []*HPACurrentMetricInfo {
+	if x != nil {
+		return x.CurrentMetrics
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetScaleUpBehavior() *HPAScalingBehavior {
+	if x != nil {
+		return x.ScaleUpBehavior
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetScaleDownBehavior() *HPAScalingBehavior {
+	if x != nil {
+		return x.ScaleDownBehavior
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetConditions() []*HPAConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *HPAInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *HPAInfo) GetLastScaleTime() int64 {
+	if x != nil && x.LastScaleTime != nil {
+		return *x.LastScaleTime
+	}
+	return 0
+}
+
+type VPAInfo struct {
+	state                    protoimpl.MessageState        `protogen:"open.v1"`
+	Name                     string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace                string                        `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TargetKind               string                        `protobuf:"bytes,3,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	TargetName               string                        `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	TargetApiVersion         string                        `protobuf:"bytes,5,opt,name=target_api_version,json=targetApiVersion,proto3" json:"target_api_version,omitempty"`
+	UpdateMode               string                        `protobuf:"bytes,6,opt,name=update_mode,json=updateMode,proto3" json:"update_mode,omitempty"`
+	ContainerRecommendations []*VPAContainerRecommendation `protobuf:"bytes,7,rep,name=container_recommendations,json=containerRecommendations,proto3" json:"container_recommendations,omitempty"`
+	Conditions               []*VPAConditionInfo           `protobuf:"bytes,8,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Labels                   map[string]string             `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp        int64                         `protobuf:"varint,10,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *VPAInfo) Reset() {
+	*x = VPAInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPAInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPAInfo) ProtoMessage() {}
+
+func (x *VPAInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPAInfo.ProtoReflect.Descriptor instead.
+func (*VPAInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VPAInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetTargetKind() string {
+	if x != nil {
+		return x.TargetKind
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetTargetApiVersion() string {
+	if x != nil {
+		return x.TargetApiVersion
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetUpdateMode() string {
+	if x != nil {
+		return x.UpdateMode
+	}
+	return ""
+}
+
+func (x *VPAInfo) GetContainerRecommendations() []*VPAContainerRecommendation {
+	if x != nil {
+		return x.ContainerRecommendations
+	}
+	return nil
+}
+
+func (x *VPAInfo) GetConditions() []*VPAConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *VPAInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *VPAInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type PDBInfo struct {
+	state              protoimpl.MessageState      `protogen:"open.v1"`
+	Name               string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                string                      `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace          string                      `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MatchLabels        map[string]string           `protobuf:"bytes,4,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MatchExpressions   []*LabelSelectorRequirement `protobuf:"bytes,5,rep,name=match_expressions,json=matchExpressions,proto3" json:"match_expressions,omitempty"`
+	TargetWorkloads    []*WorkloadReference        `protobuf:"bytes,6,rep,name=target_workloads,json=targetWorkloads,proto3" json:"target_workloads,omitempty"`
+	MinAvailable       string                      `protobuf:"bytes,7,opt,name=min_available,json=minAvailable,proto3" json:"min_available,omitempty"`
+	MaxUnavailable     string                      `protobuf:"bytes,8,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	CurrentHealthy     int32                       `protobuf:"varint,9,opt,name=current_healthy,json=currentHealthy,proto3" json:"current_healthy,omitempty"`
+	DesiredHealthy     int32                       `protobuf:"varint,10,opt,name=desired_healthy,json=desiredHealthy,proto3" json:"desired_healthy,omitempty"`
+	DisruptionsAllowed int32                       `protobuf:"varint,11,opt,name=disruptions_allowed,json=disruptionsAllowed,proto3" json:"disruptions_allowed,omitempty"`
+	ExpectedPods       int32                       `protobuf:"varint,12,opt,name=expected_pods,json=expectedPods,proto3" json:"expected_pods,omitempty"`
+	Conditions         []*PDBConditionInfo         `protobuf:"bytes,13,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Labels             map[string]string           `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations        map[string]string           `protobuf:"bytes,15,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp  int64                       `protobuf:"varint,16,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PDBInfo) Reset() {
+	*x = PDBInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PDBInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDBInfo) ProtoMessage() {}
+
+func (x *PDBInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDBInfo.ProtoReflect.Descriptor instead.
+func (*PDBInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PDBInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PDBInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PDBInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PDBInfo) GetMatchLabels() map[string]string {
+	if x != nil {
+		return x.MatchLabels
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetMatchExpressions() []*LabelSelectorRequirement {
+	if x != nil {
+		return x.MatchExpressions
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetTargetWorkloads() []*WorkloadReference {
+	if x != nil {
+		return x.TargetWorkloads
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetMinAvailable() string {
+	if x != nil {
+		return x.MinAvailable
+	}
+	return ""
+}
+
+func (x *PDBInfo) GetMaxUnavailable() string {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return ""
+}
+
+func (x *PDBInfo) GetCurrentHealthy() int32 {
+	if x != nil {
+		return x.CurrentHealthy
+	}
+	return 0
+}
+
+func (x *PDBInfo) GetDesiredHealthy() int32 {
+	if x != nil {
+		return x.DesiredHealthy
+	}
+	return 0
+}
+
+func (x *PDBInfo) GetDisruptionsAllowed() int32 {
+	if x != nil {
+		return x.DisruptionsAllowed
+	}
+	return 0
+}
+
+func (x *PDBInfo) GetExpectedPods() int32 {
+	if x != nil {
+		return x.ExpectedPods
+	}
+	return 0
+}
+
+func (x *PDBInfo) GetConditions() []*PDBConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PDBInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type ServiceInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type              string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ClusterIp         string                 `protobuf:"bytes,4,opt,name=cluster_ip,json=clusterIp,proto3" json:"cluster_ip,omitempty"`
+	ClusterIps        []string               `protobuf:"bytes,5,rep,name=cluster_ips,json=clusterIps,proto3" json:"cluster_ips,omitempty"`
+	ExternalIps       []string               `protobuf:"bytes,6,rep,name=external_ips,json=externalIps,proto3" json:"external_ips,omitempty"`
+	LoadBalancer      *LoadBalancerInfo      `protobuf:"bytes,7,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	Ports             []*ServicePortInfo     `protobuf:"bytes,8,rep,name=ports,proto3" json:"ports,omitempty"`
+	Selector          map[string]string      `protobuf:"bytes,9,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TargetWorkloads   []*WorkloadReference   `protobuf:"bytes,10,rep,name=target_workloads,json=targetWorkloads,proto3" json:"target_workloads,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp int64                  `protobuf:"varint,13,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	SessionAffinity   string                 `protobuf:"bytes,14,opt,name=session_affinity,json=sessionAffinity,proto3" json:"session_affinity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ServiceInfo) Reset() {
+	*x = ServiceInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceInfo) ProtoMessage() {}
+
+func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
+func (*ServiceInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ServiceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetClusterIp() string {
+	if x != nil {
+		return x.ClusterIp
+	}
+	return ""
+}
+
+func (x *ServiceInfo) GetClusterIps() []string {
+	if x != nil {
+		return x.ClusterIps
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetExternalIps() []string {
+	if x != nil {
+		return x.ExternalIps
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetLoadBalancer() *LoadBalancerInfo {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetPorts() []*ServicePortInfo {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetTargetWorkloads() []*WorkloadReference {
+	if x != nil {
+		return x.TargetWorkloads
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *ServiceInfo) GetSessionAffinity() string {
+	if x != nil {
+		return x.SessionAffinity
+	}
+	return ""
+}
+
+type IngressInfo struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace             string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	IngressClassName      string                 `protobuf:"bytes,3,opt,name=ingress_class_name,json=ingressClassName,proto3" json:"ingress_class_name,omitempty"`
+	Rules                 []*IngressRuleInfo     `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	Tls                   []*IngressTLSInfo      `protobuf:"bytes,5,rep,name=tls,proto3" json:"tls,omitempty"`
+	DefaultBackend        *IngressBackendInfo    `protobuf:"bytes,6,opt,name=default_backend,json=defaultBackend,proto3" json:"default_backend,omitempty"`
+	Labels                map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations           map[string]string      `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp     int64                  `protobuf:"varint,9,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	LoadBalancerHostnames []string               `protobuf:"bytes,10,rep,name=load_balancer_hostnames,json=loadBalancerHostnames,proto3" json:"load_balancer_hostnames,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *IngressInfo) Reset() {
+	*x = IngressInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressInfo) ProtoMessage() {}
+
+func (x *IngressInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressInfo.ProtoReflect.Descriptor instead.
+func (*IngressInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IngressInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngressInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *IngressInfo) GetIngressClassName() string {
+	if x != nil {
+		return x.IngressClassName
+	}
+	return ""
+}
+
+func (x *IngressInfo) GetRules() []*IngressRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *IngressInfo) GetTls() []*IngressTLSInfo {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *IngressInfo) GetDefaultBackend() *IngressBackendInfo {
+	if x != nil {
+		return x.DefaultBackend
+	}
+	return nil
+}
+
+func (x *IngressInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *IngressInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *IngressInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *IngressInfo) GetLoadBalancerHostnames() []string {
+	if x != nil {
+		return x.LoadBalancerHostnames
+	}
+	return nil
+}
+
+type PVInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity          int64                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	AccessModes       []string               `protobuf:"bytes,3,rep,name=access_modes,json=accessModes,proto3" json:"access_modes,omitempty"`
+	ReclaimPolicy     string                 `protobuf:"bytes,4,opt,name=reclaim_policy,json=reclaimPolicy,proto3" json:"reclaim_policy,omitempty"`
+	StorageClassName  string                 `protobuf:"bytes,5,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	VolumeMode        string                 `protobuf:"bytes,6,opt,name=volume_mode,json=volumeMode,proto3" json:"volume_mode,omitempty"`
+	Phase             string                 `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	MountOptions      []string               `protobuf:"bytes,8,rep,name=mount_options,json=mountOptions,proto3" json:"mount_options,omitempty"`
+	Source            *PVSourceInfo          `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	ClaimRef          *PVClaimRefInfo        `protobuf:"bytes,10,opt,name=claim_ref,json=claimRef,proto3" json:"claim_ref,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp int64                  `protobuf:"varint,13,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PVInfo) Reset() {
+	*x = PVInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVInfo) ProtoMessage() {}
+
+func (x *PVInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVInfo.ProtoReflect.Descriptor instead.
+func (*PVInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PVInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PVInfo) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PVInfo) GetAccessModes() []string {
+	if x != nil {
+		return x.AccessModes
+	}
+	return nil
+}
+
+func (x *PVInfo) GetReclaimPolicy() string {
+	if x != nil {
+		return x.ReclaimPolicy
+	}
+	return ""
+}
+
+func (x *PVInfo) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PVInfo) GetVolumeMode() string {
+	if x != nil {
+		return x.VolumeMode
+	}
+	return ""
+}
+
+func (x *PVInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PVInfo) GetMountOptions() []string {
+	if x != nil {
+		return x.MountOptions
+	}
+	return nil
+}
+
+func (x *PVInfo) GetSource() *PVSourceInfo {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PVInfo) GetClaimRef() *PVClaimRefInfo {
+	if x != nil {
+		return x.ClaimRef
+	}
+	return nil
+}
+
+func (x *PVInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PVInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PVInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type PVCInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Phase             string                 `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	AccessModes       []string               `protobuf:"bytes,4,rep,name=access_modes,json=accessModes,proto3" json:"access_modes,omitempty"`
+	StorageClassName  string                 `protobuf:"bytes,5,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	VolumeMode        string                 `protobuf:"bytes,6,opt,name=volume_mode,json=volumeMode,proto3" json:"volume_mode,omitempty"`
+	VolumeName        string                 `protobuf:"bytes,7,opt,name=volume_name,json=volumeName,proto3" json:"volume_name,omitempty"`
+	RequestedBytes    int64                  `protobuf:"varint,8,opt,name=requested_bytes,json=requestedBytes,proto3" json:"requested_bytes,omitempty"`
+	CapacityBytes     int64                  `protobuf:"varint,9,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	UsedBytes         *int64                 `protobuf:"varint,10,opt,name=used_bytes,json=usedBytes,proto3,oneof" json:"used_bytes,omitempty"`
+	AvailableBytes    *int64                 `protobuf:"varint,11,opt,name=available_bytes,json=availableBytes,proto3,oneof" json:"available_bytes,omitempty"`
+	MountedByPods     []string               `protobuf:"bytes,12,rep,name=mounted_by_pods,json=mountedByPods,proto3" json:"mounted_by_pods,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,14,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp int64                  `protobuf:"varint,15,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	Conditions        []*PVCConditionInfo    `protobuf:"bytes,16,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PVCInfo) Reset() {
+	*x = PVCInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVCInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVCInfo) ProtoMessage() {}
+
+func (x *PVCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVCInfo.ProtoReflect.Descriptor instead.
+func (*PVCInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PVCInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetAccessModes() []string {
+	if x != nil {
+		return x.AccessModes
+	}
+	return nil
+}
+
+func (x *PVCInfo) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetVolumeMode() string {
+	if x != nil {
+		return x.VolumeMode
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetVolumeName() string {
+	if x != nil {
+		return x.VolumeName
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetRequestedBytes() int64 {
+	if x != nil {
+		return x.RequestedBytes
+	}
+	return 0
+}
+
+func (x *PVCInfo) GetCapacityBytes() int64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+func (x *PVCInfo) GetUsedBytes() int64 {
+	if x != nil && x.UsedBytes != nil {
+		return *x.UsedBytes
+	}
+	return 0
+}
+
+func (x *PVCInfo) GetAvailableBytes() int64 {
+	if x != nil && x.AvailableBytes != nil {
+		return *x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *PVCInfo) GetMountedByPods() []string {
+	if x != nil {
+		return x.MountedByPods
+	}
+	return nil
+}
+
+func (x *PVCInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PVCInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PVCInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *PVCInfo) GetConditions() []*PVCConditionInfo {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type StorageClassInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Provisioner          string                 `protobuf:"bytes,2,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	ReclaimPolicy        string                 `protobuf:"bytes,3,opt,name=reclaim_policy,json=reclaimPolicy,proto3" json:"reclaim_policy,omitempty"`
+	VolumeBindingMode    string                 `protobuf:"bytes,4,opt,name=volume_binding_mode,json=volumeBindingMode,proto3" json:"volume_binding_mode,omitempty"`
+	AllowVolumeExpansion bool                   `protobuf:"varint,5,opt,name=allow_volume_expansion,json=allowVolumeExpansion,proto3" json:"allow_volume_expansion,omitempty"`
+	Parameters           map[string]string      `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MountOptions         []string               `protobuf:"bytes,7,rep,name=mount_options,json=mountOptions,proto3" json:"mount_options,omitempty"`
+	Labels               map[string]string      `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations          map[string]string      `protobuf:"bytes,9,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsDefault            bool                   `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StorageClassInfo) Reset() {
+	*x = StorageClassInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageClassInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageClassInfo) ProtoMessage() {}
+
+func (x *StorageClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageClassInfo.ProtoReflect.Descriptor instead.
+func (*StorageClassInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StorageClassInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetProvisioner() string {
+	if x != nil {
+		return x.Provisioner
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetReclaimPolicy() string {
+	if x != nil {
+		return x.ReclaimPolicy
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetVolumeBindingMode() string {
+	if x != nil {
+		return x.VolumeBindingMode
+	}
+	return ""
+}
+
+func (x *StorageClassInfo) GetAllowVolumeExpansion() bool {
+	if x != nil {
+		return x.AllowVolumeExpansion
+	}
+	return false
+}
+
+func (x *StorageClassInfo) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *StorageClassInfo) GetMountOptions() []string {
+	if x != nil {
+		return x.MountOptions
+	}
+	return nil
+}
+
+func (x *StorageClassInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *StorageClassInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *StorageClassInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type PriorityClassInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value            int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GlobalDefault    bool                   `protobuf:"varint,3,opt,name=global_default,json=globalDefault,proto3" json:"global_default,omitempty"`
+	PreemptionPolicy string                 `protobuf:"bytes,4,opt,name=preemption_policy,json=preemptionPolicy,proto3" json:"preemption_policy,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PriorityClassInfo) Reset() {
+	*x = PriorityClassInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityClassInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityClassInfo) ProtoMessage() {}
+
+func (x *PriorityClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityClassInfo.ProtoReflect.Descriptor instead.
+func (*PriorityClassInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PriorityClassInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriorityClassInfo) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PriorityClassInfo) GetGlobalDefault() bool {
+	if x != nil {
+		return x.GlobalDefault
+	}
+	return false
+}
+
+func (x *PriorityClassInfo) GetPreemptionPolicy() string {
+	if x != nil {
+		return x.PreemptionPolicy
+	}
+	return ""
+}
+
+func (x *PriorityClassInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type LimitRangeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Limits        []*LimitRangeItemInfo  `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitRangeInfo) Reset() {
+	*x = LimitRangeInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitRangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitRangeInfo) ProtoMessage() {}
+
+func (x *LimitRangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitRangeInfo.ProtoReflect.Descriptor instead.
+func (*LimitRangeInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LimitRangeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LimitRangeInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LimitRangeInfo) GetLimits() []*LimitRangeItemInfo {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type ResourceQuotaInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Hard          map[string]string      `protobuf:"bytes,3,rep,name=hard,proto3" json:"hard,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Used          map[string]string      `protobuf:"bytes,4,rep,name=used,proto3" json:"used,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceQuotaInfo) Reset() {
+	*x = ResourceQuotaInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceQuotaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceQuotaInfo) ProtoMessage() {}
+
+func (x *ResourceQuotaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceQuotaInfo.ProtoReflect.Descriptor instead.
+func (*ResourceQuotaInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResourceQuotaInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceQuotaInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ResourceQuotaInfo) GetHard() map[string]string {
+	if x != nil {
+		return x.Hard
+	}
+	return nil
+}
+
+func (x *ResourceQuotaInfo) GetUsed() map[string]string {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *ResourceQuotaInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type NodePoolInfo struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Name              string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid               string                     `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	MinReplicas       *int64                     `protobuf:"varint,3,opt,name=min_replicas,json=minReplicas,proto3,oneof" json:"min_replicas,omitempty"`
+	MaxReplicas       *int64                     `protobuf:"varint,4,opt,name=max_replicas,json=maxReplicas,proto3,oneof" json:"max_replicas,omitempty"`
+	NodeClassName     string                     `protobuf:"bytes,5,opt,name=node_class_name,json=nodeClassName,proto3" json:"node_class_name,omitempty"`
+	Labels            map[string]string          `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string          `protobuf:"bytes,7,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Taints            []*TaintInfo               `protobuf:"bytes,8,rep,name=taints,proto3" json:"taints,omitempty"`
+	Requirements      []*NodeSelectorRequirement `protobuf:"bytes,9,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	CreationTimestamp int64                      `protobuf:"varint,10,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NodePoolInfo) Reset() {
+	*x = NodePoolInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePoolInfo) ProtoMessage() {}
+
+func (x *NodePoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePoolInfo.ProtoReflect.Descriptor instead.
+func (*NodePoolInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NodePoolInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodePoolInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *NodePoolInfo) GetMinReplicas() int64 {
+	if x != nil && x.MinReplicas != nil {
+		return *x.MinReplicas
+	}
+	return 0
+}
+
+func (x *NodePoolInfo) GetMaxReplicas() int64 {
+	if x != nil && x.MaxReplicas != nil {
+		return *x.MaxReplicas
+	}
+	return 0
+}
+
+func (x *NodePoolInfo) GetNodeClassName() string {
+	if x != nil {
+		return x.NodeClassName
+	}
+	return ""
+}
+
+func (x *NodePoolInfo) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodePoolInfo) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *NodePoolInfo) GetTaints() []*TaintInfo {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *NodePoolInfo) GetRequirements() []*NodeSelectorRequirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *NodePoolInfo) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+type EventInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Type                string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Note                string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	ReportingController string                 `protobuf:"bytes,4,opt,name=reporting_controller,json=reportingController,proto3" json:"reporting_controller,omitempty"`
+	InvolvedKind        string                 `protobuf:"bytes,5,opt,name=involved_kind,json=involvedKind,proto3" json:"involved_kind,omitempty"`
+	InvolvedNamespace   string                 `protobuf:"bytes,6,opt,name=involved_namespace,json=involvedNamespace,proto3" json:"involved_namespace,omitempty"`
+	InvolvedName        string                 `protobuf:"bytes,7,opt,name=involved_name,json=involvedName,proto3" json:"involved_name,omitempty"`
+	InvolvedUid         string                 `protobuf:"bytes,8,opt,name=involved_uid,json=involvedUid,proto3" json:"involved_uid,omitempty"`
+	Count               int32                  `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	FirstTimestamp      int64                  `protobuf:"varint,10,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"`
+	LastTimestamp       int64                  `protobuf:"varint,11,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventInfo) Reset() {
+	*x = EventInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInfo) ProtoMessage() {}
+
+func (x *EventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventInfo.ProtoReflect.Descriptor instead.
+func (*EventInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EventInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *EventInfo) GetReportingController() string {
+	if x != nil {
+		return x.ReportingController
+	}
+	return ""
+}
+
+func (x *EventInfo) GetInvolvedKind() string {
+	if x != nil {
+		return x.InvolvedKind
+	}
+	return ""
+}
+
+func (x *EventInfo) GetInvolvedNamespace() string {
+	if x != nil {
+		return x.InvolvedNamespace
+	}
+	return ""
+}
+
+func (x *EventInfo) GetInvolvedName() string {
+	if x != nil {
+		return x.InvolvedName
+	}
+	return ""
+}
+
+func (x *EventInfo) GetInvolvedUid() string {
+	if x != nil {
+		return x.InvolvedUid
+	}
+	return ""
+}
+
+func (x *EventInfo) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventInfo) GetFirstTimestamp() int64 {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return 0
+}
+
+func (x *EventInfo) GetLastTimestamp() int64 {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return 0
+}
+
+type ClusterSummary struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	NodeCount              int64                  `protobuf:"varint,1,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	PodCount               int64                  `protobuf:"varint,2,opt,name=pod_count,json=podCount,proto3" json:"pod_count,omitempty"`
+	RunningPodCount        int64                  `protobuf:"varint,3,opt,name=running_pod_count,json=runningPodCount,proto3" json:"running_pod_count,omitempty"`
+	PendingPodCount        int64                  `protobuf:"varint,4,opt,name=pending_pod_count,json=pendingPodCount,proto3" json:"pending_pod_count,omitempty"`
+	FailedPodCount         int64                  `protobuf:"varint,5,opt,name=failed_pod_count,json=failedPodCount,proto3" json:"failed_pod_count,omitempty"`
+	SucceededPodCount      int64                  `protobuf:"varint,6,opt,name=succeeded_pod_count,json=succeededPodCount,proto3" json:"succeeded_pod_count,omitempty"`
+	NamespaceCount         int64                  `protobuf:"varint,7,opt,name=namespace_count,json=namespaceCount,proto3" json:"namespace_count,omitempty"`
+	DeploymentCount        int64                  `protobuf:"varint,8,opt,name=deployment_count,json=deploymentCount,proto3" json:"deployment_count,omitempty"`
+	StatefulsetCount       int64                  `protobuf:"varint,9,opt,name=statefulset_count,json=statefulsetCount,proto3" json:"statefulset_count,omitempty"`
+	DaemonsetCount         int64                  `protobuf:"varint,10,opt,name=daemonset_count,json=daemonsetCount,proto3" json:"daemonset_count,omitempty"`
+	JobCount               int64                  `protobuf:"varint,11,opt,name=job_count,json=jobCount,proto3" json:"job_count,omitempty"`
+	CronjobCount           int64                  `protobuf:"varint,12,opt,name=cronjob_count,json=cronjobCount,proto3" json:"cronjob_count,omitempty"`
+	CustomWorkloadCount    int64                  `protobuf:"varint,13,opt,name=custom_workload_count,json=customWorkloadCount,proto3" json:"custom_workload_count,omitempty"`
+	HpaCount               int64                  `protobuf:"varint,14,opt,name=hpa_count,json=hpaCount,proto3" json:"hpa_count,omitempty"`
+	ServiceCount           int64                  `protobuf:"varint,15,opt,name=service_count,json=serviceCount,proto3" json:"service_count,omitempty"`
+	IngressCount           int64                  `protobuf:"varint,16,opt,name=ingress_count,json=ingressCount,proto3" json:"ingress_count,omitempty"`
+	PvCount                int64                  `protobuf:"varint,17,opt,name=pv_count,json=pvCount,proto3" json:"pv_count,omitempty"`
+	PvcCount               int64                  `protobuf:"varint,18,opt,name=pvc_count,json=pvcCount,proto3" json:"pvc_count,omitempty"`
+	ContainerCount         int64                  `protobuf:"varint,19,opt,name=container_count,json=containerCount,proto3" json:"container_count,omitempty"`
+	TotalCpuCapacity       float64                `protobuf:"fixed64,20,opt,name=total_cpu_capacity,json=totalCpuCapacity,proto3" json:"total_cpu_capacity,omitempty"`
+	TotalCpuAllocatable    float64                `protobuf:"fixed64,21,opt,name=total_cpu_allocatable,json=totalCpuAllocatable,proto3" json:"total_cpu_allocatable,omitempty"`
+	TotalCpuRequested      float64                `protobuf:"fixed64,22,opt,name=total_cpu_requested,json=totalCpuRequested,proto3" json:"total_cpu_requested,omitempty"`
+	TotalCpuUsage          *float64               `protobuf:"fixed64,23,opt,name=total_cpu_usage,json=totalCpuUsage,proto3,oneof" json:"total_cpu_usage,omitempty"`
+	TotalMemoryCapacity    int64                  `protobuf:"varint,24,opt,name=total_memory_capacity,json=totalMemoryCapacity,proto3" json:"total_memory_capacity,omitempty"`
+	TotalMemoryAllocatable int64                  `protobuf:"varint,25,opt,name=total_memory_allocatable,json=totalMemoryAllocatable,proto3" json:"total_memory_allocatable,omitempty"`
+	TotalMemoryRequested   int64                  `protobuf:"varint,26,opt,name=total_memory_requested,json=totalMemoryRequested,proto3" json:"total_memory_requested,omitempty"`
+	TotalMemoryUsage       *int64                 `protobuf:"varint,27,opt,name=total_memory_usage,json=totalMemoryUsage,proto3,oneof" json:"total_memory_usage,omitempty"`
+	TotalGpuCapacity       int64                  `protobuf:"varint,28,opt,name=total_gpu_capacity,json=totalGpuCapacity,proto3" json:"total_gpu_capacity,omitempty"`
+	TotalGpuRequested      int64                  `protobuf:"varint,29,opt,name=total_gpu_requested,json=totalGpuRequested,proto3" json:"total_gpu_requested,omitempty"`
+	TotalGpuUsage          *float64               `protobuf:"fixed64,30,opt,name=total_gpu_usage,json=totalGpuUsage,proto3,oneof" json:"total_gpu_usage,omitempty"`
+	TotalGpuTensorActive   *float64               `protobuf:"fixed64,31,opt,name=total_gpu_tensor_active,json=totalGpuTensorActive,proto3,oneof" json:"total_gpu_tensor_active,omitempty"`
+	TotalGpuMemoryUtil     *float64               `protobuf:"fixed64,32,opt,name=total_gpu_memory_util,json=totalGpuMemoryUtil,proto3,oneof" json:"total_gpu_memory_util,omitempty"`
+	TotalGpuMemoryUsed     *int64                 `protobuf:"varint,33,opt,name=total_gpu_memory_used,json=totalGpuMemoryUsed,proto3,oneof" json:"total_gpu_memory_used,omitempty"`
+	TotalGpuMemoryTotal    *int64                 `protobuf:"varint,34,opt,name=total_gpu_memory_total,json=totalGpuMemoryTotal,proto3,oneof" json:"total_gpu_memory_total,omitempty"`
+	GpuMetricsAvailable    bool                   `protobuf:"varint,35,opt,name=gpu_metrics_available,json=gpuMetricsAvailable,proto3" json:"gpu_metrics_available,omitempty"`
+	TotalStorageCapacity   int64                  `protobuf:"varint,36,opt,name=total_storage_capacity,json=totalStorageCapacity,proto3" json:"total_storage_capacity,omitempty"`
+	TotalStorageRequested  int64                  `protobuf:"varint,37,opt,name=total_storage_requested,json=totalStorageRequested,proto3" json:"total_storage_requested,omitempty"`
+	MetricsAvailable       bool                   `protobuf:"varint,38,opt,name=metrics_available,json=metricsAvailable,proto3" json:"metrics_available,omitempty"`
+	TotalHourlyCost        *float64               `protobuf:"fixed64,39,opt,name=total_hourly_cost,json=totalHourlyCost,proto3,oneof" json:"total_hourly_cost,omitempty"`
+	IdleHourlyCost         *float64               `protobuf:"fixed64,40,opt,name=idle_hourly_cost,json=idleHourlyCost,proto3,oneof" json:"idle_hourly_cost,omitempty"`
+	CostCurrency           string                 `protobuf:"bytes,41,opt,name=cost_currency,json=costCurrency,proto3" json:"cost_currency,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ClusterSummary) Reset() {
+	*x = ClusterSummary{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSummary) ProtoMessage() {}
+
+func (x *ClusterSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSummary.ProtoReflect.Descriptor instead.
+func (*ClusterSummary) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClusterSummary) GetNodeCount() int64 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetPodCount() int64 {
+	if x != nil {
+		return x.PodCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetRunningPodCount() int64 {
+	if x != nil {
+		return x.RunningPodCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetPendingPodCount() int64 {
+	if x != nil {
+		return x.PendingPodCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetFailedPodCount() int64 {
+	if x != nil {
+		return x.FailedPodCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetSucceededPodCount() int64 {
+	if x != nil {
+		return x.SucceededPodCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetNamespaceCount() int64 {
+	if x != nil {
+		return x.NamespaceCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetDeploymentCount() int64 {
+	if x != nil {
+		return x.DeploymentCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetStatefulsetCount() int64 {
+	if x != nil {
+		return x.StatefulsetCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetDaemonsetCount() int64 {
+	if x != nil {
+		return x.DaemonsetCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetJobCount() int64 {
+	if x != nil {
+		return x.JobCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetCronjobCount() int64 {
+	if x != nil {
+		return x.CronjobCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetCustomWorkloadCount() int64 {
+	if x != nil {
+		return x.CustomWorkloadCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetHpaCount() int64 {
+	if x != nil {
+		return x.HpaCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetServiceCount() int64 {
+	if x != nil {
+		return x.ServiceCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetIngressCount() int64 {
+	if x != nil {
+		return x.IngressCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetPvCount() int64 {
+	if x != nil {
+		return x.PvCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetPvcCount() int64 {
+	if x != nil {
+		return x.PvcCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetContainerCount() int64 {
+	if x != nil {
+		return x.ContainerCount
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalCpuCapacity() float64 {
+	if x != nil {
+		return x.TotalCpuCapacity
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalCpuAllocatable() float64 {
+	if x != nil {
+		return x.TotalCpuAllocatable
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalCpuRequested() float64 {
+	if x != nil {
+		return x.TotalCpuRequested
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalCpuUsage() float64 {
+	if x != nil && x.TotalCpuUsage != nil {
+		return *x.TotalCpuUsage
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalMemoryCapacity() int64 {
+	if x != nil {
+		return x.TotalMemoryCapacity
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalMemoryAllocatable() int64 {
+	if x != nil {
+		return x.TotalMemoryAllocatable
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalMemoryRequested() int64 {
+	if x != nil {
+		return x.TotalMemoryRequested
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalMemoryUsage() int64 {
+	if x != nil && x.TotalMemoryUsage != nil {
+		return *x.TotalMemoryUsage
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuCapacity() int64 {
+	if x != nil {
+		return x.TotalGpuCapacity
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuRequested() int64 {
+	if x != nil {
+		return x.TotalGpuRequested
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuUsage() float64 {
+	if x != nil && x.TotalGpuUsage != nil {
+		return *x.TotalGpuUsage
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuTensorActive() float64 {
+	if x != nil && x.TotalGpuTensorActive != nil {
+		return *x.TotalGpuTensorActive
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuMemoryUtil() float64 {
+	if x != nil && x.TotalGpuMemoryUtil != nil {
+		return *x.TotalGpuMemoryUtil
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuMemoryUsed() int64 {
+	if x != nil && x.TotalGpuMemoryUsed != nil {
+		return *x.TotalGpuMemoryUsed
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalGpuMemoryTotal() int64 {
+	if x != nil && x.TotalGpuMemoryTotal != nil {
+		return *x.TotalGpuMemoryTotal
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetGpuMetricsAvailable() bool {
+	if x != nil {
+		return x.GpuMetricsAvailable
+	}
+	return false
+}
+
+func (x *ClusterSummary) GetTotalStorageCapacity() int64 {
+	if x != nil {
+		return x.TotalStorageCapacity
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetTotalStorageRequested() int64 {
+	if x != nil {
+		return x.TotalStorageRequested
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetMetricsAvailable() bool {
+	if x != nil {
+		return x.MetricsAvailable
+	}
+	return false
+}
+
+func (x *ClusterSummary) GetTotalHourlyCost() float64 {
+	if x != nil && x.TotalHourlyCost != nil {
+		return *x.TotalHourlyCost
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetIdleHourlyCost() float64 {
+	if x != nil && x.IdleHourlyCost != nil {
+		return *x.IdleHourlyCost
+	}
+	return 0
+}
+
+func (x *ClusterSummary) GetCostCurrency() string {
+	if x != nil {
+		return x.CostCurrency
+	}
+	return ""
+}
+
+type AgentHealth struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotsSentTotal           uint64                 `protobuf:"varint,1,opt,name=snapshots_sent_total,json=snapshotsSentTotal,proto3" json:"snapshots_sent_total,omitempty"`
+	SnapshotsFailedTotal         uint64                 `protobuf:"varint,2,opt,name=snapshots_failed_total,json=snapshotsFailedTotal,proto3" json:"snapshots_failed_total,omitempty"`
+	SnapshotsTotal               uint64                 `protobuf:"varint,3,opt,name=snapshots_total,json=snapshotsTotal,proto3" json:"snapshots_total,omitempty"`
+	SnapshotsSentDelta           uint64                 `protobuf:"varint,4,opt,name=snapshots_sent_delta,json=snapshotsSentDelta,proto3" json:"snapshots_sent_delta,omitempty"`
+	SnapshotsFailedDelta         uint64                 `protobuf:"varint,5,opt,name=snapshots_failed_delta,json=snapshotsFailedDelta,proto3" json:"snapshots_failed_delta,omitempty"`
+	State                        string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	StateReason                  string                 `protobuf:"bytes,7,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	LeaderElectionEnabled        bool                   `protobuf:"varint,8,opt,name=leader_election_enabled,json=leaderElectionEnabled,proto3" json:"leader_election_enabled,omitempty"`
+	IsLeader                     bool                   `protobuf:"varint,9,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"`
+	LeaderIdentity               string                 `protobuf:"bytes,10,opt,name=leader_identity,json=leaderIdentity,proto3" json:"leader_identity,omitempty"`
+	LeadershipTransitions        uint64                 `protobuf:"varint,11,opt,name=leadership_transitions,json=leadershipTransitions,proto3" json:"leadership_transitions,omitempty"`
+	LastBuildDurationMs          int64                  `protobuf:"varint,12,opt,name=last_build_duration_ms,json=lastBuildDurationMs,proto3" json:"last_build_duration_ms,omitempty"`
+	LastMetricsCollectDurationMs int64                  `protobuf:"varint,13,opt,name=last_metrics_collect_duration_ms,json=lastMetricsCollectDurationMs,proto3" json:"last_metrics_collect_duration_ms,omitempty"`
+	LastSendDurationMs           int64                  `protobuf:"varint,14,opt,name=last_send_duration_ms,json=lastSendDurationMs,proto3" json:"last_send_duration_ms,omitempty"`
+	EncodeDurationMs             int64                  `protobuf:"varint,15,opt,name=encode_duration_ms,json=encodeDurationMs,proto3" json:"encode_duration_ms,omitempty"`
+	OriginalSizeBytes            int64                  `protobuf:"varint,16,opt,name=original_size_bytes,json=originalSizeBytes,proto3" json:"original_size_bytes,omitempty"`
+	CompressedSizeBytes          int64                  `protobuf:"varint,17,opt,name=compressed_size_bytes,json=compressedSizeBytes,proto3" json:"compressed_size_bytes,omitempty"`
+	CompressionFactor            float64                `protobuf:"fixed64,18,opt,name=compression_factor,json=compressionFactor,proto3" json:"compression_factor,omitempty"`
+	BufferedSnapshots            int64                  `protobuf:"varint,19,opt,name=buffered_snapshots,json=bufferedSnapshots,proto3" json:"buffered_snapshots,omitempty"`
+	BufferedBytes                int64                  `protobuf:"varint,20,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	NodeCount                    int64                  `protobuf:"varint,21,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	PodCount                     int64                  `protobuf:"varint,22,opt,name=pod_count,json=podCount,proto3" json:"pod_count,omitempty"`
+	ContainerCount               int64                  `protobuf:"varint,23,opt,name=container_count,json=containerCount,proto3" json:"container_count,omitempty"`
+	WorkloadCount                int64                  `protobuf:"varint,24,opt,name=workload_count,json=workloadCount,proto3" json:"workload_count,omitempty"`
+	ServiceCount                 int64                  `protobuf:"varint,25,opt,name=service_count,json=serviceCount,proto3" json:"service_count,omitempty"`
+	HpaCount                     int64                  `protobuf:"varint,26,opt,name=hpa_count,json=hpaCount,proto3" json:"hpa_count,omitempty"`
+	PdbCount                     int64                  `protobuf:"varint,27,opt,name=pdb_count,json=pdbCount,proto3" json:"pdb_count,omitempty"`
+	PvCount                      int64                  `protobuf:"varint,28,opt,name=pv_count,json=pvCount,proto3" json:"pv_count,omitempty"`
+	MetricsServerAvailable       bool                   `protobuf:"varint,29,opt,name=metrics_server_available,json=metricsServerAvailable,proto3" json:"metrics_server_available,omitempty"`
+	VpaAvailable                 bool                   `protobuf:"varint,30,opt,name=vpa_available,json=vpaAvailable,proto3" json:"vpa_available,omitempty"`
+	KarpenterAvailable           bool                   `protobuf:"varint,31,opt,name=karpenter_available,json=karpenterAvailable,proto3" json:"karpenter_available,omitempty"`
+	GpuMetricsAvailable          bool                   `protobuf:"varint,32,opt,name=gpu_metrics_available,json=gpuMetricsAvailable,proto3" json:"gpu_metrics_available,omitempty"`
+	DcgmExporterTargets          int64                  `protobuf:"varint,33,opt,name=dcgm_exporter_targets,json=dcgmExporterTargets,proto3" json:"dcgm_exporter_targets,omitempty"`
+	DcgmExporterUpTargets        int64                  `protobuf:"varint,34,opt,name=dcgm_exporter_up_targets,json=dcgmExporterUpTargets,proto3" json:"dcgm_exporter_up_targets,omitempty"`
+	InformersSynced              bool                   `protobuf:"varint,35,opt,name=informers_synced,json=informersSynced,proto3" json:"informers_synced,omitempty"`
+	InformersHealthy             int64                  `protobuf:"varint,36,opt,name=informers_healthy,json=informersHealthy,proto3" json:"informers_healthy,omitempty"`
+	InformersTotal               int64                  `protobuf:"varint,37,opt,name=informers_total,json=informersTotal,proto3" json:"informers_total,omitempty"`
+	StaleResources               []string               `protobuf:"bytes,38,rep,name=stale_resources,json=staleResources,proto3" json:"stale_resources,omitempty"`
+	ApiCallsTotal                int64                  `protobuf:"varint,39,opt,name=api_calls_total,json=apiCallsTotal,proto3" json:"api_calls_total,omitempty"`
+	ApiCallsFailed               int64                  `protobuf:"varint,40,opt,name=api_calls_failed,json=apiCallsFailed,proto3" json:"api_calls_failed,omitempty"`
+	ActiveErrorsCount            int64                  `protobuf:"varint,41,opt,name=active_errors_count,json=activeErrorsCount,proto3" json:"active_errors_count,omitempty"`
+	ErrorCodes                   []string               `protobuf:"bytes,42,rep,name=error_codes,json=errorCodes,proto3" json:"error_codes,omitempty"`
+	UptimeSeconds                int64                  `protobuf:"varint,43,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	StartedAt                    int64                  `protobuf:"varint,44,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CollectedAt                  int64                  `protobuf:"varint,45,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Redaction                    *RedactionInfo         `protobuf:"bytes,46,opt,name=redaction,proto3" json:"redaction,omitempty"`
+	ChartVersion                 string                 `protobuf:"bytes,47,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	HelmReleaseName              string                 `protobuf:"bytes,48,opt,name=helm_release_name,json=helmReleaseName,proto3" json:"helm_release_name,omitempty"`
+	PodName                      string                 `protobuf:"bytes,49,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	PodNamespace                 string                 `protobuf:"bytes,50,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	NodeName                     string                 `protobuf:"bytes,51,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *AgentHealth) Reset() {
+	*x = AgentHealth{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentHealth) ProtoMessage() {}
+
+func (x *AgentHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentHealth.ProtoReflect.Descriptor instead.
+func (*AgentHealth) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AgentHealth) GetSnapshotsSentTotal() uint64 {
+	if x != nil {
+		return x.SnapshotsSentTotal
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetSnapshotsFailedTotal() uint64 {
+	if x != nil {
+		return x.SnapshotsFailedTotal
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetSnapshotsTotal() uint64 {
+	if x != nil {
+		return x.SnapshotsTotal
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetSnapshotsSentDelta() uint64 {
+	if x != nil {
+		return x.SnapshotsSentDelta
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetSnapshotsFailedDelta() uint64 {
+	if x != nil {
+		return x.SnapshotsFailedDelta
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetLeaderElectionEnabled() bool {
+	if x != nil {
+		return x.LeaderElectionEnabled
+	}
+	return false
+}
+
+func (x *AgentHealth) GetIsLeader() bool {
+	if x != nil {
+		return x.IsLeader
+	}
+	return false
+}
+
+func (x *AgentHealth) GetLeaderIdentity() string {
+	if x != nil {
+		return x.LeaderIdentity
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetLeadershipTransitions() uint64 {
+	if x != nil {
+		return x.LeadershipTransitions
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetLastBuildDurationMs() int64 {
+	if x != nil {
+		return x.LastBuildDurationMs
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetLastMetricsCollectDurationMs() int64 {
+	if x != nil {
+		return x.LastMetricsCollectDurationMs
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetLastSendDurationMs() int64 {
+	if x != nil {
+		return x.LastSendDurationMs
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetEncodeDurationMs() int64 {
+	if x != nil {
+		return x.EncodeDurationMs
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetOriginalSizeBytes() int64 {
+	if x != nil {
+		return x.OriginalSizeBytes
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetCompressedSizeBytes() int64 {
+	if x != nil {
+		return x.CompressedSizeBytes
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetCompressionFactor() float64 {
+	if x != nil {
+		return x.CompressionFactor
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetBufferedSnapshots() int64 {
+	if x != nil {
+		return x.BufferedSnapshots
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetBufferedBytes() int64 {
+	if x != nil {
+		return x.BufferedBytes
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetNodeCount() int64 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetPodCount() int64 {
+	if x != nil {
+		return x.PodCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetContainerCount() int64 {
+	if x != nil {
+		return x.ContainerCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetWorkloadCount() int64 {
+	if x != nil {
+		return x.WorkloadCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetServiceCount() int64 {
+	if x != nil {
+		return x.ServiceCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetHpaCount() int64 {
+	if x != nil {
+		return x.HpaCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetPdbCount() int64 {
+	if x != nil {
+		return x.PdbCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetPvCount() int64 {
+	if x != nil {
+		return x.PvCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetMetricsServerAvailable() bool {
+	if x != nil {
+		return x.MetricsServerAvailable
+	}
+	return false
+}
+
+func (x *AgentHealth) GetVpaAvailable() bool {
+	if x != nil {
+		return x.VpaAvailable
+	}
+	return false
+}
+
+func (x *AgentHealth) GetKarpenterAvailable() bool {
+	if x != nil {
+		return x.KarpenterAvailable
+	}
+	return false
+}
+
+func (x *AgentHealth) GetGpuMetricsAvailable() bool {
+	if x != nil {
+		return x.GpuMetricsAvailable
+	}
+	return false
+}
+
+func (x *AgentHealth) GetDcgmExporterTargets() int64 {
+	if x != nil {
+		return x.DcgmExporterTargets
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetDcgmExporterUpTargets() int64 {
+	if x != nil {
+		return x.DcgmExporterUpTargets
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetInformersSynced() bool {
+	if x != nil {
+		return x.InformersSynced
+	}
+	return false
+}
+
+func (x *AgentHealth) GetInformersHealthy() int64 {
+	if x != nil {
+		return x.InformersHealthy
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetInformersTotal() int64 {
+	if x != nil {
+		return x.InformersTotal
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetStaleResources() []string {
+	if x != nil {
+		return x.StaleResources
+	}
+	return nil
+}
+
+func (x *AgentHealth) GetApiCallsTotal() int64 {
+	if x != nil {
+		return x.ApiCallsTotal
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetApiCallsFailed() int64 {
+	if x != nil {
+		return x.ApiCallsFailed
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetActiveErrorsCount() int64 {
+	if x != nil {
+		return x.ActiveErrorsCount
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetErrorCodes() []string {
+	if x != nil {
+		return x.ErrorCodes
+	}
+	return nil
+}
+
+func (x *AgentHealth) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetCollectedAt() int64 {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return 0
+}
+
+func (x *AgentHealth) GetRedaction() *RedactionInfo {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
+func (x *AgentHealth) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetHelmReleaseName() string {
+	if x != nil {
+		return x.HelmReleaseName
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *AgentHealth) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+type GPUDeviceInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Uuid                 string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	DeviceIndex          string                 `protobuf:"bytes,2,opt,name=device_index,json=deviceIndex,proto3" json:"device_index,omitempty"`
+	ModelName            string                 `protobuf:"bytes,3,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	UtilizationPercent   *float64               `protobuf:"fixed64,4,opt,name=utilization_percent,json=utilizationPercent,proto3,oneof" json:"utilization_percent,omitempty"`
+	TensorActivePercent  *float64               `protobuf:"fixed64,5,opt,name=tensor_active_percent,json=tensorActivePercent,proto3,oneof" json:"tensor_active_percent,omitempty"`
+	MemoryUtilPercent    *float64               `protobuf:"fixed64,6,opt,name=memory_util_percent,json=memoryUtilPercent,proto3,oneof" json:"memory_util_percent,omitempty"`
+	MemoryUsedBytes      *int64                 `protobuf:"varint,7,opt,name=memory_used_bytes,json=memoryUsedBytes,proto3,oneof" json:"memory_used_bytes,omitempty"`
+	MemoryTotalBytes     *int64                 `protobuf:"varint,8,opt,name=memory_total_bytes,json=memoryTotalBytes,proto3,oneof" json:"memory_total_bytes,omitempty"`
+	TemperatureCelsius   *float64               `protobuf:"fixed64,9,opt,name=temperature_celsius,json=temperatureCelsius,proto3,oneof" json:"temperature_celsius,omitempty"`
+	PowerWatts           *float64               `protobuf:"fixed64,10,opt,name=power_watts,json=powerWatts,proto3,oneof" json:"power_watts,omitempty"`
+	SmClockMhz           *float64               `protobuf:"fixed64,11,opt,name=sm_clock_mhz,json=smClockMhz,proto3,oneof" json:"sm_clock_mhz,omitempty"`
+	MigProfile           string                 `protobuf:"bytes,12,opt,name=mig_profile,json=migProfile,proto3" json:"mig_profile,omitempty"`
+	MigInstanceId        string                 `protobuf:"bytes,13,opt,name=mig_instance_id,json=migInstanceId,proto3" json:"mig_instance_id,omitempty"`
+	Allocated            bool                   `protobuf:"varint,14,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XidError             *int64                 `protobuf:"varint,15,opt,name=xid_error,json=xidError,proto3,oneof" json:"xid_error,omitempty"`
+	EccSbeVolatileTotal  *int64                 `protobuf:"varint,16,opt,name=ecc_sbe_volatile_total,json=eccSbeVolatileTotal,proto3,oneof" json:"ecc_sbe_volatile_total,omitempty"`
+	EccDbeVolatileTotal  *int64                 `protobuf:"varint,17,opt,name=ecc_dbe_volatile_total,json=eccDbeVolatileTotal,proto3,oneof" json:"ecc_dbe_volatile_total,omitempty"`
+	ThrottleReasons      []string               `protobuf:"bytes,18,rep,name=throttle_reasons,json=throttleReasons,proto3" json:"throttle_reasons,omitempty"`
+	Health               string                 `protobuf:"bytes,19,opt,name=health,proto3" json:"health,omitempty"`
+	HealthReasons        []string               `protobuf:"bytes,20,rep,name=health_reasons,json=healthReasons,proto3" json:"health_reasons,omitempty"`
+	NvlinkBandwidthTotal *int64                 `protobuf:"varint,21,opt,name=nvlink_bandwidth_total,json=nvlinkBandwidthTotal,proto3,oneof" json:"nvlink_bandwidth_total,omitempty"`
+	NvlinkTxBytesPerSec  *float64               `protobuf:"fixed64,22,opt,name=nvlink_tx_bytes_per_sec,json=nvlinkTxBytesPerSec,proto3,oneof" json:"nvlink_tx_bytes_per_sec,omitempty"`
+	NvlinkRxBytesPerSec  *float64               `protobuf:"fixed64,23,opt,name=nvlink_rx_bytes_per_sec,json=nvlinkRxBytesPerSec,proto3,oneof" json:"nvlink_rx_bytes_per_sec,omitempty"`
+	GpuIdle              *GPUIdleInfo           `protobuf:"bytes,24,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GPUDeviceInfo) Reset() {
+	*x = GPUDeviceInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPUDeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUDeviceInfo) ProtoMessage() {}
+
+func (x *GPUDeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUDeviceInfo.ProtoReflect.Descriptor instead.
+func (*GPUDeviceInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GPUDeviceInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetDeviceIndex() string {
+	if x != nil {
+		return x.DeviceIndex
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetUtilizationPercent() float64 {
+	if x != nil && x.UtilizationPercent != nil {
+		return *x.UtilizationPercent
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetTensorActivePercent() float64 {
+	if x != nil && x.TensorActivePercent != nil {
+		return *x.TensorActivePercent
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetMemoryUtilPercent() float64 {
+	if x != nil && x.MemoryUtilPercent != nil {
+		return *x.MemoryUtilPercent
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetMemoryUsedBytes() int64 {
+	if x != nil && x.MemoryUsedBytes != nil {
+		return *x.MemoryUsedBytes
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetMemoryTotalBytes() int64 {
+	if x != nil && x.MemoryTotalBytes != nil {
+		return *x.MemoryTotalBytes
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetTemperatureCelsius() float64 {
+	if x != nil && x.TemperatureCelsius != nil {
+		return *x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetPowerWatts() float64 {
+	if x != nil && x.PowerWatts != nil {
+		return *x.PowerWatts
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetSmClockMhz() float64 {
+	if x != nil && x.SmClockMhz != nil {
+		return *x.SmClockMhz
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetMigProfile() string {
+	if x != nil {
+		return x.MigProfile
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetMigInstanceId() string {
+	if x != nil {
+		return x.MigInstanceId
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetAllocated() bool {
+	if x != nil {
+		return x.Allocated
+	}
+	return false
+}
+
+func (x *GPUDeviceInfo) GetXidError() int64 {
+	if x != nil && x.XidError != nil {
+		return *x.XidError
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetEccSbeVolatileTotal() int64 {
+	if x != nil && x.EccSbeVolatileTotal != nil {
+		return *x.EccSbeVolatileTotal
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetEccDbeVolatileTotal() int64 {
+	if x != nil && x.EccDbeVolatileTotal != nil {
+		return *x.EccDbeVolatileTotal
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetThrottleReasons() []string {
+	if x != nil {
+		return x.ThrottleReasons
+	}
+	return nil
+}
+
+func (x *GPUDeviceInfo) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+func (x *GPUDeviceInfo) GetHealthReasons() []string {
+	if x != nil {
+		return x.HealthReasons
+	}
+	return nil
+}
+
+func (x *GPUDeviceInfo) GetNvlinkBandwidthTotal() int64 {
+	if x != nil && x.NvlinkBandwidthTotal != nil {
+		return *x.NvlinkBandwidthTotal
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetNvlinkTxBytesPerSec() float64 {
+	if x != nil && x.NvlinkTxBytesPerSec != nil {
+		return *x.NvlinkTxBytesPerSec
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetNvlinkRxBytesPerSec() float64 {
+	if x != nil && x.NvlinkRxBytesPerSec != nil {
+		return *x.NvlinkRxBytesPerSec
+	}
+	return 0
+}
+
+func (x *GPUDeviceInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+type GPUIdleInfo struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Reason                 string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Gpus                   float64                `protobuf:"fixed64,2,opt,name=gpus,proto3" json:"gpus,omitempty"`
+	MaxUtilizationPercent  *float64               `protobuf:"fixed64,3,opt,name=max_utilization_percent,json=maxUtilizationPercent,proto3,oneof" json:"max_utilization_percent,omitempty"`
+	MaxTensorActivePercent *float64               `protobuf:"fixed64,4,opt,name=max_tensor_active_percent,json=maxTensorActivePercent,proto3,oneof" json:"max_tensor_active_percent,omitempty"`
+	WindowHours            float64                `protobuf:"fixed64,5,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	WastedGpuHours         float64                `protobuf:"fixed64,6,opt,name=wasted_gpu_hours,json=wastedGpuHours,proto3" json:"wasted_gpu_hours,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GPUIdleInfo) Reset() {
+	*x = GPUIdleInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPUIdleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUIdleInfo) ProtoMessage() {}
+
+func (x *GPUIdleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUIdleInfo.ProtoReflect.Descriptor instead.
+func (*GPUIdleInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GPUIdleInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GPUIdleInfo) GetGpus() float64 {
+	if x != nil {
+		return x.Gpus
+	}
+	return 0
+}
+
+func (x *GPUIdleInfo) GetMaxUtilizationPercent() float64 {
+	if x != nil && x.MaxUtilizationPercent != nil {
+		return *x.MaxUtilizationPercent
+	}
+	return 0
+}
+
+func (x *GPUIdleInfo) GetMaxTensorActivePercent() float64 {
+	if x != nil && x.MaxTensorActivePercent != nil {
+		return *x.MaxTensorActivePercent
+	}
+	return 0
+}
+
+func (x *GPUIdleInfo) GetWindowHours() float64 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *GPUIdleInfo) GetWastedGpuHours() float64 {
+	if x != nil {
+		return x.WastedGpuHours
+	}
+	return 0
+}
+
+type TaintInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaintInfo) Reset() {
+	*x = TaintInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaintInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaintInfo) ProtoMessage() {}
+
+func (x *TaintInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaintInfo.ProtoReflect.Descriptor instead.
+func (*TaintInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TaintInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TaintInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TaintInfo) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type NodeConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeConditionInfo) Reset() {
+	*x = NodeConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConditionInfo) ProtoMessage() {}
+
+func (x *NodeConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConditionInfo.ProtoReflect.Descriptor instead.
+func (*NodeConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NodeConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NodeConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ContainerInfo struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image                   string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	ImageId                 string                 `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	CpuRequestCores         float64                `protobuf:"fixed64,4,opt,name=cpu_request_cores,json=cpuRequestCores,proto3" json:"cpu_request_cores,omitempty"`
+	MemoryRequestBytes      int64                  `protobuf:"varint,5,opt,name=memory_request_bytes,json=memoryRequestBytes,proto3" json:"memory_request_bytes,omitempty"`
+	CpuLimitCores           float64                `protobuf:"fixed64,6,opt,name=cpu_limit_cores,json=cpuLimitCores,proto3" json:"cpu_limit_cores,omitempty"`
+	MemoryLimitBytes        int64                  `protobuf:"varint,7,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	EphemeralStorageRequest int64                  `protobuf:"varint,8,opt,name=ephemeral_storage_request,json=ephemeralStorageRequest,proto3" json:"ephemeral_storage_request,omitempty"`
+	EphemeralStorageLimit   int64                  `protobuf:"varint,9,opt,name=ephemeral_storage_limit,json=ephemeralStorageLimit,proto3" json:"ephemeral_storage_limit,omitempty"`
+	GpuRequest              int64                  `protobuf:"varint,10,opt,name=gpu_request,json=gpuRequest,proto3" json:"gpu_request,omitempty"`
+	GpuLimit                int64                  `protobuf:"varint,11,opt,name=gpu_limit,json=gpuLimit,proto3" json:"gpu_limit,omitempty"`
+	CpuUsageCores           *float64               `protobuf:"fixed64,12,opt,name=cpu_usage_cores,json=cpuUsageCores,proto3,oneof" json:"cpu_usage_cores,omitempty"`
+	MemoryUsageBytes        *int64                 `protobuf:"varint,13,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3,oneof" json:"memory_usage_bytes,omitempty"`
+	CpuUsageStats           *CPUUsageStats         `protobuf:"bytes,14,opt,name=cpu_usage_stats,json=cpuUsageStats,proto3" json:"cpu_usage_stats,omitempty"`
+	MemoryUsageStats        *MemoryUsageStats      `protobuf:"bytes,15,opt,name=memory_usage_stats,json=memoryUsageStats,proto3" json:"memory_usage_stats,omitempty"`
+	GpuUtilizationPercent   *float64               `protobuf:"fixed64,16,opt,name=gpu_utilization_percent,json=gpuUtilizationPercent,proto3,oneof" json:"gpu_utilization_percent,omitempty"`
+	GpuTensorActivePercent  *float64               `protobuf:"fixed64,17,opt,name=gpu_tensor_active_percent,json=gpuTensorActivePercent,proto3,oneof" json:"gpu_tensor_active_percent,omitempty"`
+	GpuMemoryUsedBytes      *int64                 `protobuf:"varint,18,opt,name=gpu_memory_used_bytes,json=gpuMemoryUsedBytes,proto3,oneof" json:"gpu_memory_used_bytes,omitempty"`
+	GpuIdle                 *GPUIdleInfo           `protobuf:"bytes,19,opt,name=gpu_idle,json=gpuIdle,proto3" json:"gpu_idle,omitempty"`
+	RootfsUsedBytes         *int64                 `protobuf:"varint,20,opt,name=rootfs_used_bytes,json=rootfsUsedBytes,proto3,oneof" json:"rootfs_used_bytes,omitempty"`
+	LogsUsedBytes           *int64                 `protobuf:"varint,21,opt,name=logs_used_bytes,json=logsUsedBytes,proto3,oneof" json:"logs_used_bytes,omitempty"`
+	CpuThrottledRatio       *float64               `protobuf:"fixed64,22,opt,name=cpu_throttled_ratio,json=cpuThrottledRatio,proto3,oneof" json:"cpu_throttled_ratio,omitempty"`
+	OomEvents               *int64                 `protobuf:"varint,23,opt,name=oom_events,json=oomEvents,proto3,oneof" json:"oom_events,omitempty"`
+	Ready                   bool                   `protobuf:"varint,24,opt,name=ready,proto3" json:"ready,omitempty"`
+	Started                 *bool                  `protobuf:"varint,25,opt,name=started,proto3,oneof" json:"started,omitempty"`
+	RestartCount            int32                  `protobuf:"varint,26,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	State                   string                 `protobuf:"bytes,27,opt,name=state,proto3" json:"state,omitempty"`
+	StateReason             string                 `protobuf:"bytes,28,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	StateMessage            string                 `protobuf:"bytes,29,opt,name=state_message,json=stateMessage,proto3" json:"state_message,omitempty"`
+	LastTerminationReason   string                 `protobuf:"bytes,30,opt,name=last_termination_reason,json=lastTerminationReason,proto3" json:"last_termination_reason,omitempty"`
+	ExitCode                *int32                 `protobuf:"varint,31,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	Ports                   []*ContainerPortInfo   `protobuf:"bytes,32,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ContainerInfo) Reset() {
+	*x = ContainerInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInfo) ProtoMessage() {}
+
+func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ContainerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetCpuRequestCores() float64 {
+	if x != nil {
+		return x.CpuRequestCores
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetMemoryRequestBytes() int64 {
+	if x != nil {
+		return x.MemoryRequestBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetCpuLimitCores() float64 {
+	if x != nil {
+		return x.CpuLimitCores
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetMemoryLimitBytes() int64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetEphemeralStorageRequest() int64 {
+	if x != nil {
+		return x.EphemeralStorageRequest
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetEphemeralStorageLimit() int64 {
+	if x != nil {
+		return x.EphemeralStorageLimit
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetGpuRequest() int64 {
+	if x != nil {
+		return x.GpuRequest
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetGpuLimit() int64 {
+	if x != nil {
+		return x.GpuLimit
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetCpuUsageCores() float64 {
+	if x != nil && x.CpuUsageCores != nil {
+		return *x.CpuUsageCores
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetMemoryUsageBytes() int64 {
+	if x != nil && x.MemoryUsageBytes != nil {
+		return *x.MemoryUsageBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetCpuUsageStats() *CPUUsageStats {
+	if x != nil {
+		return x.CpuUsageStats
+	}
+	return nil
+}
+
+func (x *ContainerInfo) GetMemoryUsageStats() *MemoryUsageStats {
+	if x != nil {
+		return x.MemoryUsageStats
+	}
+	return nil
+}
+
+func (x *ContainerInfo) GetGpuUtilizationPercent() float64 {
+	if x != nil && x.GpuUtilizationPercent != nil {
+		return *x.GpuUtilizationPercent
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetGpuTensorActivePercent() float64 {
+	if x != nil && x.GpuTensorActivePercent != nil {
+		return *x.GpuTensorActivePercent
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetGpuMemoryUsedBytes() int64 {
+	if x != nil && x.GpuMemoryUsedBytes != nil {
+		return *x.GpuMemoryUsedBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetGpuIdle() *GPUIdleInfo {
+	if x != nil {
+		return x.GpuIdle
+	}
+	return nil
+}
+
+func (x *ContainerInfo) GetRootfsUsedBytes() int64 {
+	if x != nil && x.RootfsUsedBytes != nil {
+		return *x.RootfsUsedBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetLogsUsedBytes() int64 {
+	if x != nil && x.LogsUsedBytes != nil {
+		return *x.LogsUsedBytes
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetCpuThrottledRatio() float64 {
+	if x != nil && x.CpuThrottledRatio != nil {
+		return *x.CpuThrottledRatio
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetOomEvents() int64 {
+	if x != nil && x.OomEvents != nil {
+		return *x.OomEvents
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ContainerInfo) GetStarted() bool {
+	if x != nil && x.Started != nil {
+		return *x.Started
+	}
+	return false
+}
+
+func (x *ContainerInfo) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetStateMessage() string {
+	if x != nil {
+		return x.StateMessage
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetLastTerminationReason() string {
+	if x != nil {
+		return x.LastTerminationReason
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *ContainerInfo) GetPorts() []*ContainerPortInfo {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type PodVolumeInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ReadOnly         bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	ClaimName        string                 `protobuf:"bytes,4,opt,name=claim_name,json=claimName,proto3" json:"claim_name,omitempty"`
+	ConfigmapName    string                 `protobuf:"bytes,5,opt,name=configmap_name,json=configmapName,proto3" json:"configmap_name,omitempty"`
+	SecretName       string                 `protobuf:"bytes,6,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	ProjectedSources []string               `protobuf:"bytes,7,rep,name=projected_sources,json=projectedSources,proto3" json:"projected_sources,omitempty"`
+	StorageClassName string                 `protobuf:"bytes,8,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	RequestedBytes   int64                  `protobuf:"varint,9,opt,name=requested_bytes,json=requestedBytes,proto3" json:"requested_bytes,omitempty"`
+	CsiDriver        string                 `protobuf:"bytes,10,opt,name=csi_driver,json=csiDriver,proto3" json:"csi_driver,omitempty"`
+	SizeLimitBytes   int64                  `protobuf:"varint,11,opt,name=size_limit_bytes,json=sizeLimitBytes,proto3" json:"size_limit_bytes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PodVolumeInfo) Reset() {
+	*x = PodVolumeInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodVolumeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodVolumeInfo) ProtoMessage() {}
+
+func (x *PodVolumeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodVolumeInfo.ProtoReflect.Descriptor instead.
+func (*PodVolumeInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PodVolumeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *PodVolumeInfo) GetClaimName() string {
+	if x != nil {
+		return x.ClaimName
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetConfigmapName() string {
+	if x != nil {
+		return x.ConfigmapName
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetProjectedSources() []string {
+	if x != nil {
+		return x.ProjectedSources
+	}
+	return nil
+}
+
+func (x *PodVolumeInfo) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetRequestedBytes() int64 {
+	if x != nil {
+		return x.RequestedBytes
+	}
+	return 0
+}
+
+func (x *PodVolumeInfo) GetCsiDriver() string {
+	if x != nil {
+		return x.CsiDriver
+	}
+	return ""
+}
+
+func (x *PodVolumeInfo) GetSizeLimitBytes() int64 {
+	if x != nil {
+		return x.SizeLimitBytes
+	}
+	return 0
+}
+
+type PodConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodConditionInfo) Reset() {
+	*x = PodConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodConditionInfo) ProtoMessage() {}
+
+func (x *PodConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodConditionInfo.ProtoReflect.Descriptor instead.
+func (*PodConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PodConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PodConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PodConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PodConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResourceRecommendation struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContainerName      string                 `protobuf:"bytes,1,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	CpuRequestCores    *float64               `protobuf:"fixed64,2,opt,name=cpu_request_cores,json=cpuRequestCores,proto3,oneof" json:"cpu_request_cores,omitempty"`
+	MemoryRequestBytes *int64                 `protobuf:"varint,3,opt,name=memory_request_bytes,json=memoryRequestBytes,proto3,oneof" json:"memory_request_bytes,omitempty"`
+	Confidence         string                 `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	HistoryHours       int64                  `protobuf:"varint,5,opt,name=history_hours,json=historyHours,proto3" json:"history_hours,omitempty"`
+	HpaControlled      []string               `protobuf:"bytes,6,rep,name=hpa_controlled,json=hpaControlled,proto3" json:"hpa_controlled,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ResourceRecommendation) Reset() {
+	*x = ResourceRecommendation{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecommendation) ProtoMessage() {}
+
+func (x *ResourceRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecommendation.ProtoReflect.Descriptor instead.
+func (*ResourceRecommendation) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResourceRecommendation) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ResourceRecommendation) GetCpuRequestCores() float64 {
+	if x != nil && x.CpuRequestCores != nil {
+		return *x.CpuRequestCores
+	}
+	return 0
+}
+
+func (x *ResourceRecommendation) GetMemoryRequestBytes() int64 {
+	if x != nil && x.MemoryRequestBytes != nil {
+		return *x.MemoryRequestBytes
+	}
+	return 0
+}
+
+func (x *ResourceRecommendation) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *ResourceRecommendation) GetHistoryHours() int64 {
+	if x != nil {
+		return x.HistoryHours
+	}
+	return 0
+}
+
+func (x *ResourceRecommendation) GetHpaControlled() []string {
+	if x != nil {
+		return x.HpaControlled
+	}
+	return nil
+}
+
+type ContainerSpecInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image              string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	CpuRequestCores    float64                `protobuf:"fixed64,3,opt,name=cpu_request_cores,json=cpuRequestCores,proto3" json:"cpu_request_cores,omitempty"`
+	MemoryRequestBytes int64                  `protobuf:"varint,4,opt,name=memory_request_bytes,json=memoryRequestBytes,proto3" json:"memory_request_bytes,omitempty"`
+	CpuLimitCores      float64                `protobuf:"fixed64,5,opt,name=cpu_limit_cores,json=cpuLimitCores,proto3" json:"cpu_limit_cores,omitempty"`
+	MemoryLimitBytes   int64                  `protobuf:"varint,6,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	GpuRequest         int64                  `protobuf:"varint,7,opt,name=gpu_request,json=gpuRequest,proto3" json:"gpu_request,omitempty"`
+	GpuLimit           int64                  `protobuf:"varint,8,opt,name=gpu_limit,json=gpuLimit,proto3" json:"gpu_limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ContainerSpecInfo) Reset() {
+	*x = ContainerSpecInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerSpecInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerSpecInfo) ProtoMessage() {}
+
+func (x *ContainerSpecInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerSpecInfo.ProtoReflect.Descriptor instead.
+func (*ContainerSpecInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ContainerSpecInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerSpecInfo) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ContainerSpecInfo) GetCpuRequestCores() float64 {
+	if x != nil {
+		return x.CpuRequestCores
+	}
+	return 0
+}
+
+func (x *ContainerSpecInfo) GetMemoryRequestBytes() int64 {
+	if x != nil {
+		return x.MemoryRequestBytes
+	}
+	return 0
+}
+
+func (x *ContainerSpecInfo) GetCpuLimitCores() float64 {
+	if x != nil {
+		return x.CpuLimitCores
+	}
+	return 0
+}
+
+func (x *ContainerSpecInfo) GetMemoryLimitBytes() int64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *ContainerSpecInfo) GetGpuRequest() int64 {
+	if x != nil {
+		return x.GpuRequest
+	}
+	return 0
+}
+
+func (x *ContainerSpecInfo) GetGpuLimit() int64 {
+	if x != nil {
+		return x.GpuLimit
+	}
+	return 0
+}
+
+type WorkloadConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadConditionInfo) Reset() {
+	*x = WorkloadConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadConditionInfo) ProtoMessage() {}
+
+func (x *WorkloadConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadConditionInfo.ProtoReflect.Descriptor instead.
+func (*WorkloadConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WorkloadConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkloadConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkloadConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorkloadConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JobConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobConditionInfo) Reset() {
+	*x = JobConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobConditionInfo) ProtoMessage() {}
+
+func (x *JobConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobConditionInfo.ProtoReflect.Descriptor instead.
+func (*JobConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *JobConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JobConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *JobConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type HPAMetricInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ResourceName  string                 `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	ContainerName string                 `protobuf:"bytes,3,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	MetricName    string                 `protobuf:"bytes,4,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"`
+	TargetType    string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetValue   string                 `protobuf:"bytes,6,opt,name=target_value,json=targetValue,proto3" json:"target_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HPAMetricInfo) Reset() {
+	*x = HPAMetricInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPAMetricInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPAMetricInfo) ProtoMessage() {}
+
+func (x *HPAMetricInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPAMetricInfo.ProtoReflect.Descriptor instead.
+func (*HPAMetricInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HPAMetricInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HPAMetricInfo) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *HPAMetricInfo) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *HPAMetricInfo) GetMetricName() string {
+	if x != nil {
+		return x.MetricName
+	}
+	return ""
+}
+
+func (x *HPAMetricInfo) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *HPAMetricInfo) GetTargetValue() string {
+	if x != nil {
+		return x.TargetValue
+	}
+	return ""
+}
+
+type HPACurrentMetricInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Type                string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ResourceName        string                 `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	CurrentValue        string                 `protobuf:"bytes,3,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	CurrentAverageValue string                 `protobuf:"bytes,4,opt,name=current_average_value,json=currentAverageValue,proto3" json:"current_average_value,omitempty"`
+	CurrentUtilization  *int32                 `protobuf:"varint,5,opt,name=current_utilization,json=currentUtilization,proto3,oneof" json:"current_utilization,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HPACurrentMetricInfo) Reset() {
+	*x = HPACurrentMetricInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPACurrentMetricInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPACurrentMetricInfo) ProtoMessage() {}
+
+func (x *HPACurrentMetricInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPACurrentMetricInfo.ProtoReflect.Descriptor instead.
+func (*HPACurrentMetricInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HPACurrentMetricInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HPACurrentMetricInfo) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *HPACurrentMetricInfo) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+func (x *HPACurrentMetricInfo) GetCurrentAverageValue() string {
+	if x != nil {
+		return x.CurrentAverageValue
+	}
+	return ""
+}
+
+func (x *HPACurrentMetricInfo) GetCurrentUtilization() int32 {
+	if x != nil && x.CurrentUtilization != nil {
+		return *x.CurrentUtilization
+	}
+	return 0
+}
+
+type HPAScalingBehavior struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	StabilizationWindowSeconds *int32                 `protobuf:"varint,1,opt,name=stabilization_window_seconds,json=stabilizationWindowSeconds,proto3,oneof" json:"stabilization_window_seconds,omitempty"`
+	Policies                   []*HPAScalingPolicy    `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	SelectPolicy               string                 `protobuf:"bytes,3,opt,name=select_policy,json=selectPolicy,proto3" json:"select_policy,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *HPAScalingBehavior) Reset() {
+	*x = HPAScalingBehavior{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPAScalingBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPAScalingBehavior) ProtoMessage() {}
+
+func (x *HPAScalingBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPAScalingBehavior.ProtoReflect.Descriptor instead.
+func (*HPAScalingBehavior) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HPAScalingBehavior) GetStabilizationWindowSeconds() int32 {
+	if x != nil && x.StabilizationWindowSeconds != nil {
+		return *x.StabilizationWindowSeconds
+	}
+	return 0
+}
+
+func (x *HPAScalingBehavior) GetPolicies() []*HPAScalingPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *HPAScalingBehavior) GetSelectPolicy() string {
+	if x != nil {
+		return x.SelectPolicy
+	}
+	return ""
+}
+
+type HPAConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HPAConditionInfo) Reset() {
+	*x = HPAConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPAConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPAConditionInfo) ProtoMessage() {}
+
+func (x *HPAConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPAConditionInfo.ProtoReflect.Descriptor instead.
+func (*HPAConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HPAConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HPAConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HPAConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HPAConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VPAContainerRecommendation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerName  string                 `protobuf:"bytes,1,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	LowerBound     *ResourceValues        `protobuf:"bytes,2,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	Target         *ResourceValues        `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	UncappedTarget *ResourceValues        `protobuf:"bytes,4,opt,name=uncapped_target,json=uncappedTarget,proto3" json:"uncapped_target,omitempty"`
+	UpperBound     *ResourceValues        `protobuf:"bytes,5,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VPAContainerRecommendation) Reset() {
+	*x = VPAContainerRecommendation{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPAContainerRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPAContainerRecommendation) ProtoMessage() {}
+
+func (x *VPAContainerRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPAContainerRecommendation.ProtoReflect.Descriptor instead.
+func (*VPAContainerRecommendation) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VPAContainerRecommendation) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *VPAContainerRecommendation) GetLowerBound() *ResourceValues {
+	if x != nil {
+		return x.LowerBound
+	}
+	return nil
+}
+
+func (x *VPAContainerRecommendation) GetTarget() *ResourceValues {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *VPAContainerRecommendation) GetUncappedTarget() *ResourceValues {
+	if x != nil {
+		return x.UncappedTarget
+	}
+	return nil
+}
+
+func (x *VPAContainerRecommendation) GetUpperBound() *ResourceValues {
+	if x != nil {
+		return x.UpperBound
+	}
+	return nil
+}
+
+type VPAConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VPAConditionInfo) Reset() {
+	*x = VPAConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VPAConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPAConditionInfo) ProtoMessage() {}
+
+func (x *VPAConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VPAConditionInfo.ProtoReflect.Descriptor instead.
+func (*VPAConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VPAConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VPAConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VPAConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VPAConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LabelSelectorRequirement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelSelectorRequirement) Reset() {
+	*x = LabelSelectorRequirement{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelSelectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelectorRequirement) ProtoMessage() {}
+
+func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LabelSelectorRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type WorkloadReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadReference) Reset() {
+	*x = WorkloadReference{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadReference) ProtoMessage() {}
+
+func (x *WorkloadReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadReference.ProtoReflect.Descriptor instead.
+func (*WorkloadReference) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WorkloadReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WorkloadReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkloadReference) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type PDBConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PDBConditionInfo) Reset() {
+	*x = PDBConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PDBConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PDBConditionInfo) ProtoMessage() {}
+
+func (x *PDBConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PDBConditionInfo.ProtoReflect.Descriptor instead.
+func (*PDBConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PDBConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PDBConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PDBConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PDBConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LoadBalancerInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Ingress             []*LoadBalancerIngress `protobuf:"bytes,1,rep,name=ingress,proto3" json:"ingress,omitempty"`
+	Class               string                 `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	AwsLoadBalancerType string                 `protobuf:"bytes,3,opt,name=aws_load_balancer_type,json=awsLoadBalancerType,proto3" json:"aws_load_balancer_type,omitempty"`
+	AwsScheme           string                 `protobuf:"bytes,4,opt,name=aws_scheme,json=awsScheme,proto3" json:"aws_scheme,omitempty"`
+	AwsArnAnnotation    string                 `protobuf:"bytes,5,opt,name=aws_arn_annotation,json=awsArnAnnotation,proto3" json:"aws_arn_annotation,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LoadBalancerInfo) Reset() {
+	*x = LoadBalancerInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadBalancerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancerInfo) ProtoMessage() {}
+
+func (x *LoadBalancerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancerInfo.ProtoReflect.Descriptor instead.
+func (*LoadBalancerInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LoadBalancerInfo) GetIngress() []*LoadBalancerIngress {
+	if x != nil {
+		return x.Ingress
+	}
+	return nil
+}
+
+func (x *LoadBalancerInfo) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *LoadBalancerInfo) GetAwsLoadBalancerType() string {
+	if x != nil {
+		return x.AwsLoadBalancerType
+	}
+	return ""
+}
+
+func (x *LoadBalancerInfo) GetAwsScheme() string {
+	if x != nil {
+		return x.AwsScheme
+	}
+	return ""
+}
+
+func (x *LoadBalancerInfo) GetAwsArnAnnotation() string {
+	if x != nil {
+		return x.AwsArnAnnotation
+	}
+	return ""
+}
+
+type ServicePortInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	TargetPort    string                 `protobuf:"bytes,4,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	NodePort      int32                  `protobuf:"varint,5,opt,name=node_port,json=nodePort,proto3" json:"node_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePortInfo) Reset() {
+	*x = ServicePortInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePortInfo) ProtoMessage() {}
+
+func (x *ServicePortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePortInfo.ProtoReflect.Descriptor instead.
+func (*ServicePortInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ServicePortInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServicePortInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServicePortInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServicePortInfo) GetTargetPort() string {
+	if x != nil {
+		return x.TargetPort
+	}
+	return ""
+}
+
+func (x *ServicePortInfo) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+type IngressRuleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Paths         []*IngressPathInfo     `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngressRuleInfo) Reset() {
+	*x = IngressRuleInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressRuleInfo) ProtoMessage() {}
+
+func (x *IngressRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressRuleInfo.ProtoReflect.Descriptor instead.
+func (*IngressRuleInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IngressRuleInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *IngressRuleInfo) GetPaths() []*IngressPathInfo {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type IngressTLSInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hosts         []string               `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	SecretName    string                 `protobuf:"bytes,2,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngressTLSInfo) Reset() {
+	*x = IngressTLSInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressTLSInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressTLSInfo) ProtoMessage() {}
+
+func (x *IngressTLSInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressTLSInfo.ProtoReflect.Descriptor instead.
+func (*IngressTLSInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IngressTLSInfo) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *IngressTLSInfo) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+type IngressBackendInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServicePort   string                 `protobuf:"bytes,2,opt,name=service_port,json=servicePort,proto3" json:"service_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngressBackendInfo) Reset() {
+	*x = IngressBackendInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressBackendInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressBackendInfo) ProtoMessage() {}
+
+func (x *IngressBackendInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressBackendInfo.ProtoReflect.Descriptor instead.
+func (*IngressBackendInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IngressBackendInfo) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *IngressBackendInfo) GetServicePort() string {
+	if x != nil {
+		return x.ServicePort
+	}
+	return ""
+}
+
+type PVSourceInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	CsiDriver       string                 `protobuf:"bytes,2,opt,name=csi_driver,json=csiDriver,proto3" json:"csi_driver,omitempty"`
+	CsiVolumeHandle string                 `protobuf:"bytes,3,opt,name=csi_volume_handle,json=csiVolumeHandle,proto3" json:"csi_volume_handle,omitempty"`
+	CsiFsType       string                 `protobuf:"bytes,4,opt,name=csi_fs_type,json=csiFsType,proto3" json:"csi_fs_type,omitempty"`
+	AwsVolumeId     string                 `protobuf:"bytes,5,opt,name=aws_volume_id,json=awsVolumeId,proto3" json:"aws_volume_id,omitempty"`
+	AwsPartition    int64                  `protobuf:"varint,6,opt,name=aws_partition,json=awsPartition,proto3" json:"aws_partition,omitempty"`
+	NfsServer       string                 `protobuf:"bytes,7,opt,name=nfs_server,json=nfsServer,proto3" json:"nfs_server,omitempty"`
+	NfsPath         string                 `protobuf:"bytes,8,opt,name=nfs_path,json=nfsPath,proto3" json:"nfs_path,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PVSourceInfo) Reset() {
+	*x = PVSourceInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVSourceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVSourceInfo) ProtoMessage() {}
+
+func (x *PVSourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVSourceInfo.ProtoReflect.Descriptor instead.
+func (*PVSourceInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PVSourceInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetCsiDriver() string {
+	if x != nil {
+		return x.CsiDriver
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetCsiVolumeHandle() string {
+	if x != nil {
+		return x.CsiVolumeHandle
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetCsiFsType() string {
+	if x != nil {
+		return x.CsiFsType
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetAwsVolumeId() string {
+	if x != nil {
+		return x.AwsVolumeId
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetAwsPartition() int64 {
+	if x != nil {
+		return x.AwsPartition
+	}
+	return 0
+}
+
+func (x *PVSourceInfo) GetNfsServer() string {
+	if x != nil {
+		return x.NfsServer
+	}
+	return ""
+}
+
+func (x *PVSourceInfo) GetNfsPath() string {
+	if x != nil {
+		return x.NfsPath
+	}
+	return ""
+}
+
+type PVClaimRefInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uid           string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVClaimRefInfo) Reset() {
+	*x = PVClaimRefInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVClaimRefInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVClaimRefInfo) ProtoMessage() {}
+
+func (x *PVClaimRefInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVClaimRefInfo.ProtoReflect.Descriptor instead.
+func (*PVClaimRefInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PVClaimRefInfo) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PVClaimRefInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PVClaimRefInfo) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type PVCConditionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVCConditionInfo) Reset() {
+	*x = PVCConditionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVCConditionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVCConditionInfo) ProtoMessage() {}
+
+func (x *PVCConditionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVCConditionInfo.ProtoReflect.Descriptor instead.
+func (*PVCConditionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PVCConditionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PVCConditionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PVCConditionInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PVCConditionInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LimitRangeItemInfo struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Type                 string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Default              map[string]string      `protobuf:"bytes,2,rep,name=default,proto3" json:"default,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultRequest       map[string]string      `protobuf:"bytes,3,rep,name=default_request,json=defaultRequest,proto3" json:"default_request,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Max                  map[string]string      `protobuf:"bytes,4,rep,name=max,proto3" json:"max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Min                  map[string]string      `protobuf:"bytes,5,rep,name=min,proto3" json:"min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MaxLimitRequestRatio map[string]string      `protobuf:"bytes,6,rep,name=max_limit_request_ratio,json=maxLimitRequestRatio,proto3" json:"max_limit_request_ratio,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LimitRangeItemInfo) Reset() {
+	*x = LimitRangeItemInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitRangeItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitRangeItemInfo) ProtoMessage() {}
+
+func (x *LimitRangeItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitRangeItemInfo.ProtoReflect.Descriptor instead.
+func (*LimitRangeItemInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LimitRangeItemInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LimitRangeItemInfo) GetDefault() map[string]string {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *LimitRangeItemInfo) GetDefaultRequest() map[string]string {
+	if x != nil {
+		return x.DefaultRequest
+	}
+	return nil
+}
+
+func (x *LimitRangeItemInfo) GetMax() map[string]string {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *LimitRangeItemInfo) GetMin() map[string]string {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *LimitRangeItemInfo) GetMaxLimitRequestRatio() map[string]string {
+	if x != nil {
+		return x.MaxLimitRequestRatio
+	}
+	return nil
+}
+
+type NodeSelectorRequirement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeSelectorRequirement) Reset() {
+	*x = NodeSelectorRequirement{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeSelectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeSelectorRequirement) ProtoMessage() {}
+
+func (x *NodeSelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*NodeSelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NodeSelectorRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NodeSelectorRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *NodeSelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RedactionInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LabelAllow      []string               `protobuf:"bytes,1,rep,name=label_allow,json=labelAllow,proto3" json:"label_allow,omitempty"`
+	LabelDeny       []string               `protobuf:"bytes,2,rep,name=label_deny,json=labelDeny,proto3" json:"label_deny,omitempty"`
+	AnnotationAllow []string               `protobuf:"bytes,3,rep,name=annotation_allow,json=annotationAllow,proto3" json:"annotation_allow,omitempty"`
+	AnnotationDeny  []string               `protobuf:"bytes,4,rep,name=annotation_deny,json=annotationDeny,proto3" json:"annotation_deny,omitempty"`
+	ValuePatterns   int64                  `protobuf:"varint,5,opt,name=value_patterns,json=valuePatterns,proto3" json:"value_patterns,omitempty"`
+	NamesHashed     bool                   `protobuf:"varint,6,opt,name=names_hashed,json=namesHashed,proto3" json:"names_hashed,omitempty"`
+	SaltFingerprint string                 `protobuf:"bytes,7,opt,name=salt_fingerprint,json=saltFingerprint,proto3" json:"salt_fingerprint,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RedactionInfo) Reset() {
+	*x = RedactionInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedactionInfo) ProtoMessage() {}
+
+func (x *RedactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedactionInfo.ProtoReflect.Descriptor instead.
+func (*RedactionInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RedactionInfo) GetLabelAllow() []string {
+	if x != nil {
+		return x.LabelAllow
+	}
+	return nil
+}
+
+func (x *RedactionInfo) GetLabelDeny() []string {
+	if x != nil {
+		return x.LabelDeny
+	}
+	return nil
+}
+
+func (x *RedactionInfo) GetAnnotationAllow() []string {
+	if x != nil {
+		return x.AnnotationAllow
+	}
+	return nil
+}
+
+func (x *RedactionInfo) GetAnnotationDeny() []string {
+	if x != nil {
+		return x.AnnotationDeny
+	}
+	return nil
+}
+
+func (x *RedactionInfo) GetValuePatterns() int64 {
+	if x != nil {
+		return x.ValuePatterns
+	}
+	return 0
+}
+
+func (x *RedactionInfo) GetNamesHashed() bool {
+	if x != nil {
+		return x.NamesHashed
+	}
+	return false
+}
+
+func (x *RedactionInfo) GetSaltFingerprint() string {
+	if x != nil {
+		return x.SaltFingerprint
+	}
+	return ""
+}
+
+type CPUUsageStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinCores      float64                `protobuf:"fixed64,1,opt,name=min_cores,json=minCores,proto3" json:"min_cores,omitempty"`
+	AvgCores      float64                `protobuf:"fixed64,2,opt,name=avg_cores,json=avgCores,proto3" json:"avg_cores,omitempty"`
+	MaxCores      float64                `protobuf:"fixed64,3,opt,name=max_cores,json=maxCores,proto3" json:"max_cores,omitempty"`
+	P95Cores      float64                `protobuf:"fixed64,4,opt,name=p95_cores,json=p95Cores,proto3" json:"p95_cores,omitempty"`
+	Samples       int64                  `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUUsageStats) Reset() {
+	*x = CPUUsageStats{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUUsageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUUsageStats) ProtoMessage() {}
+
+func (x *CPUUsageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUUsageStats.ProtoReflect.Descriptor instead.
+func (*CPUUsageStats) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CPUUsageStats) GetMinCores() float64 {
+	if x != nil {
+		return x.MinCores
+	}
+	return 0
+}
+
+func (x *CPUUsageStats) GetAvgCores() float64 {
+	if x != nil {
+		return x.AvgCores
+	}
+	return 0
+}
+
+func (x *CPUUsageStats) GetMaxCores() float64 {
+	if x != nil {
+		return x.MaxCores
+	}
+	return 0
+}
+
+func (x *CPUUsageStats) GetP95Cores() float64 {
+	if x != nil {
+		return x.P95Cores
+	}
+	return 0
+}
+
+func (x *CPUUsageStats) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type MemoryUsageStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinBytes      int64                  `protobuf:"varint,1,opt,name=min_bytes,json=minBytes,proto3" json:"min_bytes,omitempty"`
+	AvgBytes      int64                  `protobuf:"varint,2,opt,name=avg_bytes,json=avgBytes,proto3" json:"avg_bytes,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	P95Bytes      int64                  `protobuf:"varint,4,opt,name=p95_bytes,json=p95Bytes,proto3" json:"p95_bytes,omitempty"`
+	Samples       int64                  `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryUsageStats) Reset() {
+	*x = MemoryUsageStats{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryUsageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsageStats) ProtoMessage() {}
+
+func (x *MemoryUsageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsageStats.ProtoReflect.Descriptor instead.
+func (*MemoryUsageStats) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MemoryUsageStats) GetMinBytes() int64 {
+	if x != nil {
+		return x.MinBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsageStats) GetAvgBytes() int64 {
+	if x != nil {
+		return x.AvgBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsageStats) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsageStats) GetP95Bytes() int64 {
+	if x != nil {
+		return x.P95Bytes
+	}
+	return 0
+}
+
+func (x *MemoryUsageStats) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type ContainerPortInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerPortInfo) Reset() {
+	*x = ContainerPortInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerPortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerPortInfo) ProtoMessage() {}
+
+func (x *ContainerPortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerPortInfo.ProtoReflect.Descriptor instead.
+func (*ContainerPortInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ContainerPortInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerPortInfo) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *ContainerPortInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type HPAScalingPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PeriodSeconds int32                  `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HPAScalingPolicy) Reset() {
+	*x = HPAScalingPolicy{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HPAScalingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HPAScalingPolicy) ProtoMessage() {}
+
+func (x *HPAScalingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HPAScalingPolicy.ProtoReflect.Descriptor instead.
+func (*HPAScalingPolicy) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *HPAScalingPolicy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HPAScalingPolicy) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HPAScalingPolicy) GetPeriodSeconds() int32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+type ResourceValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuCores      float64                `protobuf:"fixed64,1,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryBytes   int64                  `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceValues) Reset() {
+	*x = ResourceValues{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceValues) ProtoMessage() {}
+
+func (x *ResourceValues) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceValues.ProtoReflect.Descriptor instead.
+func (*ResourceValues) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ResourceValues) GetCpuCores() float64 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *ResourceValues) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+type LoadBalancerIngress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadBalancerIngress) Reset() {
+	*x = LoadBalancerIngress{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadBalancerIngress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancerIngress) ProtoMessage() {}
+
+func (x *LoadBalancerIngress) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancerIngress.ProtoReflect.Descriptor instead.
+func (*LoadBalancerIngress) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LoadBalancerIngress) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LoadBalancerIngress) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type IngressPathInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Path           string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PathType       string                 `protobuf:"bytes,2,opt,name=path_type,json=pathType,proto3" json:"path_type,omitempty"`
+	BackendService string                 `protobuf:"bytes,3,opt,name=backend_service,json=backendService,proto3" json:"backend_service,omitempty"`
+	BackendPort    string                 `protobuf:"bytes,4,opt,name=backend_port,json=backendPort,proto3" json:"backend_port,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IngressPathInfo) Reset() {
+	*x = IngressPathInfo{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressPathInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressPathInfo) ProtoMessage() {}
+
+func (x *IngressPathInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressPathInfo.ProtoReflect.Descriptor instead.
+func (*IngressPathInfo) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *IngressPathInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *IngressPathInfo) GetPathType() string {
+	if x != nil {
+		return x.PathType
+	}
+	return ""
+}
+
+func (x *IngressPathInfo) GetBackendService() string {
+	if x != nil {
+		return x.BackendService
+	}
+	return ""
+}
+
+func (x *IngressPathInfo) GetBackendPort() string {
+	if x != nil {
+		return x.BackendPort
+	}
+	return ""
+}
+
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_kubeadapt_agent_v2_snapshot_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_kubeadapt_agent_v2_snapshot_proto protoreflect.FileDescriptor
+
+const file_kubeadapt_agent_v2_snapshot_proto_rawDesc = "" +
+	"\n" +
+	"!kubeadapt/agent/v2/snapshot.proto\x12\x12kubeadapt.agent.v2\x1a\x1cgoogle/protobuf/struct.proto\"\xcb\x0f\n" +
+	"\x0fClusterSnapshot\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
+	"snapshotId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12#\n" +
+	"\ragent_version\x18\x04 \x01(\tR\fagentVersion\x12#\n" +
+	"\rsnapshot_type\x18\x05 \x01(\tR\fsnapshotType\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x04R\bsequence\x12(\n" +
+	"\x10base_snapshot_id\x18\a \x01(\tR\x0ebaseSnapshotId\x127\n" +
+	"\x05delta\x18\b \x01(\v2!.kubeadapt.agent.v2.SnapshotDeltaR\x05delta\x124\n" +
+	"\x04part\x18\t \x01(\v2 .kubeadapt.agent.v2.SnapshotPartR\x04part\x12\x1a\n" +
+	"\bprovider\x18\n" +
+	" \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\v \x01(\tR\x06region\x12(\n" +
+	"\x10cloud_account_id\x18\f \x01(\tR\x0ecloudAccountId\x12-\n" +
+	"\x12kubernetes_version\x18\r \x01(\tR\x11kubernetesVersion\x122\n" +
+	"\x05nodes\x18\x0e \x03(\v2\x1c.kubeadapt.agent.v2.NodeInfoR\x05nodes\x12/\n" +
+	"\x04pods\x18\x0f \x03(\v2\x1b.kubeadapt.agent.v2.PodInfoR\x04pods\x12A\n" +
+	"\n" +
+	"namespaces\x18\x10 \x03(\v2!.kubeadapt.agent.v2.NamespaceInfoR\n" +
+	"namespaces\x12D\n" +
+	"\vdeployments\x18\x11 \x03(\v2\".kubeadapt.agent.v2.DeploymentInfoR\vdeployments\x12G\n" +
+	"\fstatefulsets\x18\x12 \x03(\v2#.kubeadapt.agent.v2.StatefulSetInfoR\fstatefulsets\x12A\n" +
+	"\n" +
+	"daemonsets\x18\x13 \x03(\v2!.kubeadapt.agent.v2.DaemonSetInfoR\n" +
+	"daemonsets\x12/\n" +
+	"\x04jobs\x18\x14 \x03(\v2\x1b.kubeadapt.agent.v2.JobInfoR\x04jobs\x12;\n" +
+	"\bcronjobs\x18\x15 \x03(\v2\x1f.kubeadapt.agent.v2.CronJobInfoR\bcronjobs\x12Q\n" +
+	"\x10custom_workloads\x18\x16 \x03(\v2&.kubeadapt.agent.v2.CustomWorkloadInfoR\x0fcustomWorkloads\x12/\n" +
+	"\x04hpas\x18\x17 \x03(\v2\x1b.kubeadapt.agent.v2.HPAInfoR\x04hpas\x12/\n" +
+	"\x04vpas\x18\x18 \x03(\v2\x1b.kubeadapt.agent.v2.VPAInfoR\x04vpas\x12/\n" +
+	"\x04pdbs\x18\x19 \x03(\v2\x1b.kubeadapt.agent.v2.PDBInfoR\x04pdbs\x12;\n" +
+	"\bservices\x18\x1a \x03(\v2\x1f.kubeadapt.agent.v2.ServiceInfoR\bservices\x12=\n" +
+	"\tingresses\x18\x1b \x03(\v2\x1f.kubeadapt.agent.v2.IngressInfoR\tingresses\x12,\n" +
+	"\x03pvs\x18\x1c \x03(\v2\x1a.kubeadapt.agent.v2.PVInfoR\x03pvs\x12/\n" +
+	"\x04pvcs\x18\x1d \x03(\v2\x1b.kubeadapt.agent.v2.PVCInfoR\x04pvcs\x12M\n" +
+	"\x0fstorage_classes\x18\x1e \x03(\v2$.kubeadapt.agent.v2.StorageClassInfoR\x0estorageClasses\x12P\n" +
+	"\x10priority_classes\x18\x1f \x03(\v2%.kubeadapt.agent.v2.PriorityClassInfoR\x0fpriorityClasses\x12E\n" +
+	"\flimit_ranges\x18  \x03(\v2\".kubeadapt.agent.v2.LimitRangeInfoR\vlimitRanges\x12N\n" +
+	"\x0fresource_quotas\x18! \x03(\v2%.kubeadapt.agent.v2.ResourceQuotaInfoR\x0eresourceQuotas\x12?\n" +
+	"\n" +
+	"node_pools\x18\" \x03(\v2 .kubeadapt.agent.v2.NodePoolInfoR\tnodePools\x125\n" +
+	"\x06events\x18# \x03(\v2\x1d.kubeadapt.agent.v2.EventInfoR\x06events\x12<\n" +
+	"\asummary\x18$ \x01(\v2\".kubeadapt.agent.v2.ClusterSummaryR\asummary\x127\n" +
+	"\x06health\x18% \x01(\v2\x1f.kubeadapt.agent.v2.AgentHealthR\x06health\"\xf9\x03\n" +
+	"\rSnapshotDelta\x12B\n" +
+	"\x05added\x18\x01 \x03(\v2,.kubeadapt.agent.v2.SnapshotDelta.AddedEntryR\x05added\x12H\n" +
+	"\aupdated\x18\x02 \x03(\v2..kubeadapt.agent.v2.SnapshotDelta.UpdatedEntryR\aupdated\x12H\n" +
+	"\adeleted\x18\x03 \x03(\v2..kubeadapt.agent.v2.SnapshotDelta.DeletedEntryR\adeleted\x1aX\n" +
+	"\n" +
+	"AddedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.kubeadapt.agent.v2.StringListR\x05value:\x028\x01\x1aZ\n" +
+	"\fUpdatedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.kubeadapt.agent.v2.StringListR\x05value:\x028\x01\x1aZ\n" +
+	"\fDeletedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.kubeadapt.agent.v2.StringListR\x05value:\x028\x01\"R\n" +
+	"\fSnapshotPart\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x03R\x05index\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06family\x18\x03 \x01(\tR\x06family\"\xb5\x1a\n" +
+	"\bNodeInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\tR\n" +
+	"providerId\x12\x1f\n" +
+	"\vinstance_id\x18\x04 \x01(\tR\n" +
+	"instanceId\x12#\n" +
+	"\rinstance_type\x18\x05 \x01(\tR\finstanceType\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\a \x01(\tR\x04zone\x12#\n" +
+	"\rcapacity_type\x18\b \x01(\tR\fcapacityType\x12\x1d\n" +
+	"\n" +
+	"node_group\x18\t \x01(\tR\tnodeGroup\x12\"\n" +
+	"\farchitecture\x18\n" +
+	" \x01(\tR\farchitecture\x12\x0e\n" +
+	"\x02os\x18\v \x01(\tR\x02os\x12'\n" +
+	"\x0fkubelet_version\x18\f \x01(\tR\x0ekubeletVersion\x12+\n" +
+	"\x11container_runtime\x18\r \x01(\tR\x10containerRuntime\x12\x19\n" +
+	"\bpod_cidr\x18\x0e \x01(\tR\apodCidr\x12\x1b\n" +
+	"\tpod_cidrs\x18\x0f \x03(\tR\bpodCidrs\x12\x19\n" +
+	"\bos_image\x18\x10 \x01(\tR\aosImage\x12%\n" +
+	"\x0ekernel_version\x18\x11 \x01(\tR\rkernelVersion\x12,\n" +
+	"\x12kube_proxy_version\x18\x12 \x01(\tR\x10kubeProxyVersion\x12,\n" +
+	"\x12cpu_capacity_cores\x18\x13 \x01(\x01R\x10cpuCapacityCores\x122\n" +
+	"\x15memory_capacity_bytes\x18\x14 \x01(\x03R\x13memoryCapacityBytes\x126\n" +
+	"\x17ephemeral_storage_bytes\x18\x15 \x01(\x03R\x15ephemeralStorageBytes\x12B\n" +
+	"\x1dephemeral_storage_allocatable\x18\x16 \x01(\x03R\x1bephemeralStorageAllocatable\x12!\n" +
+	"\fpod_capacity\x18\x17 \x01(\x03R\vpodCapacity\x12!\n" +
+	"\fgpu_capacity\x18\x18 \x01(\x03R\vgpuCapacity\x12'\n" +
+	"\x0fcpu_allocatable\x18\x19 \x01(\x01R\x0ecpuAllocatable\x12-\n" +
+	"\x12memory_allocatable\x18\x1a \x01(\x03R\x11memoryAllocatable\x12'\n" +
+	"\x0fpod_allocatable\x18\x1b \x01(\x03R\x0epodAllocatable\x12'\n" +
+	"\x0fgpu_allocatable\x18\x1c \x01(\x03R\x0egpuAllocatable\x12\x1d\n" +
+	"\n" +
+	"gpu_vendor\x18\x1d \x01(\tR\tgpuVendor\x12\x1b\n" +
+	"\tgpu_model\x18\x1e \x01(\tR\bgpuModel\x12,\n" +
+	"\x12gpu_driver_version\x18\x1f \x01(\tR\x10gpuDriverVersion\x12\x1f\n" +
+	"\vmig_enabled\x18  \x01(\bR\n" +
+	"migEnabled\x12M\n" +
+	"\vmig_devices\x18! \x03(\v2,.kubeadapt.agent.v2.NodeInfo.MigDevicesEntryR\n" +
+	"migDevices\x12;\n" +
+	"\x17gpu_utilization_percent\x18\" \x01(\x01H\x00R\x15gpuUtilizationPercent\x88\x01\x01\x12>\n" +
+	"\x19gpu_tensor_active_percent\x18# \x01(\x01H\x01R\x16gpuTensorActivePercent\x88\x01\x01\x12:\n" +
+	"\x17gpu_memory_util_percent\x18$ \x01(\x01H\x02R\x14gpuMemoryUtilPercent\x88\x01\x01\x126\n" +
+	"\x15gpu_memory_used_bytes\x18% \x01(\x03H\x03R\x12gpuMemoryUsedBytes\x88\x01\x01\x128\n" +
+	"\x16gpu_memory_total_bytes\x18& \x01(\x03H\x04R\x13gpuMemoryTotalBytes\x88\x01\x01\x12;\n" +
+	"\x17gpu_temperature_celsius\x18' \x01(\x01H\x05R\x15gpuTemperatureCelsius\x88\x01\x01\x12+\n" +
+	"\x0fgpu_power_watts\x18( \x01(\x01H\x06R\rgpuPowerWatts\x88\x01\x01\x12B\n" +
+	"\vgpu_devices\x18) \x03(\v2!.kubeadapt.agent.v2.GPUDeviceInfoR\n" +
+	"gpuDevices\x12\x1d\n" +
+	"\n" +
+	"gpu_health\x18* \x01(\tR\tgpuHealth\x122\n" +
+	"\x15gpu_unhealthy_devices\x18+ \x01(\x03R\x13gpuUnhealthyDevices\x12:\n" +
+	"\bgpu_idle\x18, \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12+\n" +
+	"\x0fcpu_usage_cores\x18- \x01(\x01H\aR\rcpuUsageCores\x88\x01\x01\x121\n" +
+	"\x12memory_usage_bytes\x18. \x01(\x03H\bR\x10memoryUsageBytes\x88\x01\x01\x12D\n" +
+	"\x1cephemeral_storage_used_bytes\x18/ \x01(\x03H\tR\x19ephemeralStorageUsedBytes\x88\x01\x01\x121\n" +
+	"\x12imagefs_used_bytes\x180 \x01(\x03H\n" +
+	"R\x10imagefsUsedBytes\x88\x01\x01\x12-\n" +
+	"\x10network_rx_bytes\x181 \x01(\x03H\vR\x0enetworkRxBytes\x88\x01\x01\x12-\n" +
+	"\x10network_tx_bytes\x182 \x01(\x03H\fR\x0enetworkTxBytes\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x183 \x01(\x01H\rR\n" +
+	"hourlyCost\x88\x01\x01\x12-\n" +
+	"\x10idle_hourly_cost\x184 \x01(\x01H\x0eR\x0eidleHourlyCost\x88\x01\x01\x12%\n" +
+	"\x0epricing_source\x185 \x01(\tR\rpricingSource\x12\x14\n" +
+	"\x05ready\x186 \x01(\bR\x05ready\x12$\n" +
+	"\runschedulable\x187 \x01(\bR\runschedulable\x125\n" +
+	"\x06taints\x188 \x03(\v2\x1d.kubeadapt.agent.v2.TaintInfoR\x06taints\x12E\n" +
+	"\n" +
+	"conditions\x189 \x03(\v2%.kubeadapt.agent.v2.NodeConditionInfoR\n" +
+	"conditions\x12S\n" +
+	"\revent_reasons\x18: \x03(\v2..kubeadapt.agent.v2.NodeInfo.EventReasonsEntryR\feventReasons\x12@\n" +
+	"\x06labels\x18; \x03(\v2(.kubeadapt.agent.v2.NodeInfo.LabelsEntryR\x06labels\x12O\n" +
+	"\vannotations\x18< \x03(\v2-.kubeadapt.agent.v2.NodeInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18= \x01(\x03R\x11creationTimestamp\x1a=\n" +
+	"\x0fMigDevicesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x1a\n" +
+	"\x18_gpu_utilization_percentB\x1c\n" +
+	"\x1a_gpu_tensor_active_percentB\x1a\n" +
+	"\x18_gpu_memory_util_percentB\x18\n" +
+	"\x16_gpu_memory_used_bytesB\x19\n" +
+	"\x17_gpu_memory_total_bytesB\x1a\n" +
+	"\x18_gpu_temperature_celsiusB\x12\n" +
+	"\x10_gpu_power_wattsB\x12\n" +
+	"\x10_cpu_usage_coresB\x15\n" +
+	"\x13_memory_usage_bytesB\x1f\n" +
+	"\x1d_ephemeral_storage_used_bytesB\x15\n" +
+	"\x13_imagefs_used_bytesB\x13\n" +
+	"\x11_network_rx_bytesB\x13\n" +
+	"\x11_network_tx_bytesB\x0e\n" +
+	"\f_hourly_costB\x13\n" +
+	"\x11_idle_hourly_cost\"\x93\r\n" +
+	"\aPodInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1b\n" +
+	"\tnode_name\x18\x04 \x01(\tR\bnodeName\x12\x14\n" +
+	"\x05phase\x18\x05 \x01(\tR\x05phase\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tqos_class\x18\a \x01(\tR\bqosClass\x12\x1d\n" +
+	"\n" +
+	"owner_kind\x18\b \x01(\tR\townerKind\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\t \x01(\tR\townerName\x12\x1b\n" +
+	"\towner_uid\x18\n" +
+	" \x01(\tR\bownerUid\x12*\n" +
+	"\x11owner_api_version\x18\v \x01(\tR\x0fownerApiVersion\x12A\n" +
+	"\n" +
+	"containers\x18\f \x03(\v2!.kubeadapt.agent.v2.ContainerInfoR\n" +
+	"containers\x12J\n" +
+	"\x0finit_containers\x18\r \x03(\v2!.kubeadapt.agent.v2.ContainerInfoR\x0einitContainers\x12?\n" +
+	"\x06labels\x18\x0e \x03(\v2'.kubeadapt.agent.v2.PodInfo.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\x0f \x03(\v2,.kubeadapt.agent.v2.PodInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x10 \x01(\x03R\x11creationTimestamp\x12.\n" +
+	"\x13priority_class_name\x18\x11 \x01(\tR\x11priorityClassName\x12\x1f\n" +
+	"\bpriority\x18\x12 \x01(\x05H\x00R\bpriority\x88\x01\x01\x12%\n" +
+	"\x0escheduler_name\x18\x13 \x01(\tR\rschedulerName\x120\n" +
+	"\x14service_account_name\x18\x14 \x01(\tR\x12serviceAccountName\x12\x15\n" +
+	"\x06pod_ip\x18\x15 \x01(\tR\x05podIp\x12\x17\n" +
+	"\ahost_ip\x18\x16 \x01(\tR\x06hostIp\x12!\n" +
+	"\fhost_network\x18\x17 \x01(\bR\vhostNetwork\x12!\n" +
+	"\fhas_hostpath\x18\x18 \x01(\bR\vhasHostpath\x12!\n" +
+	"\fhas_emptydir\x18\x19 \x01(\bR\vhasEmptydir\x12;\n" +
+	"\avolumes\x18\x1a \x03(\v2!.kubeadapt.agent.v2.PodVolumeInfoR\avolumes\x12D\n" +
+	"\x1cephemeral_storage_used_bytes\x18\x1b \x01(\x03H\x01R\x19ephemeralStorageUsedBytes\x88\x01\x01\x12-\n" +
+	"\x10network_rx_bytes\x18\x1c \x01(\x03H\x02R\x0enetworkRxBytes\x88\x01\x01\x12-\n" +
+	"\x10network_tx_bytes\x18\x1d \x01(\x03H\x03R\x0enetworkTxBytes\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x1e \x01(\x01H\x04R\n" +
+	"hourlyCost\x88\x01\x01\x12D\n" +
+	"\n" +
+	"conditions\x18\x1f \x03(\v2$.kubeadapt.agent.v2.PodConditionInfoR\n" +
+	"conditions\x12R\n" +
+	"\revent_reasons\x18  \x03(\v2-.kubeadapt.agent.v2.PodInfo.EventReasonsEntryR\feventReasons\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\v\n" +
+	"\t_priorityB\x1f\n" +
+	"\x1d_ephemeral_storage_used_bytesB\x13\n" +
+	"\x11_network_rx_bytesB\x13\n" +
+	"\x11_network_tx_bytesB\x0e\n" +
+	"\f_hourly_cost\"\x80\x03\n" +
+	"\rNamespaceInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12E\n" +
+	"\x06labels\x18\x03 \x03(\v2-.kubeadapt.agent.v2.NamespaceInfo.LabelsEntryR\x06labels\x12T\n" +
+	"\vannotations\x18\x04 \x03(\v22.kubeadapt.agent.v2.NamespaceInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x05 \x01(\x03R\x11creationTimestamp\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe9\r\n" +
+	"\x0eDeploymentInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\x04 \x01(\x05R\breplicas\x12%\n" +
+	"\x0eready_replicas\x18\x05 \x01(\x05R\rreadyReplicas\x12-\n" +
+	"\x12available_replicas\x18\x06 \x01(\x05R\x11availableReplicas\x121\n" +
+	"\x14unavailable_replicas\x18\a \x01(\x05R\x13unavailableReplicas\x12)\n" +
+	"\x10updated_replicas\x18\b \x01(\x05R\x0fupdatedReplicas\x12\x1a\n" +
+	"\bstrategy\x18\t \x01(\tR\bstrategy\x12\x1b\n" +
+	"\tmax_surge\x18\n" +
+	" \x01(\tR\bmaxSurge\x12'\n" +
+	"\x0fmax_unavailable\x18\v \x01(\tR\x0emaxUnavailable\x12*\n" +
+	"\x11total_cpu_request\x18\f \x01(\x01R\x0ftotalCpuRequest\x120\n" +
+	"\x14total_memory_request\x18\r \x01(\x03R\x12totalMemoryRequest\x12&\n" +
+	"\x0ftotal_cpu_limit\x18\x0e \x01(\x01R\rtotalCpuLimit\x12,\n" +
+	"\x12total_memory_limit\x18\x0f \x01(\x03R\x10totalMemoryLimit\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x10 \x01(\x01H\x00R\rtotalCpuUsage\x88\x01\x01\x121\n" +
+	"\x12total_memory_usage\x18\x11 \x01(\x03H\x01R\x10totalMemoryUsage\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x12 \x01(\x01H\x02R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x13 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12T\n" +
+	"\x0frecommendations\x18\x14 \x03(\v2*.kubeadapt.agent.v2.ResourceRecommendationR\x0frecommendations\x127\n" +
+	"\x18total_pvc_capacity_bytes\x18\x15 \x01(\x03R\x15totalPvcCapacityBytes\x12N\n" +
+	"\x0fcontainer_specs\x18\x16 \x03(\v2%.kubeadapt.agent.v2.ContainerSpecInfoR\x0econtainerSpecs\x12L\n" +
+	"\bselector\x18\x17 \x03(\v20.kubeadapt.agent.v2.DeploymentInfo.SelectorEntryR\bselector\x12F\n" +
+	"\x06labels\x18\x18 \x03(\v2..kubeadapt.agent.v2.DeploymentInfo.LabelsEntryR\x06labels\x12U\n" +
+	"\vannotations\x18\x19 \x03(\v23.kubeadapt.agent.v2.DeploymentInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x1a \x01(\x03R\x11creationTimestamp\x12I\n" +
+	"\n" +
+	"conditions\x18\x1b \x03(\v2).kubeadapt.agent.v2.WorkloadConditionInfoR\n" +
+	"conditions\x12\x16\n" +
+	"\x06paused\x18\x1c \x01(\bR\x06paused\x12Y\n" +
+	"\revent_reasons\x18\x1d \x03(\v24.kubeadapt.agent.v2.DeploymentInfo.EventReasonsEntryR\feventReasons\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x0e\n" +
+	"\f_hourly_cost\"\x9b\x0e\n" +
+	"\x0fStatefulSetInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\breplicas\x18\x04 \x01(\x05R\breplicas\x12%\n" +
+	"\x0eready_replicas\x18\x05 \x01(\x05R\rreadyReplicas\x12-\n" +
+	"\x12available_replicas\x18\x06 \x01(\x05R\x11availableReplicas\x12)\n" +
+	"\x10updated_replicas\x18\a \x01(\x05R\x0fupdatedReplicas\x12\x1a\n" +
+	"\bstrategy\x18\b \x01(\tR\bstrategy\x12!\n" +
+	"\fservice_name\x18\t \x01(\tR\vserviceName\x122\n" +
+	"\x15pod_management_policy\x18\n" +
+	" \x01(\tR\x13podManagementPolicy\x124\n" +
+	"\x16volume_claim_templates\x18\v \x03(\tR\x14volumeClaimTemplates\x12*\n" +
+	"\x11total_cpu_request\x18\f \x01(\x01R\x0ftotalCpuRequest\x120\n" +
+	"\x14total_memory_request\x18\r \x01(\x03R\x12totalMemoryRequest\x12&\n" +
+	"\x0ftotal_cpu_limit\x18\x0e \x01(\x01R\rtotalCpuLimit\x12,\n" +
+	"\x12total_memory_limit\x18\x0f \x01(\x03R\x10totalMemoryLimit\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x10 \x01(\x01H\x00R\rtotalCpuUsage\x88\x01\x01\x121\n" +
+	"\x12total_memory_usage\x18\x11 \x01(\x03H\x01R\x10totalMemoryUsage\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x12 \x01(\x01H\x02R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x13 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12T\n" +
+	"\x0frecommendations\x18\x14 \x03(\v2*.kubeadapt.agent.v2.ResourceRecommendationR\x0frecommendations\x127\n" +
+	"\x18total_pvc_capacity_bytes\x18\x15 \x01(\x03R\x15totalPvcCapacityBytes\x12N\n" +
+	"\x0fcontainer_specs\x18\x16 \x03(\v2%.kubeadapt.agent.v2.ContainerSpecInfoR\x0econtainerSpecs\x12M\n" +
+	"\bselector\x18\x17 \x03(\v21.kubeadapt.agent.v2.StatefulSetInfo.SelectorEntryR\bselector\x12G\n" +
+	"\x06labels\x18\x18 \x03(\v2/.kubeadapt.agent.v2.StatefulSetInfo.LabelsEntryR\x06labels\x12V\n" +
+	"\vannotations\x18\x19 \x03(\v24.kubeadapt.agent.v2.StatefulSetInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x1a \x01(\x03R\x11creationTimestamp\x12!\n" +
+	"\tpartition\x18\x1b \x01(\x05H\x03R\tpartition\x88\x01\x01\x12I\n" +
+	"\n" +
+	"conditions\x18\x1c \x03(\v2).kubeadapt.agent.v2.WorkloadConditionInfoR\n" +
+	"conditions\x12Z\n" +
+	"\revent_reasons\x18\x1d \x03(\v25.kubeadapt.agent.v2.StatefulSetInfo.EventReasonsEntryR\feventReasons\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x0e\n" +
+	"\f_hourly_costB\f\n" +
+	"\n" +
+	"_partition\"\xd9\r\n" +
+	"\rDaemonSetInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x128\n" +
+	"\x18desired_number_scheduled\x18\x04 \x01(\x05R\x16desiredNumberScheduled\x128\n" +
+	"\x18current_number_scheduled\x18\x05 \x01(\x05R\x16currentNumberScheduled\x12!\n" +
+	"\fnumber_ready\x18\x06 \x01(\x05R\vnumberReady\x12/\n" +
+	"\x13number_misscheduled\x18\a \x01(\x05R\x12numberMisscheduled\x128\n" +
+	"\x18updated_number_scheduled\x18\b \x01(\x05R\x16updatedNumberScheduled\x12-\n" +
+	"\x12number_unavailable\x18\t \x01(\x05R\x11numberUnavailable\x12)\n" +
+	"\x10number_available\x18\n" +
+	" \x01(\x05R\x0fnumberAvailable\x12\x1a\n" +
+	"\bstrategy\x18\v \x01(\tR\bstrategy\x12*\n" +
+	"\x11total_cpu_request\x18\f \x01(\x01R\x0ftotalCpuRequest\x120\n" +
+	"\x14total_memory_request\x18\r \x01(\x03R\x12totalMemoryRequest\x12&\n" +
+	"\x0ftotal_cpu_limit\x18\x0e \x01(\x01R\rtotalCpuLimit\x12,\n" +
+	"\x12total_memory_limit\x18\x0f \x01(\x03R\x10totalMemoryLimit\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x10 \x01(\x01H\x00R\rtotalCpuUsage\x88\x01\x01\x121\n" +
+	"\x12total_memory_usage\x18\x11 \x01(\x03H\x01R\x10totalMemoryUsage\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x12 \x01(\x01H\x02R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x13 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12T\n" +
+	"\x0frecommendations\x18\x14 \x03(\v2*.kubeadapt.agent.v2.ResourceRecommendationR\x0frecommendations\x12N\n" +
+	"\x0fcontainer_specs\x18\x15 \x03(\v2%.kubeadapt.agent.v2.ContainerSpecInfoR\x0econtainerSpecs\x12K\n" +
+	"\bselector\x18\x16 \x03(\v2/.kubeadapt.agent.v2.DaemonSetInfo.SelectorEntryR\bselector\x12E\n" +
+	"\x06labels\x18\x17 \x03(\v2-.kubeadapt.agent.v2.DaemonSetInfo.LabelsEntryR\x06labels\x12T\n" +
+	"\vannotations\x18\x18 \x03(\v22.kubeadapt.agent.v2.DaemonSetInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x19 \x01(\x03R\x11creationTimestamp\x12I\n" +
+	"\n" +
+	"conditions\x18\x1a \x03(\v2).kubeadapt.agent.v2.WorkloadConditionInfoR\n" +
+	"conditions\x12X\n" +
+	"\revent_reasons\x18\x1b \x03(\v23.kubeadapt.agent.v2.DaemonSetInfo.EventReasonsEntryR\feventReasons\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x0e\n" +
+	"\f_hourly_cost\"\x9f\r\n" +
+	"\aJobInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12#\n" +
+	"\rowner_cronjob\x18\x04 \x01(\tR\fownerCronjob\x12%\n" +
+	"\vcompletions\x18\x05 \x01(\x05H\x00R\vcompletions\x88\x01\x01\x12%\n" +
+	"\vparallelism\x18\x06 \x01(\x05H\x01R\vparallelism\x88\x01\x01\x12(\n" +
+	"\rbackoff_limit\x18\a \x01(\x05H\x02R\fbackoffLimit\x88\x01\x01\x12;\n" +
+	"\x17active_deadline_seconds\x18\b \x01(\x03H\x03R\x15activeDeadlineSeconds\x88\x01\x01\x12@\n" +
+	"\x1attl_seconds_after_finished\x18\t \x01(\x05H\x04R\x17ttlSecondsAfterFinished\x88\x01\x01\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\x05R\x06active\x12\x1c\n" +
+	"\tsucceeded\x18\v \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\f \x01(\x05R\x06failed\x12\"\n" +
+	"\n" +
+	"start_time\x18\r \x01(\x03H\x05R\tstartTime\x88\x01\x01\x12,\n" +
+	"\x0fcompletion_time\x18\x0e \x01(\x03H\x06R\x0ecompletionTime\x88\x01\x01\x12.\n" +
+	"\x10duration_seconds\x18\x0f \x01(\x01H\aR\x0fdurationSeconds\x88\x01\x01\x12*\n" +
+	"\x11total_cpu_request\x18\x10 \x01(\x01R\x0ftotalCpuRequest\x120\n" +
+	"\x14total_memory_request\x18\x11 \x01(\x03R\x12totalMemoryRequest\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x12 \x01(\x01H\bR\rtotalCpuUsage\x88\x01\x01\x121\n" +
+	"\x12total_memory_usage\x18\x13 \x01(\x03H\tR\x10totalMemoryUsage\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x14 \x01(\x01H\n" +
+	"R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x15 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12N\n" +
+	"\x0fcontainer_specs\x18\x16 \x03(\v2%.kubeadapt.agent.v2.ContainerSpecInfoR\x0econtainerSpecs\x12?\n" +
+	"\x06labels\x18\x17 \x03(\v2'.kubeadapt.agent.v2.JobInfo.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\x18 \x03(\v2,.kubeadapt.agent.v2.JobInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x19 \x01(\x03R\x11creationTimestamp\x12D\n" +
+	"\n" +
+	"conditions\x18\x1a \x03(\v2$.kubeadapt.agent.v2.JobConditionInfoR\n" +
+	"conditions\x12R\n" +
+	"\revent_reasons\x18\x1b \x03(\v2-.kubeadapt.agent.v2.JobInfo.EventReasonsEntryR\feventReasons\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x0e\n" +
+	"\f_completionsB\x0e\n" +
+	"\f_parallelismB\x10\n" +
+	"\x0e_backoff_limitB\x1a\n" +
+	"\x18_active_deadline_secondsB\x1d\n" +
+	"\x1b_ttl_seconds_after_finishedB\r\n" +
+	"\v_start_timeB\x12\n" +
+	"\x10_completion_timeB\x13\n" +
+	"\x11_duration_secondsB\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x0e\n" +
+	"\f_hourly_cost\"\x8f\b\n" +
+	"\vCronJobInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\bschedule\x18\x04 \x01(\tR\bschedule\x12\x18\n" +
+	"\asuspend\x18\x05 \x01(\bR\asuspend\x12-\n" +
+	"\x12concurrency_policy\x18\x06 \x01(\tR\x11concurrencyPolicy\x121\n" +
+	"\x12last_schedule_time\x18\a \x01(\x03H\x00R\x10lastScheduleTime\x88\x01\x01\x125\n" +
+	"\x14last_successful_time\x18\b \x01(\x03H\x01R\x12lastSuccessfulTime\x88\x01\x01\x12\x1f\n" +
+	"\vactive_jobs\x18\t \x03(\tR\n" +
+	"activeJobs\x12$\n" +
+	"\vhourly_cost\x18\n" +
+	" \x01(\x01H\x02R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\v \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12N\n" +
+	"\x0fcontainer_specs\x18\f \x03(\v2%.kubeadapt.agent.v2.ContainerSpecInfoR\x0econtainerSpecs\x12C\n" +
+	"\x06labels\x18\r \x03(\v2+.kubeadapt.agent.v2.CronJobInfo.LabelsEntryR\x06labels\x12R\n" +
+	"\vannotations\x18\x0e \x03(\v20.kubeadapt.agent.v2.CronJobInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x0f \x01(\x03R\x11creationTimestamp\x12V\n" +
+	"\revent_reasons\x18\x10 \x03(\v21.kubeadapt.agent.v2.CronJobInfo.EventReasonsEntryR\feventReasons\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x15\n" +
+	"\x13_last_schedule_timeB\x17\n" +
+	"\x15_last_successful_timeB\x0e\n" +
+	"\f_hourly_cost\"\x9b\n" +
+	"\n" +
+	"\x12CustomWorkloadInfo\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x04 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\breplicas\x18\x06 \x01(\x05H\x00R\breplicas\x88\x01\x01\x12*\n" +
+	"\x0eready_replicas\x18\a \x01(\x05H\x01R\rreadyReplicas\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"owner_kind\x18\b \x01(\tR\townerKind\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\t \x01(\tR\townerName\x12\x1b\n" +
+	"\towner_uid\x18\n" +
+	" \x01(\tR\bownerUid\x12*\n" +
+	"\x11owner_api_version\x18\v \x01(\tR\x0fownerApiVersion\x12/\n" +
+	"\x06status\x18\f \x01(\v2\x17.google.protobuf.StructR\x06status\x12\x1b\n" +
+	"\tpod_count\x18\r \x01(\x03R\bpodCount\x12*\n" +
+	"\x11total_cpu_request\x18\x0e \x01(\x01R\x0ftotalCpuRequest\x120\n" +
+	"\x14total_memory_request\x18\x0f \x01(\x03R\x12totalMemoryRequest\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x10 \x01(\x01H\x02R\rtotalCpuUsage\x88\x01\x01\x121\n" +
+	"\x12total_memory_usage\x18\x11 \x01(\x03H\x03R\x10totalMemoryUsage\x88\x01\x01\x12$\n" +
+	"\vhourly_cost\x18\x12 \x01(\x01H\x04R\n" +
+	"hourlyCost\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x13 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12J\n" +
+	"\x06labels\x18\x14 \x03(\v22.kubeadapt.agent.v2.CustomWorkloadInfo.LabelsEntryR\x06labels\x12Y\n" +
+	"\vannotations\x18\x15 \x03(\v27.kubeadapt.agent.v2.CustomWorkloadInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x16 \x01(\x03R\x11creationTimestamp\x12]\n" +
+	"\revent_reasons\x18\x17 \x03(\v28.kubeadapt.agent.v2.CustomWorkloadInfo.EventReasonsEntryR\feventReasons\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventReasonsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\v\n" +
+	"\t_replicasB\x11\n" +
+	"\x0f_ready_replicasB\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x0e\n" +
+	"\f_hourly_cost\"\xed\b\n" +
+	"\aHPAInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vtarget_kind\x18\x04 \x01(\tR\n" +
+	"targetKind\x12\x1f\n" +
+	"\vtarget_name\x18\x05 \x01(\tR\n" +
+	"targetName\x12,\n" +
+	"\x12target_api_version\x18\x06 \x01(\tR\x10targetApiVersion\x12&\n" +
+	"\fmin_replicas\x18\a \x01(\x05H\x00R\vminReplicas\x88\x01\x01\x12!\n" +
+	"\fmax_replicas\x18\b \x01(\x05R\vmaxReplicas\x12)\n" +
+	"\x10current_replicas\x18\t \x01(\x05R\x0fcurrentReplicas\x12)\n" +
+	"\x10desired_replicas\x18\n" +
+	" \x01(\x05R\x0fdesiredReplicas\x12;\n" +
+	"\ametrics\x18\v \x03(\v2!.kubeadapt.agent.v2.HPAMetricInfoR\ametrics\x12Q\n" +
+	"\x0fcurrent_metrics\x18\f \x03(\v2(.kubeadapt.agent.v2.HPACurrentMetricInfoR\x0ecurrentMetrics\x12R\n" +
+	"\x11scale_up_behavior\x18\r \x01(\v2&.kubeadapt.agent.v2.HPAScalingBehaviorR\x0fscaleUpBehavior\x12V\n" +
+	"\x13scale_down_behavior\x18\x0e \x01(\v2&.kubeadapt.agent.v2.HPAScalingBehaviorR\x11scaleDownBehavior\x12D\n" +
+	"\n" +
+	"conditions\x18\x0f \x03(\v2$.kubeadapt.agent.v2.HPAConditionInfoR\n" +
+	"conditions\x12?\n" +
+	"\x06labels\x18\x10 \x03(\v2'.kubeadapt.agent.v2.HPAInfo.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\x11 \x03(\v2,.kubeadapt.agent.v2.HPAInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x12 \x01(\x03R\x11creationTimestamp\x12+\n" +
+	"\x0flast_scale_time\x18\x13 \x01(\x03H\x01R\rlastScaleTime\x88\x01\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_min_replicasB\x12\n" +
+	"\x10_last_scale_time\"\xaa\x04\n" +
+	"\aVPAInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vtarget_kind\x18\x03 \x01(\tR\n" +
+	"targetKind\x12\x1f\n" +
+	"\vtarget_name\x18\x04 \x01(\tR\n" +
+	"targetName\x12,\n" +
+	"\x12target_api_version\x18\x05 \x01(\tR\x10targetApiVersion\x12\x1f\n" +
+	"\vupdate_mode\x18\x06 \x01(\tR\n" +
+	"updateMode\x12k\n" +
+	"\x19container_recommendations\x18\a \x03(\v2..kubeadapt.agent.v2.VPAContainerRecommendationR\x18containerRecommendations\x12D\n" +
+	"\n" +
+	"conditions\x18\b \x03(\v2$.kubeadapt.agent.v2.VPAConditionInfoR\n" +
+	"conditions\x12?\n" +
+	"\x06labels\x18\t \x03(\v2'.kubeadapt.agent.v2.VPAInfo.LabelsEntryR\x06labels\x12-\n" +
+	"\x12creation_timestamp\x18\n" +
+	" \x01(\x03R\x11creationTimestamp\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x82\b\n" +
+	"\aPDBInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12O\n" +
+	"\fmatch_labels\x18\x04 \x03(\v2,.kubeadapt.agent.v2.PDBInfo.MatchLabelsEntryR\vmatchLabels\x12Y\n" +
+	"\x11match_expressions\x18\x05 \x03(\v2,.kubeadapt.agent.v2.LabelSelectorRequirementR\x10matchExpressions\x12P\n" +
+	"\x10target_workloads\x18\x06 \x03(\v2%.kubeadapt.agent.v2.WorkloadReferenceR\x0ftargetWorkloads\x12#\n" +
+	"\rmin_available\x18\a \x01(\tR\fminAvailable\x12'\n" +
+	"\x0fmax_unavailable\x18\b \x01(\tR\x0emaxUnavailable\x12'\n" +
+	"\x0fcurrent_healthy\x18\t \x01(\x05R\x0ecurrentHealthy\x12'\n" +
+	"\x0fdesired_healthy\x18\n" +
+	" \x01(\x05R\x0edesiredHealthy\x12/\n" +
+	"\x13disruptions_allowed\x18\v \x01(\x05R\x12disruptionsAllowed\x12#\n" +
+	"\rexpected_pods\x18\f \x01(\x05R\fexpectedPods\x12D\n" +
+	"\n" +
+	"conditions\x18\r \x03(\v2$.kubeadapt.agent.v2.PDBConditionInfoR\n" +
+	"conditions\x12?\n" +
+	"\x06labels\x18\x0e \x03(\v2'.kubeadapt.agent.v2.PDBInfo.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\x0f \x03(\v2,.kubeadapt.agent.v2.PDBInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x10 \x01(\x03R\x11creationTimestamp\x1a>\n" +
+	"\x10MatchLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\a\n" +
+	"\vServiceInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"cluster_ip\x18\x04 \x01(\tR\tclusterIp\x12\x1f\n" +
+	"\vcluster_ips\x18\x05 \x03(\tR\n" +
+	"clusterIps\x12!\n" +
+	"\fexternal_ips\x18\x06 \x03(\tR\vexternalIps\x12I\n" +
+	"\rload_balancer\x18\a \x01(\v2$.kubeadapt.agent.v2.LoadBalancerInfoR\floadBalancer\x129\n" +
+	"\x05ports\x18\b \x03(\v2#.kubeadapt.agent.v2.ServicePortInfoR\x05ports\x12I\n" +
+	"\bselector\x18\t \x03(\v2-.kubeadapt.agent.v2.ServiceInfo.SelectorEntryR\bselector\x12P\n" +
+	"\x10target_workloads\x18\n" +
+	" \x03(\v2%.kubeadapt.agent.v2.WorkloadReferenceR\x0ftargetWorkloads\x12C\n" +
+	"\x06labels\x18\v \x03(\v2+.kubeadapt.agent.v2.ServiceInfo.LabelsEntryR\x06labels\x12R\n" +
+	"\vannotations\x18\f \x03(\v20.kubeadapt.agent.v2.ServiceInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\r \x01(\x03R\x11creationTimestamp\x12)\n" +
+	"\x10session_affinity\x18\x0e \x01(\tR\x0fsessionAffinity\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x05\n" +
+	"\vIngressInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
+	"\x12ingress_class_name\x18\x03 \x01(\tR\x10ingressClassName\x129\n" +
+	"\x05rules\x18\x04 \x03(\v2#.kubeadapt.agent.v2.IngressRuleInfoR\x05rules\x124\n" +
+	"\x03tls\x18\x05 \x03(\v2\".kubeadapt.agent.v2.IngressTLSInfoR\x03tls\x12O\n" +
+	"\x0fdefault_backend\x18\x06 \x01(\v2&.kubeadapt.agent.v2.IngressBackendInfoR\x0edefaultBackend\x12C\n" +
+	"\x06labels\x18\a \x03(\v2+.kubeadapt.agent.v2.IngressInfo.LabelsEntryR\x06labels\x12R\n" +
+	"\vannotations\x18\b \x03(\v20.kubeadapt.agent.v2.IngressInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\t \x01(\x03R\x11creationTimestamp\x126\n" +
+	"\x17load_balancer_hostnames\x18\n" +
+	" \x03(\tR\x15loadBalancerHostnames\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc0\x05\n" +
+	"\x06PVInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x03R\bcapacity\x12!\n" +
+	"\faccess_modes\x18\x03 \x03(\tR\vaccessModes\x12%\n" +
+	"\x0ereclaim_policy\x18\x04 \x01(\tR\rreclaimPolicy\x12,\n" +
+	"\x12storage_class_name\x18\x05 \x01(\tR\x10storageClassName\x12\x1f\n" +
+	"\vvolume_mode\x18\x06 \x01(\tR\n" +
+	"volumeMode\x12\x14\n" +
+	"\x05phase\x18\a \x01(\tR\x05phase\x12#\n" +
+	"\rmount_options\x18\b \x03(\tR\fmountOptions\x128\n" +
+	"\x06source\x18\t \x01(\v2 .kubeadapt.agent.v2.PVSourceInfoR\x06source\x12?\n" +
+	"\tclaim_ref\x18\n" +
+	" \x01(\v2\".kubeadapt.agent.v2.PVClaimRefInfoR\bclaimRef\x12>\n" +
+	"\x06labels\x18\v \x03(\v2&.kubeadapt.agent.v2.PVInfo.LabelsEntryR\x06labels\x12M\n" +
+	"\vannotations\x18\f \x03(\v2+.kubeadapt.agent.v2.PVInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\r \x01(\x03R\x11creationTimestamp\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd2\x06\n" +
+	"\aPVCInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05phase\x18\x03 \x01(\tR\x05phase\x12!\n" +
+	"\faccess_modes\x18\x04 \x03(\tR\vaccessModes\x12,\n" +
+	"\x12storage_class_name\x18\x05 \x01(\tR\x10storageClassName\x12\x1f\n" +
+	"\vvolume_mode\x18\x06 \x01(\tR\n" +
+	"volumeMode\x12\x1f\n" +
+	"\vvolume_name\x18\a \x01(\tR\n" +
+	"volumeName\x12'\n" +
+	"\x0frequested_bytes\x18\b \x01(\x03R\x0erequestedBytes\x12%\n" +
+	"\x0ecapacity_bytes\x18\t \x01(\x03R\rcapacityBytes\x12\"\n" +
+	"\n" +
+	"used_bytes\x18\n" +
+	" \x01(\x03H\x00R\tusedBytes\x88\x01\x01\x12,\n" +
+	"\x0favailable_bytes\x18\v \x01(\x03H\x01R\x0eavailableBytes\x88\x01\x01\x12&\n" +
+	"\x0fmounted_by_pods\x18\f \x03(\tR\rmountedByPods\x12?\n" +
+	"\x06labels\x18\r \x03(\v2'.kubeadapt.agent.v2.PVCInfo.LabelsEntryR\x06labels\x12N\n" +
+	"\vannotations\x18\x0e \x03(\v2,.kubeadapt.agent.v2.PVCInfo.AnnotationsEntryR\vannotations\x12-\n" +
+	"\x12creation_timestamp\x18\x0f \x01(\x03R\x11creationTimestamp\x12D\n" +
+	"\n" +
+	"conditions\x18\x10 \x03(\v2$.kubeadapt.agent.v2.PVCConditionInfoR\n" +
+	"conditions\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_used_bytesB\x12\n" +
+	"\x10_available_bytes\"\xcc\x05\n" +
+	"\x10StorageClassInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vprovisioner\x18\x02 \x01(\tR\vprovisioner\x12%\n" +
+	"\x0ereclaim_policy\x18\x03 \x01(\tR\rreclaimPolicy\x12.\n" +
+	"\x13volume_binding_mode\x18\x04 \x01(\tR\x11volumeBindingMode\x124\n" +
+	"\x16allow_volume_expansion\x18\x05 \x01(\bR\x14allowVolumeExpansion\x12T\n" +
+	"\n" +
+	"parameters\x18\x06 \x03(\v24.kubeadapt.agent.v2.StorageClassInfo.ParametersEntryR\n" +
+	"parameters\x12#\n" +
+	"\rmount_options\x18\a \x03(\tR\fmountOptions\x12H\n" +
+	"\x06labels\x18\b \x03(\v20.kubeadapt.agent.v2.StorageClassInfo.LabelsEntryR\x06labels\x12W\n" +
+	"\vannotations\x18\t \x03(\v25.kubeadapt.agent.v2.StorageClassInfo.AnnotationsEntryR\vannotations\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\n" +
+	" \x01(\bR\tisDefault\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x01\n" +
+	"\x11PriorityClassInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\x12%\n" +
+	"\x0eglobal_default\x18\x03 \x01(\bR\rglobalDefault\x12+\n" +
+	"\x11preemption_policy\x18\x04 \x01(\tR\x10preemptionPolicy\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x82\x01\n" +
+	"\x0eLimitRangeInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12>\n" +
+	"\x06limits\x18\x03 \x03(\v2&.kubeadapt.agent.v2.LimitRangeItemInfoR\x06limits\"\xc7\x03\n" +
+	"\x11ResourceQuotaInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12C\n" +
+	"\x04hard\x18\x03 \x03(\v2/.kubeadapt.agent.v2.ResourceQuotaInfo.HardEntryR\x04hard\x12C\n" +
+	"\x04used\x18\x04 \x03(\v2/.kubeadapt.agent.v2.ResourceQuotaInfo.UsedEntryR\x04used\x12I\n" +
+	"\x06labels\x18\x05 \x03(\v21.kubeadapt.agent.v2.ResourceQuotaInfo.LabelsEntryR\x06labels\x1a7\n" +
+	"\tHardEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tUsedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x05\n" +
+	"\fNodePoolInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12&\n" +
+	"\fmin_replicas\x18\x03 \x01(\x03H\x00R\vminReplicas\x88\x01\x01\x12&\n" +
+	"\fmax_replicas\x18\x04 \x01(\x03H\x01R\vmaxReplicas\x88\x01\x01\x12&\n" +
+	"\x0fnode_class_name\x18\x05 \x01(\tR\rnodeClassName\x12D\n" +
+	"\x06labels\x18\x06 \x03(\v2,.kubeadapt.agent.v2.NodePoolInfo.LabelsEntryR\x06labels\x12S\n" +
+	"\vannotations\x18\a \x03(\v21.kubeadapt.agent.v2.NodePoolInfo.AnnotationsEntryR\vannotations\x125\n" +
+	"\x06taints\x18\b \x03(\v2\x1d.kubeadapt.agent.v2.TaintInfoR\x06taints\x12O\n" +
+	"\frequirements\x18\t \x03(\v2+.kubeadapt.agent.v2.NodeSelectorRequirementR\frequirements\x12-\n" +
+	"\x12creation_timestamp\x18\n" +
+	" \x01(\x03R\x11creationTimestamp\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_min_replicasB\x0f\n" +
+	"\r_max_replicas\"\x80\x03\n" +
+	"\tEventInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x121\n" +
+	"\x14reporting_controller\x18\x04 \x01(\tR\x13reportingController\x12#\n" +
+	"\rinvolved_kind\x18\x05 \x01(\tR\finvolvedKind\x12-\n" +
+	"\x12involved_namespace\x18\x06 \x01(\tR\x11involvedNamespace\x12#\n" +
+	"\rinvolved_name\x18\a \x01(\tR\finvolvedName\x12!\n" +
+	"\finvolved_uid\x18\b \x01(\tR\vinvolvedUid\x12\x14\n" +
+	"\x05count\x18\t \x01(\x05R\x05count\x12'\n" +
+	"\x0ffirst_timestamp\x18\n" +
+	" \x01(\x03R\x0efirstTimestamp\x12%\n" +
+	"\x0elast_timestamp\x18\v \x01(\x03R\rlastTimestamp\"\x96\x10\n" +
+	"\x0eClusterSummary\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x01 \x01(\x03R\tnodeCount\x12\x1b\n" +
+	"\tpod_count\x18\x02 \x01(\x03R\bpodCount\x12*\n" +
+	"\x11running_pod_count\x18\x03 \x01(\x03R\x0frunningPodCount\x12*\n" +
+	"\x11pending_pod_count\x18\x04 \x01(\x03R\x0fpendingPodCount\x12(\n" +
+	"\x10failed_pod_count\x18\x05 \x01(\x03R\x0efailedPodCount\x12.\n" +
+	"\x13succeeded_pod_count\x18\x06 \x01(\x03R\x11succeededPodCount\x12'\n" +
+	"\x0fnamespace_count\x18\a \x01(\x03R\x0enamespaceCount\x12)\n" +
+	"\x10deployment_count\x18\b \x01(\x03R\x0fdeploymentCount\x12+\n" +
+	"\x11statefulset_count\x18\t \x01(\x03R\x10statefulsetCount\x12'\n" +
+	"\x0fdaemonset_count\x18\n" +
+	" \x01(\x03R\x0edaemonsetCount\x12\x1b\n" +
+	"\tjob_count\x18\v \x01(\x03R\bjobCount\x12#\n" +
+	"\rcronjob_count\x18\f \x01(\x03R\fcronjobCount\x122\n" +
+	"\x15custom_workload_count\x18\r \x01(\x03R\x13customWorkloadCount\x12\x1b\n" +
+	"\thpa_count\x18\x0e \x01(\x03R\bhpaCount\x12#\n" +
+	"\rservice_count\x18\x0f \x01(\x03R\fserviceCount\x12#\n" +
+	"\ringress_count\x18\x10 \x01(\x03R\fingressCount\x12\x19\n" +
+	"\bpv_count\x18\x11 \x01(\x03R\apvCount\x12\x1b\n" +
+	"\tpvc_count\x18\x12 \x01(\x03R\bpvcCount\x12'\n" +
+	"\x0fcontainer_count\x18\x13 \x01(\x03R\x0econtainerCount\x12,\n" +
+	"\x12total_cpu_capacity\x18\x14 \x01(\x01R\x10totalCpuCapacity\x122\n" +
+	"\x15total_cpu_allocatable\x18\x15 \x01(\x01R\x13totalCpuAllocatable\x12.\n" +
+	"\x13total_cpu_requested\x18\x16 \x01(\x01R\x11totalCpuRequested\x12+\n" +
+	"\x0ftotal_cpu_usage\x18\x17 \x01(\x01H\x00R\rtotalCpuUsage\x88\x01\x01\x122\n" +
+	"\x15total_memory_capacity\x18\x18 \x01(\x03R\x13totalMemoryCapacity\x128\n" +
+	"\x18total_memory_allocatable\x18\x19 \x01(\x03R\x16totalMemoryAllocatable\x124\n" +
+	"\x16total_memory_requested\x18\x1a \x01(\x03R\x14totalMemoryRequested\x121\n" +
+	"\x12total_memory_usage\x18\x1b \x01(\x03H\x01R\x10totalMemoryUsage\x88\x01\x01\x12,\n" +
+	"\x12total_gpu_capacity\x18\x1c \x01(\x03R\x10totalGpuCapacity\x12.\n" +
+	"\x13total_gpu_requested\x18\x1d \x01(\x03R\x11totalGpuRequested\x12+\n" +
+	"\x0ftotal_gpu_usage\x18\x1e \x01(\x01H\x02R\rtotalGpuUsage\x88\x01\x01\x12:\n" +
+	"\x17total_gpu_tensor_active\x18\x1f \x01(\x01H\x03R\x14totalGpuTensorActive\x88\x01\x01\x126\n" +
+	"\x15total_gpu_memory_util\x18  \x01(\x01H\x04R\x12totalGpuMemoryUtil\x88\x01\x01\x126\n" +
+	"\x15total_gpu_memory_used\x18! \x01(\x03H\x05R\x12totalGpuMemoryUsed\x88\x01\x01\x128\n" +
+	"\x16total_gpu_memory_total\x18\" \x01(\x03H\x06R\x13totalGpuMemoryTotal\x88\x01\x01\x122\n" +
+	"\x15gpu_metrics_available\x18# \x01(\bR\x13gpuMetricsAvailable\x124\n" +
+	"\x16total_storage_capacity\x18$ \x01(\x03R\x14totalStorageCapacity\x126\n" +
+	"\x17total_storage_requested\x18% \x01(\x03R\x15totalStorageRequested\x12+\n" +
+	"\x11metrics_available\x18& \x01(\bR\x10metricsAvailable\x12/\n" +
+	"\x11total_hourly_cost\x18' \x01(\x01H\aR\x0ftotalHourlyCost\x88\x01\x01\x12-\n" +
+	"\x10idle_hourly_cost\x18( \x01(\x01H\bR\x0eidleHourlyCost\x88\x01\x01\x12#\n" +
+	"\rcost_currency\x18) \x01(\tR\fcostCurrencyB\x12\n" +
+	"\x10_total_cpu_usageB\x15\n" +
+	"\x13_total_memory_usageB\x12\n" +
+	"\x10_total_gpu_usageB\x1a\n" +
+	"\x18_total_gpu_tensor_activeB\x18\n" +
+	"\x16_total_gpu_memory_utilB\x18\n" +
+	"\x16_total_gpu_memory_usedB\x19\n" +
+	"\x17_total_gpu_memory_totalB\x14\n" +
+	"\x12_total_hourly_costB\x13\n" +
+	"\x11_idle_hourly_cost\"\x97\x11\n" +
+	"\vAgentHealth\x120\n" +
+	"\x14snapshots_sent_total\x18\x01 \x01(\x04R\x12snapshotsSentTotal\x124\n" +
+	"\x16snapshots_failed_total\x18\x02 \x01(\x04R\x14snapshotsFailedTotal\x12'\n" +
+	"\x0fsnapshots_total\x18\x03 \x01(\x04R\x0esnapshotsTotal\x120\n" +
+	"\x14snapshots_sent_delta\x18\x04 \x01(\x04R\x12snapshotsSentDelta\x124\n" +
+	"\x16snapshots_failed_delta\x18\x05 \x01(\x04R\x14snapshotsFailedDelta\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12!\n" +
+	"\fstate_reason\x18\a \x01(\tR\vstateReason\x126\n" +
+	"\x17leader_election_enabled\x18\b \x01(\bR\x15leaderElectionEnabled\x12\x1b\n" +
+	"\tis_leader\x18\t \x01(\bR\bisLeader\x12'\n" +
+	"\x0fleader_identity\x18\n" +
+	" \x01(\tR\x0eleaderIdentity\x125\n" +
+	"\x16leadership_transitions\x18\v \x01(\x04R\x15leadershipTransitions\x123\n" +
+	"\x16last_build_duration_ms\x18\f \x01(\x03R\x13lastBuildDurationMs\x12F\n" +
+	" last_metrics_collect_duration_ms\x18\r \x01(\x03R\x1clastMetricsCollectDurationMs\x121\n" +
+	"\x15last_send_duration_ms\x18\x0e \x01(\x03R\x12lastSendDurationMs\x12,\n" +
+	"\x12encode_duration_ms\x18\x0f \x01(\x03R\x10encodeDurationMs\x12.\n" +
+	"\x13original_size_bytes\x18\x10 \x01(\x03R\x11originalSizeBytes\x122\n" +
+	"\x15compressed_size_bytes\x18\x11 \x01(\x03R\x13compressedSizeBytes\x12-\n" +
+	"\x12compression_factor\x18\x12 \x01(\x01R\x11compressionFactor\x12-\n" +
+	"\x12buffered_snapshots\x18\x13 \x01(\x03R\x11bufferedSnapshots\x12%\n" +
+	"\x0ebuffered_bytes\x18\x14 \x01(\x03R\rbufferedBytes\x12\x1d\n" +
+	"\n" +
+	"node_count\x18\x15 \x01(\x03R\tnodeCount\x12\x1b\n" +
+	"\tpod_count\x18\x16 \x01(\x03R\bpodCount\x12'\n" +
+	"\x0fcontainer_count\x18\x17 \x01(\x03R\x0econtainerCount\x12%\n" +
+	"\x0eworkload_count\x18\x18 \x01(\x03R\rworkloadCount\x12#\n" +
+	"\rservice_count\x18\x19 \x01(\x03R\fserviceCount\x12\x1b\n" +
+	"\thpa_count\x18\x1a \x01(\x03R\bhpaCount\x12\x1b\n" +
+	"\tpdb_count\x18\x1b \x01(\x03R\bpdbCount\x12\x19\n" +
+	"\bpv_count\x18\x1c \x01(\x03R\apvCount\x128\n" +
+	"\x18metrics_server_available\x18\x1d \x01(\bR\x16metricsServerAvailable\x12#\n" +
+	"\rvpa_available\x18\x1e \x01(\bR\fvpaAvailable\x12/\n" +
+	"\x13karpenter_available\x18\x1f \x01(\bR\x12karpenterAvailable\x122\n" +
+	"\x15gpu_metrics_available\x18  \x01(\bR\x13gpuMetricsAvailable\x122\n" +
+	"\x15dcgm_exporter_targets\x18! \x01(\x03R\x13dcgmExporterTargets\x127\n" +
+	"\x18dcgm_exporter_up_targets\x18\" \x01(\x03R\x15dcgmExporterUpTargets\x12)\n" +
+	"\x10informers_synced\x18# \x01(\bR\x0finformersSynced\x12+\n" +
+	"\x11informers_healthy\x18$ \x01(\x03R\x10informersHealthy\x12'\n" +
+	"\x0finformers_total\x18% \x01(\x03R\x0einformersTotal\x12'\n" +
+	"\x0fstale_resources\x18& \x03(\tR\x0estaleResources\x12&\n" +
+	"\x0fapi_calls_total\x18' \x01(\x03R\rapiCallsTotal\x12(\n" +
+	"\x10api_calls_failed\x18( \x01(\x03R\x0eapiCallsFailed\x12.\n" +
+	"\x13active_errors_count\x18) \x01(\x03R\x11activeErrorsCount\x12\x1f\n" +
+	"\verror_codes\x18* \x03(\tR\n" +
+	"errorCodes\x12%\n" +
+	"\x0euptime_seconds\x18+ \x01(\x03R\ruptimeSeconds\x12\x1d\n" +
+	"\n" +
+	"started_at\x18, \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcollected_at\x18- \x01(\x03R\vcollectedAt\x12?\n" +
+	"\tredaction\x18. \x01(\v2!.kubeadapt.agent.v2.RedactionInfoR\tredaction\x12#\n" +
+	"\rchart_version\x18/ \x01(\tR\fchartVersion\x12*\n" +
+	"\x11helm_release_name\x180 \x01(\tR\x0fhelmReleaseName\x12\x19\n" +
+	"\bpod_name\x181 \x01(\tR\apodName\x12#\n" +
+	"\rpod_namespace\x182 \x01(\tR\fpodNamespace\x12\x1b\n" +
+	"\tnode_name\x183 \x01(\tR\bnodeName\"\x8b\v\n" +
+	"\rGPUDeviceInfo\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12!\n" +
+	"\fdevice_index\x18\x02 \x01(\tR\vdeviceIndex\x12\x1d\n" +
+	"\n" +
+	"model_name\x18\x03 \x01(\tR\tmodelName\x124\n" +
+	"\x13utilization_percent\x18\x04 \x01(\x01H\x00R\x12utilizationPercent\x88\x01\x01\x127\n" +
+	"\x15tensor_active_percent\x18\x05 \x01(\x01H\x01R\x13tensorActivePercent\x88\x01\x01\x123\n" +
+	"\x13memory_util_percent\x18\x06 \x01(\x01H\x02R\x11memoryUtilPercent\x88\x01\x01\x12/\n" +
+	"\x11memory_used_bytes\x18\a \x01(\x03H\x03R\x0fmemoryUsedBytes\x88\x01\x01\x121\n" +
+	"\x12memory_total_bytes\x18\b \x01(\x03H\x04R\x10memoryTotalBytes\x88\x01\x01\x124\n" +
+	"\x13temperature_celsius\x18\t \x01(\x01H\x05R\x12temperatureCelsius\x88\x01\x01\x12$\n" +
+	"\vpower_watts\x18\n" +
+	" \x01(\x01H\x06R\n" +
+	"powerWatts\x88\x01\x01\x12%\n" +
+	"\fsm_clock_mhz\x18\v \x01(\x01H\aR\n" +
+	"smClockMhz\x88\x01\x01\x12\x1f\n" +
+	"\vmig_profile\x18\f \x01(\tR\n" +
+	"migProfile\x12&\n" +
+	"\x0fmig_instance_id\x18\r \x01(\tR\rmigInstanceId\x12\x1c\n" +
+	"\tallocated\x18\x0e \x01(\bR\tallocated\x12 \n" +
+	"\txid_error\x18\x0f \x01(\x03H\bR\bxidError\x88\x01\x01\x128\n" +
+	"\x16ecc_sbe_volatile_total\x18\x10 \x01(\x03H\tR\x13eccSbeVolatileTotal\x88\x01\x01\x128\n" +
+	"\x16ecc_dbe_volatile_total\x18\x11 \x01(\x03H\n" +
+	"R\x13eccDbeVolatileTotal\x88\x01\x01\x12)\n" +
+	"\x10throttle_reasons\x18\x12 \x03(\tR\x0fthrottleReasons\x12\x16\n" +
+	"\x06health\x18\x13 \x01(\tR\x06health\x12%\n" +
+	"\x0ehealth_reasons\x18\x14 \x03(\tR\rhealthReasons\x129\n" +
+	"\x16nvlink_bandwidth_total\x18\x15 \x01(\x03H\vR\x14nvlinkBandwidthTotal\x88\x01\x01\x129\n" +
+	"\x17nvlink_tx_bytes_per_sec\x18\x16 \x01(\x01H\fR\x13nvlinkTxBytesPerSec\x88\x01\x01\x129\n" +
+	"\x17nvlink_rx_bytes_per_sec\x18\x17 \x01(\x01H\rR\x13nvlinkRxBytesPerSec\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x18 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdleB\x16\n" +
+	"\x14_utilization_percentB\x18\n" +
+	"\x16_tensor_active_percentB\x16\n" +
+	"\x14_memory_util_percentB\x14\n" +
+	"\x12_memory_used_bytesB\x15\n" +
+	"\x13_memory_total_bytesB\x16\n" +
+	"\x14_temperature_celsiusB\x0e\n" +
+	"\f_power_wattsB\x0f\n" +
+	"\r_sm_clock_mhzB\f\n" +
+	"\n" +
+	"_xid_errorB\x19\n" +
+	"\x17_ecc_sbe_volatile_totalB\x19\n" +
+	"\x17_ecc_dbe_volatile_totalB\x19\n" +
+	"\x17_nvlink_bandwidth_totalB\x1a\n" +
+	"\x18_nvlink_tx_bytes_per_secB\x1a\n" +
+	"\x18_nvlink_rx_bytes_per_sec\"\xbd\x02\n" +
+	"\vGPUIdleInfo\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04gpus\x18\x02 \x01(\x01R\x04gpus\x12;\n" +
+	"\x17max_utilization_percent\x18\x03 \x01(\x01H\x00R\x15maxUtilizationPercent\x88\x01\x01\x12>\n" +
+	"\x19max_tensor_active_percent\x18\x04 \x01(\x01H\x01R\x16maxTensorActivePercent\x88\x01\x01\x12!\n" +
+	"\fwindow_hours\x18\x05 \x01(\x01R\vwindowHours\x12(\n" +
+	"\x10wasted_gpu_hours\x18\x06 \x01(\x01R\x0ewastedGpuHoursB\x1a\n" +
+	"\x18_max_utilization_percentB\x1c\n" +
+	"\x1a_max_tensor_active_percent\"K\n" +
+	"\tTaintInfo\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\"q\n" +
+	"\x11NodeConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x9a\r\n" +
+	"\rContainerInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x19\n" +
+	"\bimage_id\x18\x03 \x01(\tR\aimageId\x12*\n" +
+	"\x11cpu_request_cores\x18\x04 \x01(\x01R\x0fcpuRequestCores\x120\n" +
+	"\x14memory_request_bytes\x18\x05 \x01(\x03R\x12memoryRequestBytes\x12&\n" +
+	"\x0fcpu_limit_cores\x18\x06 \x01(\x01R\rcpuLimitCores\x12,\n" +
+	"\x12memory_limit_bytes\x18\a \x01(\x03R\x10memoryLimitBytes\x12:\n" +
+	"\x19ephemeral_storage_request\x18\b \x01(\x03R\x17ephemeralStorageRequest\x126\n" +
+	"\x17ephemeral_storage_limit\x18\t \x01(\x03R\x15ephemeralStorageLimit\x12\x1f\n" +
+	"\vgpu_request\x18\n" +
+	" \x01(\x03R\n" +
+	"gpuRequest\x12\x1b\n" +
+	"\tgpu_limit\x18\v \x01(\x03R\bgpuLimit\x12+\n" +
+	"\x0fcpu_usage_cores\x18\f \x01(\x01H\x00R\rcpuUsageCores\x88\x01\x01\x121\n" +
+	"\x12memory_usage_bytes\x18\r \x01(\x03H\x01R\x10memoryUsageBytes\x88\x01\x01\x12I\n" +
+	"\x0fcpu_usage_stats\x18\x0e \x01(\v2!.kubeadapt.agent.v2.CPUUsageStatsR\rcpuUsageStats\x12R\n" +
+	"\x12memory_usage_stats\x18\x0f \x01(\v2$.kubeadapt.agent.v2.MemoryUsageStatsR\x10memoryUsageStats\x12;\n" +
+	"\x17gpu_utilization_percent\x18\x10 \x01(\x01H\x02R\x15gpuUtilizationPercent\x88\x01\x01\x12>\n" +
+	"\x19gpu_tensor_active_percent\x18\x11 \x01(\x01H\x03R\x16gpuTensorActivePercent\x88\x01\x01\x126\n" +
+	"\x15gpu_memory_used_bytes\x18\x12 \x01(\x03H\x04R\x12gpuMemoryUsedBytes\x88\x01\x01\x12:\n" +
+	"\bgpu_idle\x18\x13 \x01(\v2\x1f.kubeadapt.agent.v2.GPUIdleInfoR\agpuIdle\x12/\n" +
+	"\x11rootfs_used_bytes\x18\x14 \x01(\x03H\x05R\x0frootfsUsedBytes\x88\x01\x01\x12+\n" +
+	"\x0flogs_used_bytes\x18\x15 \x01(\x03H\x06R\rlogsUsedBytes\x88\x01\x01\x123\n" +
+	"\x13cpu_throttled_ratio\x18\x16 \x01(\x01H\aR\x11cpuThrottledRatio\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"oom_events\x18\x17 \x01(\x03H\bR\toomEvents\x88\x01\x01\x12\x14\n" +
+	"\x05ready\x18\x18 \x01(\bR\x05ready\x12\x1d\n" +
+	"\astarted\x18\x19 \x01(\bH\tR\astarted\x88\x01\x01\x12#\n" +
+	"\rrestart_count\x18\x1a \x01(\x05R\frestartCount\x12\x14\n" +
+	"\x05state\x18\x1b \x01(\tR\x05state\x12!\n" +
+	"\fstate_reason\x18\x1c \x01(\tR\vstateReason\x12#\n" +
+	"\rstate_message\x18\x1d \x01(\tR\fstateMessage\x126\n" +
+	"\x17last_termination_reason\x18\x1e \x01(\tR\x15lastTerminationReason\x12 \n" +
+	"\texit_code\x18\x1f \x01(\x05H\n" +
+	"R\bexitCode\x88\x01\x01\x12;\n" +
+	"\x05ports\x18  \x03(\v2%.kubeadapt.agent.v2.ContainerPortInfoR\x05portsB\x12\n" +
+	"\x10_cpu_usage_coresB\x15\n" +
+	"\x13_memory_usage_bytesB\x1a\n" +
+	"\x18_gpu_utilization_percentB\x1c\n" +
+	"\x1a_gpu_tensor_active_percentB\x18\n" +
+	"\x16_gpu_memory_used_bytesB\x14\n" +
+	"\x12_rootfs_used_bytesB\x12\n" +
+	"\x10_logs_used_bytesB\x16\n" +
+	"\x14_cpu_throttled_ratioB\r\n" +
+	"\v_oom_eventsB\n" +
+	"\n" +
+	"\b_startedB\f\n" +
+	"\n" +
+	"_exit_code\"\x88\x03\n" +
+	"\rPodVolumeInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\x12\x1d\n" +
+	"\n" +
+	"claim_name\x18\x04 \x01(\tR\tclaimName\x12%\n" +
+	"\x0econfigmap_name\x18\x05 \x01(\tR\rconfigmapName\x12\x1f\n" +
+	"\vsecret_name\x18\x06 \x01(\tR\n" +
+	"secretName\x12+\n" +
+	"\x11projected_sources\x18\a \x03(\tR\x10projectedSources\x12,\n" +
+	"\x12storage_class_name\x18\b \x01(\tR\x10storageClassName\x12'\n" +
+	"\x0frequested_bytes\x18\t \x01(\x03R\x0erequestedBytes\x12\x1d\n" +
+	"\n" +
+	"csi_driver\x18\n" +
+	" \x01(\tR\tcsiDriver\x12(\n" +
+	"\x10size_limit_bytes\x18\v \x01(\x03R\x0esizeLimitBytes\"p\n" +
+	"\x10PodConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xc2\x02\n" +
+	"\x16ResourceRecommendation\x12%\n" +
+	"\x0econtainer_name\x18\x01 \x01(\tR\rcontainerName\x12/\n" +
+	"\x11cpu_request_cores\x18\x02 \x01(\x01H\x00R\x0fcpuRequestCores\x88\x01\x01\x125\n" +
+	"\x14memory_request_bytes\x18\x03 \x01(\x03H\x01R\x12memoryRequestBytes\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\tR\n" +
+	"confidence\x12#\n" +
+	"\rhistory_hours\x18\x05 \x01(\x03R\fhistoryHours\x12%\n" +
+	"\x0ehpa_controlled\x18\x06 \x03(\tR\rhpaControlledB\x14\n" +
+	"\x12_cpu_request_coresB\x17\n" +
+	"\x15_memory_request_bytes\"\xaf\x02\n" +
+	"\x11ContainerSpecInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12*\n" +
+	"\x11cpu_request_cores\x18\x03 \x01(\x01R\x0fcpuRequestCores\x120\n" +
+	"\x14memory_request_bytes\x18\x04 \x01(\x03R\x12memoryRequestBytes\x12&\n" +
+	"\x0fcpu_limit_cores\x18\x05 \x01(\x01R\rcpuLimitCores\x12,\n" +
+	"\x12memory_limit_bytes\x18\x06 \x01(\x03R\x10memoryLimitBytes\x12\x1f\n" +
+	"\vgpu_request\x18\a \x01(\x03R\n" +
+	"gpuRequest\x12\x1b\n" +
+	"\tgpu_limit\x18\b \x01(\x03R\bgpuLimit\"u\n" +
+	"\x15WorkloadConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"p\n" +
+	"\x10JobConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xd4\x01\n" +
+	"\rHPAMetricInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rresource_name\x18\x02 \x01(\tR\fresourceName\x12%\n" +
+	"\x0econtainer_name\x18\x03 \x01(\tR\rcontainerName\x12\x1f\n" +
+	"\vmetric_name\x18\x04 \x01(\tR\n" +
+	"metricName\x12\x1f\n" +
+	"\vtarget_type\x18\x05 \x01(\tR\n" +
+	"targetType\x12!\n" +
+	"\ftarget_value\x18\x06 \x01(\tR\vtargetValue\"\xf6\x01\n" +
+	"\x14HPACurrentMetricInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rresource_name\x18\x02 \x01(\tR\fresourceName\x12#\n" +
+	"\rcurrent_value\x18\x03 \x01(\tR\fcurrentValue\x122\n" +
+	"\x15current_average_value\x18\x04 \x01(\tR\x13currentAverageValue\x124\n" +
+	"\x13current_utilization\x18\x05 \x01(\x05H\x00R\x12currentUtilization\x88\x01\x01B\x16\n" +
+	"\x14_current_utilization\"\xe3\x01\n" +
+	"\x12HPAScalingBehavior\x12E\n" +
+	"\x1cstabilization_window_seconds\x18\x01 \x01(\x05H\x00R\x1astabilizationWindowSeconds\x88\x01\x01\x12@\n" +
+	"\bpolicies\x18\x02 \x03(\v2$.kubeadapt.agent.v2.HPAScalingPolicyR\bpolicies\x12#\n" +
+	"\rselect_policy\x18\x03 \x01(\tR\fselectPolicyB\x1f\n" +
+	"\x1d_stabilization_window_seconds\"p\n" +
+	"\x10HPAConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xd6\x02\n" +
+	"\x1aVPAContainerRecommendation\x12%\n" +
+	"\x0econtainer_name\x18\x01 \x01(\tR\rcontainerName\x12C\n" +
+	"\vlower_bound\x18\x02 \x01(\v2\".kubeadapt.agent.v2.ResourceValuesR\n" +
+	"lowerBound\x12:\n" +
+	"\x06target\x18\x03 \x01(\v2\".kubeadapt.agent.v2.ResourceValuesR\x06target\x12K\n" +
+	"\x0funcapped_target\x18\x04 \x01(\v2\".kubeadapt.agent.v2.ResourceValuesR\x0euncappedTarget\x12C\n" +
+	"\vupper_bound\x18\x05 \x01(\v2\".kubeadapt.agent.v2.ResourceValuesR\n" +
+	"upperBound\"p\n" +
+	"\x10VPAConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"`\n" +
+	"\x18LabelSelectorRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"Y\n" +
+	"\x11WorkloadReference\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"p\n" +
+	"\x10PDBConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xed\x01\n" +
+	"\x10LoadBalancerInfo\x12A\n" +
+	"\aingress\x18\x01 \x03(\v2'.kubeadapt.agent.v2.LoadBalancerIngressR\aingress\x12\x14\n" +
+	"\x05class\x18\x02 \x01(\tR\x05class\x123\n" +
+	"\x16aws_load_balancer_type\x18\x03 \x01(\tR\x13awsLoadBalancerType\x12\x1d\n" +
+	"\n" +
+	"aws_scheme\x18\x04 \x01(\tR\tawsScheme\x12,\n" +
+	"\x12aws_arn_annotation\x18\x05 \x01(\tR\x10awsArnAnnotation\"\x93\x01\n" +
+	"\x0fServicePortInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vtarget_port\x18\x04 \x01(\tR\n" +
+	"targetPort\x12\x1b\n" +
+	"\tnode_port\x18\x05 \x01(\x05R\bnodePort\"`\n" +
+	"\x0fIngressRuleInfo\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x129\n" +
+	"\x05paths\x18\x02 \x03(\v2#.kubeadapt.agent.v2.IngressPathInfoR\x05paths\"G\n" +
+	"\x0eIngressTLSInfo\x12\x14\n" +
+	"\x05hosts\x18\x01 \x03(\tR\x05hosts\x12\x1f\n" +
+	"\vsecret_name\x18\x02 \x01(\tR\n" +
+	"secretName\"Z\n" +
+	"\x12IngressBackendInfo\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_port\x18\x02 \x01(\tR\vservicePort\"\x90\x02\n" +
+	"\fPVSourceInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"csi_driver\x18\x02 \x01(\tR\tcsiDriver\x12*\n" +
+	"\x11csi_volume_handle\x18\x03 \x01(\tR\x0fcsiVolumeHandle\x12\x1e\n" +
+	"\vcsi_fs_type\x18\x04 \x01(\tR\tcsiFsType\x12\"\n" +
+	"\raws_volume_id\x18\x05 \x01(\tR\vawsVolumeId\x12#\n" +
+	"\raws_partition\x18\x06 \x01(\x03R\fawsPartition\x12\x1d\n" +
+	"\n" +
+	"nfs_server\x18\a \x01(\tR\tnfsServer\x12\x19\n" +
+	"\bnfs_path\x18\b \x01(\tR\anfsPath\"T\n" +
+	"\x0ePVClaimRefInfo\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\tR\x03uid\"p\n" +
+	"\x10PVCConditionInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x93\x06\n" +
+	"\x12LimitRangeItemInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12M\n" +
+	"\adefault\x18\x02 \x03(\v23.kubeadapt.agent.v2.LimitRangeItemInfo.DefaultEntryR\adefault\x12c\n" +
+	"\x0fdefault_request\x18\x03 \x03(\v2:.kubeadapt.agent.v2.LimitRangeItemInfo.DefaultRequestEntryR\x0edefaultRequest\x12A\n" +
+	"\x03max\x18\x04 \x03(\v2/.kubeadapt.agent.v2.LimitRangeItemInfo.MaxEntryR\x03max\x12A\n" +
+	"\x03min\x18\x05 \x03(\v2/.kubeadapt.agent.v2.LimitRangeItemInfo.MinEntryR\x03min\x12w\n" +
+	"\x17max_limit_request_ratio\x18\x06 \x03(\v2@.kubeadapt.agent.v2.LimitRangeItemInfo.MaxLimitRequestRatioEntryR\x14maxLimitRequestRatio\x1a:\n" +
+	"\fDefaultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13DefaultRequestEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a6\n" +
+	"\bMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a6\n" +
+	"\bMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aG\n" +
+	"\x19MaxLimitRequestRatioEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"_\n" +
+	"\x17NodeSelectorRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x98\x02\n" +
+	"\rRedactionInfo\x12\x1f\n" +
+	"\vlabel_allow\x18\x01 \x03(\tR\n" +
+	"labelAllow\x12\x1d\n" +
+	"\n" +
+	"label_deny\x18\x02 \x03(\tR\tlabelDeny\x12)\n" +
+	"\x10annotation_allow\x18\x03 \x03(\tR\x0fannotationAllow\x12'\n" +
+	"\x0fannotation_deny\x18\x04 \x03(\tR\x0eannotationDeny\x12%\n" +
+	"\x0evalue_patterns\x18\x05 \x01(\x03R\rvaluePatterns\x12!\n" +
+	"\fnames_hashed\x18\x06 \x01(\bR\vnamesHashed\x12)\n" +
+	"\x10salt_fingerprint\x18\a \x01(\tR\x0fsaltFingerprint\"\x9d\x01\n" +
+	"\rCPUUsageStats\x12\x1b\n" +
+	"\tmin_cores\x18\x01 \x01(\x01R\bminCores\x12\x1b\n" +
+	"\tavg_cores\x18\x02 \x01(\x01R\bavgCores\x12\x1b\n" +
+	"\tmax_cores\x18\x03 \x01(\x01R\bmaxCores\x12\x1b\n" +
+	"\tp95_cores\x18\x04 \x01(\x01R\bp95Cores\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\x03R\asamples\"\xa0\x01\n" +
+	"\x10MemoryUsageStats\x12\x1b\n" +
+	"\tmin_bytes\x18\x01 \x01(\x03R\bminBytes\x12\x1b\n" +
+	"\tavg_bytes\x18\x02 \x01(\x03R\bavgBytes\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12\x1b\n" +
+	"\tp95_bytes\x18\x04 \x01(\x03R\bp95Bytes\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\x03R\asamples\"j\n" +
+	"\x11ContainerPortInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0econtainer_port\x18\x02 \x01(\x05R\rcontainerPort\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"c\n" +
+	"\x10HPAScalingPolicy\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\x12%\n" +
+	"\x0eperiod_seconds\x18\x03 \x01(\x05R\rperiodSeconds\"P\n" +
+	"\x0eResourceValues\x12\x1b\n" +
+	"\tcpu_cores\x18\x01 \x01(\x01R\bcpuCores\x12!\n" +
+	"\fmemory_bytes\x18\x02 \x01(\x03R\vmemoryBytes\"A\n" +
+	"\x13LoadBalancerIngress\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\"\x8e\x01\n" +
+	"\x0fIngressPathInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tpath_type\x18\x02 \x01(\tR\bpathType\x12'\n" +
+	"\x0fbackend_service\x18\x03 \x01(\tR\x0ebackendService\x12!\n" +
+	"\fbackend_port\x18\x04 \x01(\tR\vbackendPort\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06valuesB2Z0github.com/kubeadapt/kubeadapt-agent/pkg/modelpbb\x06proto3"
+
+var (
+	file_kubeadapt_agent_v2_snapshot_proto_rawDescOnce sync.Once
+	file_kubeadapt_agent_v2_snapshot_proto_rawDescData []byte
+)
+
+func file_kubeadapt_agent_v2_snapshot_proto_rawDescGZIP() []byte {
+	file_kubeadapt_agent_v2_snapshot_proto_rawDescOnce.Do(func() {
+		file_kubeadapt_agent_v2_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kubeadapt_agent_v2_snapshot_proto_rawDesc), len(file_kubeadapt_agent_v2_snapshot_proto_rawDesc)))
+	})
+	return file_kubeadapt_agent_v2_snapshot_proto_rawDescData
+}
+
+var file_kubeadapt_agent_v2_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
+var file_kubeadapt_agent_v2_snapshot_proto_goTypes = []any{
+	(*ClusterSnapshot)(nil),            // 0: kubeadapt.agent.v2.ClusterSnapshot
+	(*SnapshotDelta)(nil),              // 1: kubeadapt.agent.v2.SnapshotDelta
+	(*SnapshotPart)(nil),               // 2: kubeadapt.agent.v2.SnapshotPart
+	(*NodeInfo)(nil),                   // 3: kubeadapt.agent.v2.NodeInfo
+	(*PodInfo)(nil),                    // 4: kubeadapt.agent.v2.PodInfo
+	(*NamespaceInfo)(nil),              // 5: kubeadapt.agent.v2.NamespaceInfo
+	(*DeploymentInfo)(nil),             // 6: kubeadapt.agent.v2.DeploymentInfo
+	(*StatefulSetInfo)(nil),            // 7: kubeadapt.agent.v2.StatefulSetInfo
+	(*DaemonSetInfo)(nil),              // 8: kubeadapt.agent.v2.DaemonSetInfo
+	(*JobInfo)(nil),                    // 9: kubeadapt.agent.v2.JobInfo
+	(*CronJobInfo)(nil),                // 10: kubeadapt.agent.v2.CronJobInfo
+	(*CustomWorkloadInfo)(nil),         // 11: kubeadapt.agent.v2.CustomWorkloadInfo
+	(*HPAInfo)(nil),                    // 12: kubeadapt.agent.v2.HPAInfo
+	(*VPAInfo)(nil),                    // 13: kubeadapt.agent.v2.VPAInfo
+	(*PDBInfo)(nil),                    // 14: kubeadapt.agent.v2.PDBInfo
+	(*ServiceInfo)(nil),                // 15: kubeadapt.agent.v2.ServiceInfo
+	(*IngressInfo)(nil),                // 16: kubeadapt.agent.v2.IngressInfo
+	(*PVInfo)(nil),                     // 17: kubeadapt.agent.v2.PVInfo
+	(*PVCInfo)(nil),                    // 18: kubeadapt.agent.v2.PVCInfo
+	(*StorageClassInfo)(nil),           // 19: kubeadapt.agent.v2.StorageClassInfo
+	(*PriorityClassInfo)(nil),          // 20: kubeadapt.agent.v2.PriorityClassInfo
+	(*LimitRangeInfo)(nil),             // 21: kubeadapt.agent.v2.LimitRangeInfo
+	(*ResourceQuotaInfo)(nil),          // 22: kubeadapt.agent.v2.ResourceQuotaInfo
+	(*NodePoolInfo)(nil),               // 23: kubeadapt.agent.v2.NodePoolInfo
+	(*EventInfo)(nil),                  // 24: kubeadapt.agent.v2.EventInfo
+	(*ClusterSummary)(nil),             // 25: kubeadapt.agent.v2.ClusterSummary
+	(*AgentHealth)(nil),                // 26: kubeadapt.agent.v2.AgentHealth
+	(*GPUDeviceInfo)(nil),              // 27: kubeadapt.agent.v2.GPUDeviceInfo
+	(*GPUIdleInfo)(nil),                // 28: kubeadapt.agent.v2.GPUIdleInfo
+	(*TaintInfo)(nil),                  // 29: kubeadapt.agent.v2.TaintInfo
+	(*NodeConditionInfo)(nil),          // 30: kubeadapt.agent.v2.NodeConditionInfo
+	(*ContainerInfo)(nil),              // 31: kubeadapt.agent.v2.ContainerInfo
+	(*PodVolumeInfo)(nil),              // 32: kubeadapt.agent.v2.PodVolumeInfo
+	(*PodConditionInfo)(nil),           // 33: kubeadapt.agent.v2.PodConditionInfo
+	(*ResourceRecommendation)(nil),     // 34: kubeadapt.agent.v2.ResourceRecommendation
+	(*ContainerSpecInfo)(nil),          // 35: kubeadapt.agent.v2.ContainerSpecInfo
+	(*WorkloadConditionInfo)(nil),      // 36: kubeadapt.agent.v2.WorkloadConditionInfo
+	(*JobConditionInfo)(nil),           // 37: kubeadapt.agent.v2.JobConditionInfo
+	(*HPAMetricInfo)(nil),              // 38: kubeadapt.agent.v2.HPAMetricInfo
+	(*HPACurrentMetricInfo)(nil),       // 39: kubeadapt.agent.v2.HPACurrentMetricInfo
+	(*HPAScalingBehavior)(nil),         // 40: kubeadapt.agent.v2.HPAScalingBehavior
+	(*HPAConditionInfo)(nil),           // 41: kubeadapt.agent.v2.HPAConditionInfo
+	(*VPAContainerRecommendation)(nil), // 42: kubeadapt.agent.v2.VPAContainerRecommendation
+	(*VPAConditionInfo)(nil),           // 43: kubeadapt.agent.v2.VPAConditionInfo
+	(*LabelSelectorRequirement)(nil),   // 44: kubeadapt.agent.v2.LabelSelectorRequirement
+	(*WorkloadReference)(nil),          // 45: kubeadapt.agent.v2.WorkloadReference
+	(*PDBConditionInfo)(nil),           // 46: kubeadapt.agent.v2.PDBConditionInfo
+	(*LoadBalancerInfo)(nil),           // 47: kubeadapt.agent.v2.LoadBalancerInfo
+	(*ServicePortInfo)(nil),            // 48: kubeadapt.agent.v2.ServicePortInfo
+	(*IngressRuleInfo)(nil),            // 49: kubeadapt.agent.v2.IngressRuleInfo
+	(*IngressTLSInfo)(nil),             // 50: kubeadapt.agent.v2.IngressTLSInfo
+	(*IngressBackendInfo)(nil),         // 51: kubeadapt.agent.v2.IngressBackendInfo
+	(*PVSourceInfo)(nil),               // 52: kubeadapt.agent.v2.PVSourceInfo
+	(*PVClaimRefInfo)(nil),             // 53: kubeadapt.agent.v2.PVClaimRefInfo
+	(*PVCConditionInfo)(nil),           // 54: kubeadapt.agent.v2.PVCConditionInfo
+	(*LimitRangeItemInfo)(nil),         // 55: kubeadapt.agent.v2.LimitRangeItemInfo
+	(*NodeSelectorRequirement)(nil),    // 56: kubeadapt.agent.v2.NodeSelectorRequirement
+	(*RedactionInfo)(nil),              // 57: kubeadapt.agent.v2.RedactionInfo
+	(*CPUUsageStats)(nil),              // 58: kubeadapt.agent.v2.CPUUsageStats
+	(*MemoryUsageStats)(nil),           // 59: kubeadapt.agent.v2.MemoryUsageStats
+	(*ContainerPortInfo)(nil),          // 60: kubeadapt.agent.v2.ContainerPortInfo
+	(*HPAScalingPolicy)(nil),           // 61: kubeadapt.agent.v2.HPAScalingPolicy
+	(*ResourceValues)(nil),             // 62: kubeadapt.agent.v2.ResourceValues
+	(*LoadBalancerIngress)(nil),        // 63: kubeadapt.agent.v2.LoadBalancerIngress
+	(*IngressPathInfo)(nil),            // 64: kubeadapt.agent.v2.IngressPathInfo
+	(*StringList)(nil),                 // 65: kubeadapt.agent.v2.StringList
+	nil,                                // 66: kubeadapt.agent.v2.SnapshotDelta.AddedEntry
+	nil,                                // 67: kubeadapt.agent.v2.SnapshotDelta.UpdatedEntry
+	nil,                                // 68: kubeadapt.agent.v2.SnapshotDelta.DeletedEntry
+	nil,                                // 69: kubeadapt.agent.v2.NodeInfo.MigDevicesEntry
+	nil,                                // 70: kubeadapt.agent.v2.NodeInfo.EventReasonsEntry
+	nil,                                // 71: kubeadapt.agent.v2.NodeInfo.LabelsEntry
+	nil,                                // 72: kubeadapt.agent.v2.NodeInfo.AnnotationsEntry
+	nil,                                // 73: kubeadapt.agent.v2.PodInfo.LabelsEntry
+	nil,                                // 74: kubeadapt.agent.v2.PodInfo.AnnotationsEntry
+	nil,                                // 75: kubeadapt.agent.v2.PodInfo.EventReasonsEntry
+	nil,                                // 76: kubeadapt.agent.v2.NamespaceInfo.LabelsEntry
+	nil,                                // 77: kubeadapt.agent.v2.NamespaceInfo.AnnotationsEntry
+	nil,                                // 78: kubeadapt.agent.v2.DeploymentInfo.SelectorEntry
+	nil,                                // 79: kubeadapt.agent.v2.DeploymentInfo.LabelsEntry
+	nil,                                // 80: kubeadapt.agent.v2.DeploymentInfo.AnnotationsEntry
+	nil,                                // 81: kubeadapt.agent.v2.DeploymentInfo.EventReasonsEntry
+	nil,                                // 82: kubeadapt.agent.v2.StatefulSetInfo.SelectorEntry
+	nil,                                // 83: kubeadapt.agent.v2.StatefulSetInfo.LabelsEntry
+	nil,                                // 84: kubeadapt.agent.v2.StatefulSetInfo.AnnotationsEntry
+	nil,                                // 85: kubeadapt.agent.v2.StatefulSetInfo.EventReasonsEntry
+	nil,                                // 86: kubeadapt.agent.v2.DaemonSetInfo.SelectorEntry
+	nil,                                // 87: kubeadapt.agent.v2.DaemonSetInfo.LabelsEntry
+	nil,                                // 88: kubeadapt.agent.v2.DaemonSetInfo.AnnotationsEntry
+	nil,                                // 89: kubeadapt.agent.v2.DaemonSetInfo.EventReasonsEntry
+	nil,                                // 90: kubeadapt.agent.v2.JobInfo.LabelsEntry
+	nil,                                // 91: kubeadapt.agent.v2.JobInfo.AnnotationsEntry
+	nil,                                // 92: kubeadapt.agent.v2.JobInfo.EventReasonsEntry
+	nil,                                // 93: kubeadapt.agent.v2.CronJobInfo.LabelsEntry
+	nil,                                // 94: kubeadapt.agent.v2.CronJobInfo.AnnotationsEntry
+	nil,                                // 95: kubeadapt.agent.v2.CronJobInfo.EventReasonsEntry
+	nil,                                // 96: kubeadapt.agent.v2.CustomWorkloadInfo.LabelsEntry
+	nil,                                // 97: kubeadapt.agent.v2.CustomWorkloadInfo.AnnotationsEntry
+	nil,                                // 98: kubeadapt.agent.v2.CustomWorkloadInfo.EventReasonsEntry
+	nil,                                // 99: kubeadapt.agent.v2.HPAInfo.LabelsEntry
+	nil,                                // 100: kubeadapt.agent.v2.HPAInfo.AnnotationsEntry
+	nil,                                // 101: kubeadapt.agent.v2.VPAInfo.LabelsEntry
+	nil,                                // 102: kubeadapt.agent.v2.PDBInfo.MatchLabelsEntry
+	nil,                                // 103: kubeadapt.agent.v2.PDBInfo.LabelsEntry
+	nil,                                // 104: kubeadapt.agent.v2.PDBInfo.AnnotationsEntry
+	nil,                                // 105: kubeadapt.agent.v2.ServiceInfo.SelectorEntry
+	nil,                                // 106: kubeadapt.agent.v2.ServiceInfo.LabelsEntry
+	nil,                                // 107: kubeadapt.agent.v2.ServiceInfo.AnnotationsEntry
+	nil,                                // 108: kubeadapt.agent.v2.IngressInfo.LabelsEntry
+	nil,                                // 109: kubeadapt.agent.v2.IngressInfo.AnnotationsEntry
+	nil,                                // 110: kubeadapt.agent.v2.PVInfo.LabelsEntry
+	nil,                                // 111: kubeadapt.agent.v2.PVInfo.AnnotationsEntry
+	nil,                                // 112: kubeadapt.agent.v2.PVCInfo.LabelsEntry
+	nil,                                // 113: kubeadapt.agent.v2.PVCInfo.AnnotationsEntry
+	nil,                                // 114: kubeadapt.agent.v2.StorageClassInfo.ParametersEntry
+	nil,                                // 115: kubeadapt.agent.v2.StorageClassInfo.LabelsEntry
+	nil,                                // 116: kubeadapt.agent.v2.StorageClassInfo.AnnotationsEntry
+	nil,                                // 117: kubeadapt.agent.v2.ResourceQuotaInfo.HardEntry
+	nil,                                // 118: kubeadapt.agent.v2.ResourceQuotaInfo.UsedEntry
+	nil,                                // 119: kubeadapt.agent.v2.ResourceQuotaInfo.LabelsEntry
+	nil,                                // 120: kubeadapt.agent.v2.NodePoolInfo.LabelsEntry
+	nil,                                // 121: kubeadapt.agent.v2.NodePoolInfo.AnnotationsEntry
+	nil,                                // 122: kubeadapt.agent.v2.LimitRangeItemInfo.DefaultEntry
+	nil,                                // 123: kubeadapt.agent.v2.LimitRangeItemInfo.DefaultRequestEntry
+	nil,                                // 124: kubeadapt.agent.v2.LimitRangeItemInfo.MaxEntry
+	nil,                                // 125: kubeadapt.agent.v2.LimitRangeItemInfo.MinEntry
+	nil,                                // 126: kubeadapt.agent.v2.LimitRangeItemInfo.MaxLimitRequestRatioEntry
+	(*structpb.Struct)(nil),            // 127: google.protobuf.Struct
+}
+var file_kubeadapt_agent_v2_snapshot_proto_depIdxs = []int32{
+	1,   // 0: kubeadapt.agent.v2.ClusterSnapshot.delta:type_name -> kubeadapt.agent.v2.SnapshotDelta
+	2,   // 1: kubeadapt.agent.v2.ClusterSnapshot.part:type_name -> kubeadapt.agent.v2.SnapshotPart
+	3,   // 2: kubeadapt.agent.v2.ClusterSnapshot.nodes:type_name -> kubeadapt.agent.v2.NodeInfo
+	4,   // 3: kubeadapt.agent.v2.ClusterSnapshot.pods:type_name -> kubeadapt.agent.v2.PodInfo
+	5,   // 4: kubeadapt.agent.v2.ClusterSnapshot.namespaces:type_name -> kubeadapt.agent.v2.NamespaceInfo
+	6,   // 5: kubeadapt.agent.v2.ClusterSnapshot.deployments:type_name -> kubeadapt.agent.v2.DeploymentInfo
+	7,   // 6: kubeadapt.agent.v2.ClusterSnapshot.statefulsets:type_name -> kubeadapt.agent.v2.StatefulSetInfo
+	8,   // 7: kubeadapt.agent.v2.ClusterSnapshot.daemonsets:type_name -> kubeadapt.agent.v2.DaemonSetInfo
+	9,   // 8: kubeadapt.agent.v2.ClusterSnapshot.jobs:type_name -> kubeadapt.agent.v2.JobInfo
+	10,  // 9: kubeadapt.agent.v2.ClusterSnapshot.cronjobs:type_name -> kubeadapt.agent.v2.CronJobInfo
+	11,  // 10: kubeadapt.agent.v2.ClusterSnapshot.custom_workloads:type_name -> kubeadapt.agent.v2.CustomWorkloadInfo
+	12,  // 11: kubeadapt.agent.v2.ClusterSnapshot.hpas:type_name -> kubeadapt.agent.v2.HPAInfo
+	13,  // 12: kubeadapt.agent.v2.ClusterSnapshot.vpas:type_name -> kubeadapt.agent.v2.VPAInfo
+	14,  // 13: kubeadapt.agent.v2.ClusterSnapshot.pdbs:type_name -> kubeadapt.agent.v2.PDBInfo
+	15,  // 14: kubeadapt.agent.v2.ClusterSnapshot.services:type_name -> kubeadapt.agent.v2.ServiceInfo
+	16,  // 15: kubeadapt.agent.v2.ClusterSnapshot.ingresses:type_name -> kubeadapt.agent.v2.IngressInfo
+	17,  // 16: kubeadapt.agent.v2.ClusterSnapshot.pvs:type_name -> kubeadapt.agent.v2.PVInfo
+	18,  // 17: kubeadapt.agent.v2.ClusterSnapshot.pvcs:type_name -> kubeadapt.agent.v2.PVCInfo
+	19,  // 18: kubeadapt.agent.v2.ClusterSnapshot.storage_classes:type_name -> kubeadapt.agent.v2.StorageClassInfo
+	20,  // 19: kubeadapt.agent.v2.ClusterSnapshot.priority_classes:type_name -> kubeadapt.agent.v2.PriorityClassInfo
+	21,  // 20: kubeadapt.agent.v2.ClusterSnapshot.limit_ranges:type_name -> kubeadapt.agent.v2.LimitRangeInfo
+	22,  // 21: kubeadapt.agent.v2.ClusterSnapshot.resource_quotas:type_name -> kubeadapt.agent.v2.ResourceQuotaInfo
+	23,  // 22: kubeadapt.agent.v2.ClusterSnapshot.node_pools:type_name -> kubeadapt.agent.v2.NodePoolInfo
+	24,  // 23: kubeadapt.agent.v2.ClusterSnapshot.events:type_name -> kubeadapt.agent.v2.EventInfo
+	25,  // 24: kubeadapt.agent.v2.ClusterSnapshot.summary:type_name -> kubeadapt.agent.v2.ClusterSummary
+	26,  // 25: kubeadapt.agent.v2.ClusterSnapshot.health:type_name -> kubeadapt.agent.v2.AgentHealth
+	66,  // 26: kubeadapt.agent.v2.SnapshotDelta.added:type_name -> kubeadapt.agent.v2.SnapshotDelta.AddedEntry
+	67,  // 27: kubeadapt.agent.v2.SnapshotDelta.updated:type_name -> kubeadapt.agent.v2.SnapshotDelta.UpdatedEntry
+	68,  // 28: kubeadapt.agent.v2.SnapshotDelta.deleted:type_name -> kubeadapt.agent.v2.SnapshotDelta.DeletedEntry
+	69,  // 29: kubeadapt.agent.v2.NodeInfo.mig_devices:type_name -> kubeadapt.agent.v2.NodeInfo.MigDevicesEntry
+	27,  // 30: kubeadapt.agent.v2.NodeInfo.gpu_devices:type_name -> kubeadapt.agent.v2.GPUDeviceInfo
+	28,  // 31: kubeadapt.agent.v2.NodeInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	29,  // 32: kubeadapt.agent.v2.NodeInfo.taints:type_name -> kubeadapt.agent.v2.TaintInfo
+	30,  // 33: kubeadapt.agent.v2.NodeInfo.conditions:type_name -> kubeadapt.agent.v2.NodeConditionInfo
+	70,  // 34: kubeadapt.agent.v2.NodeInfo.event_reasons:type_name -> kubeadapt.agent.v2.NodeInfo.EventReasonsEntry
+	71,  // 35: kubeadapt.agent.v2.NodeInfo.labels:type_name -> kubeadapt.agent.v2.NodeInfo.LabelsEntry
+	72,  // 36: kubeadapt.agent.v2.NodeInfo.annotations:type_name -> kubeadapt.agent.v2.NodeInfo.AnnotationsEntry
+	31,  // 37: kubeadapt.agent.v2.PodInfo.containers:type_name -> kubeadapt.agent.v2.ContainerInfo
+	31,  // 38: kubeadapt.agent.v2.PodInfo.init_containers:type_name -> kubeadapt.agent.v2.ContainerInfo
+	73,  // 39: kubeadapt.agent.v2.PodInfo.labels:type_name -> kubeadapt.agent.v2.PodInfo.LabelsEntry
+	74,  // 40: kubeadapt.agent.v2.PodInfo.annotations:type_name -> kubeadapt.agent.v2.PodInfo.AnnotationsEntry
+	32,  // 41: kubeadapt.agent.v2.PodInfo.volumes:type_name -> kubeadapt.agent.v2.PodVolumeInfo
+	33,  // 42: kubeadapt.agent.v2.PodInfo.conditions:type_name -> kubeadapt.agent.v2.PodConditionInfo
+	75,  // 43: kubeadapt.agent.v2.PodInfo.event_reasons:type_name -> kubeadapt.agent.v2.PodInfo.EventReasonsEntry
+	76,  // 44: kubeadapt.agent.v2.NamespaceInfo.labels:type_name -> kubeadapt.agent.v2.NamespaceInfo.LabelsEntry
+	77,  // 45: kubeadapt.agent.v2.NamespaceInfo.annotations:type_name -> kubeadapt.agent.v2.NamespaceInfo.AnnotationsEntry
+	28,  // 46: kubeadapt.agent.v2.DeploymentInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	34,  // 47: kubeadapt.agent.v2.DeploymentInfo.recommendations:type_name -> kubeadapt.agent.v2.ResourceRecommendation
+	35,  // 48: kubeadapt.agent.v2.DeploymentInfo.container_specs:type_name -> kubeadapt.agent.v2.ContainerSpecInfo
+	78,  // 49: kubeadapt.agent.v2.DeploymentInfo.selector:type_name -> kubeadapt.agent.v2.DeploymentInfo.SelectorEntry
+	79,  // 50: kubeadapt.agent.v2.DeploymentInfo.labels:type_name -> kubeadapt.agent.v2.DeploymentInfo.LabelsEntry
+	80,  // 51: kubeadapt.agent.v2.DeploymentInfo.annotations:type_name -> kubeadapt.agent.v2.DeploymentInfo.AnnotationsEntry
+	36,  // 52: kubeadapt.agent.v2.DeploymentInfo.conditions:type_name -> kubeadapt.agent.v2.WorkloadConditionInfo
+	81,  // 53: kubeadapt.agent.v2.DeploymentInfo.event_reasons:type_name -> kubeadapt.agent.v2.DeploymentInfo.EventReasonsEntry
+	28,  // 54: kubeadapt.agent.v2.StatefulSetInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	34,  // 55: kubeadapt.agent.v2.StatefulSetInfo.recommendations:type_name -> kubeadapt.agent.v2.ResourceRecommendation
+	35,  // 56: kubeadapt.agent.v2.StatefulSetInfo.container_specs:type_name -> kubeadapt.agent.v2.ContainerSpecInfo
+	82,  // 57: kubeadapt.agent.v2.StatefulSetInfo.selector:type_name -> kubeadapt.agent.v2.StatefulSetInfo.SelectorEntry
+	83,  // 58: kubeadapt.agent.v2.StatefulSetInfo.labels:type_name -> kubeadapt.agent.v2.StatefulSetInfo.LabelsEntry
+	84,  // 59: kubeadapt.agent.v2.StatefulSetInfo.annotations:type_name -> kubeadapt.agent.v2.StatefulSetInfo.AnnotationsEntry
+	36,  // 60: kubeadapt.agent.v2.StatefulSetInfo.conditions:type_name -> kubeadapt.agent.v2.WorkloadConditionInfo
+	85,  // 61: kubeadapt.agent.v2.StatefulSetInfo.event_reasons:type_name -> kubeadapt.agent.v2.StatefulSetInfo.EventReasonsEntry
+	28,  // 62: kubeadapt.agent.v2.DaemonSetInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	34,  // 63: kubeadapt.agent.v2.DaemonSetInfo.recommendations:type_name -> kubeadapt.agent.v2.ResourceRecommendation
+	35,  // 64: kubeadapt.agent.v2.DaemonSetInfo.container_specs:type_name -> kubeadapt.agent.v2.ContainerSpecInfo
+	86,  // 65: kubeadapt.agent.v2.DaemonSetInfo.selector:type_name -> kubeadapt.agent.v2.DaemonSetInfo.SelectorEntry
+	87,  // 66: kubeadapt.agent.v2.DaemonSetInfo.labels:type_name -> kubeadapt.agent.v2.DaemonSetInfo.LabelsEntry
+	88,  // 67: kubeadapt.agent.v2.DaemonSetInfo.annotations:type_name -> kubeadapt.agent.v2.DaemonSetInfo.AnnotationsEntry
+	36,  // 68: kubeadapt.agent.v2.DaemonSetInfo.conditions:type_name -> kubeadapt.agent.v2.WorkloadConditionInfo
+	89,  // 69: kubeadapt.agent.v2.DaemonSetInfo.event_reasons:type_name -> kubeadapt.agent.v2.DaemonSetInfo.EventReasonsEntry
+	28,  // 70: kubeadapt.agent.v2.JobInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	35,  // 71: kubeadapt.agent.v2.JobInfo.container_specs:type_name -> kubeadapt.agent.v2.ContainerSpecInfo
+	90,  // 72: kubeadapt.agent.v2.JobInfo.labels:type_name -> kubeadapt.agent.v2.JobInfo.LabelsEntry
+	91,  // 73: kubeadapt.agent.v2.JobInfo.annotations:type_name -> kubeadapt.agent.v2.JobInfo.AnnotationsEntry
+	37,  // 74: kubeadapt.agent.v2.JobInfo.conditions:type_name -> kubeadapt.agent.v2.JobConditionInfo
+	92,  // 75: kubeadapt.agent.v2.JobInfo.event_reasons:type_name -> kubeadapt.agent.v2.JobInfo.EventReasonsEntry
+	28,  // 76: kubeadapt.agent.v2.CronJobInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	35,  // 77: kubeadapt.agent.v2.CronJobInfo.container_specs:type_name -> kubeadapt.agent.v2.ContainerSpecInfo
+	93,  // 78: kubeadapt.agent.v2.CronJobInfo.labels:type_name -> kubeadapt.agent.v2.CronJobInfo.LabelsEntry
+	94,  // 79: kubeadapt.agent.v2.CronJobInfo.annotations:type_name -> kubeadapt.agent.v2.CronJobInfo.AnnotationsEntry
+	95,  // 80: kubeadapt.agent.v2.CronJobInfo.event_reasons:type_name -> kubeadapt.agent.v2.CronJobInfo.EventReasonsEntry
+	127, // 81: kubeadapt.agent.v2.CustomWorkloadInfo.status:type_name -> google.protobuf.Struct
+	28,  // 82: kubeadapt.agent.v2.CustomWorkloadInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	96,  // 83: kubeadapt.agent.v2.CustomWorkloadInfo.labels:type_name -> kubeadapt.agent.v2.CustomWorkloadInfo.LabelsEntry
+	97,  // 84: kubeadapt.agent.v2.CustomWorkloadInfo.annotations:type_name -> kubeadapt.agent.v2.CustomWorkloadInfo.AnnotationsEntry
+	98,  // 85: kubeadapt.agent.v2.CustomWorkloadInfo.event_reasons:type_name -> kubeadapt.agent.v2.CustomWorkloadInfo.EventReasonsEntry
+	38,  // 86: kubeadapt.agent.v2.HPAInfo.metrics:type_name -> kubeadapt.agent.v2.HPAMetricInfo
+	39,  // 87: kubeadapt.agent.v2.HPAInfo.current_metrics:type_name -> kubeadapt.agent.v2.HPACurrentMetricInfo
+	40,  // 88: kubeadapt.agent.v2.HPAInfo.scale_up_behavior:type_name -> kubeadapt.agent.v2.HPAScalingBehavior
+	40,  // 89: kubeadapt.agent.v2.HPAInfo.scale_down_behavior:type_name -> kubeadapt.agent.v2.HPAScalingBehavior
+	41,  // 90: kubeadapt.agent.v2.HPAInfo.conditions:type_name -> kubeadapt.agent.v2.HPAConditionInfo
+	99,  // 91: kubeadapt.agent.v2.HPAInfo.labels:type_name -> kubeadapt.agent.v2.HPAInfo.LabelsEntry
+	100, // 92: kubeadapt.agent.v2.HPAInfo.annotations:type_name -> kubeadapt.agent.v2.HPAInfo.AnnotationsEntry
+	42,  // 93: kubeadapt.agent.v2.VPAInfo.container_recommendations:type_name -> kubeadapt.agent.v2.VPAContainerRecommendation
+	43,  // 94: kubeadapt.agent.v2.VPAInfo.conditions:type_name -> kubeadapt.agent.v2.VPAConditionInfo
+	101, // 95: kubeadapt.agent.v2.VPAInfo.labels:type_name -> kubeadapt.agent.v2.VPAInfo.LabelsEntry
+	102, // 96: kubeadapt.agent.v2.PDBInfo.match_labels:type_name -> kubeadapt.agent.v2.PDBInfo.MatchLabelsEntry
+	44,  // 97: kubeadapt.agent.v2.PDBInfo.match_expressions:type_name -> kubeadapt.agent.v2.LabelSelectorRequirement
+	45,  // 98: kubeadapt.agent.v2.PDBInfo.target_workloads:type_name -> kubeadapt.agent.v2.WorkloadReference
+	46,  // 99: kubeadapt.agent.v2.PDBInfo.conditions:type_name -> kubeadapt.agent.v2.PDBConditionInfo
+	103, // 100: kubeadapt.agent.v2.PDBInfo.labels:type_name -> kubeadapt.agent.v2.PDBInfo.LabelsEntry
+	104, // 101: kubeadapt.agent.v2.PDBInfo.annotations:type_name -> kubeadapt.agent.v2.PDBInfo.AnnotationsEntry
+	47,  // 102: kubeadapt.agent.v2.ServiceInfo.load_balancer:type_name -> kubeadapt.agent.v2.LoadBalancerInfo
+	48,  // 103: kubeadapt.agent.v2.ServiceInfo.ports:type_name -> kubeadapt.agent.v2.ServicePortInfo
+	105, // 104: kubeadapt.agent.v2.ServiceInfo.selector:type_name -> kubeadapt.agent.v2.ServiceInfo.SelectorEntry
+	45,  // 105: kubeadapt.agent.v2.ServiceInfo.target_workloads:type_name -> kubeadapt.agent.v2.WorkloadReference
+	106, // 106: kubeadapt.agent.v2.ServiceInfo.labels:type_name -> kubeadapt.agent.v2.ServiceInfo.LabelsEntry
+	107, // 107: kubeadapt.agent.v2.ServiceInfo.annotations:type_name -> kubeadapt.agent.v2.ServiceInfo.AnnotationsEntry
+	49,  // 108: kubeadapt.agent.v2.IngressInfo.rules:type_name -> kubeadapt.agent.v2.IngressRuleInfo
+	50,  // 109: kubeadapt.agent.v2.IngressInfo.tls:type_name -> kubeadapt.agent.v2.IngressTLSInfo
+	51,  // 110: kubeadapt.agent.v2.IngressInfo.default_backend:type_name -> kubeadapt.agent.v2.IngressBackendInfo
+	108, // 111: kubeadapt.agent.v2.IngressInfo.labels:type_name -> kubeadapt.agent.v2.IngressInfo.LabelsEntry
+	109, // 112: kubeadapt.agent.v2.IngressInfo.annotations:type_name -> kubeadapt.agent.v2.IngressInfo.AnnotationsEntry
+	52,  // 113: kubeadapt.agent.v2.PVInfo.source:type_name -> kubeadapt.agent.v2.PVSourceInfo
+	53,  // 114: kubeadapt.agent.v2.PVInfo.claim_ref:type_name -> kubeadapt.agent.v2.PVClaimRefInfo
+	110, // 115: kubeadapt.agent.v2.PVInfo.labels:type_name -> kubeadapt.agent.v2.PVInfo.LabelsEntry
+	111, // 116: kubeadapt.agent.v2.PVInfo.annotations:type_name -> kubeadapt.agent.v2.PVInfo.AnnotationsEntry
+	112, // 117: kubeadapt.agent.v2.PVCInfo.labels:type_name -> kubeadapt.agent.v2.PVCInfo.LabelsEntry
+	113, // 118: kubeadapt.agent.v2.PVCInfo.annotations:type_name -> kubeadapt.agent.v2.PVCInfo.AnnotationsEntry
+	54,  // 119: kubeadapt.agent.v2.PVCInfo.conditions:type_name -> kubeadapt.agent.v2.PVCConditionInfo
+	114, // 120: kubeadapt.agent.v2.StorageClassInfo.parameters:type_name -> kubeadapt.agent.v2.StorageClassInfo.ParametersEntry
+	115, // 121: kubeadapt.agent.v2.StorageClassInfo.labels:type_name -> kubeadapt.agent.v2.StorageClassInfo.LabelsEntry
+	116, // 122: kubeadapt.agent.v2.StorageClassInfo.annotations:type_name -> kubeadapt.agent.v2.StorageClassInfo.AnnotationsEntry
+	55,  // 123: kubeadapt.agent.v2.LimitRangeInfo.limits:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo
+	117, // 124: kubeadapt.agent.v2.ResourceQuotaInfo.hard:type_name -> kubeadapt.agent.v2.ResourceQuotaInfo.HardEntry
+	118, // 125: kubeadapt.agent.v2.ResourceQuotaInfo.used:type_name -> kubeadapt.agent.v2.ResourceQuotaInfo.UsedEntry
+	119, // 126: kubeadapt.agent.v2.ResourceQuotaInfo.labels:type_name -> kubeadapt.agent.v2.ResourceQuotaInfo.LabelsEntry
+	120, // 127: kubeadapt.agent.v2.NodePoolInfo.labels:type_name -> kubeadapt.agent.v2.NodePoolInfo.LabelsEntry
+	121, // 128: kubeadapt.agent.v2.NodePoolInfo.annotations:type_name -> kubeadapt.agent.v2.NodePoolInfo.AnnotationsEntry
+	29,  // 129: kubeadapt.agent.v2.NodePoolInfo.taints:type_name -> kubeadapt.agent.v2.TaintInfo
+	56,  // 130: kubeadapt.agent.v2.NodePoolInfo.requirements:type_name -> kubeadapt.agent.v2.NodeSelectorRequirement
+	57,  // 131: kubeadapt.agent.v2.AgentHealth.redaction:type_name -> kubeadapt.agent.v2.RedactionInfo
+	28,  // 132: kubeadapt.agent.v2.GPUDeviceInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	58,  // 133: kubeadapt.agent.v2.ContainerInfo.cpu_usage_stats:type_name -> kubeadapt.agent.v2.CPUUsageStats
+	59,  // 134: kubeadapt.agent.v2.ContainerInfo.memory_usage_stats:type_name -> kubeadapt.agent.v2.MemoryUsageStats
+	28,  // 135: kubeadapt.agent.v2.ContainerInfo.gpu_idle:type_name -> kubeadapt.agent.v2.GPUIdleInfo
+	60,  // 136: kubeadapt.agent.v2.ContainerInfo.ports:type_name -> kubeadapt.agent.v2.ContainerPortInfo
+	61,  // 137: kubeadapt.agent.v2.HPAScalingBehavior.policies:type_name -> kubeadapt.agent.v2.HPAScalingPolicy
+	62,  // 138: kubeadapt.agent.v2.VPAContainerRecommendation.lower_bound:type_name -> kubeadapt.agent.v2.ResourceValues
+	62,  // 139: kubeadapt.agent.v2.VPAContainerRecommendation.target:type_name -> kubeadapt.agent.v2.ResourceValues
+	62,  // 140: kubeadapt.agent.v2.VPAContainerRecommendation.uncapped_target:type_name -> kubeadapt.agent.v2.ResourceValues
+	62,  // 141: kubeadapt.agent.v2.VPAContainerRecommendation.upper_bound:type_name -> kubeadapt.agent.v2.ResourceValues
+	63,  // 142: kubeadapt.agent.v2.LoadBalancerInfo.ingress:type_name -> kubeadapt.agent.v2.LoadBalancerIngress
+	64,  // 143: kubeadapt.agent.v2.IngressRuleInfo.paths:type_name -> kubeadapt.agent.v2.IngressPathInfo
+	122, // 144: kubeadapt.agent.v2.LimitRangeItemInfo.default:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo.DefaultEntry
+	123, // 145: kubeadapt.agent.v2.LimitRangeItemInfo.default_request:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo.DefaultRequestEntry
+	124, // 146: kubeadapt.agent.v2.LimitRangeItemInfo.max:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo.MaxEntry
+	125, // 147: kubeadapt.agent.v2.LimitRangeItemInfo.min:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo.MinEntry
+	126, // 148: kubeadapt.agent.v2.LimitRangeItemInfo.max_limit_request_ratio:type_name -> kubeadapt.agent.v2.LimitRangeItemInfo.MaxLimitRequestRatioEntry
+	65,  // 149: kubeadapt.agent.v2.SnapshotDelta.AddedEntry.value:type_name -> kubeadapt.agent.v2.StringList
+	65,  // 150: kubeadapt.agent.v2.SnapshotDelta.UpdatedEntry.value:type_name -> kubeadapt.agent.v2.StringList
+	65,  // 151: kubeadapt.agent.v2.SnapshotDelta.DeletedEntry.value:type_name -> kubeadapt.agent.v2.StringList
+	152, // [152:152] is the sub-list for method output_type
+	152, // [152:152] is the sub-list for method input_type
+	152, // [152:152] is the sub-list for extension type_name
+	152, // [152:152] is the sub-list for extension extendee
+	0,   // [0:152] is the sub-list for field type_name
+}
+
+func init() { file_kubeadapt_agent_v2_snapshot_proto_init() }
+func file_kubeadapt_agent_v2_snapshot_proto_init() {
+	if File_kubeadapt_agent_v2_snapshot_proto != nil {
+		return
+	}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[3].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[4].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[6].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[7].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[8].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[9].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[10].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[11].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[12].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[18].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[23].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[25].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[27].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[28].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[31].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[34].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[39].OneofWrappers = []any{}
+	file_kubeadapt_agent_v2_snapshot_proto_msgTypes[40].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kubeadapt_agent_v2_snapshot_proto_rawDesc), len(file_kubeadapt_agent_v2_snapshot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   127,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kubeadapt_agent_v2_snapshot_proto_goTypes,
+		DependencyIndexes: file_kubeadapt_agent_v2_snapshot_proto_depIdxs,
+		MessageInfos:      file_kubeadapt_agent_v2_snapshot_proto_msgTypes,
+	}.Build()
+	File_kubeadapt_agent_v2_snapshot_proto = out.File
+	file_kubeadapt_agent_v2_snapshot_proto_goTypes = nil
+	file_kubeadapt_agent_v2_snapshot_proto_depIdxs = nil
+}
@@ -137,6 +137,8 @@ func (s *Stub) handleIngest(w http.ResponseWriter, r *http.Request) {
 			"is_within_quota":   true,
 			"cluster_cpu":       0,
 		},
+		// The collect_* flags are the constant false of backends without
+		// collector toggles; the agent takes them as its baseline.
 		"directives": map[string]interface{}{
 			"next_snapshot_in_seconds": 10,
 			"collect_vpas":             false,